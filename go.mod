@@ -23,6 +23,7 @@ require (
 	github.com/gorilla/mux v1.8.1
 	github.com/hobbyfarm/gargantua/v3 v3.2.5
 	github.com/hobbyfarm/mink v0.32.1
+	github.com/jackc/pgx/v5 v5.7.2
 	github.com/peterhellberg/duration v0.0.2
 	github.com/pkg/errors v0.9.1
 	github.com/rancher/lasso v0.2.1
@@ -37,6 +38,7 @@ require (
 	k8s.io/client-go v12.0.0+incompatible
 	k8s.io/component-base v0.32.2
 	k8s.io/kube-openapi v0.0.0-20241212222426-2c72e554b1e7
+	modernc.org/sqlite v1.34.5
 	sigs.k8s.io/controller-runtime v0.20.2
 	sigs.k8s.io/controller-tools v0.17.2
 )
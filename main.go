@@ -10,8 +10,14 @@ import (
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
 	predefinedserviceserver "github.com/hobbyfarm/gargantua/v3/pkg/predefinedserviceserver"
 	"github.com/hobbyfarm/gargantua/v3/pkg/shell"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
 	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 
@@ -39,6 +45,9 @@ var (
 	localKubeconfig string
 	shellServer     bool
 	tlsCA           string
+	recordingSink   string
+	recordingDir    string
+	recordAll       bool
 )
 
 func init() {
@@ -46,6 +55,9 @@ func init() {
 	flag.StringVar(&localMasterUrl, "master", "", "The address of the Kubernetes API server. Overrides any value in kubeconfig. Only required if out-of-cluster.")
 	flag.BoolVar(&shellServer, "shellserver", false, "Be a shell server")
 	flag.StringVar(&tlsCA, "tls-ca", "/etc/ssl/certs/ca.crt", "Path to CA cert for auth servers")
+	flag.StringVar(&recordingSink, "recording-sink", "", "Where to store recordings of ssh shell sessions, either 'filesystem' or 'configmap'. Sessions are not recorded if empty.")
+	flag.StringVar(&recordingDir, "recording-dir", "/var/lib/hobbyfarm/recordings", "Directory for recordings of ssh shell sessions if the recording sink is 'filesystem'")
+	flag.BoolVar(&recordAll, "record-all-sessions", false, "Record all ssh shell sessions, instead of only the sessions of scheduled events labeled with hobbyfarm.io/record-sessions=true")
 }
 
 func main() {
@@ -97,6 +109,11 @@ func main() {
 		microservices.AuthR,
 		microservices.VM,
 		microservices.VMTemplate,
+		microservices.ScheduledEvent,
+		microservices.Setting,
+		microservices.Progress,
+		microservices.Quiz,
+		microservices.Scenario,
 	}
 	connections := microservices.EstablishConnections(services, cert)
 	for _, conn := range connections {
@@ -107,8 +124,28 @@ func main() {
 	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
 	vmClient := vmpb.NewVMSvcClient(connections[microservices.VM])
 	vmTemplateClient := vmtemplatepb.NewVMTemplateSvcClient(connections[microservices.VMTemplate])
+	scheduledEventClient := scheduledeventpb.NewScheduledEventSvcClient(connections[microservices.ScheduledEvent])
+	settingClient := settingpb.NewSettingSvcClient(connections[microservices.Setting])
+	progressClient := progresspb.NewProgressSvcClient(connections[microservices.Progress])
+	quizClient := quizpb.NewQuizSvcClient(connections[microservices.Quiz])
+	quizEvaluationClient := quizpb.NewQuizEvaluationSvcClient(connections[microservices.Quiz])
+	scenarioClient := scenariopb.NewScenarioSvcClient(connections[microservices.Scenario])
+
+	recordingConfig := shell.RecordingConfig{RecordAllSessions: recordAll}
+	switch recordingSink {
+	case "":
+	case "filesystem":
+		recordingConfig.Sink, err = shell.NewFilesystemRecordingSink(recordingDir)
+		if err != nil {
+			glog.Fatalf("error creating recording directory: %s", err.Error())
+		}
+	case "configmap":
+		recordingConfig.Sink = shell.NewConfigMapRecordingSink(kubeClient, util.GetReleaseNamespace())
+	default:
+		glog.Fatalf("unknown recording sink %s", recordingSink)
+	}
 
-	shellProxy := shell.NewShellProxy(authnClient, authrClient, vmClient, vmTemplateClient, kubeClient)
+	shellProxy := shell.NewShellProxy(authnClient, authrClient, vmClient, vmTemplateClient, scheduledEventClient, settingClient, progressClient, quizClient, quizEvaluationClient, scenarioClient, kubeClient, recordingConfig)
 
 	predefinedServiceServer, err := predefinedserviceserver.NewPredefinedServiceServer(authnClient, authrClient, hfClient, ctx)
 	if err != nil {
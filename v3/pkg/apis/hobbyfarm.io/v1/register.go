@@ -65,6 +65,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&QuizList{},
 		&QuizEvaluation{},
 		&QuizEvaluationList{},
+		&Leaderboard{},
+		&LeaderboardList{},
+		&ArcadeScore{},
+		&ArcadeScoreList{},
+		&ArcadeScan{},
+		&ArcadeScanList{},
+		&CertificateTemplate{},
+		&CertificateTemplateList{},
+		&Certificate{},
+		&CertificateList{},
+		&GitSync{},
+		&GitSyncList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
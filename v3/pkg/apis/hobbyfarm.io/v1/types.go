@@ -116,9 +116,18 @@ type VirtualMachineTemplateList struct {
 // VM type is a genercized collection of information about a VM. this includes things like
 // cpu, ram, disk, etc.
 type VirtualMachineTemplateSpec struct {
-	Name      string            `json:"name"`  // 2x4, etc.
-	Image     string            `json:"image"` // ubuntu-18.04
-	ConfigMap map[string]string `json:"config_map"`
+	Name      string                  `json:"name"`  // 2x4, etc.
+	Image     string                  `json:"image"` // ubuntu-18.04
+	ConfigMap map[string]string       `json:"config_map"`
+	Resources VirtualMachineResources `json:"resources,omitempty"`
+}
+
+// VirtualMachineResources are the resources a virtual machine requires, or the total resources
+// an environment provides. Resources left at zero are not accounted for.
+type VirtualMachineResources struct {
+	CPU    int `json:"cpu,omitempty"`    // cores
+	Memory int `json:"memory,omitempty"` // MiB
+	Disk   int `json:"disk,omitempty"`   // GiB
 }
 
 // +genclient
@@ -179,6 +188,7 @@ type EnvironmentSpec struct {
 	IPTranslationMap     map[string]string            `json:"ip_translation_map"`
 	WsEndpoint           string                       `json:"ws_endpoint"`
 	CountCapacity        map[string]int               `json:"count_capacity"`
+	ResourceCapacity     VirtualMachineResources      `json:"resource_capacity,omitempty"`
 }
 
 // +genclient
@@ -204,6 +214,8 @@ type VirtualMachineSetStatus struct {
 	Machines         []VirtualMachineProvision `json:"machines"`
 	AvailableCount   int                       `json:"available"`
 	ProvisionedCount int                       `json:"provisioned"`
+	PoolHits         int                       `json:"pool_hits,omitempty"`   // claims served by a vm of the warm pool
+	PoolMisses       int                       `json:"pool_misses,omitempty"` // claims which found the warm pool empty
 }
 
 type VirtualMachineProvision struct {
@@ -302,8 +314,25 @@ type Task struct {
 	ExpectedOutputValue string `json:"expected_output_value"`
 	ExpectedReturnCode  int    `json:"expected_return_code"`
 	ReturnType          string `json:"return_type"`
+	// Type selects the checker verifying the task. Tasks without type run the command
+	// and compare its output according to ReturnType.
+	Type           string   `json:"type,omitempty"`
+	Path           string   `json:"path,omitempty"`
+	URL            string   `json:"url,omitempty"`
+	JSONPath       string   `json:"json_path,omitempty"`
+	DependsOn      []string `json:"depends_on,omitempty"`
+	TimeoutSeconds int      `json:"timeout_seconds,omitempty"`
+	Points         int      `json:"points,omitempty"`
 }
 
+const (
+	TaskTypeCommand     = "command"
+	TaskTypeJSONPath    = "json_path"
+	TaskTypeFileExists  = "file_exists"
+	TaskTypeFileContent = "file_content"
+	TaskTypeHTTP        = "http"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -369,6 +398,8 @@ type ProgressSpec struct {
 	LastUpdate  string         `json:"last_update"`
 	Finished    string         `json:"finished"`
 	Steps       []ProgressStep `json:"steps"`
+	// TaskVerifications holds the latest verification result of each task per step
+	TaskVerifications []TaskVerification `json:"task_verifications,omitempty"`
 }
 
 type ProgressStep struct {
@@ -376,6 +407,18 @@ type ProgressStep struct {
 	Timestamp string `json:"timestamp"`
 }
 
+type TaskVerification struct {
+	Step      int    `json:"step"`
+	VMName    string `json:"vm_name"`
+	Task      string `json:"task"`
+	Success   bool   `json:"success"`
+	Score     int    `json:"score"`
+	MaxScore  int    `json:"max_score"`
+	Output    string `json:"output,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Timestamp string `json:"timestamp"`
+}
+
 // +genclient
 // +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -493,14 +536,30 @@ type ScheduledEventSpec struct {
 	Printable               bool                      `json:"printable"`
 	Scenarios               []string                  `json:"scenarios"`
 	Courses                 []string                  `json:"courses"`
+	SpreadVirtualMachines   []VirtualMachineSpread    `json:"spread_vms,omitempty"` // virtual machines of a template split across several environments
+	WarmPool                map[string]map[string]int `json:"warm_pool,omitempty"`  // map of environment to vm template to the count of vms kept provisioned for on demand events
+}
+
+// VirtualMachineSpread requires Count virtual machines of a VMTemplate, split across the environments by their weight and remaining capacity
+type VirtualMachineSpread struct {
+	VMTemplate   string              `json:"vm_template"`
+	Count        int                 `json:"count"`
+	Environments []SpreadEnvironment `json:"environments"`
+}
+
+type SpreadEnvironment struct {
+	Environment string `json:"environment"`
+	Weight      int    `json:"weight,omitempty"` // relative preference for the environment, 1 if unset
 }
 
 type ScheduledEventStatus struct {
-	VirtualMachineSets []string `json:"vmsets"`
-	Active             bool     `json:"active"`
-	Provisioned        bool     `json:"provisioned"`
-	Ready              bool     `json:"ready"`
-	Finished           bool     `json:"finished"`
+	VirtualMachineSets []string                  `json:"vmsets"`
+	Active             bool                      `json:"active"`
+	Provisioned        bool                      `json:"provisioned"`
+	Ready              bool                      `json:"ready"`
+	Finished           bool                      `json:"finished"`
+	SpreadAllocation   map[string]map[string]int `json:"spread_allocation,omitempty"`   // environment: vm template: count of the spread virtual machines
+	FailedEnvironments []string                  `json:"failed_environments,omitempty"` // environments no longer used for spread virtual machines
 }
 
 // +genclient
@@ -627,13 +686,14 @@ type QuizList struct {
 }
 
 type QuizSpec struct {
-	Title            string         `json:"title"`             // title of the quiz
-	Issuer           string         `json:"issuer"`            // name of the issuer of this quiz
-	Shuffle          bool           `json:"shuffle"`           // shuffle the questions within the quiz (default: false)
-	PoolSize         uint32         `json:"pool_size"`         // amount of questions to pick (default: all)
-	MaxAttempts      uint32         `json:"max_attempts"`      // the maximum number of attempts for the quiz
-	SuccessThreshold uint32         `json:"success_threshold"` // threshold in percent [0, 100] to pass the quiz
-	ValidationType   string         `json:"validation_type"`   // type of validation defined by the admin-ui
+	Title            string         `json:"title"`                // title of the quiz
+	Issuer           string         `json:"issuer"`               // name of the issuer of this quiz
+	Shuffle          bool           `json:"shuffle"`              // shuffle the questions within the quiz (default: false)
+	PoolSize         uint32         `json:"pool_size"`            // amount of questions to pick (default: all)
+	MaxAttempts      uint32         `json:"max_attempts"`         // the maximum number of attempts for the quiz
+	SuccessThreshold uint32         `json:"success_threshold"`    // threshold in percent [0, 100] to pass the quiz
+	ValidationType   string         `json:"validation_type"`      // type of validation defined by the admin-ui
+	TimeLimit        uint32         `json:"time_limit,omitempty"` // time limit of an attempt in seconds (default: no limit)
 	Questions        []QuizQuestion `json:"questions"`
 }
 
@@ -646,6 +706,37 @@ type QuizQuestion struct {
 	SuccessMessage string       `json:"success_message"` // message in case user succeeded the quiz
 	Weight         uint32       `json:"weight"`          // weight of the question
 	Answers        []QuizAnswer `json:"answers"`
+	// Numeric is the expected answer of numeric questions
+	Numeric *QuizNumericAnswer `json:"numeric,omitempty"`
+	// Text is the expected answer of text questions
+	Text *QuizTextAnswer `json:"text,omitempty"`
+	// Command is the task verified on a vm of the user for command questions
+	Command *QuizCommandCheck `json:"command,omitempty"`
+}
+
+// Question types which are not choice questions. Questions of any other type are choice questions,
+// they are answered correctly if exactly the correct answers are selected.
+const (
+	QuizQuestionTypeOrdering = "ordering" // the answers have to be put into the order they are defined in
+	QuizQuestionTypeNumeric  = "numeric"
+	QuizQuestionTypeText     = "text"
+	QuizQuestionTypeCommand  = "command"
+)
+
+type QuizNumericAnswer struct {
+	Value     float64 `json:"value"`     // the expected value
+	Tolerance float64 `json:"tolerance"` // the accepted absolute deviation from the value
+}
+
+type QuizTextAnswer struct {
+	Alternatives  []string `json:"alternatives,omitempty"` // accepted answers
+	Regex         string   `json:"regex,omitempty"`        // answers matching the regular expression are accepted as well
+	CaseSensitive bool     `json:"case_sensitive"`
+}
+
+type QuizCommandCheck struct {
+	VMName string `json:"vm_name"` // the name of the vm within the scenario
+	Task   Task   `json:"task"`
 }
 
 type QuizAnswer struct {
@@ -684,6 +775,204 @@ type QuizEvaluationAttempt struct {
 	Attempt           uint32              `json:"attempt"`
 	Score             uint32              `json:"score"`
 	Pass              bool                `json:"pass"`
-	Corrects          map[string][]string `json:"corrects,omitempty"` // key is question id and values are correct answer ids
-	Selects           map[string][]string `json:"selects"`            // key is question id and values are answer ids of the answers chosen by the user
+	Corrects          map[string][]string `json:"corrects,omitempty"`      // key is question id and values are correct answer ids
+	Selects           map[string][]string `json:"selects"`                 // key is question id and values are answer ids of the answers chosen by the user
+	Results           map[string]bool     `json:"results,omitempty"`       // key is question id and value is whether the question was answered correctly
+	Verifications     map[string]bool     `json:"verifications,omitempty"` // key is question id of a command question and value is whether its task was verified
+	TimedOut          bool                `json:"timed_out,omitempty"`     // the attempt was recorded after the time limit of the quiz
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Leaderboard struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LeaderboardSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type LeaderboardList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Leaderboard `json:"items"`
+}
+
+type LeaderboardSpec struct {
+	Language       string `json:"language"`                  // language the scores were achieved in
+	ScheduledEvent string `json:"scheduled_event,omitempty"` // the scheduled event id, empty for boards not bound to an event
+	Board          string `json:"board"`                     // name of the board
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ArcadeScore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ArcadeScoreSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ArcadeScoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ArcadeScore `json:"items"`
+}
+
+type ArcadeScoreSpec struct {
+	Leaderboard string `json:"leaderboard"` // name of the leaderboard the score belongs to
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Score       int    `json:"score"`
+	Code        string `json:"code,omitempty"` // the scanned badge code of the player
+	Timestamp   string `json:"timestamp"`      // the time the score was achieved, RFC3339
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ArcadeScan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ArcadeScanSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ArcadeScanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []ArcadeScan `json:"items"`
+}
+
+type ArcadeScanSpec struct {
+	Code         string                  `json:"code"`                   // the scanned badge code
+	Cooldown     string                  `json:"cooldown"`               // the time until the badge can not play again, RFC3339
+	Notification *ArcadeScanNotification `json:"notification,omitempty"` // outbox entry of the notification about the first scan
+}
+
+type ArcadeScanNotification struct {
+	Timestamp  string                        `json:"timestamp"` // the time of the first scan, RFC3339
+	Done       bool                          `json:"done"`      // every channel delivered the notification or gave up
+	Deliveries map[string]ArcadeScanDelivery `json:"deliveries,omitempty"`
+}
+
+type ArcadeScanDelivery struct {
+	Attempts    int    `json:"attempts"`
+	Delivered   bool   `json:"delivered"`
+	Failed      bool   `json:"failed"`                 // the channel gave up after too many attempts
+	NextAttempt string `json:"next_attempt,omitempty"` // RFC3339
+	LastError   string `json:"last_error,omitempty"`
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CertificateTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CertificateTemplateSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CertificateTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CertificateTemplate `json:"items"`
+}
+
+type CertificateTemplateSpec struct {
+	Course   string           `json:"course,omitempty"`   // the course id, either course or scenario is set
+	Scenario string           `json:"scenario,omitempty"` // the scenario id
+	Title    string           `json:"title"`              // text/template rendered into the title of the certificate
+	Body     string           `json:"body"`               // text/template rendered into the body of the certificate
+	Issuer   string           `json:"issuer"`             // the name of the issuing organization
+	Rules    CertificateRules `json:"rules"`
+}
+
+// CertificateRules decide whether the progress of a user earns a certificate.
+type CertificateRules struct {
+	RequireAllSteps      bool   `json:"require_all_steps"`      // every step of the scenario was visited
+	RequirePassedQuizzes bool   `json:"require_passed_quizzes"` // every quiz of the scenario has a passed attempt
+	MinTaskScore         uint32 `json:"min_task_score"`         // minimum percentage of the verified task score, 0 to not require task verification
+}
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Certificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              CertificateSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []Certificate `json:"items"`
+}
+
+type CertificateSpec struct {
+	Template       string   `json:"template"`
+	User           string   `json:"user"`
+	Course         string   `json:"course,omitempty"`
+	Scenario       string   `json:"scenario,omitempty"`
+	ScheduledEvent string   `json:"scheduled_event"`
+	Progresses     []string `json:"progresses"` // the finished progresses the certificate was earned with
+	Title          string   `json:"title"`      // rendered title
+	Body           string   `json:"body"`       // rendered body
+	Issuer         string   `json:"issuer"`
+	IssuedAt       string   `json:"issued_at"` // time.UnixDate
+	Signature      string   `json:"signature"` // HMAC-SHA256 over the issued fields, hex encoded
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GitSync reconciles the content bundle of a git repository branch into the cluster.
+type GitSync struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GitSyncSpec   `json:"spec"`
+	Status            GitSyncStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type GitSyncList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []GitSync `json:"items"`
+}
+
+type GitSyncSpec struct {
+	Repository string `json:"repository"`         // url of the repository
+	Branch     string `json:"branch"`             // branch to reconcile, the default branch if empty
+	Path       string `json:"path,omitempty"`     // directory of the bundle within the repository
+	Interval   string `json:"interval,omitempty"` // time.Duration between syncs, default 5m
+	Prune      bool   `json:"prune"`              // delete resources of this sync that were removed from the bundle
+	Suspend    bool   `json:"suspend"`
+	// CredentialsSecret is the name of a secret with "username" and "password" keys for https repositories
+	CredentialsSecret string `json:"credentials_secret,omitempty"`
+}
+
+type GitSyncStatus struct {
+	ObservedGeneration int64  `json:"observed_generation,omitempty"`
+	Commit             string `json:"commit,omitempty"`         // last synced commit
+	LastSyncTime       string `json:"last_sync_time,omitempty"` // time.UnixDate
+	Created            int    `json:"created"`                  // resources created by the last sync
+	Updated            int    `json:"updated"`                  // resources updated by the last sync
+	Deleted            int    `json:"deleted"`                  // resources deleted by the last sync
+	Error              string `json:"error,omitempty"`          // error of the last sync
 }
@@ -111,6 +111,376 @@ func (in *AccessCodeSpec) DeepCopy() *AccessCodeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScan) DeepCopyInto(out *ArcadeScan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScan.
+func (in *ArcadeScan) DeepCopy() *ArcadeScan {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArcadeScan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScanDelivery) DeepCopyInto(out *ArcadeScanDelivery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScanDelivery.
+func (in *ArcadeScanDelivery) DeepCopy() *ArcadeScanDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScanDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScanList) DeepCopyInto(out *ArcadeScanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArcadeScan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScanList.
+func (in *ArcadeScanList) DeepCopy() *ArcadeScanList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArcadeScanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScanNotification) DeepCopyInto(out *ArcadeScanNotification) {
+	*out = *in
+	if in.Deliveries != nil {
+		in, out := &in.Deliveries, &out.Deliveries
+		*out = make(map[string]ArcadeScanDelivery, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScanNotification.
+func (in *ArcadeScanNotification) DeepCopy() *ArcadeScanNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScanNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScanSpec) DeepCopyInto(out *ArcadeScanSpec) {
+	*out = *in
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(ArcadeScanNotification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScanSpec.
+func (in *ArcadeScanSpec) DeepCopy() *ArcadeScanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScore) DeepCopyInto(out *ArcadeScore) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScore.
+func (in *ArcadeScore) DeepCopy() *ArcadeScore {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArcadeScore) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScoreList) DeepCopyInto(out *ArcadeScoreList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArcadeScore, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScoreList.
+func (in *ArcadeScoreList) DeepCopy() *ArcadeScoreList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScoreList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArcadeScoreList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArcadeScoreSpec) DeepCopyInto(out *ArcadeScoreSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArcadeScoreSpec.
+func (in *ArcadeScoreSpec) DeepCopy() *ArcadeScoreSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArcadeScoreSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Certificate) DeepCopyInto(out *Certificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Certificate.
+func (in *Certificate) DeepCopy() *Certificate {
+	if in == nil {
+		return nil
+	}
+	out := new(Certificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Certificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateList) DeepCopyInto(out *CertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Certificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateList.
+func (in *CertificateList) DeepCopy() *CertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRules) DeepCopyInto(out *CertificateRules) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRules.
+func (in *CertificateRules) DeepCopy() *CertificateRules {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
+	*out = *in
+	if in.Progresses != nil {
+		in, out := &in.Progresses, &out.Progresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
+func (in *CertificateSpec) DeepCopy() *CertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTemplate) DeepCopyInto(out *CertificateTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTemplate.
+func (in *CertificateTemplate) DeepCopy() *CertificateTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTemplateList) DeepCopyInto(out *CertificateTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTemplateList.
+func (in *CertificateTemplateList) DeepCopy() *CertificateTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateTemplateSpec) DeepCopyInto(out *CertificateTemplateSpec) {
+	*out = *in
+	out.Rules = in.Rules
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateTemplateSpec.
+func (in *CertificateTemplateSpec) DeepCopy() *CertificateTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cost) DeepCopyInto(out *Cost) {
 	*out = *in
@@ -297,37 +667,236 @@ func (in *CourseSpec) DeepCopyInto(out *CourseSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CourseSpec.
-func (in *CourseSpec) DeepCopy() *CourseSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CourseSpec.
+func (in *CourseSpec) DeepCopy() *CourseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CourseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicBindConfiguration) DeepCopyInto(out *DynamicBindConfiguration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfiguration.
+func (in *DynamicBindConfiguration) DeepCopy() *DynamicBindConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicBindConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamicBindConfiguration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicBindConfigurationList) DeepCopyInto(out *DynamicBindConfigurationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DynamicBindConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfigurationList.
+func (in *DynamicBindConfigurationList) DeepCopy() *DynamicBindConfigurationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicBindConfigurationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DynamicBindConfigurationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicBindConfigurationSpec) DeepCopyInto(out *DynamicBindConfigurationSpec) {
+	*out = *in
+	if in.BurstCountCapacity != nil {
+		in, out := &in.BurstCountCapacity, &out.BurstCountCapacity
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfigurationSpec.
+func (in *DynamicBindConfigurationSpec) DeepCopy() *DynamicBindConfigurationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicBindConfigurationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Environment) DeepCopyInto(out *Environment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
+func (in *Environment) DeepCopy() *Environment {
+	if in == nil {
+		return nil
+	}
+	out := new(Environment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Environment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Environment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
+func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+	*out = *in
+	if in.TemplateMapping != nil {
+		in, out := &in.TemplateMapping, &out.TemplateMapping
+		*out = make(map[string]map[string]string, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]string, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.EnvironmentSpecifics != nil {
+		in, out := &in.EnvironmentSpecifics, &out.EnvironmentSpecifics
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.IPTranslationMap != nil {
+		in, out := &in.IPTranslationMap, &out.IPTranslationMap
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CountCapacity != nil {
+		in, out := &in.CountCapacity, &out.CountCapacity
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.ResourceCapacity = in.ResourceCapacity
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
+func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CourseSpec)
+	out := new(EnvironmentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicBindConfiguration) DeepCopyInto(out *DynamicBindConfiguration) {
+func (in *GitSync) DeepCopyInto(out *GitSync) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
+	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfiguration.
-func (in *DynamicBindConfiguration) DeepCopy() *DynamicBindConfiguration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSync.
+func (in *GitSync) DeepCopy() *GitSync {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicBindConfiguration)
+	out := new(GitSync)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DynamicBindConfiguration) DeepCopyObject() runtime.Object {
+func (in *GitSync) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -335,13 +904,13 @@ func (in *DynamicBindConfiguration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicBindConfigurationList) DeepCopyInto(out *DynamicBindConfigurationList) {
+func (in *GitSyncList) DeepCopyInto(out *GitSyncList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DynamicBindConfiguration, len(*in))
+		*out = make([]GitSync, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -349,18 +918,18 @@ func (in *DynamicBindConfigurationList) DeepCopyInto(out *DynamicBindConfigurati
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfigurationList.
-func (in *DynamicBindConfigurationList) DeepCopy() *DynamicBindConfigurationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncList.
+func (in *GitSyncList) DeepCopy() *GitSyncList {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicBindConfigurationList)
+	out := new(GitSyncList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DynamicBindConfigurationList) DeepCopyObject() runtime.Object {
+func (in *GitSyncList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -368,49 +937,58 @@ func (in *DynamicBindConfigurationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DynamicBindConfigurationSpec) DeepCopyInto(out *DynamicBindConfigurationSpec) {
+func (in *GitSyncSpec) DeepCopyInto(out *GitSyncSpec) {
 	*out = *in
-	if in.BurstCountCapacity != nil {
-		in, out := &in.BurstCountCapacity, &out.BurstCountCapacity
-		*out = make(map[string]int, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncSpec.
+func (in *GitSyncSpec) DeepCopy() *GitSyncSpec {
+	if in == nil {
+		return nil
 	}
+	out := new(GitSyncSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitSyncStatus) DeepCopyInto(out *GitSyncStatus) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicBindConfigurationSpec.
-func (in *DynamicBindConfigurationSpec) DeepCopy() *DynamicBindConfigurationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSyncStatus.
+func (in *GitSyncStatus) DeepCopy() *GitSyncStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DynamicBindConfigurationSpec)
+	out := new(GitSyncStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Environment) DeepCopyInto(out *Environment) {
+func (in *Leaderboard) DeepCopyInto(out *Leaderboard) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Environment.
-func (in *Environment) DeepCopy() *Environment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Leaderboard.
+func (in *Leaderboard) DeepCopy() *Leaderboard {
 	if in == nil {
 		return nil
 	}
-	out := new(Environment)
+	out := new(Leaderboard)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Environment) DeepCopyObject() runtime.Object {
+func (in *Leaderboard) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -418,13 +996,13 @@ func (in *Environment) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
+func (in *LeaderboardList) DeepCopyInto(out *LeaderboardList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Environment, len(*in))
+		*out = make([]Leaderboard, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -432,18 +1010,18 @@ func (in *EnvironmentList) DeepCopyInto(out *EnvironmentList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentList.
-func (in *EnvironmentList) DeepCopy() *EnvironmentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderboardList.
+func (in *LeaderboardList) DeepCopy() *LeaderboardList {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentList)
+	out := new(LeaderboardList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *EnvironmentList) DeepCopyObject() runtime.Object {
+func (in *LeaderboardList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -451,55 +1029,17 @@ func (in *EnvironmentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EnvironmentSpec) DeepCopyInto(out *EnvironmentSpec) {
+func (in *LeaderboardSpec) DeepCopyInto(out *LeaderboardSpec) {
 	*out = *in
-	if in.TemplateMapping != nil {
-		in, out := &in.TemplateMapping, &out.TemplateMapping
-		*out = make(map[string]map[string]string, len(*in))
-		for key, val := range *in {
-			var outVal map[string]string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make(map[string]string, len(*in))
-				for key, val := range *in {
-					(*out)[key] = val
-				}
-			}
-			(*out)[key] = outVal
-		}
-	}
-	if in.EnvironmentSpecifics != nil {
-		in, out := &in.EnvironmentSpecifics, &out.EnvironmentSpecifics
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.IPTranslationMap != nil {
-		in, out := &in.IPTranslationMap, &out.IPTranslationMap
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.CountCapacity != nil {
-		in, out := &in.CountCapacity, &out.CountCapacity
-		*out = make(map[string]int, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentSpec.
-func (in *EnvironmentSpec) DeepCopy() *EnvironmentSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LeaderboardSpec.
+func (in *LeaderboardSpec) DeepCopy() *LeaderboardSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(EnvironmentSpec)
+	out := new(LeaderboardSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -708,6 +1248,11 @@ func (in *ProgressSpec) DeepCopyInto(out *ProgressSpec) {
 		*out = make([]ProgressStep, len(*in))
 		copy(*out, *in)
 	}
+	if in.TaskVerifications != nil {
+		in, out := &in.TaskVerifications, &out.TaskVerifications
+		*out = make([]TaskVerification, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -780,6 +1325,23 @@ func (in *QuizAnswer) DeepCopy() *QuizAnswer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuizCommandCheck) DeepCopyInto(out *QuizCommandCheck) {
+	*out = *in
+	in.Task.DeepCopyInto(&out.Task)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuizCommandCheck.
+func (in *QuizCommandCheck) DeepCopy() *QuizCommandCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(QuizCommandCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuizEvaluation) DeepCopyInto(out *QuizEvaluation) {
 	*out = *in
@@ -840,6 +1402,20 @@ func (in *QuizEvaluationAttempt) DeepCopyInto(out *QuizEvaluationAttempt) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Verifications != nil {
+		in, out := &in.Verifications, &out.Verifications
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -942,6 +1518,22 @@ func (in *QuizList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuizNumericAnswer) DeepCopyInto(out *QuizNumericAnswer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuizNumericAnswer.
+func (in *QuizNumericAnswer) DeepCopy() *QuizNumericAnswer {
+	if in == nil {
+		return nil
+	}
+	out := new(QuizNumericAnswer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QuizQuestion) DeepCopyInto(out *QuizQuestion) {
 	*out = *in
@@ -950,6 +1542,21 @@ func (in *QuizQuestion) DeepCopyInto(out *QuizQuestion) {
 		*out = make([]QuizAnswer, len(*in))
 		copy(*out, *in)
 	}
+	if in.Numeric != nil {
+		in, out := &in.Numeric, &out.Numeric
+		*out = new(QuizNumericAnswer)
+		**out = **in
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = new(QuizTextAnswer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(QuizCommandCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -986,6 +1593,27 @@ func (in *QuizSpec) DeepCopy() *QuizSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuizTextAnswer) DeepCopyInto(out *QuizTextAnswer) {
+	*out = *in
+	if in.Alternatives != nil {
+		in, out := &in.Alternatives, &out.Alternatives
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuizTextAnswer.
+func (in *QuizTextAnswer) DeepCopy() *QuizTextAnswer {
+	if in == nil {
+		return nil
+	}
+	out := new(QuizTextAnswer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Scenario) DeepCopyInto(out *Scenario) {
 	*out = *in
@@ -1204,6 +1832,30 @@ func (in *ScheduledEventSpec) DeepCopyInto(out *ScheduledEventSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SpreadVirtualMachines != nil {
+		in, out := &in.SpreadVirtualMachines, &out.SpreadVirtualMachines
+		*out = make([]VirtualMachineSpread, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = make(map[string]map[string]int, len(*in))
+		for key, val := range *in {
+			var outVal map[string]int
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]int, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -1225,6 +1877,28 @@ func (in *ScheduledEventStatus) DeepCopyInto(out *ScheduledEventStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SpreadAllocation != nil {
+		in, out := &in.SpreadAllocation, &out.SpreadAllocation
+		*out = make(map[string]map[string]int, len(*in))
+		for key, val := range *in {
+			var outVal map[string]int
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make(map[string]int, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.FailedEnvironments != nil {
+		in, out := &in.FailedEnvironments, &out.FailedEnvironments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1471,9 +2145,30 @@ func (in *SettingList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpreadEnvironment) DeepCopyInto(out *SpreadEnvironment) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpreadEnvironment.
+func (in *SpreadEnvironment) DeepCopy() *SpreadEnvironment {
+	if in == nil {
+		return nil
+	}
+	out := new(SpreadEnvironment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Task) DeepCopyInto(out *Task) {
 	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1487,6 +2182,22 @@ func (in *Task) DeepCopy() *Task {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskVerification) DeepCopyInto(out *TaskVerification) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskVerification.
+func (in *TaskVerification) DeepCopy() *TaskVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *User) DeepCopyInto(out *User) {
 	*out = *in
@@ -1768,6 +2479,22 @@ func (in *VirtualMachineProvision) DeepCopy() *VirtualMachineProvision {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineResources) DeepCopyInto(out *VirtualMachineResources) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResources.
+func (in *VirtualMachineResources) DeepCopy() *VirtualMachineResources {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSet) DeepCopyInto(out *VirtualMachineSet) {
 	*out = *in
@@ -1882,6 +2609,27 @@ func (in *VirtualMachineSpec) DeepCopy() *VirtualMachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineSpread) DeepCopyInto(out *VirtualMachineSpread) {
+	*out = *in
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]SpreadEnvironment, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineSpread.
+func (in *VirtualMachineSpread) DeepCopy() *VirtualMachineSpread {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineSpread)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 	*out = *in
@@ -1904,7 +2652,9 @@ func (in *VirtualMachineTasks) DeepCopyInto(out *VirtualMachineTasks) {
 	if in.Tasks != nil {
 		in, out := &in.Tasks, &out.Tasks
 		*out = make([]Task, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1989,6 +2739,7 @@ func (in *VirtualMachineTemplateSpec) DeepCopyInto(out *VirtualMachineTemplateSp
 			(*out)[key] = val
 		}
 	}
+	out.Resources = in.Resources
 	return
 }
 
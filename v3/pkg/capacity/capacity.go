@@ -0,0 +1,210 @@
+package capacity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+)
+
+const (
+	ResourceCount  = "count"
+	ResourceCPU    = "cpu"
+	ResourceMemory = "memory"
+	ResourceDisk   = "disk"
+)
+
+// Capacity is what an environment is able to provide at any given time.
+type Capacity struct {
+	// Count is the number of virtual machines per virtual machine template. Templates without a count have no capacity.
+	Count map[string]int
+	// Resources are shared by the virtual machines of all templates. Resources of 0 are unlimited.
+	Resources hfv1.VirtualMachineResources
+}
+
+// Overflow describes a window during which an environment is not able to provide what is required of it.
+type Overflow struct {
+	Environment string `json:"environment"`
+	// Template is the overflowing virtual machine template. Resource overflows are caused by all templates listed in Usage.
+	Template string `json:"template,omitempty"`
+	Resource string `json:"resource"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	// Reserved by other scheduled events during the window
+	Reserved int64 `json:"reserved"`
+	// Requested by the checked scheduled event during the window
+	Requested int64 `json:"requested"`
+	Capacity  int64 `json:"capacity"`
+	// Usage is how much of the resource each virtual machine template uses during the window
+	Usage map[string]int64 `json:"usage,omitempty"`
+}
+
+func (o Overflow) String() string {
+	subject := o.Resource
+	if o.Template != "" {
+		subject = fmt.Sprintf("%s of template %s", o.Resource, o.Template)
+	}
+	return fmt.Sprintf("environment %s is overbooked by %s from %s to %s: %d reserved + %d requested > %d capacity",
+		o.Environment, subject, o.Start, o.End, o.Reserved, o.Requested, o.Capacity)
+}
+
+// Report lists all overflows of a scheduled event.
+type Report struct {
+	Overflows []Overflow `json:"overflows"`
+}
+
+func (r Report) Overbooked() bool {
+	return len(r.Overflows) > 0
+}
+
+func (r Report) String() string {
+	overflows := make([]string, len(r.Overflows))
+	for i, o := range r.Overflows {
+		overflows[i] = o.String()
+	}
+	return strings.Join(overflows, "; ")
+}
+
+type window struct {
+	start    time.Time
+	end      time.Time
+	reserved map[string]uint32
+}
+
+// windows splits the period (start, end) at every timestamp where the reserved counts change. The reserved counts
+// are the ones returned by util.VirtualMachinesReservedDuringPeriod, they apply from their timestamp until the next one.
+func windows(reserved map[time.Time]map[string]uint32, start time.Time, end time.Time) []window {
+	var timestamps []time.Time
+	for timestamp := range reserved {
+		timestamps = append(timestamps, timestamp)
+	}
+	sort.Slice(timestamps, func(i, j int) bool { return timestamps[i].Before(timestamps[j]) })
+
+	// the counts at start are the ones of the latest change before or at start
+	current := map[string]uint32{}
+	for _, timestamp := range timestamps {
+		if timestamp.After(start) {
+			break
+		}
+		current = reserved[timestamp]
+	}
+
+	var result []window
+	windowStart := start
+	for _, timestamp := range timestamps {
+		if !timestamp.After(windowStart) {
+			continue
+		}
+		if !timestamp.Before(end) {
+			break
+		}
+		result = append(result, window{start: windowStart, end: timestamp, reserved: current})
+		windowStart = timestamp
+		current = reserved[timestamp]
+	}
+	if windowStart.Before(end) {
+		result = append(result, window{start: windowStart, end: end, reserved: current})
+	}
+
+	return result
+}
+
+// Check compares the virtual machines requested from an environment during the period (start, end) plus the virtual
+// machines reserved by other scheduled events to the capacity of the environment.
+// Only templates and resources requested are checked, so existing overflows do not prevent unrelated requests.
+// Consecutive windows overflowing by the same amounts are reported once.
+func Check(environment string, capacity Capacity, templates map[string]hfv1.VirtualMachineResources, reserved map[time.Time]map[string]uint32, requested map[string]uint32, start time.Time, end time.Time) []Overflow {
+	var overflows []Overflow
+
+	add := func(o Overflow, w window) {
+		o.Environment = environment
+		o.Start = w.start.Format(time.UnixDate)
+		o.End = w.end.Format(time.UnixDate)
+		for i := len(overflows) - 1; i >= 0; i-- {
+			last := &overflows[i]
+			if last.Template != o.Template || last.Resource != o.Resource {
+				continue
+			}
+			if last.End == o.Start && last.Reserved == o.Reserved && last.Requested == o.Requested {
+				last.End = o.End
+				return
+			}
+			break
+		}
+		overflows = append(overflows, o)
+	}
+
+	var requestedTemplates []string
+	for template, count := range requested {
+		if count > 0 {
+			requestedTemplates = append(requestedTemplates, template)
+		}
+	}
+	sort.Strings(requestedTemplates)
+
+	resources := []struct {
+		name     string
+		capacity int
+		amount   func(hfv1.VirtualMachineResources) int
+	}{
+		{ResourceCPU, capacity.Resources.CPU, func(r hfv1.VirtualMachineResources) int { return r.CPU }},
+		{ResourceMemory, capacity.Resources.Memory, func(r hfv1.VirtualMachineResources) int { return r.Memory }},
+		{ResourceDisk, capacity.Resources.Disk, func(r hfv1.VirtualMachineResources) int { return r.Disk }},
+	}
+
+	for _, w := range windows(reserved, start, end) {
+		for _, template := range requestedTemplates {
+			reservedCount := int64(w.reserved[template])
+			requestedCount := int64(requested[template])
+			templateCapacity := int64(capacity.Count[template])
+			if reservedCount+requestedCount > templateCapacity {
+				add(Overflow{
+					Template:  template,
+					Resource:  ResourceCount,
+					Reserved:  reservedCount,
+					Requested: requestedCount,
+					Capacity:  templateCapacity,
+				}, w)
+			}
+		}
+
+		for _, resource := range resources {
+			if resource.capacity <= 0 {
+				continue
+			}
+
+			var reservedAmount, requestedAmount int64
+			usage := make(map[string]int64)
+			for template, count := range w.reserved {
+				amount := int64(count) * int64(resource.amount(templates[template]))
+				reservedAmount += amount
+				usage[template] += amount
+			}
+			for template, count := range requested {
+				amount := int64(count) * int64(resource.amount(templates[template]))
+				requestedAmount += amount
+				usage[template] += amount
+			}
+			for template, amount := range usage {
+				if amount == 0 {
+					delete(usage, template)
+				}
+			}
+
+			if requestedAmount == 0 || reservedAmount+requestedAmount <= int64(resource.capacity) {
+				continue
+			}
+			add(Overflow{
+				Resource:  resource.name,
+				Reserved:  reservedAmount,
+				Requested: requestedAmount,
+				Capacity:  int64(resource.capacity),
+				Usage:     usage,
+			}, w)
+		}
+	}
+
+	return overflows
+}
@@ -0,0 +1,98 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var base = time.Date(2030, 1, 1, 8, 0, 0, 0, time.UTC)
+
+func at(hours int) time.Time {
+	return base.Add(time.Duration(hours) * time.Hour)
+}
+
+func TestWindows(t *testing.T) {
+	reserved := map[time.Time]map[string]uint32{
+		at(-1): {"small": 2},
+		at(2):  {"small": 4},
+		at(4):  {},
+		at(9):  {"small": 1},
+	}
+
+	w := windows(reserved, at(0), at(6))
+	require.Len(t, w, 3)
+	assert.Equal(t, window{start: at(0), end: at(2), reserved: map[string]uint32{"small": 2}}, w[0])
+	assert.Equal(t, window{start: at(2), end: at(4), reserved: map[string]uint32{"small": 4}}, w[1])
+	assert.Equal(t, window{start: at(4), end: at(6), reserved: map[string]uint32{}}, w[2])
+
+	w = windows(map[time.Time]map[string]uint32{}, at(0), at(6))
+	require.Len(t, w, 1)
+	assert.Empty(t, w[0].reserved)
+}
+
+func TestCheckCount(t *testing.T) {
+	capacity := Capacity{Count: map[string]int{"small": 5, "large": 1}}
+	reserved := map[time.Time]map[string]uint32{
+		at(0): {"small": 4, "large": 1},
+		at(2): {"small": 1, "large": 1},
+		at(4): {},
+	}
+
+	overflows := Check("env", capacity, nil, reserved, map[string]uint32{"small": 2}, at(0), at(6))
+	require.Len(t, overflows, 1)
+	assert.Equal(t, Overflow{
+		Environment: "env",
+		Template:    "small",
+		Resource:    ResourceCount,
+		Start:       at(0).Format(time.UnixDate),
+		End:         at(2).Format(time.UnixDate),
+		Reserved:    4,
+		Requested:   2,
+		Capacity:    5,
+	}, overflows[0])
+
+	// the large template is already overbooked, but not requested
+	assert.Empty(t, Check("env", capacity, nil, reserved, map[string]uint32{"small": 1, "large": 0}, at(0), at(6)))
+
+	overflows = Check("env", capacity, nil, reserved, map[string]uint32{"unknown": 1}, at(0), at(6))
+	require.Len(t, overflows, 1)
+	assert.Equal(t, int64(0), overflows[0].Capacity, "templates without count capacity can not be provided")
+	assert.Equal(t, at(6).Format(time.UnixDate), overflows[0].End, "windows overflowing by the same amounts are merged")
+}
+
+func TestCheckResources(t *testing.T) {
+	capacity := Capacity{
+		Count:     map[string]int{"small": 10, "large": 10},
+		Resources: hfv1.VirtualMachineResources{CPU: 8, Memory: 16384},
+	}
+	templates := map[string]hfv1.VirtualMachineResources{
+		"small": {CPU: 1, Memory: 1024, Disk: 10},
+		"large": {CPU: 4, Memory: 8192, Disk: 50},
+	}
+	reserved := map[time.Time]map[string]uint32{
+		at(0): {"large": 1},
+		at(3): {},
+	}
+
+	overflows := Check("env", capacity, templates, reserved, map[string]uint32{"small": 5}, at(0), at(6))
+	require.Len(t, overflows, 1)
+	assert.Equal(t, ResourceCPU, overflows[0].Resource)
+	assert.Empty(t, overflows[0].Template)
+	assert.Equal(t, int64(4), overflows[0].Reserved)
+	assert.Equal(t, int64(5), overflows[0].Requested)
+	assert.Equal(t, map[string]int64{"large": 4, "small": 5}, overflows[0].Usage)
+	assert.Equal(t, at(3).Format(time.UnixDate), overflows[0].End)
+
+	overflows = Check("env", capacity, templates, reserved, map[string]uint32{"large": 2}, at(0), at(6))
+	require.Len(t, overflows, 2, "memory overflows next to cpu, disk is unlimited")
+	assert.Equal(t, ResourceCPU, overflows[0].Resource)
+	assert.Equal(t, ResourceMemory, overflows[1].Resource)
+
+	report := Report{Overflows: overflows}
+	assert.True(t, report.Overbooked())
+	assert.Contains(t, report.String(), "environment env is overbooked by cpu from")
+}
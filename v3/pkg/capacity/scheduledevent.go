@@ -0,0 +1,194 @@
+package capacity
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	environmentpb "github.com/hobbyfarm/gargantua/v3/protos/environment"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
+)
+
+// Checker checks scheduled events against the capacity of the environments they require virtual machines from.
+type Checker struct {
+	environmentClient environmentpb.EnvironmentSvcClient
+	vmTemplateClient  vmtemplatepb.VMTemplateSvcClient
+}
+
+func NewChecker(environmentClient environmentpb.EnvironmentSvcClient, vmTemplateClient vmtemplatepb.VMTemplateSvcClient) *Checker {
+	return &Checker{
+		environmentClient: environmentClient,
+		vmTemplateClient:  vmTemplateClient,
+	}
+}
+
+// CheckScheduledEvent checks if the virtual machines required by the scheduled event fit into their environments next to
+// the virtual machines reserved by the other scheduled events. The scheduled event itself is excluded from the other
+// scheduled events, so it can be checked again when it is updated or provisioned.
+// Only the future part of the scheduled event is checked.
+func (c *Checker) CheckScheduledEvent(ctx context.Context, se *scheduledeventpb.ScheduledEvent, scheduledEvents []*scheduledeventpb.ScheduledEvent) (Report, error) {
+	start, end, err := period(se)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Overflows: []Overflow{}}
+	if !start.Before(end) {
+		return report, nil
+	}
+
+	otherEvents := excludeScheduledEvent(scheduledEvents, se)
+
+	templates, err := c.templateResources(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	for environment, vmMapping := range util.RequiredVirtualMachines(se) {
+		env, err := c.environmentClient.GetEnvironment(ctx, &generalpb.GetRequest{Id: environment})
+		if err != nil {
+			return Report{}, fmt.Errorf("error retrieving environment %s: %s", environment, hferrors.GetErrorMessage(err))
+		}
+
+		reserved, _, err := util.VirtualMachinesReservedDuringPeriod(otherEvents, environment, start, end)
+		if err != nil {
+			return Report{}, err
+		}
+
+		capacity := Capacity{
+			Count:     util.ConvertIntMap[uint32, int](env.GetCountCapacity()),
+			Resources: util.ConvertFromResources(env.GetResourceCapacity()),
+		}
+
+		overflows := Check(environment, capacity, templates, reserved, vmMapping.GetVmTemplateCounts(), start, end)
+		report.Overflows = append(report.Overflows, overflows...)
+	}
+
+	return report, nil
+}
+
+// AllocateSpread splits the spread virtual machines of the scheduled event across their environments. The remaining
+// capacity of an environment is its count capacity minus the most virtual machines reserved by other scheduled events
+// during the scheduled event and minus the virtual machines the scheduled event requires from it directly.
+// Failed environments are skipped unless all environments of a spread failed.
+func (c *Checker) AllocateSpread(ctx context.Context, se *scheduledeventpb.ScheduledEvent, scheduledEvents []*scheduledeventpb.ScheduledEvent, failedEnvironments []string) (map[string]*scheduledeventpb.VMTemplateCountMap, error) {
+	allocation := make(map[string]*scheduledeventpb.VMTemplateCountMap)
+	if len(se.GetSpreadVms()) == 0 {
+		return allocation, nil
+	}
+
+	start, end, err := period(se)
+	if err != nil {
+		return nil, err
+	}
+
+	otherEvents := excludeScheduledEvent(scheduledEvents, se)
+
+	failed := make(map[string]bool, len(failedEnvironments))
+	for _, environment := range failedEnvironments {
+		failed[environment] = true
+	}
+
+	remaining := make(map[string]map[string]int) // environment -> vm template -> count
+	for _, spread := range se.GetSpreadVms() {
+		for _, preference := range spread.GetEnvironments() {
+			environment := preference.GetEnvironment()
+			if _, ok := remaining[environment]; ok {
+				continue
+			}
+
+			env, err := c.environmentClient.GetEnvironment(ctx, &generalpb.GetRequest{Id: environment})
+			if err != nil {
+				return nil, fmt.Errorf("error retrieving environment %s: %s", environment, hferrors.GetErrorMessage(err))
+			}
+
+			_, maximumReserved, err := util.VirtualMachinesReservedDuringPeriod(otherEvents, environment, start, end)
+			if err != nil {
+				return nil, err
+			}
+
+			remaining[environment] = make(map[string]int)
+			for vmTemplate, count := range env.GetCountCapacity() {
+				required := se.GetRequiredVms()[environment].GetVmTemplateCounts()[vmTemplate]
+				remaining[environment][vmTemplate] = int(count) - int(maximumReserved[vmTemplate]) - int(required)
+			}
+		}
+	}
+
+	for _, spread := range se.GetSpreadVms() {
+		vmTemplate := spread.GetVmTemplate()
+
+		var preferences []Preference
+		for _, p := range spread.GetEnvironments() {
+			if !failed[p.GetEnvironment()] {
+				preferences = append(preferences, Preference{Environment: p.GetEnvironment(), Weight: int(p.GetWeight())})
+			}
+		}
+		if len(preferences) == 0 {
+			for _, p := range spread.GetEnvironments() {
+				preferences = append(preferences, Preference{Environment: p.GetEnvironment(), Weight: int(p.GetWeight())})
+			}
+		}
+
+		templateRemaining := make(map[string]int, len(preferences))
+		for _, p := range preferences {
+			templateRemaining[p.Environment] = remaining[p.Environment][vmTemplate]
+		}
+
+		for environment, count := range Spread(int(spread.GetCount()), preferences, templateRemaining) {
+			remaining[environment][vmTemplate] -= count
+			if _, ok := allocation[environment]; !ok {
+				allocation[environment] = &scheduledeventpb.VMTemplateCountMap{VmTemplateCounts: make(map[string]uint32)}
+			}
+			allocation[environment].VmTemplateCounts[vmTemplate] += uint32(count)
+		}
+	}
+
+	return allocation, nil
+}
+
+// period returns the future part of the scheduled event.
+func period(se *scheduledeventpb.ScheduledEvent) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.UnixDate, se.GetStartTime())
+	if err != nil {
+		return start, start, fmt.Errorf("error parsing start time %v", err)
+	}
+	end, err := time.Parse(time.UnixDate, se.GetEndTime())
+	if err != nil {
+		return start, end, fmt.Errorf("error parsing end time %v", err)
+	}
+	if start.Before(time.Now()) {
+		start = time.Now()
+	}
+	return start, end, nil
+}
+
+// excludeScheduledEvent removes the scheduled event from the scheduled events, new scheduled events have no id yet.
+func excludeScheduledEvent(scheduledEvents []*scheduledeventpb.ScheduledEvent, se *scheduledeventpb.ScheduledEvent) []*scheduledeventpb.ScheduledEvent {
+	var otherEvents []*scheduledeventpb.ScheduledEvent
+	for _, other := range scheduledEvents {
+		if se.GetId() != "" && other.GetId() == se.GetId() {
+			continue
+		}
+		otherEvents = append(otherEvents, other)
+	}
+	return otherEvents
+}
+
+func (c *Checker) templateResources(ctx context.Context) (map[string]hfv1.VirtualMachineResources, error) {
+	vmTemplateList, err := c.vmTemplateClient.ListVMTemplate(ctx, &generalpb.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving virtual machine templates: %s", hferrors.GetErrorMessage(err))
+	}
+
+	templates := make(map[string]hfv1.VirtualMachineResources)
+	for _, vmTemplate := range vmTemplateList.GetVmtemplates() {
+		templates[vmTemplate.GetId()] = util.ConvertFromResources(vmTemplate.GetResources())
+	}
+	return templates, nil
+}
@@ -0,0 +1,84 @@
+package capacity
+
+import (
+	"sort"
+)
+
+// Preference is how strongly spread virtual machines should be put into an environment.
+type Preference struct {
+	Environment string
+	Weight      int
+}
+
+func (p Preference) weight() int {
+	if p.Weight > 0 {
+		return p.Weight
+	}
+	return 1
+}
+
+// Spread splits count virtual machines across the environments in proportion to their weights. No environment gets
+// more virtual machines than its remaining capacity, its share is split across the other environments instead.
+// Virtual machines which do not fit into any environment are put into the most preferred one, so the overbooking
+// shows up when the capacity is checked.
+func Spread(count int, preferences []Preference, remaining map[string]int) map[string]int {
+	allocation := make(map[string]int)
+	free := func(environment string) int {
+		return remaining[environment] - allocation[environment]
+	}
+
+	left := count
+	for left > 0 {
+		var candidates []Preference
+		totalWeight := 0
+		for _, p := range preferences {
+			if free(p.Environment) > 0 {
+				candidates = append(candidates, p)
+				totalWeight += p.weight()
+			}
+		}
+		if len(candidates) == 0 {
+			break
+		}
+
+		// largest remainder method, earlier preferences win ties
+		shares := make([]int, len(candidates))
+		remainders := make([]int, len(candidates))
+		order := make([]int, len(candidates))
+		assigned := 0
+		for i, c := range candidates {
+			shares[i] = left * c.weight() / totalWeight
+			remainders[i] = left * c.weight() % totalWeight
+			order[i] = i
+			assigned += shares[i]
+		}
+		sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+		for _, i := range order[:left-assigned] {
+			shares[i]++
+		}
+
+		for i, c := range candidates {
+			n := min(shares[i], free(c.Environment))
+			allocation[c.Environment] += n
+			left -= n
+		}
+	}
+
+	if left > 0 && len(preferences) > 0 {
+		preferred := preferences[0]
+		for _, p := range preferences[1:] {
+			if p.weight() > preferred.weight() {
+				preferred = p
+			}
+		}
+		allocation[preferred.Environment] += left
+	}
+
+	for environment, n := range allocation {
+		if n == 0 {
+			delete(allocation, environment)
+		}
+	}
+
+	return allocation
+}
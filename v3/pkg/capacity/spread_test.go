@@ -0,0 +1,31 @@
+package capacity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadByWeight(t *testing.T) {
+	preferences := []Preference{{Environment: "a", Weight: 2}, {Environment: "b", Weight: 1}}
+	remaining := map[string]int{"a": 100, "b": 100}
+
+	assert.Equal(t, map[string]int{"a": 20, "b": 10}, Spread(30, preferences, remaining))
+	assert.Equal(t, map[string]int{"a": 1}, Spread(1, preferences, remaining))
+	assert.Equal(t, map[string]int{"a": 2, "b": 2}, Spread(4, []Preference{{Environment: "a"}, {Environment: "b"}}, remaining), "weights default to 1")
+}
+
+func TestSpreadRemainingCapacity(t *testing.T) {
+	preferences := []Preference{{Environment: "a", Weight: 3}, {Environment: "b", Weight: 1}, {Environment: "c", Weight: 1}}
+
+	// the share of a which does not fit is split across b and c
+	assert.Equal(t, map[string]int{"a": 10, "b": 10, "c": 10}, Spread(30, preferences, map[string]int{"a": 10, "b": 50, "c": 50}))
+
+	// b is full, so it gets nothing
+	assert.Equal(t, map[string]int{"a": 15, "c": 5}, Spread(20, preferences, map[string]int{"a": 50, "b": 0, "c": 50}))
+
+	// more vms than capacity overbook the most preferred environment
+	assert.Equal(t, map[string]int{"a": 7, "b": 1, "c": 2}, Spread(10, preferences, map[string]int{"a": 2, "b": 1, "c": 2}))
+
+	assert.Empty(t, Spread(5, nil, map[string]int{}))
+}
@@ -0,0 +1,137 @@
+package changefeed
+
+import (
+	"sync"
+
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type EventType string
+
+const (
+	EventAdded   EventType = "added"
+	EventUpdated EventType = "updated"
+	EventDeleted EventType = "deleted"
+)
+
+// subscriberBuffer is the number of events a subscriber may fall behind before it is disconnected.
+const subscriberBuffer = 256
+
+// Event is a change of a resource as it is sent to subscribers.
+type Event struct {
+	Type           EventType   `json:"type"`
+	Resource       string      `json:"resource"`
+	Id             string      `json:"id"`
+	ScheduledEvent string      `json:"scheduled_event,omitempty"`
+	Object         interface{} `json:"object"`
+}
+
+// PrepareFunc converts an object of the informer into the representation sent to subscribers.
+type PrepareFunc func(obj metav1.Object) interface{}
+
+// Feed fans the changes of a resource out to its subscribers.
+type Feed struct {
+	resource    string
+	prepare     PrepareFunc
+	list        func() []interface{}
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	scheduledEvent string
+	events         chan Event
+	// overflow is closed when the subscriber fell behind and got removed from the feed
+	overflow chan struct{}
+}
+
+func newFeed(resource string, list func() []interface{}, prepare PrepareFunc) *Feed {
+	return &Feed{
+		resource:    resource,
+		prepare:     prepare,
+		list:        list,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (f *Feed) newEvent(eventType EventType, obj metav1.Object) Event {
+	return Event{
+		Type:           eventType,
+		Resource:       f.resource,
+		Id:             obj.GetName(),
+		ScheduledEvent: obj.GetLabels()[hflabels.ScheduledEventLabel],
+		Object:         f.prepare(obj),
+	}
+}
+
+// matches returns whether the subscriber is interested in the event, subscribers without scheduled event get every event.
+func (s *subscriber) matches(event Event) bool {
+	return s.scheduledEvent == "" || s.scheduledEvent == event.ScheduledEvent
+}
+
+func (f *Feed) subscribe(scheduledEvent string) *subscriber {
+	s := &subscriber{
+		scheduledEvent: scheduledEvent,
+		events:         make(chan Event, subscriberBuffer),
+		overflow:       make(chan struct{}),
+	}
+	f.mu.Lock()
+	f.subscribers[s] = struct{}{}
+	f.mu.Unlock()
+	return s
+}
+
+func (f *Feed) unsubscribe(s *subscriber) {
+	f.mu.Lock()
+	delete(f.subscribers, s)
+	f.mu.Unlock()
+}
+
+// publish sends the event to every matching subscriber. Informer handlers must not block, subscribers that do not
+// keep up are removed instead of waiting for them. Their clients reconnect and start over with a snapshot.
+func (f *Feed) publish(eventType EventType, obj metav1.Object) {
+	event := f.newEvent(eventType, obj)
+
+	var overflowed []*subscriber
+	f.mu.RLock()
+	for s := range f.subscribers {
+		if !s.matches(event) {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			overflowed = append(overflowed, s)
+		}
+	}
+	f.mu.RUnlock()
+
+	if len(overflowed) == 0 {
+		return
+	}
+	f.mu.Lock()
+	for _, s := range overflowed {
+		if _, ok := f.subscribers[s]; ok {
+			delete(f.subscribers, s)
+			close(s.overflow)
+		}
+	}
+	f.mu.Unlock()
+}
+
+// snapshot returns an added event for every object of the informer the subscriber is interested in.
+func (f *Feed) snapshot(s *subscriber) []Event {
+	events := make([]Event, 0)
+	for _, item := range f.list() {
+		obj, ok := item.(metav1.Object)
+		if !ok {
+			continue
+		}
+		event := f.newEvent(EventAdded, obj)
+		if s.matches(event) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
@@ -0,0 +1,123 @@
+package changefeed
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testObject(name string, scheduledEvent string) *metav1.ObjectMeta {
+	return &metav1.ObjectMeta{Name: name, Labels: map[string]string{hflabels.ScheduledEventLabel: scheduledEvent}}
+}
+
+func testFeed(objects ...interface{}) *Feed {
+	return newFeed("things", func() []interface{} { return objects }, func(obj metav1.Object) interface{} {
+		return obj.GetName()
+	})
+}
+
+func TestPublishFiltersByScheduledEvent(t *testing.T) {
+	f := testFeed()
+	all := f.subscribe("")
+	scoped := f.subscribe("se1")
+
+	f.publish(EventAdded, testObject("a", "se1"))
+	f.publish(EventDeleted, testObject("b", "se2"))
+
+	require.Len(t, all.events, 2)
+	require.Len(t, scoped.events, 1)
+	event := <-scoped.events
+	assert.Equal(t, Event{Type: EventAdded, Resource: "things", Id: "a", ScheduledEvent: "se1", Object: "a"}, event)
+}
+
+func TestPublishRemovesSlowSubscribers(t *testing.T) {
+	f := testFeed()
+	s := f.subscribe("")
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		f.publish(EventUpdated, testObject("a", "se1"))
+	}
+
+	select {
+	case <-s.overflow:
+	default:
+		t.Fatal("expected the subscriber to overflow")
+	}
+	assert.Empty(t, f.subscribers)
+
+	// unsubscribing an overflowed subscriber is safe
+	f.unsubscribe(s)
+}
+
+func TestSnapshot(t *testing.T) {
+	f := testFeed(testObject("a", "se1"), testObject("b", "se2"), "not an object")
+
+	events := f.snapshot(f.subscribe("se2"))
+	require.Len(t, events, 1)
+	assert.Equal(t, "b", events[0].Id)
+	assert.Equal(t, EventAdded, events[0].Type)
+}
+
+func TestStream(t *testing.T) {
+	f := testFeed(testObject("a", "se1"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		f.Stream(w, req, "se1")
+	}))
+	defer server.Close()
+	defer cancel()
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(r)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if line := scanner.Text(); strings.HasPrefix(line, "event: ") || strings.HasPrefix(line, "data: ") {
+				lines <- line
+			}
+		}
+		close(lines)
+	}()
+	next := func() string {
+		select {
+		case line := <-lines:
+			return line
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the stream")
+			return ""
+		}
+	}
+
+	assert.Equal(t, "event: added", next())
+	assert.Equal(t, `data: {"type":"added","resource":"things","id":"a","scheduled_event":"se1","object":"a"}`, next())
+	assert.Equal(t, "event: synced", next())
+	assert.Equal(t, "data: {}", next())
+
+	// wait for the subscription before publishing
+	assert.Eventually(t, func() bool {
+		f.mu.RLock()
+		defer f.mu.RUnlock()
+		return len(f.subscribers) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	f.publish(EventDeleted, testObject("b", "se2"))
+	f.publish(EventDeleted, testObject("a", "se1"))
+
+	assert.Equal(t, "event: deleted", next())
+	assert.Equal(t, `data: {"type":"deleted","resource":"things","id":"a","scheduled_event":"se1","object":"a"}`, next())
+}
@@ -0,0 +1,46 @@
+package changefeed
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NewFeed creates a feed of the changes observed by a shared informer. The informer is shared with the listers of
+// the service, subscribers therefore do not put any additional load on the kubernetes apiserver.
+func NewFeed(resource string, informer cache.SharedIndexInformer, prepare PrepareFunc) (*Feed, error) {
+	f := newFeed(resource, informer.GetStore().List, prepare)
+
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if o, ok := obj.(metav1.Object); ok {
+				f.publish(EventAdded, o)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldO, ok := oldObj.(metav1.Object)
+			if !ok {
+				return
+			}
+			newO, ok := newObj.(metav1.Object)
+			// periodic resyncs deliver unchanged objects
+			if !ok || oldO.GetResourceVersion() == newO.GetResourceVersion() {
+				return
+			}
+			f.publish(EventUpdated, newO)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if o, ok := obj.(metav1.Object); ok {
+				f.publish(EventDeleted, o)
+			}
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error adding %s change feed event handler: %v", resource, err)
+	}
+	return f, nil
+}
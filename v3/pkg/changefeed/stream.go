@@ -0,0 +1,96 @@
+package changefeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+)
+
+const (
+	heartbeatInterval = 30 * time.Second
+	// retryMilliseconds tells EventSource clients how long to wait before reconnecting
+	retryMilliseconds = 3000
+)
+
+/*
+Stream the changes of the feed as server-sent events until the client disconnects
+
+	Query:
+	- snapshot : Unless "false", every object is sent as "added" event first, followed by a "synced" event
+
+Every change is sent as event of its type ("added", "updated" or "deleted") with the Event as JSON data. An
+"overflow" event is sent before the stream is closed because the client did not keep up with the changes.
+*/
+func (f *Feed) Stream(w http.ResponseWriter, r *http.Request, scheduledEvent string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		util.ReturnHTTPMessage(w, r, 500, "error", "streaming is not supported")
+		return
+	}
+
+	// subscribe before taking the snapshot so no change gets lost in between, "added" events are upserts
+	s := f.subscribe(scheduledEvent)
+	defer f.unsubscribe(s)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", retryMilliseconds); err != nil {
+		return
+	}
+
+	if r.URL.Query().Get("snapshot") != "false" {
+		for _, event := range f.snapshot(s) {
+			if err := writeEvent(w, string(event.Type), event); err != nil {
+				return
+			}
+		}
+		if err := writeEvent(w, "synced", struct{}{}); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.overflow:
+			glog.V(4).Infof("closing %s change feed, the client fell behind", f.resource)
+			_ = writeEvent(w, "overflow", struct{}{})
+			flusher.Flush()
+			return
+		case event := <-s.events:
+			if err := writeEvent(w, string(event.Type), event); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeEvent(w io.Writer, name string, data interface{}) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		glog.Errorf("error encoding change feed event: %v", err)
+		return nil
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, encoded)
+	return err
+}
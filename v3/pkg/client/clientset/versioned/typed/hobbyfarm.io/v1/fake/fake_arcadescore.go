@@ -0,0 +1,50 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hobbyfarmiov1 "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned/typed/hobbyfarm.io/v1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeArcadeScores implements ArcadeScoreInterface
+type fakeArcadeScores struct {
+	*gentype.FakeClientWithList[*v1.ArcadeScore, *v1.ArcadeScoreList]
+	Fake *FakeHobbyfarmV1
+}
+
+func newFakeArcadeScores(fake *FakeHobbyfarmV1, namespace string) hobbyfarmiov1.ArcadeScoreInterface {
+	return &fakeArcadeScores{
+		gentype.NewFakeClientWithList[*v1.ArcadeScore, *v1.ArcadeScoreList](
+			fake.Fake,
+			namespace,
+			v1.SchemeGroupVersion.WithResource("arcadescores"),
+			v1.SchemeGroupVersion.WithKind("ArcadeScore"),
+			func() *v1.ArcadeScore { return &v1.ArcadeScore{} },
+			func() *v1.ArcadeScoreList { return &v1.ArcadeScoreList{} },
+			func(dst, src *v1.ArcadeScoreList) { dst.ListMeta = src.ListMeta },
+			func(list *v1.ArcadeScoreList) []*v1.ArcadeScore { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1.ArcadeScoreList, items []*v1.ArcadeScore) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
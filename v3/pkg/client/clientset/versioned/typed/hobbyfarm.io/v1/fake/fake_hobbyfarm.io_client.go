@@ -32,6 +32,22 @@ func (c *FakeHobbyfarmV1) AccessCodes(namespace string) v1.AccessCodeInterface {
 	return newFakeAccessCodes(c, namespace)
 }
 
+func (c *FakeHobbyfarmV1) ArcadeScans(namespace string) v1.ArcadeScanInterface {
+	return newFakeArcadeScans(c, namespace)
+}
+
+func (c *FakeHobbyfarmV1) ArcadeScores(namespace string) v1.ArcadeScoreInterface {
+	return newFakeArcadeScores(c, namespace)
+}
+
+func (c *FakeHobbyfarmV1) Certificates(namespace string) v1.CertificateInterface {
+	return newFakeCertificates(c, namespace)
+}
+
+func (c *FakeHobbyfarmV1) CertificateTemplates(namespace string) v1.CertificateTemplateInterface {
+	return newFakeCertificateTemplates(c, namespace)
+}
+
 func (c *FakeHobbyfarmV1) Costs(namespace string) v1.CostInterface {
 	return newFakeCosts(c, namespace)
 }
@@ -48,6 +64,14 @@ func (c *FakeHobbyfarmV1) Environments(namespace string) v1.EnvironmentInterface
 	return newFakeEnvironments(c, namespace)
 }
 
+func (c *FakeHobbyfarmV1) GitSyncs(namespace string) v1.GitSyncInterface {
+	return newFakeGitSyncs(c, namespace)
+}
+
+func (c *FakeHobbyfarmV1) Leaderboards(namespace string) v1.LeaderboardInterface {
+	return newFakeLeaderboards(c, namespace)
+}
+
 func (c *FakeHobbyfarmV1) OneTimeAccessCodes(namespace string) v1.OneTimeAccessCodeInterface {
 	return newFakeOneTimeAccessCodes(c, namespace)
 }
@@ -20,6 +20,14 @@ package v1
 
 type AccessCodeExpansion interface{}
 
+type ArcadeScanExpansion interface{}
+
+type ArcadeScoreExpansion interface{}
+
+type CertificateExpansion interface{}
+
+type CertificateTemplateExpansion interface{}
+
 type CostExpansion interface{}
 
 type CourseExpansion interface{}
@@ -28,6 +36,10 @@ type DynamicBindConfigurationExpansion interface{}
 
 type EnvironmentExpansion interface{}
 
+type GitSyncExpansion interface{}
+
+type LeaderboardExpansion interface{}
+
 type OneTimeAccessCodeExpansion interface{}
 
 type PredefinedServiceExpansion interface{}
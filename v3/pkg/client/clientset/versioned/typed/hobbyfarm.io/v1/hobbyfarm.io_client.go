@@ -29,10 +29,16 @@ import (
 type HobbyfarmV1Interface interface {
 	RESTClient() rest.Interface
 	AccessCodesGetter
+	ArcadeScansGetter
+	ArcadeScoresGetter
+	CertificatesGetter
+	CertificateTemplatesGetter
 	CostsGetter
 	CoursesGetter
 	DynamicBindConfigurationsGetter
 	EnvironmentsGetter
+	GitSyncsGetter
+	LeaderboardsGetter
 	OneTimeAccessCodesGetter
 	PredefinedServicesGetter
 	ProgressesGetter
@@ -59,6 +65,22 @@ func (c *HobbyfarmV1Client) AccessCodes(namespace string) AccessCodeInterface {
 	return newAccessCodes(c, namespace)
 }
 
+func (c *HobbyfarmV1Client) ArcadeScans(namespace string) ArcadeScanInterface {
+	return newArcadeScans(c, namespace)
+}
+
+func (c *HobbyfarmV1Client) ArcadeScores(namespace string) ArcadeScoreInterface {
+	return newArcadeScores(c, namespace)
+}
+
+func (c *HobbyfarmV1Client) Certificates(namespace string) CertificateInterface {
+	return newCertificates(c, namespace)
+}
+
+func (c *HobbyfarmV1Client) CertificateTemplates(namespace string) CertificateTemplateInterface {
+	return newCertificateTemplates(c, namespace)
+}
+
 func (c *HobbyfarmV1Client) Costs(namespace string) CostInterface {
 	return newCosts(c, namespace)
 }
@@ -75,6 +97,14 @@ func (c *HobbyfarmV1Client) Environments(namespace string) EnvironmentInterface
 	return newEnvironments(c, namespace)
 }
 
+func (c *HobbyfarmV1Client) GitSyncs(namespace string) GitSyncInterface {
+	return newGitSyncs(c, namespace)
+}
+
+func (c *HobbyfarmV1Client) Leaderboards(namespace string) LeaderboardInterface {
+	return newLeaderboards(c, namespace)
+}
+
 func (c *HobbyfarmV1Client) OneTimeAccessCodes(namespace string) OneTimeAccessCodeInterface {
 	return newOneTimeAccessCodes(c, namespace)
 }
@@ -57,6 +57,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=hobbyfarm.io, Version=v1
 	case v1.SchemeGroupVersion.WithResource("accesscodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().AccessCodes().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("arcadescans"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().ArcadeScans().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("arcadescores"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().ArcadeScores().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("certificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().Certificates().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("certificatetemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().CertificateTemplates().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("costs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().Costs().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("courses"):
@@ -65,6 +73,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().DynamicBindConfigurations().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("environments"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().Environments().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("gitsyncs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().GitSyncs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("leaderboards"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().Leaderboards().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("onetimeaccesscodes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Hobbyfarm().V1().OneTimeAccessCodes().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("predefinedservices"):
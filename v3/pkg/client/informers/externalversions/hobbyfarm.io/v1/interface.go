@@ -26,6 +26,14 @@ import (
 type Interface interface {
 	// AccessCodes returns a AccessCodeInformer.
 	AccessCodes() AccessCodeInformer
+	// ArcadeScans returns a ArcadeScanInformer.
+	ArcadeScans() ArcadeScanInformer
+	// ArcadeScores returns a ArcadeScoreInformer.
+	ArcadeScores() ArcadeScoreInformer
+	// Certificates returns a CertificateInformer.
+	Certificates() CertificateInformer
+	// CertificateTemplates returns a CertificateTemplateInformer.
+	CertificateTemplates() CertificateTemplateInformer
 	// Costs returns a CostInformer.
 	Costs() CostInformer
 	// Courses returns a CourseInformer.
@@ -34,6 +42,10 @@ type Interface interface {
 	DynamicBindConfigurations() DynamicBindConfigurationInformer
 	// Environments returns a EnvironmentInformer.
 	Environments() EnvironmentInformer
+	// GitSyncs returns a GitSyncInformer.
+	GitSyncs() GitSyncInformer
+	// Leaderboards returns a LeaderboardInformer.
+	Leaderboards() LeaderboardInformer
 	// OneTimeAccessCodes returns a OneTimeAccessCodeInformer.
 	OneTimeAccessCodes() OneTimeAccessCodeInformer
 	// PredefinedServices returns a PredefinedServiceInformer.
@@ -82,6 +94,26 @@ func (v *version) AccessCodes() AccessCodeInformer {
 	return &accessCodeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ArcadeScans returns a ArcadeScanInformer.
+func (v *version) ArcadeScans() ArcadeScanInformer {
+	return &arcadeScanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ArcadeScores returns a ArcadeScoreInformer.
+func (v *version) ArcadeScores() ArcadeScoreInformer {
+	return &arcadeScoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Certificates returns a CertificateInformer.
+func (v *version) Certificates() CertificateInformer {
+	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CertificateTemplates returns a CertificateTemplateInformer.
+func (v *version) CertificateTemplates() CertificateTemplateInformer {
+	return &certificateTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Costs returns a CostInformer.
 func (v *version) Costs() CostInformer {
 	return &costInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -102,6 +134,16 @@ func (v *version) Environments() EnvironmentInformer {
 	return &environmentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// GitSyncs returns a GitSyncInformer.
+func (v *version) GitSyncs() GitSyncInformer {
+	return &gitSyncInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Leaderboards returns a LeaderboardInformer.
+func (v *version) Leaderboards() LeaderboardInformer {
+	return &leaderboardInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // OneTimeAccessCodes returns a OneTimeAccessCodeInformer.
 func (v *version) OneTimeAccessCodes() OneTimeAccessCodeInformer {
 	return &oneTimeAccessCodeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -0,0 +1,70 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1
+
+import (
+	hobbyfarmiov1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// ArcadeScoreLister helps list ArcadeScores.
+// All objects returned here must be treated as read-only.
+type ArcadeScoreLister interface {
+	// List lists all ArcadeScores in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*hobbyfarmiov1.ArcadeScore, err error)
+	// ArcadeScores returns an object that can list and get ArcadeScores.
+	ArcadeScores(namespace string) ArcadeScoreNamespaceLister
+	ArcadeScoreListerExpansion
+}
+
+// arcadeScoreLister implements the ArcadeScoreLister interface.
+type arcadeScoreLister struct {
+	listers.ResourceIndexer[*hobbyfarmiov1.ArcadeScore]
+}
+
+// NewArcadeScoreLister returns a new ArcadeScoreLister.
+func NewArcadeScoreLister(indexer cache.Indexer) ArcadeScoreLister {
+	return &arcadeScoreLister{listers.New[*hobbyfarmiov1.ArcadeScore](indexer, hobbyfarmiov1.Resource("arcadescore"))}
+}
+
+// ArcadeScores returns an object that can list and get ArcadeScores.
+func (s *arcadeScoreLister) ArcadeScores(namespace string) ArcadeScoreNamespaceLister {
+	return arcadeScoreNamespaceLister{listers.NewNamespaced[*hobbyfarmiov1.ArcadeScore](s.ResourceIndexer, namespace)}
+}
+
+// ArcadeScoreNamespaceLister helps list and get ArcadeScores.
+// All objects returned here must be treated as read-only.
+type ArcadeScoreNamespaceLister interface {
+	// List lists all ArcadeScores in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*hobbyfarmiov1.ArcadeScore, err error)
+	// Get retrieves the ArcadeScore from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*hobbyfarmiov1.ArcadeScore, error)
+	ArcadeScoreNamespaceListerExpansion
+}
+
+// arcadeScoreNamespaceLister implements the ArcadeScoreNamespaceLister
+// interface.
+type arcadeScoreNamespaceLister struct {
+	listers.ResourceIndexer[*hobbyfarmiov1.ArcadeScore]
+}
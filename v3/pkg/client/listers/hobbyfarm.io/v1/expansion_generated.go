@@ -26,6 +26,38 @@ type AccessCodeListerExpansion interface{}
 // AccessCodeNamespaceLister.
 type AccessCodeNamespaceListerExpansion interface{}
 
+// ArcadeScanListerExpansion allows custom methods to be added to
+// ArcadeScanLister.
+type ArcadeScanListerExpansion interface{}
+
+// ArcadeScanNamespaceListerExpansion allows custom methods to be added to
+// ArcadeScanNamespaceLister.
+type ArcadeScanNamespaceListerExpansion interface{}
+
+// ArcadeScoreListerExpansion allows custom methods to be added to
+// ArcadeScoreLister.
+type ArcadeScoreListerExpansion interface{}
+
+// ArcadeScoreNamespaceListerExpansion allows custom methods to be added to
+// ArcadeScoreNamespaceLister.
+type ArcadeScoreNamespaceListerExpansion interface{}
+
+// CertificateListerExpansion allows custom methods to be added to
+// CertificateLister.
+type CertificateListerExpansion interface{}
+
+// CertificateNamespaceListerExpansion allows custom methods to be added to
+// CertificateNamespaceLister.
+type CertificateNamespaceListerExpansion interface{}
+
+// CertificateTemplateListerExpansion allows custom methods to be added to
+// CertificateTemplateLister.
+type CertificateTemplateListerExpansion interface{}
+
+// CertificateTemplateNamespaceListerExpansion allows custom methods to be added to
+// CertificateTemplateNamespaceLister.
+type CertificateTemplateNamespaceListerExpansion interface{}
+
 // CostListerExpansion allows custom methods to be added to
 // CostLister.
 type CostListerExpansion interface{}
@@ -58,6 +90,22 @@ type EnvironmentListerExpansion interface{}
 // EnvironmentNamespaceLister.
 type EnvironmentNamespaceListerExpansion interface{}
 
+// GitSyncListerExpansion allows custom methods to be added to
+// GitSyncLister.
+type GitSyncListerExpansion interface{}
+
+// GitSyncNamespaceListerExpansion allows custom methods to be added to
+// GitSyncNamespaceLister.
+type GitSyncNamespaceListerExpansion interface{}
+
+// LeaderboardListerExpansion allows custom methods to be added to
+// LeaderboardLister.
+type LeaderboardListerExpansion interface{}
+
+// LeaderboardNamespaceListerExpansion allows custom methods to be added to
+// LeaderboardNamespaceLister.
+type LeaderboardNamespaceListerExpansion interface{}
+
 // OneTimeAccessCodeListerExpansion allows custom methods to be added to
 // OneTimeAccessCodeLister.
 type OneTimeAccessCodeListerExpansion interface{}
@@ -82,6 +82,15 @@ func GrpcNotFoundError[T IdGetterProtoMessage](resourceId T, resourceName string
 func IsGrpcNotFound(err error) bool {
 	return status.Code(err) == codes.NotFound
 }
+
+// GrpcConflictError is returned if a resource could not be updated because it was modified concurrently
+func GrpcConflictError[T IdGetterProtoMessage](protoMessage T, resourceName string) error {
+	return GrpcError[T](codes.Aborted, "%s %s has been modified", protoMessage, resourceName, protoMessage.GetId())
+}
+
+func IsGrpcConflict(err error) bool {
+	return status.Code(err) == codes.Aborted
+}
 func IsGrpcParsingError(err error) bool {
 	statusErr := status.Convert(err)
 	return statusErr.Code() == codes.Internal && strings.HasPrefix(statusErr.Message(), "error parsing")
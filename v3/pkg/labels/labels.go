@@ -21,6 +21,16 @@ const (
 	CostTimeUnit           = "hobbyfarm.io/cost-time-unit"
 	QuizLabel              = "hobbyfarm.io/quiz"
 	ScenarioLabel          = "hobbyfarm.io/scenario"
+	VirtualMachineLabel    = "hobbyfarm.io/virtualmachine"
+	RecordingLabel         = "hobbyfarm.io/recording"
+	RecordingChunkLabel    = "hobbyfarm.io/recording-chunk"
+	RecordSessionsLabel    = "hobbyfarm.io/record-sessions"
+	OverbookedLabel        = "hobbyfarm.io/overbooked"
+	WarmPoolLabel          = "hobbyfarm.io/warm-pool"
+	NotificationPending    = "hobbyfarm.io/notification-pending"
+	LeaderboardLabel       = "hobbyfarm.io/leaderboard"
+	CertTemplateLabel      = "hobbyfarm.io/certificate-template"
+	GitSyncLabel           = "hobbyfarm.io/gitsync"
 )
 
 func DotEscapeLabel(label string) string {
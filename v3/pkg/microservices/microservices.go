@@ -61,6 +61,7 @@ const (
 	DBConfig       MicroService = "dbconfig-service"
 	Environment    MicroService = "environment-service"
 	Progress       MicroService = "progress-service"
+	Quiz           MicroService = "quiz-service"
 	Rbac           MicroService = "rbac-service"
 	Scenario       MicroService = "scenario-service"
 	ScheduledEvent MicroService = "scheduledevent-service"
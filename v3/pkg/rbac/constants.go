@@ -29,6 +29,7 @@ const (
 	ResourcePluralUser           = "users"
 	ResourcePluralSession        = "sessions"
 	ResourcePluralVM             = "virtualmachines"
+	ResourcePluralVMShell        = "virtualmachines/shell"
 	ResourcePluralVMSet          = "virtualmachinesets"
 	ResourcePluralVMClaim        = "virtualmachineclaims"
 	ResourcePluralVMTemplate     = "virtualmachinetemplates"
@@ -43,4 +44,9 @@ const (
 	ResourcePluralCost           = "costs"
 	ResourcePluralQuiz           = "quizes"
 	ResourcePluralQuizEvaluation = "quizevaluations"
+	ResourcePluralRecording      = "sessionrecordings"
+	ResourcePluralCertificate    = "certificates"
+	ResourcePluralCertTemplate   = "certificatetemplates"
+	ResourcePluralGitSync        = "gitsyncs"
+	ResourcePluralLeaderboard    = "leaderboards"
 )
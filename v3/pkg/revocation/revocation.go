@@ -0,0 +1,97 @@
+package revocation
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	listersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	TokenRevocationConfigMapName = "hobbyfarm-token-revocations"
+)
+
+// TokenRevocations records, per user id, the time before which all tokens of that user are revoked.
+// Revocations are stored in a ConfigMap so that they apply to every replica.
+type TokenRevocations struct {
+	kubeClient kubernetes.Interface
+	lister     listersv1.ConfigMapLister
+	namespace  string
+}
+
+func NewTokenRevocations(kubeClient kubernetes.Interface, informerFactory informers.SharedInformerFactory,
+	namespace string) *TokenRevocations {
+	return &TokenRevocations{
+		kubeClient: kubeClient,
+		lister:     informerFactory.Core().V1().ConfigMaps().Lister(),
+		namespace:  namespace,
+	}
+}
+
+// Revoke revokes all tokens of the user that were issued before the current second. Tokens carry their issue time
+// with a precision of one second, so tokens issued within the second of the revocation, e.g. by a login right after
+// a password change, stay valid.
+func (tr *TokenRevocations) Revoke(ctx context.Context, userId string) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := tr.kubeClient.CoreV1().ConfigMaps(tr.namespace).Get(ctx, TokenRevocationConfigMapName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			_, err = tr.kubeClient.CoreV1().ConfigMaps(tr.namespace).Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      TokenRevocationConfigMapName,
+					Namespace: tr.namespace,
+				},
+				Data: map[string]string{userId: now},
+			}, metav1.CreateOptions{})
+			if errors.IsAlreadyExists(err) {
+				// created concurrently, retry as an update
+				return errors.NewConflict(corev1.Resource("configmaps"), TokenRevocationConfigMapName, err)
+			}
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[userId] = now
+
+		_, err = tr.kubeClient.CoreV1().ConfigMaps(tr.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+// IsRevoked returns true if a token of the user issued at issuedAt has been revoked.
+func (tr *TokenRevocations) IsRevoked(userId string, issuedAt time.Time) bool {
+	cm, err := tr.lister.ConfigMaps(tr.namespace).Get(TokenRevocationConfigMapName)
+	if errors.IsNotFound(err) {
+		return false
+	} else if err != nil {
+		// fail closed
+		glog.Errorf("error retrieving token revocations: %s", err.Error())
+		return true
+	}
+
+	revoked, ok := cm.Data[userId]
+	if !ok {
+		return false
+	}
+
+	revokedAt, err := time.Parse(time.RFC3339, revoked)
+	if err != nil {
+		glog.Errorf("invalid token revocation time %s for user %s", revoked, userId)
+		return true
+	}
+
+	// revocations are stored with second precision, truncate in case they were stored with a finer one
+	return issuedAt.Before(revokedAt.Truncate(time.Second))
+}
@@ -0,0 +1,43 @@
+package revocation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestIsRevoked(t *testing.T) {
+	ctx := context.Background()
+
+	kubeClient := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	tr := NewTokenRevocations(kubeClient, informerFactory, "hobbyfarm")
+
+	before := time.Now().Truncate(time.Second).Add(-time.Second)
+	if err := tr.Revoke(ctx, "u-test"); err != nil {
+		t.Fatal(err)
+	}
+	revokedAt := time.Now().Truncate(time.Second)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	if !tr.IsRevoked("u-test", before) {
+		t.Error("expected token issued before the revocation to be revoked")
+	}
+	// tokens carry their issue time in seconds, a token issued right after the revocation has the same issue time
+	if tr.IsRevoked("u-test", revokedAt) {
+		t.Error("expected token issued within the second of the revocation to be valid")
+	}
+	if tr.IsRevoked("u-test", revokedAt.Add(time.Second)) {
+		t.Error("expected token issued after the revocation to be valid")
+	}
+	if tr.IsRevoked("u-other", before) {
+		t.Error("expected tokens of other users to be valid")
+	}
+}
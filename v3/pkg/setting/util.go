@@ -26,6 +26,22 @@ const (
 	ImprintLinkName                          SettingName = "imprint-linkname"
 	AboutModalButtons                        SettingName = "aboutmodal-buttons"
 	UserTokenExpiration                      SettingName = "user-token-expiration"
+	TaskVerificationMaxConcurrentCommands    SettingName = "task-verification-max-concurrent-commands"
+	TaskVerificationMaxCommandAttempts       SettingName = "task-verification-max-command-attempts"
+	TaskVerificationTimeout                  SettingName = "task-verification-timeout"
+	ScheduledEventEnvironmentFailureTimeout  SettingName = "scheduledevent-environment-failure-timeout"
+	ArcadeBaseUrl                            SettingName = "arcade-base-url"
+	ArcadeNotificationTemplate               SettingName = "arcade-notification-template"
+	ArcadeNotificationMaxAttempts            SettingName = "arcade-notification-max-attempts"
+	ArcadeTeamsWebhookUrl                    SettingName = "arcade-teams-webhook-url"
+	ArcadeSlackWebhookUrl                    SettingName = "arcade-slack-webhook-url"
+	ArcadeWebhookUrl                         SettingName = "arcade-webhook-url"
+	ArcadeWebhookSecret                      SettingName = "arcade-webhook-secret"
+	ArcadeSmtpServer                         SettingName = "arcade-smtp-server"
+	ArcadeSmtpUsername                       SettingName = "arcade-smtp-username"
+	ArcadeSmtpPassword                       SettingName = "arcade-smtp-password"
+	ArcadeEmailFrom                          SettingName = "arcade-email-from"
+	ArcadeEmailTo                            SettingName = "arcade-email-to"
 )
 
 var DataTypeMappingToProto = map[property.DataType]settingpb.DataType{
@@ -0,0 +1,157 @@
+package shell
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	rbac2 "github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+)
+
+// authorizeShell checks if the user may access the shell of vms owned by other users.
+// Observing a shell requires virtualmachines/shell get, typing into it virtualmachines/shell update.
+func (sp ShellProxy) authorizeShell(r *http.Request, userId string, mode ShellMode) error {
+	verb := rbac2.VerbUpdate
+	if mode == ShellModeReadOnly {
+		verb = rbac2.VerbGet
+	}
+
+	authrResponse, err := rbac2.AuthorizeSimple(r, sp.authrClient, userId, rbac2.HobbyfarmPermission(rbac2.ResourcePluralVMShell, verb))
+	if err != nil {
+		return err
+	}
+
+	if !authrResponse.Success {
+		return fmt.Errorf("permission denied")
+	}
+
+	return nil
+}
+
+/*
+* Joins the most recent ssh shell session on a VM as an observer. The mode query parameter
+* is either read-only (default) or read-write.
+ */
+func (sp ShellProxy) FollowSSHFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac2.AuthenticateWS(r, sp.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get vm")
+		return
+	}
+
+	vmId := mux.Vars(r)["vm_id"]
+	if len(vmId) == 0 {
+		util.ReturnHTTPMessage(w, r, 500, "error", "no vm id passed in")
+		return
+	}
+
+	mode := ShellMode(r.URL.Query().Get("mode"))
+	if mode == "" {
+		mode = ShellModeReadOnly
+	}
+	if mode != ShellModeReadOnly && mode != ShellModeReadWrite {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "mode must be either read-only or read-write")
+		return
+	}
+
+	vm, err := sp.vmClient.GetVM(r.Context(), &generalpb.GetRequest{Id: vmId, LoadFromCache: true})
+	if err != nil {
+		glog.Errorf("did not find the right virtual machine ID")
+		util.ReturnHTTPMessage(w, r, 500, "error", "no vm found")
+		return
+	}
+
+	if vm.GetUser() != user.GetId() {
+		if err := sp.authorizeShell(r, user.GetId(), mode); err != nil {
+			glog.Infof("Error doing authGrantWS %s", err)
+			util.ReturnHTTPMessage(w, r, 403, "forbidden", "access denied to follow ssh shell session")
+			return
+		}
+	}
+
+	shared := sp.sessions.latest(vmId)
+	if shared == nil {
+		util.ReturnHTTPMessage(w, r, 404, "notfound", "no active ssh shell session on vm")
+		return
+	}
+
+	var upgrader = websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+	}
+
+	// todo - HACK
+	upgrader.CheckOrigin = func(r *http.Request) bool {
+		return true
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil) // upgrade to websocket
+	if err != nil {
+		glog.Errorf("error upgrading: %s", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error upgrading to websocket")
+		return
+	}
+
+	wrapper := NewWSWrapper(conn, websocket.TextMessage)
+
+	client, ok := shared.join(user.GetId(), mode, wrapper)
+	if !ok {
+		// the session ended in the meantime
+		wrapper.Close()
+		return
+	}
+
+	glog.Infof("user %s follows ssh shell session on vm %s (%s)", user.GetId(), vmId, mode)
+
+	go func() {
+		if mode == ShellModeReadWrite {
+			io.Copy(shared.stdin, &InputWrapper{ws: conn, recorder: shared.recorder})
+		} else {
+			// keep reading to notice when the observer disconnects
+			io.Copy(io.Discard, &InputWrapper{ws: conn})
+		}
+
+		shared.leave(client)
+		wrapper.Close()
+		glog.Infof("user %s stopped following ssh shell session on vm %s", user.GetId(), vmId)
+	}()
+}
+
+/*
+* Lists the observers of the ssh shell sessions on a VM, so the owner can see who is watching.
+ */
+func (sp ShellProxy) ListObserversFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac2.AuthenticateRequest(r, sp.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get vm")
+		return
+	}
+
+	vmId := mux.Vars(r)["vm_id"]
+
+	vm, err := sp.vmClient.GetVM(r.Context(), &generalpb.GetRequest{Id: vmId, LoadFromCache: true})
+	if err != nil {
+		glog.Errorf("did not find the right virtual machine ID")
+		util.ReturnHTTPMessage(w, r, 404, "notfound", "no vm found")
+		return
+	}
+
+	if vm.GetUser() != user.GetId() {
+		if err := sp.authorizeShell(r, user.GetId(), ShellModeReadOnly); err != nil {
+			util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list observers")
+			return
+		}
+	}
+
+	encodedObservers, err := json.Marshal(sp.sessions.observers(vmId))
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encodedObservers)
+}
@@ -5,11 +5,17 @@ import (
 	"io"
 	"strconv"
 
+	"github.com/golang/glog"
 	"github.com/gorilla/websocket"
+	"golang.org/x/crypto/ssh"
 )
 
+// InputWrapper reads the input of a websocket client of a shell session. Resize events are
+// applied to sess, clients without a session (observers) cannot resize the PTY.
 type InputWrapper struct {
-	ws *websocket.Conn
+	ws       *websocket.Conn
+	sess     *ssh.Session
+	recorder *Recorder
 }
 
 const patternLen = 5
@@ -33,7 +39,12 @@ func (this *InputWrapper) Read(out []byte) (n int, err error) {
 
 		h, _ := strconv.Atoi(size[1])
 		w, _ := strconv.Atoi(size[2])
-		ResizePty(h, w)
+		if this.sess != nil {
+			if err := this.sess.WindowChange(h, w); err != nil {
+				glog.Warningf("error resizing pty: %s", err)
+			}
+			this.recorder.Resize(w, h)
+		}
 		return 0, nil
 	}
 
@@ -45,5 +56,7 @@ func (this *InputWrapper) Read(out []byte) (n int, err error) {
 			}
 		}
 	}
-	return copy(out, data), nil
+	n = copy(out, data)
+	this.recorder.Input(out[:n])
+	return n, nil
 }
@@ -0,0 +1,127 @@
+package shell
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	rbac2 "github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	"github.com/hobbyfarm/gargantua/v3/pkg/verification"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// QuizVerificationRequest verifies the task of a command question on a vm of the user.
+type QuizVerificationRequest struct {
+	VMId     string `json:"vm_id"`
+	Quiz     string `json:"quiz"`     // the quiz id
+	Scenario string `json:"scenario"` // the scenario id
+	Question string `json:"question"` // the question id
+}
+
+// QuizVerificationResult only tells whether the task was verified, its output could give away the expected answer.
+type QuizVerificationResult struct {
+	Question string `json:"question"`
+	Success  bool   `json:"success"`
+}
+
+/*
+Function verifies the task of a command question of a quiz on a virtual machine of the user.
+The result is stored in the current attempt of the quiz evaluation of the user and is scored once the attempt is recorded.
+*/
+func (sp ShellProxy) VerifyQuizQuestionFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac2.AuthenticateRequest(r, sp.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get vm")
+		return
+	}
+
+	var req QuizVerificationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid json body")
+		return
+	}
+	if req.VMId == "" || req.Quiz == "" || req.Question == "" {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "vm_id, quiz and question are required")
+		return
+	}
+
+	quiz, err := sp.quizClient.GetQuiz(r.Context(), &generalpb.GetRequest{Id: req.Quiz, LoadFromCache: true})
+	if err != nil {
+		glog.Errorf("error retrieving quiz %s: %s", req.Quiz, hferrors.GetErrorMessage(err))
+		if hferrors.IsGrpcNotFound(err) {
+			util.ReturnHTTPMessage(w, r, 404, "notfound", "quiz not found")
+			return
+		}
+		util.ReturnHTTPMessage(w, r, 500, "error", "error retrieving quiz")
+		return
+	}
+
+	var command *quizpb.QuizCommandCheck
+	for _, question := range quiz.GetQuestions() {
+		if question.GetId() == req.Question && question.GetType() == hfv1.QuizQuestionTypeCommand {
+			command = question.GetCommand()
+		}
+	}
+	if command == nil {
+		util.ReturnHTTPMessage(w, r, 404, "notfound", "command question not found")
+		return
+	}
+
+	errorChan := make(chan error, 1)
+	sshConn, err := sp.GetSSHConn(w, r, user, req.VMId, errorChan)
+	if err != nil {
+		select {
+		case <-errorChan:
+			util.ReturnHTTPMessage(w, r, 500, "error", "could not connect to vm")
+		default:
+			// the response has already been written
+		}
+		return
+	}
+	defer sshConn.Close()
+
+	limits := verification.LimitsFromSettings(r.Context(), sp.settingClient)
+	results := verification.NewRunner(limits).Verify(r.Context(), verification.SSHTarget{Client: sshConn}, []hfv1.Task{newQuizTask(command)})
+	success := len(results) == 1 && results[0].Success
+
+	_, err = sp.quizEvalClient.RecordQuestionVerification(r.Context(), &quizpb.RecordQuestionVerificationRequest{
+		Quiz:     req.Quiz,
+		User:     user.GetId(),
+		Scenario: req.Scenario,
+		Question: req.Question,
+		Success:  success,
+	})
+	if err != nil {
+		glog.Errorf("error recording verification of question %s: %s", req.Question, hferrors.GetErrorMessage(err))
+		if status.Code(err) == codes.FailedPrecondition {
+			util.ReturnHTTPMessage(w, r, 409, "conflict", "no quiz attempt in progress")
+			return
+		}
+		util.ReturnHTTPMessage(w, r, 500, "error", "could not store verification")
+		return
+	}
+
+	jsonStr, _ := json.Marshal(QuizVerificationResult{Question: req.Question, Success: success})
+	util.ReturnHTTPContent(w, r, 200, "success", jsonStr)
+}
+
+func newQuizTask(command *quizpb.QuizCommandCheck) hfv1.Task {
+	return hfv1.Task{
+		Name:                command.GetName(),
+		Command:             command.GetCommand(),
+		ExpectedOutputValue: command.GetExpectedOutputValue(),
+		ExpectedReturnCode:  int(command.GetExpectedReturnCode()),
+		ReturnType:          command.GetReturnType(),
+		Type:                command.GetType(),
+		Path:                command.GetPath(),
+		URL:                 command.GetUrl(),
+		JSONPath:            command.GetJsonPath(),
+		TimeoutSeconds:      int(command.GetTimeoutSeconds()),
+	}
+}
@@ -0,0 +1,139 @@
+package shell
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+// asciicast v2 event codes, see https://docs.asciinema.org/manual/asciicast/v2/
+const (
+	eventOutput = "o"
+	eventInput  = "i"
+	eventResize = "r"
+)
+
+// RecordingMetadata links a recording to the VM, user and ScheduledEvent of the recorded shell session.
+type RecordingMetadata struct {
+	Id               string    `json:"id"`
+	VMId             string    `json:"vm_id"`
+	UserId           string    `json:"user_id"`
+	ScheduledEventId string    `json:"scheduled_event_id"`
+	Started          time.Time `json:"started"`
+}
+
+// Matches returns true if every non-empty field of filter equals the field of the metadata.
+func (m RecordingMetadata) Matches(filter RecordingMetadata) bool {
+	return (filter.VMId == "" || filter.VMId == m.VMId) &&
+		(filter.UserId == "" || filter.UserId == m.UserId) &&
+		(filter.ScheduledEventId == "" || filter.ScheduledEventId == m.ScheduledEventId)
+}
+
+type asciicastHeader struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Title     string            `json:"title,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// Recorder writes the output, input and resize events of a terminal session in asciicast v2 format.
+// It is safe for concurrent use. Errors writing the recording never interrupt the session, they
+// are logged once and further events are dropped.
+type Recorder struct {
+	lock   sync.Mutex
+	w      io.WriteCloser
+	start  time.Time
+	failed bool
+	closed bool
+}
+
+func NewRecordingId() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		// fall back to the time, the id only has to be unique within the sink
+		return fmt.Sprintf("rec-%x", time.Now().UnixNano())
+	}
+
+	return "rec-" + hex.EncodeToString(b)
+}
+
+func NewRecorder(w io.WriteCloser, meta RecordingMetadata, width int, height int) (*Recorder, error) {
+	header, err := json.Marshal(asciicastHeader{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: meta.Started.Unix(),
+		Title:     fmt.Sprintf("%s on %s", meta.UserId, meta.VMId),
+		Env:       map[string]string{"TERM": "xterm"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := w.Write(append(header, '\n')); err != nil {
+		return nil, err
+	}
+
+	return &Recorder{w: w, start: meta.Started}, nil
+}
+
+func (r *Recorder) Output(data []byte) {
+	r.event(eventOutput, string(validUtf8(data)))
+}
+
+func (r *Recorder) Input(data []byte) {
+	r.event(eventInput, string(validUtf8(data)))
+}
+
+func (r *Recorder) Resize(width int, height int) {
+	r.event(eventResize, strconv.Itoa(width)+"x"+strconv.Itoa(height))
+}
+
+// Close completes the recording. It may be called more than once.
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed {
+		return nil
+	}
+	r.closed = true
+
+	return r.w.Close()
+}
+
+func (r *Recorder) event(code string, data string) {
+	if r == nil || len(data) == 0 {
+		return
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.closed || r.failed {
+		return
+	}
+
+	line, err := json.Marshal([]interface{}{time.Since(r.start).Seconds(), code, data})
+	if err == nil {
+		_, err = r.w.Write(append(line, '\n'))
+	}
+
+	if err != nil {
+		glog.Errorf("error writing session recording, dropping further events: %s", err)
+		r.failed = true
+	}
+}
@@ -0,0 +1,117 @@
+package shell
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorderWritesAsciicast(t *testing.T) {
+	sink, err := NewFilesystemRecordingSink(t.TempDir())
+	require.NoError(t, err)
+
+	meta := RecordingMetadata{
+		Id:               NewRecordingId(),
+		VMId:             "vm-1",
+		UserId:           "u-1",
+		ScheduledEventId: "se-1",
+		Started:          time.Now().Truncate(time.Second),
+	}
+
+	w, err := sink.Create(context.Background(), meta)
+	require.NoError(t, err)
+
+	recorder, err := NewRecorder(w, meta, 80, 40)
+	require.NoError(t, err)
+
+	recorder.Output([]byte("$ "))
+	recorder.Input([]byte("ls\r"))
+	recorder.Resize(120, 50)
+	recorder.Output(nil)
+	require.NoError(t, recorder.Close())
+	require.NoError(t, recorder.Close())
+
+	// events after close are dropped
+	recorder.Output([]byte("dropped"))
+
+	gotMeta, content, err := sink.Open(context.Background(), meta.Id)
+	require.NoError(t, err)
+	defer content.Close()
+
+	assert.Equal(t, meta.VMId, gotMeta.VMId)
+	assert.True(t, meta.Started.Equal(gotMeta.Started))
+
+	scanner := bufio.NewScanner(content)
+
+	require.True(t, scanner.Scan())
+	var header asciicastHeader
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	assert.Equal(t, 2, header.Version)
+	assert.Equal(t, 80, header.Width)
+	assert.Equal(t, 40, header.Height)
+	assert.Equal(t, meta.Started.Unix(), header.Timestamp)
+
+	var events [][]interface{}
+	for scanner.Scan() {
+		var event []interface{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		require.Len(t, event, 3)
+		events = append(events, event)
+	}
+
+	require.Len(t, events, 3)
+	assert.Equal(t, []interface{}{"o", "$ "}, events[0][1:])
+	assert.Equal(t, []interface{}{"i", "ls\r"}, events[1][1:])
+	assert.Equal(t, []interface{}{"r", "120x50"}, events[2][1:])
+}
+
+func TestFilesystemRecordingSinkList(t *testing.T) {
+	sink, err := NewFilesystemRecordingSink(t.TempDir())
+	require.NoError(t, err)
+
+	now := time.Now()
+	for i, meta := range []RecordingMetadata{
+		{VMId: "vm-1", UserId: "u-1", ScheduledEventId: "se-1"},
+		{VMId: "vm-2", UserId: "u-1", ScheduledEventId: "se-1"},
+		{VMId: "vm-3", UserId: "u-2", ScheduledEventId: "se-2"},
+	} {
+		meta.Id = NewRecordingId()
+		meta.Started = now.Add(time.Duration(i) * time.Minute)
+
+		w, err := sink.Create(context.Background(), meta)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+	}
+
+	all, err := sink.List(context.Background(), RecordingMetadata{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "vm-3", all[0].VMId, "newest recording first")
+
+	byUser, err := sink.List(context.Background(), RecordingMetadata{UserId: "u-1"})
+	require.NoError(t, err)
+	assert.Len(t, byUser, 2)
+
+	byEvent, err := sink.List(context.Background(), RecordingMetadata{UserId: "u-1", ScheduledEventId: "se-2"})
+	require.NoError(t, err)
+	assert.Empty(t, byEvent)
+}
+
+func TestFilesystemRecordingSinkRejectsInvalidIds(t *testing.T) {
+	sink, err := NewFilesystemRecordingSink(t.TempDir())
+	require.NoError(t, err)
+
+	_, err = sink.Create(context.Background(), RecordingMetadata{Id: "../etc/passwd"})
+	assert.Error(t, err)
+
+	_, _, err = sink.Open(context.Background(), "../etc/passwd")
+	assert.ErrorIs(t, err, ErrRecordingNotFound)
+
+	_, _, err = sink.Open(context.Background(), NewRecordingId())
+	assert.ErrorIs(t, err, ErrRecordingNotFound)
+}
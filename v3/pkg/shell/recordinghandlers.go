@@ -0,0 +1,149 @@
+package shell
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	rbac2 "github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
+)
+
+// startRecording starts the recording of a shell session on the vm if recording is enabled for it.
+// It returns nil if the session is not recorded, all methods of Recorder accept a nil receiver.
+func (sp ShellProxy) startRecording(ctx context.Context, vm *vmpb.VM, userId string) *Recorder {
+	if sp.recording.Sink == nil {
+		return nil
+	}
+
+	seId := vm.GetLabels()[hflabels.ScheduledEventLabel]
+	if !sp.recording.RecordAllSessions && !sp.recordSessionsOf(ctx, seId) {
+		return nil
+	}
+
+	meta := RecordingMetadata{
+		Id:               NewRecordingId(),
+		VMId:             vm.GetId(),
+		UserId:           userId,
+		ScheduledEventId: seId,
+		Started:          time.Now(),
+	}
+
+	w, err := sp.recording.Sink.Create(ctx, meta)
+	if err != nil {
+		glog.Errorf("error creating recording for shell session on vm %s: %s", vm.GetId(), err)
+		return nil
+	}
+
+	recorder, err := NewRecorder(w, meta, 80, 40)
+	if err != nil {
+		glog.Errorf("error starting recording for shell session on vm %s: %s", vm.GetId(), err)
+		w.Close()
+		return nil
+	}
+
+	glog.V(2).Infof("recording shell session on vm %s as %s", vm.GetId(), meta.Id)
+
+	return recorder
+}
+
+// recordSessionsOf returns true if the scheduled event opted in to the recording of shell sessions.
+func (sp ShellProxy) recordSessionsOf(ctx context.Context, seId string) bool {
+	if seId == "" {
+		return false
+	}
+
+	se, err := sp.seClient.GetScheduledEvent(ctx, &generalpb.GetRequest{Id: seId, LoadFromCache: true})
+	if err != nil {
+		glog.Errorf("error retrieving scheduled event %s: %s", seId, hferrors.GetErrorMessage(err))
+		return false
+	}
+
+	return se.GetLabels()[hflabels.RecordSessionsLabel] == "true"
+}
+
+func (sp ShellProxy) ListRecordingsFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac2.AuthenticateRequest(r, sp.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list recordings")
+		return
+	}
+
+	authrResponse, err := rbac2.AuthorizeSimple(r, sp.authrClient, user.GetId(), rbac2.HobbyfarmPermission(rbac2.ResourcePluralRecording, rbac2.VerbList))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list recordings")
+		return
+	}
+
+	if sp.recording.Sink == nil {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "notfound", "session recording is not enabled")
+		return
+	}
+
+	query := r.URL.Query()
+	recordings, err := sp.recording.Sink.List(r.Context(), RecordingMetadata{
+		VMId:             query.Get("vm_id"),
+		UserId:           query.Get("user_id"),
+		ScheduledEventId: query.Get("scheduled_event_id"),
+	})
+	if err != nil {
+		glog.Errorf("error listing recordings: %s", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error listing recordings")
+		return
+	}
+
+	encodedRecordings, err := json.Marshal(recordings)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encodedRecordings)
+}
+
+// GetRecordingFunc streams a recording as asciicast v2, which can be played back e.g. by asciinema-player.
+func (sp ShellProxy) GetRecordingFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac2.AuthenticateRequest(r, sp.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get recording")
+		return
+	}
+
+	authrResponse, err := rbac2.AuthorizeSimple(r, sp.authrClient, user.GetId(), rbac2.HobbyfarmPermission(rbac2.ResourcePluralRecording, rbac2.VerbGet))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get recording")
+		return
+	}
+
+	if sp.recording.Sink == nil {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "notfound", "session recording is not enabled")
+		return
+	}
+
+	recordingId := mux.Vars(r)["recording_id"]
+
+	_, content, err := sp.recording.Sink.Open(r.Context(), recordingId)
+	if errors.Is(err, ErrRecordingNotFound) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "notfound", "recording not found")
+		return
+	} else if err != nil {
+		glog.Errorf("error opening recording %s: %s", recordingId, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error retrieving recording")
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", "application/x-asciicast")
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+recordingId+".cast\"")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, content); err != nil {
+		glog.Errorf("error streaming recording %s: %s", recordingId, err)
+	}
+}
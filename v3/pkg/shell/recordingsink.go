@@ -0,0 +1,314 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	recordingDataKey = "recording.cast"
+
+	// configMapChunkSize keeps each chunk of a recording well below the 1MiB object size limit of Kubernetes
+	configMapChunkSize = 512 * 1024
+)
+
+var (
+	ErrRecordingNotFound = errors.New("recording not found")
+
+	recordingIdPattern = regexp.MustCompile(`^rec-[0-9a-f]+$`)
+)
+
+// RecordingSink stores session recordings.
+type RecordingSink interface {
+	// Create returns a writer for a new recording. The recording is complete once the writer is closed.
+	Create(ctx context.Context, meta RecordingMetadata) (io.WriteCloser, error)
+	// Open returns the metadata and a reader for the content of a recording.
+	Open(ctx context.Context, id string) (*RecordingMetadata, io.ReadCloser, error)
+	// List returns the metadata of all recordings matching the filter, see RecordingMetadata.Matches.
+	List(ctx context.Context, filter RecordingMetadata) ([]RecordingMetadata, error)
+}
+
+// FilesystemRecordingSink stores each recording as <id>.cast, next to its metadata in <id>.json.
+// Recordings are written to disk as the session progresses.
+type FilesystemRecordingSink struct {
+	dir string
+}
+
+func NewFilesystemRecordingSink(dir string) (*FilesystemRecordingSink, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &FilesystemRecordingSink{dir: dir}, nil
+}
+
+func (s *FilesystemRecordingSink) Create(ctx context.Context, meta RecordingMetadata) (io.WriteCloser, error) {
+	if !recordingIdPattern.MatchString(meta.Id) {
+		return nil, fmt.Errorf("invalid recording id %s", meta.Id)
+	}
+
+	metaBytes, err := json.Marshal(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(s.path(meta.Id, ".json"), metaBytes, 0o640); err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(s.path(meta.Id, ".cast"), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o640)
+}
+
+func (s *FilesystemRecordingSink) Open(ctx context.Context, id string) (*RecordingMetadata, io.ReadCloser, error) {
+	if !recordingIdPattern.MatchString(id) {
+		return nil, nil, ErrRecordingNotFound
+	}
+
+	meta, err := s.readMetadata(s.path(id, ".json"))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := os.Open(s.path(id, ".cast"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil, ErrRecordingNotFound
+	} else if err != nil {
+		return nil, nil, err
+	}
+
+	return meta, f, nil
+}
+
+func (s *FilesystemRecordingSink) List(ctx context.Context, filter RecordingMetadata) ([]RecordingMetadata, error) {
+	files, err := filepath.Glob(filepath.Join(s.dir, "rec-*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var out = []RecordingMetadata{}
+	for _, f := range files {
+		meta, err := s.readMetadata(f)
+		if err != nil {
+			return nil, err
+		}
+
+		if meta.Matches(filter) {
+			out = append(out, *meta)
+		}
+	}
+
+	sortRecordings(out)
+
+	return out, nil
+}
+
+func (s *FilesystemRecordingSink) path(id string, ext string) string {
+	return filepath.Join(s.dir, id+ext)
+}
+
+func (s *FilesystemRecordingSink) readMetadata(path string) (*RecordingMetadata, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrRecordingNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var meta = &RecordingMetadata{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, err
+	}
+
+	return meta, nil
+}
+
+// ConfigMapRecordingSink stores recordings in ConfigMaps, which makes them available to every replica
+// of the shell proxy without shared storage. A recording is split into chunks of configMapChunkSize,
+// each stored in its own ConfigMap, and the metadata is kept in the labels of the chunks.
+// A chunk is written once it is full, and the last chunk when the recording is closed.
+type ConfigMapRecordingSink struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func NewConfigMapRecordingSink(kubeClient kubernetes.Interface, namespace string) *ConfigMapRecordingSink {
+	return &ConfigMapRecordingSink{
+		kubeClient: kubeClient,
+		namespace:  namespace,
+	}
+}
+
+func (s *ConfigMapRecordingSink) Create(ctx context.Context, meta RecordingMetadata) (io.WriteCloser, error) {
+	if !recordingIdPattern.MatchString(meta.Id) {
+		return nil, fmt.Errorf("invalid recording id %s", meta.Id)
+	}
+
+	// the writer outlives the request that created it
+	return &configMapRecordingWriter{sink: s, meta: meta}, nil
+}
+
+func (s *ConfigMapRecordingSink) Open(ctx context.Context, id string) (*RecordingMetadata, io.ReadCloser, error) {
+	if !recordingIdPattern.MatchString(id) {
+		return nil, nil, ErrRecordingNotFound
+	}
+
+	chunks, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).List(ctx, v1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{hflabels.RecordingLabel: id}).String(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(chunks.Items) == 0 {
+		return nil, nil, ErrRecordingNotFound
+	}
+
+	sort.Slice(chunks.Items, func(i, j int) bool {
+		return chunkIndex(&chunks.Items[i]) < chunkIndex(&chunks.Items[j])
+	})
+
+	var readers = make([]io.Reader, len(chunks.Items))
+	for i := range chunks.Items {
+		readers[i] = bytes.NewReader(chunks.Items[i].BinaryData[recordingDataKey])
+	}
+
+	meta := metadataFromConfigMap(&chunks.Items[0])
+
+	return &meta, io.NopCloser(io.MultiReader(readers...)), nil
+}
+
+func (s *ConfigMapRecordingSink) List(ctx context.Context, filter RecordingMetadata) ([]RecordingMetadata, error) {
+	set := labels.Set{hflabels.RecordingChunkLabel: "0"}
+	if filter.VMId != "" {
+		set[hflabels.VirtualMachineLabel] = filter.VMId
+	}
+	if filter.UserId != "" {
+		set[hflabels.UserLabel] = filter.UserId
+	}
+	if filter.ScheduledEventId != "" {
+		set[hflabels.ScheduledEventLabel] = filter.ScheduledEventId
+	}
+
+	chunks, err := s.kubeClient.CoreV1().ConfigMaps(s.namespace).List(ctx, v1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(set).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var out = make([]RecordingMetadata, 0, len(chunks.Items))
+	for i := range chunks.Items {
+		out = append(out, metadataFromConfigMap(&chunks.Items[i]))
+	}
+
+	sortRecordings(out)
+
+	return out, nil
+}
+
+type configMapRecordingWriter struct {
+	sink   *ConfigMapRecordingSink
+	meta   RecordingMetadata
+	buf    bytes.Buffer
+	chunks int
+}
+
+func (w *configMapRecordingWriter) Write(data []byte) (int, error) {
+	n, _ := w.buf.Write(data)
+
+	if w.buf.Len() >= configMapChunkSize {
+		if err := w.flush(); err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
+
+func (w *configMapRecordingWriter) Close() error {
+	if w.buf.Len() == 0 && w.chunks > 0 {
+		return nil
+	}
+
+	return w.flush()
+}
+
+func (w *configMapRecordingWriter) flush() error {
+	// a terminal session should never block on the api server for long
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	index := strconv.Itoa(w.chunks)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      w.meta.Id + "-" + index,
+			Namespace: w.sink.namespace,
+			Labels: map[string]string{
+				hflabels.RecordingLabel:      w.meta.Id,
+				hflabels.RecordingChunkLabel: index,
+				hflabels.VirtualMachineLabel: w.meta.VMId,
+				hflabels.UserLabel:           w.meta.UserId,
+				hflabels.ScheduledEventLabel: w.meta.ScheduledEventId,
+			},
+			Annotations: map[string]string{
+				"hobbyfarm.io/recording-started": w.meta.Started.UTC().Format(time.RFC3339),
+			},
+		},
+		BinaryData: map[string][]byte{
+			recordingDataKey: w.buf.Bytes(),
+		},
+	}
+
+	if _, err := w.sink.kubeClient.CoreV1().ConfigMaps(w.sink.namespace).Create(ctx, cm, v1.CreateOptions{}); err != nil {
+		return err
+	}
+
+	w.buf.Reset()
+	w.chunks++
+
+	return nil
+}
+
+func metadataFromConfigMap(cm *corev1.ConfigMap) RecordingMetadata {
+	started, _ := time.Parse(time.RFC3339, cm.Annotations["hobbyfarm.io/recording-started"])
+
+	return RecordingMetadata{
+		Id:               cm.Labels[hflabels.RecordingLabel],
+		VMId:             cm.Labels[hflabels.VirtualMachineLabel],
+		UserId:           cm.Labels[hflabels.UserLabel],
+		ScheduledEventId: cm.Labels[hflabels.ScheduledEventLabel],
+		Started:          started,
+	}
+}
+
+func chunkIndex(cm *corev1.ConfigMap) int {
+	i, _ := strconv.Atoi(cm.Labels[hflabels.RecordingChunkLabel])
+	return i
+}
+
+// sortRecordings sorts recordings by start time, newest first.
+func sortRecordings(recordings []RecordingMetadata) {
+	sort.Slice(recordings, func(i, j int) bool {
+		if recordings[i].Started.Equal(recordings[j].Started) {
+			return strings.Compare(recordings[i].Id, recordings[j].Id) < 0
+		}
+		return recordings[i].Started.After(recordings[j].Started)
+	})
+}
@@ -0,0 +1,217 @@
+package shell
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+type ShellMode string
+
+const (
+	// ShellModeOwner is the mode of the client that started the shell session
+	ShellModeOwner ShellMode = "owner"
+	// ShellModeReadOnly observers see the output of the shell session
+	ShellModeReadOnly ShellMode = "read-only"
+	// ShellModeReadWrite observers see the output and type into the shell session
+	ShellModeReadWrite ShellMode = "read-write"
+
+	// sharedSessionBacklog is the amount of recent output replayed to observers joining a session
+	sharedSessionBacklog = 16 * 1024
+)
+
+// ShellClient is a websocket connected to a shared shell session.
+type ShellClient struct {
+	UserId string    `json:"user_id"`
+	Mode   ShellMode `json:"mode"`
+	Joined time.Time `json:"joined"`
+
+	out io.Writer
+}
+
+// sharedSession fans the output of one ssh shell session out to all connected clients. The owner
+// and read-write observers share the same PTY, so they type into the same shell.
+type sharedSession struct {
+	vmId     string
+	sess     *ssh.Session
+	stdin    io.Writer
+	recorder *Recorder
+
+	lock    sync.RWMutex
+	clients []*ShellClient
+	backlog []byte
+	closed  bool
+}
+
+func newSharedSession(vmId string, sess *ssh.Session, stdin io.Writer, recorder *Recorder) *sharedSession {
+	return &sharedSession{
+		vmId:     vmId,
+		sess:     sess,
+		stdin:    stdin,
+		recorder: recorder,
+	}
+}
+
+// Write sends the output of the shell session to every client. A client failing to receive the
+// output is not removed here, it leaves once its websocket is closed.
+func (s *sharedSession) Write(data []byte) (int, error) {
+	s.recorder.Output(data)
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.backlog = append(s.backlog, data...)
+	if len(s.backlog) > sharedSessionBacklog {
+		s.backlog = s.backlog[len(s.backlog)-sharedSessionBacklog:]
+	}
+
+	for _, c := range s.clients {
+		c.out.Write(data)
+	}
+
+	return len(data), nil
+}
+
+// join adds a client to the session. Observers first receive the recent output of the session,
+// so they do not start with a blank terminal.
+func (s *sharedSession) join(userId string, mode ShellMode, out io.Writer) (*ShellClient, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil, false
+	}
+
+	c := &ShellClient{
+		UserId: userId,
+		Mode:   mode,
+		Joined: time.Now(),
+		out:    out,
+	}
+
+	if mode != ShellModeOwner && len(s.backlog) > 0 {
+		out.Write(s.backlog)
+	}
+
+	s.clients = append(s.clients, c)
+
+	return c, true
+}
+
+func (s *sharedSession) leave(c *ShellClient) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i := range s.clients {
+		if s.clients[i] == c {
+			s.clients = append(s.clients[:i], s.clients[i+1:]...)
+			return
+		}
+	}
+}
+
+// observers returns all clients but the owner.
+func (s *sharedSession) observers() []ShellClient {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var out = []ShellClient{}
+	for _, c := range s.clients {
+		if c.Mode != ShellModeOwner {
+			out = append(out, *c)
+		}
+	}
+
+	return out
+}
+
+// close ends the ssh session and the recording, and disconnects all remaining clients.
+func (s *sharedSession) close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return
+	}
+	s.closed = true
+
+	if s.sess != nil {
+		s.sess.Close()
+	}
+	s.recorder.Close()
+
+	for _, c := range s.clients {
+		if closer, ok := c.out.(io.Closer); ok {
+			closer.Close()
+		}
+	}
+	s.clients = nil
+}
+
+// sessionRegistry keeps track of the shared shell sessions of this shell proxy, so that observers
+// can join them. Observers have to connect to the same replica as the owner of the session.
+type sessionRegistry struct {
+	lock     sync.RWMutex
+	sessions map[string][]*sharedSession
+}
+
+func newSessionRegistry() *sessionRegistry {
+	return &sessionRegistry{
+		sessions: make(map[string][]*sharedSession),
+	}
+}
+
+func (r *sessionRegistry) add(s *sharedSession) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.sessions[s.vmId] = append(r.sessions[s.vmId], s)
+}
+
+func (r *sessionRegistry) remove(s *sharedSession) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	sessions := r.sessions[s.vmId]
+	for i := range sessions {
+		if sessions[i] == s {
+			sessions = append(sessions[:i], sessions[i+1:]...)
+			break
+		}
+	}
+
+	if len(sessions) == 0 {
+		delete(r.sessions, s.vmId)
+	} else {
+		r.sessions[s.vmId] = sessions
+	}
+}
+
+// latest returns the most recently started shell session on the vm, or nil if there is none.
+func (r *sessionRegistry) latest(vmId string) *sharedSession {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	sessions := r.sessions[vmId]
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	return sessions[len(sessions)-1]
+}
+
+// observers returns the observers of all shell sessions on the vm.
+func (r *sessionRegistry) observers(vmId string) []ShellClient {
+	r.lock.RLock()
+	sessions := append([]*sharedSession{}, r.sessions[vmId]...)
+	r.lock.RUnlock()
+
+	var out = []ShellClient{}
+	for _, s := range sessions {
+		out = append(out, s.observers()...)
+	}
+
+	return out
+}
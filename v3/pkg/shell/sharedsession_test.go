@@ -0,0 +1,90 @@
+package shell
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestSharedSessionFanOut(t *testing.T) {
+	shared := newSharedSession("vm-1", nil, nil, nil)
+
+	owner := &closingBuffer{}
+	ownerClient, ok := shared.join("u-1", ShellModeOwner, owner)
+	require.True(t, ok)
+
+	shared.Write([]byte("$ ls\r\n"))
+
+	observer := &closingBuffer{}
+	_, ok = shared.join("admin", ShellModeReadOnly, observer)
+	require.True(t, ok)
+
+	shared.Write([]byte("file\r\n"))
+
+	assert.Equal(t, "$ ls\r\nfile\r\n", owner.String())
+	assert.Equal(t, "$ ls\r\nfile\r\n", observer.String(), "observer receives the backlog on join")
+
+	observers := shared.observers()
+	require.Len(t, observers, 1)
+	assert.Equal(t, "admin", observers[0].UserId)
+	assert.Equal(t, ShellModeReadOnly, observers[0].Mode)
+
+	shared.leave(ownerClient)
+	shared.close()
+
+	assert.False(t, owner.closed, "clients that left are not closed")
+	assert.True(t, observer.closed)
+
+	_, ok = shared.join("admin", ShellModeReadWrite, &closingBuffer{})
+	assert.False(t, ok, "closed sessions cannot be joined")
+}
+
+func TestSharedSessionBacklogIsBounded(t *testing.T) {
+	shared := newSharedSession("vm-1", nil, nil, nil)
+
+	shared.Write([]byte(strings.Repeat("a", sharedSessionBacklog)))
+	shared.Write([]byte("b"))
+
+	observer := &closingBuffer{}
+	_, ok := shared.join("admin", ShellModeReadOnly, observer)
+	require.True(t, ok)
+
+	assert.Equal(t, sharedSessionBacklog, observer.Len())
+	assert.True(t, strings.HasSuffix(observer.String(), "ab"))
+}
+
+func TestSessionRegistry(t *testing.T) {
+	registry := newSessionRegistry()
+	assert.Nil(t, registry.latest("vm-1"))
+
+	first := newSharedSession("vm-1", nil, nil, nil)
+	second := newSharedSession("vm-1", nil, nil, nil)
+	registry.add(first)
+	registry.add(second)
+
+	assert.Same(t, second, registry.latest("vm-1"))
+
+	first.join("admin", ShellModeReadWrite, &closingBuffer{})
+	second.join("proctor", ShellModeReadOnly, &closingBuffer{})
+	assert.Len(t, registry.observers("vm-1"), 2)
+
+	registry.remove(second)
+	assert.Same(t, first, registry.latest("vm-1"))
+
+	registry.remove(first)
+	assert.Nil(t, registry.latest("vm-1"))
+	assert.Empty(t, registry.observers("vm-1"))
+}
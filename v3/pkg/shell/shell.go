@@ -24,14 +24,20 @@ import (
 	"github.com/gorilla/websocket"
 	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/verification"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
 	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
 	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 	"golang.org/x/crypto/ssh"
-	"golang.org/x/sync/semaphore"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -41,7 +47,23 @@ type ShellProxy struct {
 	authrClient      authrpb.AuthRClient
 	vmClient         vmpb.VMSvcClient
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient
+	seClient         scheduledeventpb.ScheduledEventSvcClient
+	settingClient    settingpb.SettingSvcClient
+	progressClient   progresspb.ProgressSvcClient
+	quizClient       quizpb.QuizSvcClient
+	quizEvalClient   quizpb.QuizEvaluationSvcClient
+	scenarioClient   scenariopb.ScenarioSvcClient
 	kubeClient       kubernetes.Interface
+	recording        RecordingConfig
+	sessions         *sessionRegistry
+}
+
+// RecordingConfig controls which ssh shell sessions are recorded. Without a Sink no session is recorded.
+// Otherwise all sessions are recorded if RecordAllSessions is set, or only the sessions of
+// ScheduledEvents labeled with hobbyfarm.io/record-sessions=true.
+type RecordingConfig struct {
+	Sink              RecordingSink
+	RecordAllSessions bool
 }
 
 type Service struct {
@@ -69,7 +91,6 @@ const (
 
 // SIGWINCH is the regex to match window change (resize) codes
 var SIGWINCH *regexp.Regexp
-var sess *ssh.Session
 
 var DefaultDialer = websocket.DefaultDialer
 
@@ -87,14 +108,29 @@ func NewShellProxy(
 	authrClient authrpb.AuthRClient,
 	vmClient vmpb.VMSvcClient,
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient,
+	seClient scheduledeventpb.ScheduledEventSvcClient,
+	settingClient settingpb.SettingSvcClient,
+	progressClient progresspb.ProgressSvcClient,
+	quizClient quizpb.QuizSvcClient,
+	quizEvalClient quizpb.QuizEvaluationSvcClient,
+	scenarioClient scenariopb.ScenarioSvcClient,
 	kubeClient kubernetes.Interface,
+	recording RecordingConfig,
 ) *ShellProxy {
 	return &ShellProxy{
 		authnClient:      authnClient,
 		authrClient:      authrClient,
 		vmClient:         vmClient,
 		vmTemplateClient: vmTemplateClient,
+		seClient:         seClient,
+		settingClient:    settingClient,
+		progressClient:   progressClient,
+		quizClient:       quizClient,
+		quizEvalClient:   quizEvalClient,
+		scenarioClient:   scenarioClient,
 		kubeClient:       kubeClient,
+		recording:        recording,
+		sessions:         newSessionRegistry(),
 	}
 }
 
@@ -102,7 +138,12 @@ func (sp ShellProxy) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/shell/healthz", sp.HealthzFunc)
 	r.HandleFunc("/shell/websocketTest", sp.WebsocketTestFunc)
 	r.HandleFunc("/shell/{vm_id}/connect", sp.ConnectSSHFunc)
+	r.HandleFunc("/shell/{vm_id}/follow", sp.FollowSSHFunc)
+	r.HandleFunc("/shell/{vm_id}/observers", sp.ListObserversFunc).Methods("GET")
 	r.HandleFunc("/shell/verify", sp.VerifyTasksFuncByVMIdGroupWithSemaphore)
+	r.HandleFunc("/shell/quiz/verify", sp.VerifyQuizQuestionFunc).Methods("POST")
+	r.HandleFunc("/shell/recordings", sp.ListRecordingsFunc).Methods("GET")
+	r.HandleFunc("/shell/recordings/{recording_id}", sp.GetRecordingFunc).Methods("GET")
 	r.HandleFunc("/guacShell/{vm_id}/connect", sp.ConnectGuacFunc)
 	r.HandleFunc("/p/{vm_id}/{port}/{rest:.*}", sp.checkCookieAndProxy)
 	r.HandleFunc("/pa/{token}/{vm_id}/{port}/{rest:.*}", sp.authAndProxyFunc)
@@ -529,6 +570,8 @@ type VirtualMachineOutputTask struct {
 	VMId        string           `json:"vm_id"`
 	VMName      string           `json:"vm_name"`
 	TaskOutputs []TaskWithOutput `json:"task_outputs"`
+	Score       int              `json:"score"`
+	MaxScore    int              `json:"max_score"`
 }
 
 type TaskOutputCommand struct {
@@ -541,165 +584,40 @@ type TaskWithOutput struct {
 	Task       hfv1.Task         `json:"task"`
 	TaskOutput TaskOutputCommand `json:"task_output"`
 	Error      string            `json:"error"`
-}
-
-func isMatchRegex(text, pattern string) bool {
-	re := regexp.MustCompile(pattern)
-	return re.MatchString(text)
+	Score      int               `json:"score"`
+	MaxScore   int               `json:"max_score"`
 }
 
 /*
-Function executes a command on a remote server session and checks for success based on the provided task command.
-It returns TaskOutputCommand struct containing the actual output value, return code, and success status, depen of ReturnType.
+Function verifies the tasks of a virtual machine over the SSH connection with the checkers of the verification package.
+Limits like the number of tasks verified at the same time in a VM are taken from the task verification settings.
 */
-func VMTaskCommandRun(task_cmd *hfv1.Task, sess *ssh.Session) (*TaskOutputCommand, error) {
-	out, err := sess.CombinedOutput(task_cmd.Command)
-	actual_output_value := strings.TrimRight(string(out), "\r\n")
-	actual_return_code := 0
-	if err != nil {
-		switch err.(type) {
-		case *ssh.ExitError:
-			actual_return_code = err.(*ssh.ExitError).ExitStatus()
-			glog.Infof("%v", actual_return_code)
-		default:
-			return nil, err
-		}
-	}
-
-	is_task_success := false
-	switch task_cmd.ReturnType {
-	case "Return_Code_And_Text":
-		is_task_success = task_cmd.ExpectedOutputValue == actual_output_value && task_cmd.ExpectedReturnCode == actual_return_code
-		break
-	case "Return_Code":
-		is_task_success = task_cmd.ExpectedReturnCode == actual_return_code
-		break
-	case "Return_Text":
-		is_task_success = task_cmd.ExpectedOutputValue == actual_output_value
-		break
-	case "Match_Regex":
-		if !isMatchRegex(actual_output_value, task_cmd.ExpectedOutputValue) {
-			actual_output_value = "regex:error"
-		}
-		is_task_success = actual_output_value != "regex:error"
-		break
-	default:
-		actual_output_value = "undefined ReturnType"
-		is_task_success = false
-	}
-
-	task_cmd_res := &TaskOutputCommand{
-		ActualOutputValue: actual_output_value,
-		ActualReturnCode:  actual_return_code,
-		Success:           is_task_success,
-	}
-	return task_cmd_res, nil
-}
-
-/*
-Function retrieves output tasks from a virtual machine by executing multiple commands concurrently on the SSH connection.
-It takes an SSH client connection, a VirtualMachineInputTask representing input tasks for the VM,
-and an error channel to report any errors encountered during execution.
-It returns a VirtualMachineOutputTask containing the output of the executed tasks, along with any errors encountered.
-*/
-func GetVMOutputTask(sshConn *ssh.Client, closure_vm_input_task VirtualMachineInputTask, errorChan chan<- error) (*VirtualMachineOutputTask, error) {
-	// TODO: settings for define max command go routine run in same time in VM
-	const MAX_COMMANDS_GO = 3
-	// Initialize slice to store task outputs
-	commands_resp := make([]TaskWithOutput, 0)
-	// Mutex for synchronizing access to commands_resp slice
-	var commands_mutex = &sync.Mutex{}
-	// WaitGroup to wait for all goroutines in VM to finish
-	var commands_wg sync.WaitGroup
-	// Semaphore for count goroutine run in same time in VM
-	// a context is required for the weighted semaphore pkg.
-	ctx := context.Background()
-	var commands_semaphore = semaphore.NewWeighted(int64(MAX_COMMANDS_GO))
-
-	for _, task_command := range closure_vm_input_task.Tasks {
-		commands_wg.Add(1)
-		if err := commands_semaphore.Acquire(ctx, 1); err != nil {
-			glog.Errorf("did not acquire vm_semafore")
-		}
-		go func(closure_task_command hfv1.Task) {
-			defer commands_wg.Done()
-			defer commands_semaphore.Release(1)
-			vm_task_with_output, _ := GetTaskWithOutput(sshConn, errorChan, closure_task_command)
-			commands_mutex.Lock()
-			commands_resp = append(commands_resp, *vm_task_with_output)
-			commands_mutex.Unlock()
-
-		}(task_command)
-	}
-	commands_wg.Wait()
-	vm_output_task := &VirtualMachineOutputTask{
+func GetVMOutputTask(ctx context.Context, sshConn *ssh.Client, closure_vm_input_task VirtualMachineInputTask, limits verification.Limits) *VirtualMachineOutputTask {
+	results := verification.NewRunner(limits).Verify(ctx, verification.SSHTarget{Client: sshConn}, closure_vm_input_task.Tasks)
+
+	commands_resp := make([]TaskWithOutput, 0, len(results))
+	for _, result := range results {
+		commands_resp = append(commands_resp, TaskWithOutput{
+			Task: result.Task,
+			TaskOutput: TaskOutputCommand{
+				ActualOutputValue: result.Output,
+				ActualReturnCode:  result.ReturnCode,
+				Success:           result.Success,
+			},
+			Error:    result.Error,
+			Score:    result.Score,
+			MaxScore: result.MaxScore,
+		})
+	}
+
+	score, maxScore := verification.Score(results)
+	return &VirtualMachineOutputTask{
 		VMId:        closure_vm_input_task.VMId,
 		VMName:      closure_vm_input_task.VMName,
 		TaskOutputs: commands_resp,
+		Score:       score,
+		MaxScore:    maxScore,
 	}
-	return vm_output_task, nil
-}
-
-/*
-Function executes a task command on the SSH connection with number of attempts MAX_TRY_COMMAND_RUN
-to retrieve output when the task command execute with return code 141.
-*/
-func GetTaskWithOutput(sshConn *ssh.Client, errorChan chan<- error, task_command hfv1.Task) (*TaskWithOutput, error) {
-	// TODO: settings for define max try command run in VM if return code 141
-	const MAX_TRY_COMMAND_RUN = 5
-	count_try_command_run := MAX_TRY_COMMAND_RUN
-	var errRun error
-	// try command run again when exit code == 141
-	for count_try_command_run > 0 {
-		task_output, err := GetOutputTask(sshConn, errorChan, task_command)
-		count_try_command_run -= 1
-		if task_output.ActualReturnCode != 141 {
-			vm_task_with_output := &TaskWithOutput{
-				Task:       task_command,
-				TaskOutput: *task_output,
-			}
-			return vm_task_with_output, nil
-		}
-		if count_try_command_run == 0 {
-			glog.Errorf("error try run command: %v", err)
-			vm_task_with_output := &TaskWithOutput{
-				Task:  task_command,
-				Error: "error try run command",
-			}
-			return vm_task_with_output, err
-		}
-		errRun = err
-	}
-	return nil, errRun
-}
-
-func GetOutputTask(sshConn *ssh.Client, errorChan chan<- error, closure_task_command hfv1.Task) (*TaskOutputCommand, error) {
-	sess, err := CreateNewSession(sshConn, errorChan)
-	if err != nil {
-		return nil, err
-	}
-	task_output, err := VMTaskCommandRun(&closure_task_command, sess)
-	if err != nil {
-		glog.Infof("error sending command: %v", err)
-		if len(errorChan) < cap(errorChan) {
-			errorChan <- err
-		}
-		return nil, err
-	}
-	sess.Close()
-	return task_output, nil
-}
-
-func CreateNewSession(sshConn *ssh.Client, errorChan chan<- error) (*ssh.Session, error) {
-	sess, err := sshConn.NewSession()
-	if err != nil {
-		glog.Errorf("did not setup ssh session properly")
-		if len(errorChan) < cap(errorChan) {
-			errorChan <- err
-		}
-		return nil, err
-	}
-	return sess, nil
 }
 
 func (sp ShellProxy) GetSSHConn(w http.ResponseWriter, r *http.Request, user *userpb.User, vmId string, errorChan chan<- error) (*ssh.Client, error) {
@@ -713,15 +631,8 @@ func (sp ShellProxy) GetSSHConn(w http.ResponseWriter, r *http.Request, user *us
 		return nil, err
 	}
 	if vm.GetUser() != user.GetId() {
-		// check if the user has access to access user sessions
-		// TODO: add permission like 'virtualmachine/shell' similar to 'pod/exec'
-		impersonatedUserId := user.GetId()
-		authrResponse, err := rbac2.Authorize(r, sp.authrClient, impersonatedUserId, []*authrpb.Permission{
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralUser, rbac2.VerbGet),
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralSession, rbac2.VerbGet),
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralVM, rbac2.VerbGet),
-		}, rbac2.OperatorAND)
-		if err != nil || !authrResponse.Success {
+		// check if the user has access to the shell of other users' vms
+		if err := sp.authorizeShell(r, user.GetId(), ShellModeReadWrite); err != nil {
 			glog.Infof("Error doing authGrantWS %s", err)
 			util.ReturnHTTPMessage(w, r, 403, "forbidden", "access denied to connect to ssh shell session")
 			return nil, err
@@ -789,6 +700,9 @@ func (sp ShellProxy) GetSSHConn(w http.ResponseWriter, r *http.Request, user *us
 Function handles the HTTP request to verify tasks for a group of virtual machines using a semaphore for concurrency control.
 It authenticates the request, decodes the incoming JSON payload containing VirtualMachineInputTasks,
 and executes the tasks concurrently on the corresponding virtual machines.
+If the session_id query parameter is set, only the names of the requested tasks are used. The tasks are taken from
+the scenario of the active progress of the user in that session and the results are stored in the progress
+for the step passed in the step query parameter, or the current step of the progress.
 */
 func (sp ShellProxy) VerifyTasksFuncByVMIdGroupWithSemaphore(w http.ResponseWriter, r *http.Request) {
 	user, err := rbac2.AuthenticateRequest(r, sp.authnClient)
@@ -797,6 +711,16 @@ func (sp ShellProxy) VerifyTasksFuncByVMIdGroupWithSemaphore(w http.ResponseWrit
 		return
 	}
 
+	sessionId := r.URL.Query().Get("session_id")
+	step := -1
+	if stepRaw := r.URL.Query().Get("step"); stepRaw != "" {
+		step, err = strconv.Atoi(stepRaw)
+		if err != nil || step < 0 {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "provided step was invalid")
+			return
+		}
+	}
+
 	// Decode the incoming JSON payload containing VirtualMachineInputTasks
 	var vm_input_tasks []VirtualMachineInputTask
 	err = json.NewDecoder(r.Body).Decode(&vm_input_tasks)
@@ -804,6 +728,39 @@ func (sp ShellProxy) VerifyTasksFuncByVMIdGroupWithSemaphore(w http.ResponseWrit
 		glog.Infof("%s", err)
 	}
 
+	var progresses []*progresspb.Progress
+	tasksByScenario := map[string]scenarioTasks{}
+	if sessionId != "" {
+		progresses, err = sp.activeProgresses(r.Context(), user.GetId(), sessionId)
+		if err != nil {
+			glog.Errorf("error retrieving progress of session %s: %s", sessionId, hferrors.GetErrorMessage(err))
+			util.ReturnHTTPMessage(w, r, 500, "error", "error retrieving progress")
+			return
+		}
+		if len(progresses) < 1 {
+			util.ReturnHTTPMessage(w, r, 404, "notfound", "no active progress found for session")
+			return
+		}
+
+		scenarios := []scenarioTasks{}
+		for _, progress := range progresses {
+			if _, ok := tasksByScenario[progress.GetScenario()]; ok {
+				continue
+			}
+			tasks, err := sp.getScenarioTasks(r.Context(), progress.GetScenario())
+			if err != nil {
+				glog.Errorf("error retrieving scenario %s: %s", progress.GetScenario(), hferrors.GetErrorMessage(err))
+				util.ReturnHTTPMessage(w, r, 500, "error", "error retrieving scenario")
+				return
+			}
+			tasksByScenario[progress.GetScenario()] = tasks
+			scenarios = append(scenarios, tasks)
+		}
+		vm_input_tasks = resolveTasks(vm_input_tasks, scenarios...)
+	}
+
+	limits := verification.LimitsFromSettings(r.Context(), sp.settingClient)
+
 	// Create an error channel to report errors encountered during task execution
 	errorChan := make(chan error, 1)
 
@@ -823,10 +780,9 @@ func (sp ShellProxy) VerifyTasksFuncByVMIdGroupWithSemaphore(w http.ResponseWrit
 			if err != nil {
 				return
 			}
-			vm_output_task, err := GetVMOutputTask(sshConn, closure_vm_input_task, errorChan)
-			if err != nil {
-				return
-			}
+			defer sshConn.Close()
+
+			vm_output_task := GetVMOutputTask(r.Context(), sshConn, closure_vm_input_task, limits)
 			vm_mutex.Lock()
 			vm_output_tasks = append(vm_output_tasks, *vm_output_task)
 			vm_mutex.Unlock()
@@ -845,11 +801,78 @@ func (sp ShellProxy) VerifyTasksFuncByVMIdGroupWithSemaphore(w http.ResponseWrit
 	default:
 		// No error in the errorChan
 		glog.Infof("No Error in goroutine: %v", vm_output_tasks)
+		if sessionId != "" {
+			if err := sp.recordTaskVerifications(r.Context(), progresses, tasksByScenario, step, vm_output_tasks); err != nil {
+				glog.Errorf("error recording task verifications for session %s: %v", sessionId, err)
+				util.ReturnHTTPMessage(w, r, 500, "error", "could not store task verifications")
+				return
+			}
+		}
 		jsonStr, _ := json.Marshal(vm_output_tasks)
 		util.ReturnHTTPContent(w, r, 200, "success", jsonStr)
 	}
 }
 
+// maxRecordedOutputLength limits the output of a task stored in the progress of a user.
+const maxRecordedOutputLength = 1024
+
+// activeProgresses returns the progress of the user in the session that is not finished yet.
+func (sp ShellProxy) activeProgresses(ctx context.Context, userId string, sessionId string) ([]*progresspb.Progress, error) {
+	progressList, err := sp.progressClient.ListProgress(ctx, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s,finished=false", hflabels.SessionLabel, sessionId, hflabels.UserLabel, userId),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return progressList.GetProgresses(), nil
+}
+
+// recordTaskVerifications stores the results of a verification in the active progress of the user in the session.
+// Only results of tasks defined in the scenario of a progress are stored in it.
+func (sp ShellProxy) recordTaskVerifications(ctx context.Context, progresses []*progresspb.Progress, tasksByScenario map[string]scenarioTasks, step int, vm_output_tasks []VirtualMachineOutputTask) error {
+	now := time.Now().Format(time.UnixDate)
+	for _, progress := range progresses {
+		progressStep := uint32(step)
+		if step < 0 {
+			progressStep = progress.GetCurrentStep()
+		}
+
+		verifications := []*progresspb.TaskVerification{}
+		for _, vm_output_task := range vm_output_tasks {
+			for _, output := range vm_output_task.TaskOutputs {
+				if _, ok := tasksByScenario[progress.GetScenario()].lookup(vm_output_task.VMName, output.Task.Name); !ok {
+					continue
+				}
+				actualOutput := output.TaskOutput.ActualOutputValue
+				if len(actualOutput) > maxRecordedOutputLength {
+					actualOutput = actualOutput[:maxRecordedOutputLength]
+				}
+				verifications = append(verifications, &progresspb.TaskVerification{
+					Step:      progressStep,
+					VmName:    vm_output_task.VMName,
+					Task:      output.Task.Name,
+					Success:   output.TaskOutput.Success,
+					Score:     int32(output.Score),
+					MaxScore:  int32(output.MaxScore),
+					Output:    actualOutput,
+					Error:     output.Error,
+					Timestamp: now,
+				})
+			}
+		}
+
+		_, err := sp.progressClient.RecordTaskVerifications(ctx, &progresspb.RecordTaskVerificationsRequest{
+			Id:                progress.GetId(),
+			TaskVerifications: verifications,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 /*
 * This is mainly used for SSH Connections to VMs
  */
@@ -877,15 +900,8 @@ func (sp ShellProxy) ConnectSSHFunc(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if vm.GetUser() != user.GetId() {
-		// check if the user has access to access user sessions
-		// TODO: add permission like 'virtualmachine/shell' similar to 'pod/exec'
-		impersonatedUserId := user.GetId()
-		authrResponse, err := rbac2.Authorize(r, sp.authrClient, impersonatedUserId, []*authrpb.Permission{
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralUser, rbac2.VerbGet),
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralSession, rbac2.VerbGet),
-			rbac2.HobbyfarmPermission(rbac2.ResourcePluralVM, rbac2.VerbGet),
-		}, rbac2.OperatorAND)
-		if err != nil || !authrResponse.Success {
+		// check if the user has access to the shell of other users' vms
+		if err := sp.authorizeShell(r, user.GetId(), ShellModeReadWrite); err != nil {
 			glog.Infof("Error doing authGrantWS %s", err)
 			util.ReturnHTTPMessage(w, r, 403, "forbidden", "access denied to connect to ssh shell session")
 			return
@@ -965,31 +981,34 @@ func (sp ShellProxy) ConnectSSHFunc(w http.ResponseWriter, r *http.Request) {
 	}
 
 	wrapper := NewWSWrapper(conn, websocket.TextMessage)
-	stdout := wrapper
-	stderr := wrapper
 
-	stdin := &InputWrapper{ws: conn}
-
-	sess, err = sshConn.NewSession()
+	sess, err := sshConn.NewSession()
 	if err != nil {
 		glog.Errorf("did not setup ssh session properly")
 		util.ReturnHTTPMessage(w, r, 500, "error", "could not setup ssh session")
 		return
 	}
 
-	go func() {
-		pip, _ := sess.StdoutPipe()
-		io.Copy(stdout, pip)
-	}()
+	stdoutPipe, _ := sess.StdoutPipe()
+	stderrPipe, _ := sess.StderrPipe()
+	stdinPipe, _ := sess.StdinPipe()
 
-	go func() {
-		pip, _ := sess.StderrPipe()
-		io.Copy(stderr, pip)
-	}()
+	recorder := sp.startRecording(r.Context(), vm, user.GetId())
+	shared := newSharedSession(vmId, sess, stdinPipe, recorder)
+	owner, _ := shared.join(user.GetId(), ShellModeOwner, wrapper)
+	sp.sessions.add(shared)
+
+	stdin := &InputWrapper{ws: conn, sess: sess, recorder: recorder}
+
+	go io.Copy(shared, stdoutPipe)
+	go io.Copy(shared, stderrPipe)
 
 	go func() {
-		pip, _ := sess.StdinPipe()
-		io.Copy(pip, stdin)
+		io.Copy(stdinPipe, stdin)
+		// the owner is gone, this ends the session for all observers
+		shared.leave(owner)
+		sp.sessions.remove(shared)
+		shared.close()
 	}()
 
 	err = sess.RequestPty("xterm", 40, 80, ssh.TerminalModes{ssh.ECHO: 1, ssh.TTY_OP_ISPEED: 14400, ssh.TTY_OP_OSPEED: 14400})
@@ -999,11 +1018,15 @@ func (sp ShellProxy) ConnectSSHFunc(w http.ResponseWriter, r *http.Request) {
 	err = sess.Shell()
 	if err != nil {
 		glog.Error(err)
+		return
 	}
 
-	//sess.Wait()
-	//
-	//defer sess.Close()
+	go func() {
+		// the shell exited, disconnect the owner and all observers
+		sess.Wait()
+		sp.sessions.remove(shared)
+		shared.close()
+	}()
 }
 
 /*
@@ -1072,12 +1095,6 @@ func mapProtocolToPort() map[string]int {
 	return m
 }
 
-func ResizePty(h int, w int) {
-	if err := sess.WindowChange(h, w); err != nil {
-		glog.Warningf("error resizing pty: %s", err)
-	}
-}
-
 func retry[T any](attempts int, sleep int, f func() (T, error)) (result T, err error) {
 	for i := 0; i < attempts; i++ {
 		if i > 0 {
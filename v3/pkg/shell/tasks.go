@@ -0,0 +1,83 @@
+package shell
+
+import (
+	"context"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+)
+
+// scenarioTasks holds the tasks defined in a scenario by vm name and task name.
+type scenarioTasks map[string]map[string]hfv1.Task
+
+func newScenarioTasks(vmTasks []*scenariopb.VirtualMachineTasks) scenarioTasks {
+	tasks := scenarioTasks{}
+	for _, vm := range vmTasks {
+		byName, ok := tasks[vm.GetVmName()]
+		if !ok {
+			byName = map[string]hfv1.Task{}
+			tasks[vm.GetVmName()] = byName
+		}
+		for _, task := range vm.GetTasks() {
+			byName[task.GetName()] = newScenarioTask(task)
+		}
+	}
+	return tasks
+}
+
+func (s scenarioTasks) lookup(vmName string, name string) (hfv1.Task, bool) {
+	task, ok := s[vmName][name]
+	return task, ok
+}
+
+func newScenarioTask(task *scenariopb.Task) hfv1.Task {
+	return hfv1.Task{
+		Name:                task.GetName(),
+		Description:         task.GetDescription(),
+		Command:             task.GetCommand(),
+		ExpectedOutputValue: task.GetExpectedOutputValue(),
+		ExpectedReturnCode:  int(task.GetExpectedReturnCode()),
+		ReturnType:          task.GetReturnType(),
+		Type:                task.GetType(),
+		Path:                task.GetPath(),
+		URL:                 task.GetUrl(),
+		JSONPath:            task.GetJsonPath(),
+		DependsOn:           task.GetDependsOn(),
+		TimeoutSeconds:      int(task.GetTimeoutSeconds()),
+		Points:              int(task.GetPoints()),
+	}
+}
+
+// getScenarioTasks retrieves the tasks of a scenario from the scenario service.
+func (sp ShellProxy) getScenarioTasks(ctx context.Context, scenarioId string) (scenarioTasks, error) {
+	scenario, err := sp.scenarioClient.GetScenario(ctx, &generalpb.GetRequest{Id: scenarioId, LoadFromCache: true})
+	if err != nil {
+		return nil, err
+	}
+	return newScenarioTasks(scenario.GetVmTasks()), nil
+}
+
+// resolveTasks replaces the tasks of a verification request with the tasks of the same name defined for the vm in
+// one of the scenarios. Only the names of the requested tasks are used, commands and expected outputs sent by the
+// client are ignored. Tasks not defined in any of the scenarios are dropped.
+func resolveTasks(vmInputTasks []VirtualMachineInputTask, scenarios ...scenarioTasks) []VirtualMachineInputTask {
+	resolved := make([]VirtualMachineInputTask, 0, len(vmInputTasks))
+	for _, vmInputTask := range vmInputTasks {
+		tasks := []hfv1.Task{}
+		for _, requested := range vmInputTask.Tasks {
+			for _, scenario := range scenarios {
+				if task, ok := scenario.lookup(vmInputTask.VMName, requested.Name); ok {
+					tasks = append(tasks, task)
+					break
+				}
+			}
+		}
+		resolved = append(resolved, VirtualMachineInputTask{
+			VMId:   vmInputTask.VMId,
+			VMName: vmInputTask.VMName,
+			Tasks:  tasks,
+		})
+	}
+	return resolved
+}
@@ -0,0 +1,50 @@
+package shell
+
+import (
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveTasks(t *testing.T) {
+	tasks := newScenarioTasks([]*scenariopb.VirtualMachineTasks{
+		{VmName: "server", Tasks: []*scenariopb.Task{
+			{Name: "nginx", Command: "systemctl is-active nginx", ExpectedOutputValue: "active", ReturnType: "Match_Regex", Points: 2},
+		}},
+		{VmName: "client", Tasks: []*scenariopb.Task{
+			{Name: "curl", Command: "curl -s server", ExpectedReturnCode: 0, ReturnType: "Return_Code"},
+		}},
+	})
+
+	resolved := resolveTasks([]VirtualMachineInputTask{
+		{VMId: "vm-1", VMName: "server", Tasks: []hfv1.Task{
+			// the command and expected output of the client are replaced by the task of the scenario
+			{Name: "nginx", Command: "true", ExpectedOutputValue: "", ReturnType: "Return_Code", Points: 100},
+			// tasks of another vm or not defined in the scenario are dropped
+			{Name: "curl", Command: "true"},
+			{Name: "injected", Command: "true"},
+		}},
+		{VMId: "vm-2", VMName: "client", Tasks: []hfv1.Task{{Name: "curl"}}},
+		{VMId: "vm-3", VMName: "unknown", Tasks: []hfv1.Task{{Name: "nginx"}}},
+	}, tasks)
+
+	require.Len(t, resolved, 3)
+	assert.Equal(t, []hfv1.Task{{
+		Name:                "nginx",
+		Command:             "systemctl is-active nginx",
+		ExpectedOutputValue: "active",
+		ReturnType:          "Match_Regex",
+		Points:              2,
+	}}, resolved[0].Tasks)
+	assert.Equal(t, "vm-1", resolved[0].VMId)
+	assert.Equal(t, []hfv1.Task{{Name: "curl", Command: "curl -s server", ReturnType: "Return_Code"}}, resolved[1].Tasks)
+	assert.Empty(t, resolved[2].Tasks)
+
+	_, ok := tasks.lookup("server", "injected")
+	assert.False(t, ok)
+	_, ok = tasks.lookup("server", "nginx")
+	assert.True(t, ok)
+}
@@ -1,6 +1,9 @@
 package util
 
 import (
+	"fmt"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
 )
@@ -55,3 +58,83 @@ func ConvertToStringMapStructMap(in map[string]map[string]string) map[string]*ge
 	}
 	return output
 }
+
+// A function that converts hfv1.VirtualMachineResources to *generalpb.Resources
+func ConvertToResources(resources hfv1.VirtualMachineResources) *generalpb.Resources {
+	return &generalpb.Resources{
+		Cpu:    int64(resources.CPU),
+		Memory: int64(resources.Memory),
+		Disk:   int64(resources.Disk),
+	}
+}
+
+func ConvertFromResources(resources *generalpb.Resources) hfv1.VirtualMachineResources {
+	return hfv1.VirtualMachineResources{
+		CPU:    int(resources.GetCpu()),
+		Memory: int(resources.GetMemory()),
+		Disk:   int(resources.GetDisk()),
+	}
+}
+
+// A function that parses raw resources like {"cpu": 2, "memory": 4096, "disk": 20}. Negative resources are invalid.
+func ParseResources(rawResources string, propName string) (hfv1.VirtualMachineResources, error) {
+	resources, err := GenericUnmarshal[hfv1.VirtualMachineResources](rawResources, propName)
+	if err != nil {
+		return resources, err
+	}
+	if resources.CPU < 0 || resources.Memory < 0 || resources.Disk < 0 {
+		return resources, fmt.Errorf("%s must not be negative", propName)
+	}
+	return resources, nil
+}
+
+// A function that converts map[string]map[string]int to map[string]*scheduledeventpb.VMTemplateCountMap
+func ConvertToVMTemplateCountMaps(in map[string]map[string]int) map[string]*scheduledeventpb.VMTemplateCountMap {
+	output := make(map[string]*scheduledeventpb.VMTemplateCountMap, len(in))
+	for key, val := range in {
+		output[key] = &scheduledeventpb.VMTemplateCountMap{VmTemplateCounts: ConvertIntMap[int, uint32](val)}
+	}
+	return output
+}
+
+// A function that converts []hfv1.VirtualMachineSpread to []*scheduledeventpb.VMSpread
+func ConvertToVMSpreads(spreads []hfv1.VirtualMachineSpread) []*scheduledeventpb.VMSpread {
+	output := make([]*scheduledeventpb.VMSpread, 0, len(spreads))
+	for _, spread := range spreads {
+		environments := make([]*scheduledeventpb.SpreadEnvironment, 0, len(spread.Environments))
+		for _, environment := range spread.Environments {
+			environments = append(environments, &scheduledeventpb.SpreadEnvironment{
+				Environment: environment.Environment,
+				Weight:      uint32(environment.Weight),
+			})
+		}
+		output = append(output, &scheduledeventpb.VMSpread{
+			VmTemplate:   spread.VMTemplate,
+			Count:        uint32(spread.Count),
+			Environments: environments,
+		})
+	}
+	return output
+}
+
+// A function that parses raw spread virtual machines like [{"vm_template": "x", "count": 40, "environments": [{"environment": "a", "weight": 2}, {"environment": "b"}]}].
+// Every spread needs a virtual machine template and at least one environment, environments must not repeat within a spread.
+func ParseSpreadVirtualMachines(rawSpreads string, propName string) ([]hfv1.VirtualMachineSpread, error) {
+	spreads, err := GenericUnmarshal[[]hfv1.VirtualMachineSpread](rawSpreads, propName)
+	if err != nil {
+		return spreads, err
+	}
+	for _, spread := range spreads {
+		if spread.VMTemplate == "" || spread.Count < 0 || len(spread.Environments) == 0 {
+			return spreads, fmt.Errorf("%s needs a vm template, a count and environments", propName)
+		}
+		environments := make(map[string]bool, len(spread.Environments))
+		for _, environment := range spread.Environments {
+			if environment.Environment == "" || environment.Weight < 0 || environments[environment.Environment] {
+				return spreads, fmt.Errorf("%s has an invalid environment %q for vm template %s", propName, environment.Environment, spread.VMTemplate)
+			}
+			environments[environment.Environment] = true
+		}
+	}
+	return spreads, nil
+}
@@ -371,14 +371,42 @@ func VirtualMachinesUsedDuringPeriod(eventClient scheduledeventpb.ScheduledEvent
 		return map[time.Time]map[string]uint32{}, map[string]uint32{}, fmt.Errorf("error retrieving scheduled events: %s", hferrors.GetErrorMessage(err))
 	}
 
+	return VirtualMachinesReservedDuringPeriod(scheduledEventList.GetScheduledevents(), environment, start, end)
+}
+
+// Returns the virtualMachineTemplates a scheduled event requires per environment.
+// These are the required vms of the scheduled event together with its spread vms as they are allocated to the environments.
+func RequiredVirtualMachines(se *scheduledeventpb.ScheduledEvent) map[string]*scheduledeventpb.VMTemplateCountMap {
+	spreadAllocation := se.GetStatus().GetSpreadAllocation()
+	if len(spreadAllocation) == 0 {
+		return se.GetRequiredVms()
+	}
+
+	requiredVms := make(map[string]*scheduledeventpb.VMTemplateCountMap, len(se.GetRequiredVms())+len(spreadAllocation))
+	for _, vmMappings := range []map[string]*scheduledeventpb.VMTemplateCountMap{se.GetRequiredVms(), spreadAllocation} {
+		for environment, vmMapping := range vmMappings {
+			if _, ok := requiredVms[environment]; !ok {
+				requiredVms[environment] = &scheduledeventpb.VMTemplateCountMap{VmTemplateCounts: make(map[string]uint32)}
+			}
+			for vmTemplate, count := range vmMapping.GetVmTemplateCounts() {
+				requiredVms[environment].VmTemplateCounts[vmTemplate] += count
+			}
+		}
+	}
+	return requiredVms
+}
+
+// Calculates the virtualMachineTemplates reserved by the given scheduled events for a given period (start, end) and environment
+// Returns a map with the timestamps where the reserved count changes and the count reserved from there on. Also returns the maximum reserved count of virtualmachinetemplates over the whole duration.
+func VirtualMachinesReservedDuringPeriod(scheduledEvents []*scheduledeventpb.ScheduledEvent, environment string, start time.Time, end time.Time) (map[time.Time]map[string]uint32, map[string]uint32, error) {
 	var timeRange []Range
 	var changingTimestamps []time.Time                           // All timestamps where number of virtualmachines changes (Begin or End of Scheduled Event)
 	virtualMachineCount := make(map[time.Time]map[string]uint32) // Count of virtualmachines per VMTemplate for any given timestamp where a change happened
 	maximumVirtualMachineCount := make(map[string]uint32)        // Maximum VirtualMachine Count per VirtualMachineTemplate over all timestamps
 
-	for _, se := range scheduledEventList.GetScheduledevents() {
+	for _, se := range scheduledEvents {
 		// Scheduled Event uses the environment we are checking
-		if vmMapping, ok := se.GetRequiredVms()[environment]; ok {
+		if vmMapping, ok := RequiredVirtualMachines(se)[environment]; ok {
 			seStart, err := time.Parse(time.UnixDate, se.GetStartTime())
 			if err != nil {
 				return map[time.Time]map[string]uint32{}, map[string]uint32{}, fmt.Errorf("error parsing scheduled event start %v", err)
@@ -412,7 +440,8 @@ func VirtualMachinesUsedDuringPeriod(eventClient scheduledeventpb.ScheduledEvent
 			if eventRange.Start.After(timestamp) {
 				continue
 			}
-			if eventRange.End.Before(timestamp) {
+			// The event no longer reserves its virtual machines when it ends, so back-to-back events do not overlap.
+			if !eventRange.End.After(timestamp) {
 				break
 			}
 
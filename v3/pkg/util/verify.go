@@ -74,9 +74,26 @@ func VerifyTaskContent(vm_tasks []hfv1.VirtualMachineTasks, request proto.Messag
 				glog.Errorf("error description of task in vm_tasks is not specified")
 				return hferrors.GrpcError(codes.InvalidArgument, "description of task in vm_tasks is not specified", request)
 			}
-			if task.Command == "" || task.Command == "[]" {
-				glog.Errorf("error command of task in vm_tasks is not specified")
-				return hferrors.GrpcError(codes.InvalidArgument, "command of task in vm_tasks is not specified", request)
+			switch task.Type {
+			case hfv1.TaskTypeFileExists, hfv1.TaskTypeFileContent:
+				if task.Path == "" {
+					glog.Errorf("error path of task in vm_tasks is not specified")
+					return hferrors.GrpcError(codes.InvalidArgument, "path of task in vm_tasks is not specified", request)
+				}
+			case hfv1.TaskTypeHTTP:
+				if task.URL == "" {
+					glog.Errorf("error url of task in vm_tasks is not specified")
+					return hferrors.GrpcError(codes.InvalidArgument, "url of task in vm_tasks is not specified", request)
+				}
+			default:
+				if task.Command == "" || task.Command == "[]" {
+					glog.Errorf("error command of task in vm_tasks is not specified")
+					return hferrors.GrpcError(codes.InvalidArgument, "command of task in vm_tasks is not specified", request)
+				}
+			}
+			if task.TimeoutSeconds < 0 || task.Points < 0 {
+				glog.Errorf("error timeout_seconds or points of task in vm_tasks is negative")
+				return hferrors.GrpcError(codes.InvalidArgument, "timeout_seconds and points of task in vm_tasks must not be negative", request)
 			}
 		}
 	}
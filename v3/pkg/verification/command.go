@@ -0,0 +1,123 @@
+package verification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	ReturnTypeCodeAndText = "Return_Code_And_Text"
+	ReturnTypeCode        = "Return_Code"
+	ReturnTypeText        = "Return_Text"
+	ReturnTypeMatchRegex  = "Match_Regex"
+)
+
+// checkCommand runs the command of the task and compares its output and exit code according to the ReturnType.
+func checkCommand(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	output, returnCode, err := target.Run(ctx, task.Command)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return matchCommandOutput(task, output, returnCode), nil
+}
+
+func matchCommandOutput(task hfv1.Task, output string, returnCode int) Result {
+	result := Result{
+		Output:     output,
+		ReturnCode: returnCode,
+	}
+
+	switch task.ReturnType {
+	case ReturnTypeCodeAndText:
+		result.Success = task.ExpectedOutputValue == output && task.ExpectedReturnCode == returnCode
+	case ReturnTypeCode:
+		result.Success = task.ExpectedReturnCode == returnCode
+	case ReturnTypeText:
+		result.Success = task.ExpectedOutputValue == output
+	case ReturnTypeMatchRegex:
+		result.Success = isMatchRegex(output, task.ExpectedOutputValue)
+		if !result.Success {
+			result.Output = "regex:error"
+		}
+	default:
+		result.Output = "undefined ReturnType"
+	}
+
+	return result
+}
+
+// matchText compares a value to the expected output value of a task. Match_Regex tasks match a
+// regular expression, all other tasks compare the values.
+func matchText(task hfv1.Task, actual string) bool {
+	if task.ReturnType == ReturnTypeMatchRegex {
+		return isMatchRegex(actual, task.ExpectedOutputValue)
+	}
+	return task.ExpectedOutputValue == actual
+}
+
+func isMatchRegex(text, pattern string) bool {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(text)
+}
+
+// checkJSONPath runs the command of the task, which has to print JSON, and compares the value
+// selected by the JSONPath of the task to the expected output value.
+func checkJSONPath(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	output, returnCode, err := target.Run(ctx, task.Command)
+	if err != nil {
+		return Result{}, err
+	}
+
+	result := Result{ReturnCode: returnCode}
+	if returnCode != task.ExpectedReturnCode {
+		result.Output = output
+		return result, nil
+	}
+
+	value, err := evaluateJSONPath(task.JSONPath, output)
+	if err != nil {
+		result.Output = output
+		result.Error = err.Error()
+		return result, nil
+	}
+
+	result.Output = value
+	result.Success = matchText(task, value)
+
+	return result, nil
+}
+
+func evaluateJSONPath(path string, document string) (string, error) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(document), &data); err != nil {
+		return "", fmt.Errorf("command output is not valid json: %v", err)
+	}
+
+	// allow plain paths like .status.phase besides templates like {.status.phase}
+	if !strings.Contains(path, "{") {
+		path = "{" + path + "}"
+	}
+
+	jp := jsonpath.New("task")
+	if err := jp.Parse(path); err != nil {
+		return "", fmt.Errorf("invalid json path: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, data); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
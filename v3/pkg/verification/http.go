@@ -0,0 +1,63 @@
+package verification
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+)
+
+// maxHTTPBodySize limits how much of a response body is read to verify it
+const maxHTTPBodySize = 64 * 1024
+
+// checkHTTP requests the url of the task from inside the vm, so that services only listening on
+// localhost or private networks of the vm can be probed. The response status has to equal the
+// expected return code, 200 if unset. If an expected output value is set, the body has to match it.
+func checkHTTP(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+				return target.Dial(ctx, network, addr)
+			},
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+	defer client.CloseIdleConnections()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, task.URL, nil)
+	if err != nil {
+		return Result{Error: err.Error()}, nil
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPBodySize))
+	if err != nil {
+		return Result{}, err
+	}
+
+	expectedCode := task.ExpectedReturnCode
+	if expectedCode == 0 {
+		expectedCode = http.StatusOK
+	}
+
+	output := strings.TrimRight(string(body), "\r\n")
+	success := resp.StatusCode == expectedCode
+	if success && task.ExpectedOutputValue != "" {
+		success = matchText(task, output)
+	}
+
+	return Result{
+		Output:     output,
+		ReturnCode: resp.StatusCode,
+		Success:    success,
+	}, nil
+}
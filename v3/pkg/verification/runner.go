@@ -0,0 +1,245 @@
+package verification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	settingUtil "github.com/hobbyfarm/gargantua/v3/pkg/setting"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
+	"golang.org/x/sync/semaphore"
+)
+
+// exitCodeSIGPIPE is returned by commands killed by SIGPIPE. Those commands are run again.
+const exitCodeSIGPIPE = 141
+
+// Limits bound the load task verification puts on a vm.
+type Limits struct {
+	// MaxConcurrentCommands is the number of tasks verified at the same time on one vm
+	MaxConcurrentCommands int
+	// MaxCommandAttempts is how often a command killed by SIGPIPE is run
+	MaxCommandAttempts int
+	// Timeout applies to tasks without their own timeout
+	Timeout time.Duration
+}
+
+var DefaultLimits = Limits{
+	MaxConcurrentCommands: 3,
+	MaxCommandAttempts:    5,
+	Timeout:               30 * time.Second,
+}
+
+// LimitsFromSettings reads the limits from the task verification settings. Limits that
+// cannot be read keep their default.
+func LimitsFromSettings(ctx context.Context, settingClient settingpb.SettingSvcClient) Limits {
+	limits := DefaultLimits
+
+	read := func(name settingUtil.SettingName) (int64, bool) {
+		setting, err := settingClient.GetSettingValue(ctx, &generalpb.ResourceId{Id: string(name)})
+		if err != nil {
+			glog.Errorf("error retrieving setting %s: %v", name, err)
+			return 0, false
+		}
+		s, ok := setting.GetValue().(*settingpb.SettingValue_Int64Value)
+		if !ok || s.Int64Value < 1 {
+			glog.Errorf("setting %s is not a positive integer", name)
+			return 0, false
+		}
+		return s.Int64Value, true
+	}
+
+	if v, ok := read(settingUtil.TaskVerificationMaxConcurrentCommands); ok {
+		limits.MaxConcurrentCommands = int(v)
+	}
+	if v, ok := read(settingUtil.TaskVerificationMaxCommandAttempts); ok {
+		limits.MaxCommandAttempts = int(v)
+	}
+	if v, ok := read(settingUtil.TaskVerificationTimeout); ok {
+		limits.Timeout = time.Duration(v) * time.Second
+	}
+
+	return limits
+}
+
+type TaskResult struct {
+	Task hfv1.Task
+	Result
+}
+
+// Runner verifies the tasks of a vm.
+type Runner struct {
+	Registry *Registry
+	Limits   Limits
+}
+
+func NewRunner(limits Limits) *Runner {
+	return &Runner{
+		Registry: DefaultRegistry,
+		Limits:   limits,
+	}
+}
+
+// Verify verifies all tasks on the target and returns their results in the order of the tasks.
+// Independent tasks are verified concurrently. A task is only verified once all tasks it depends
+// on succeeded, otherwise it fails without being verified.
+func (r *Runner) Verify(ctx context.Context, target Target, tasks []hfv1.Task) []TaskResult {
+	results := make([]TaskResult, len(tasks))
+	done := make([]chan struct{}, len(tasks))
+	for i := range tasks {
+		done[i] = make(chan struct{})
+	}
+
+	dependencies, dependencyErrors := resolveDependencies(tasks)
+	sem := semaphore.NewWeighted(int64(max(r.Limits.MaxConcurrentCommands, 1)))
+
+	for i := range tasks {
+		go func(i int) {
+			defer close(done[i])
+
+			task := tasks[i]
+			results[i].Task = task
+			results[i].MaxScore = points(task)
+
+			if err := dependencyErrors[i]; err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+
+			for _, d := range dependencies[i] {
+				<-done[d]
+				if !results[d].Success {
+					results[i].Error = fmt.Sprintf("depends on task %s which did not succeed", tasks[d].Name)
+					return
+				}
+			}
+
+			if err := sem.Acquire(ctx, 1); err != nil {
+				results[i].Error = err.Error()
+				return
+			}
+			defer sem.Release(1)
+
+			results[i].Result = r.check(ctx, target, task)
+			results[i].MaxScore = points(task)
+			if results[i].Success {
+				results[i].Score = results[i].MaxScore
+			}
+		}(i)
+	}
+
+	for i := range tasks {
+		<-done[i]
+	}
+
+	return results
+}
+
+// check verifies a single task within its timeout. Commands killed by SIGPIPE are run again.
+func (r *Runner) check(ctx context.Context, target Target, task hfv1.Task) Result {
+	checker, ok := r.Registry.Lookup(task.Type)
+	if !ok {
+		return Result{Error: fmt.Sprintf("unknown task type %s", task.Type)}
+	}
+
+	timeout := r.Limits.Timeout
+	if task.TimeoutSeconds > 0 {
+		timeout = time.Duration(task.TimeoutSeconds) * time.Second
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 0; attempt < max(r.Limits.MaxCommandAttempts, 1); attempt++ {
+		result, err := checker.Check(ctx, target, task)
+		if err != nil {
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return Result{Error: fmt.Sprintf("timed out after %s", timeout)}
+			}
+			glog.Infof("error verifying task %s: %v", task.Name, err)
+			return Result{Error: err.Error()}
+		}
+		if result.ReturnCode != exitCodeSIGPIPE {
+			return result
+		}
+	}
+
+	return Result{Error: "error try run command"}
+}
+
+// resolveDependencies maps the dependencies of each task to the indices of the tasks they name.
+// Tasks depending on unknown tasks or on themselves through a cycle get an error instead.
+func resolveDependencies(tasks []hfv1.Task) ([][]int, []error) {
+	index := make(map[string]int, len(tasks))
+	for i, task := range tasks {
+		index[task.Name] = i
+	}
+
+	dependencies := make([][]int, len(tasks))
+	errs := make([]error, len(tasks))
+	for i, task := range tasks {
+		for _, name := range task.DependsOn {
+			d, ok := index[name]
+			if !ok {
+				errs[i] = fmt.Errorf("depends on unknown task %s", name)
+				break
+			}
+			dependencies[i] = append(dependencies[i], d)
+		}
+	}
+
+	// tasks waiting on each other would never be verified
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make([]int, len(tasks))
+	cyclic := make([]bool, len(tasks))
+	var visit func(i int) bool
+	visit = func(i int) bool {
+		switch state[i] {
+		case visiting:
+			return true
+		case visited:
+			return cyclic[i]
+		}
+		state[i] = visiting
+		for _, d := range dependencies[i] {
+			if visit(d) {
+				cyclic[i] = true
+				if errs[i] == nil {
+					errs[i] = fmt.Errorf("dependency cycle through task %s", tasks[d].Name)
+				}
+			}
+		}
+		state[i] = visited
+		return cyclic[i]
+	}
+	for i := range tasks {
+		visit(i)
+	}
+
+	return dependencies, errs
+}
+
+// points returns how many points a task is worth, tasks without points are worth one.
+func points(task hfv1.Task) int {
+	if task.Points > 0 {
+		return task.Points
+	}
+	return 1
+}
+
+// Score sums up the scores of the results. Tasks that did not succeed do not score, so the
+// score of a partially solved step is between zero and the maximum score.
+func Score(results []TaskResult) (int, int) {
+	score, maxScore := 0, 0
+	for _, r := range results {
+		score += r.Score
+		maxScore += r.MaxScore
+	}
+	return score, maxScore
+}
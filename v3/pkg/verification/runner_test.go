@@ -0,0 +1,151 @@
+package verification
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeTarget returns canned command results and counts how often each command ran.
+type fakeTarget struct {
+	lock    sync.Mutex
+	outputs map[string][]fakeOutput
+	runs    map[string]int
+}
+
+type fakeOutput struct {
+	output     string
+	returnCode int
+}
+
+func (t *fakeTarget) Run(ctx context.Context, command string) (string, int, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.runs == nil {
+		t.runs = make(map[string]int)
+	}
+	outputs := t.outputs[command]
+	out := outputs[min(t.runs[command], len(outputs)-1)]
+	t.runs[command]++
+
+	return out.output, out.returnCode, nil
+}
+
+func (t *fakeTarget) Dial(ctx context.Context, network string, addr string) (net.Conn, error) {
+	return nil, io.EOF
+}
+
+func (t *fakeTarget) Subsystem(ctx context.Context, name string) (io.ReadWriteCloser, error) {
+	return nil, io.EOF
+}
+
+func TestMatchCommandOutput(t *testing.T) {
+	task := hfv1.Task{ExpectedOutputValue: "ok", ExpectedReturnCode: 0}
+
+	task.ReturnType = ReturnTypeCodeAndText
+	assert.True(t, matchCommandOutput(task, "ok", 0).Success)
+	assert.False(t, matchCommandOutput(task, "ok", 1).Success)
+
+	task.ReturnType = ReturnTypeCode
+	assert.True(t, matchCommandOutput(task, "nok", 0).Success)
+
+	task.ReturnType = ReturnTypeText
+	assert.False(t, matchCommandOutput(task, "nok", 0).Success)
+
+	task.ReturnType = ReturnTypeMatchRegex
+	task.ExpectedOutputValue = "^v[0-9]+$"
+	assert.True(t, matchCommandOutput(task, "v12", 0).Success)
+	result := matchCommandOutput(task, "version 12", 0)
+	assert.False(t, result.Success)
+	assert.Equal(t, "regex:error", result.Output)
+
+	task.ReturnType = "Unknown"
+	assert.Equal(t, "undefined ReturnType", matchCommandOutput(task, "ok", 0).Output)
+}
+
+func TestRunnerDependenciesAndScore(t *testing.T) {
+	target := &fakeTarget{outputs: map[string][]fakeOutput{
+		"install": {{output: "done"}},
+		"start":   {{output: "failed", returnCode: 1}},
+		"curl":    {{output: "hello"}},
+	}}
+
+	tasks := []hfv1.Task{
+		{Name: "installed", Command: "install", ReturnType: ReturnTypeCode, Points: 2},
+		{Name: "started", Command: "start", ReturnType: ReturnTypeCode, DependsOn: []string{"installed"}},
+		{Name: "serving", Command: "curl", ReturnType: ReturnTypeCode, DependsOn: []string{"started"}},
+		{Name: "orphan", Command: "install", ReturnType: ReturnTypeCode, DependsOn: []string{"missing"}},
+	}
+
+	results := NewRunner(DefaultLimits).Verify(context.Background(), target, tasks)
+	require.Len(t, results, 4)
+
+	assert.True(t, results[0].Success)
+	assert.Equal(t, 2, results[0].Score)
+	assert.False(t, results[1].Success)
+	assert.Empty(t, results[1].Error)
+	assert.Equal(t, "depends on task started which did not succeed", results[2].Error)
+	assert.Equal(t, "depends on unknown task missing", results[3].Error)
+
+	assert.Equal(t, 0, target.runs["curl"], "tasks with failed dependencies are not verified")
+
+	score, maxScore := Score(results)
+	assert.Equal(t, 2, score)
+	assert.Equal(t, 5, maxScore)
+}
+
+func TestRunnerDependencyCycle(t *testing.T) {
+	tasks := []hfv1.Task{
+		{Name: "a", Command: "a", DependsOn: []string{"b"}},
+		{Name: "b", Command: "b", DependsOn: []string{"a"}},
+	}
+
+	results := NewRunner(DefaultLimits).Verify(context.Background(), &fakeTarget{}, tasks)
+	assert.Contains(t, results[0].Error, "dependency cycle")
+	assert.Contains(t, results[1].Error, "dependency cycle")
+}
+
+func TestRunnerRetriesSIGPIPE(t *testing.T) {
+	target := &fakeTarget{outputs: map[string][]fakeOutput{
+		"flaky":  {{returnCode: exitCodeSIGPIPE}, {output: "ok"}},
+		"broken": {{returnCode: exitCodeSIGPIPE}},
+	}}
+
+	limits := DefaultLimits
+	limits.MaxCommandAttempts = 3
+
+	results := NewRunner(limits).Verify(context.Background(), target, []hfv1.Task{
+		{Name: "flaky", Command: "flaky", ReturnType: ReturnTypeText, ExpectedOutputValue: "ok"},
+		{Name: "broken", Command: "broken", ReturnType: ReturnTypeCode},
+	})
+
+	assert.True(t, results[0].Success)
+	assert.Equal(t, 2, target.runs["flaky"])
+	assert.Equal(t, "error try run command", results[1].Error)
+	assert.Equal(t, 3, target.runs["broken"])
+}
+
+func TestRunnerTimeoutAndUnknownType(t *testing.T) {
+	runner := &Runner{Registry: NewRegistry(), Limits: DefaultLimits}
+	runner.Registry.Register("slow", CheckerFunc(func(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+		<-ctx.Done()
+		return Result{}, ctx.Err()
+	}))
+	runner.Limits.Timeout = 10 * time.Millisecond
+
+	results := runner.Verify(context.Background(), &fakeTarget{}, []hfv1.Task{
+		{Name: "slow", Type: "slow"},
+		{Name: "command", Command: "true"},
+	})
+
+	assert.Equal(t, "timed out after 10ms", results[0].Error)
+	assert.Equal(t, "unknown task type ", results[1].Error, "only registered checkers are available")
+}
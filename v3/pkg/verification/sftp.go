@@ -0,0 +1,343 @@
+package verification
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+)
+
+// The file checkers only need to stat and read files, so instead of a full sftp client this
+// implements the few requests of version 3 of the sftp protocol they use.
+const (
+	sftpProtocolVersion = 3
+
+	sftpPacketInit    = 1
+	sftpPacketVersion = 2
+	sftpPacketOpen    = 3
+	sftpPacketClose   = 4
+	sftpPacketRead    = 5
+	sftpPacketStat    = 17
+	sftpPacketStatus  = 101
+	sftpPacketHandle  = 102
+	sftpPacketData    = 103
+	sftpPacketAttrs   = 105
+
+	sftpStatusOK         = 0
+	sftpStatusEOF        = 1
+	sftpStatusNoSuchFile = 2
+
+	sftpOpenRead = 0x1
+
+	sftpAttrSize        = 0x1
+	sftpAttrUidGid      = 0x2
+	sftpAttrPermissions = 0x4
+	sftpAttrAcModTime   = 0x8
+
+	sftpMaxPacket = 256 * 1024
+	sftpReadChunk = 32 * 1024
+
+	// maxFileContentSize limits how much of a file is read to verify its content
+	maxFileContentSize = 1024 * 1024
+)
+
+var errFileNotFound = errors.New("file not found")
+
+type sftpClient struct {
+	rw     io.ReadWriteCloser
+	nextId uint32
+}
+
+type sftpFileInfo struct {
+	size        uint64
+	permissions uint32
+}
+
+func (fi sftpFileInfo) isDir() bool {
+	return fi.permissions&0170000 == 0040000
+}
+
+func newSFTPClient(rw io.ReadWriteCloser) (*sftpClient, error) {
+	c := &sftpClient{rw: rw}
+
+	if err := c.send(sftpPacketInit, binary.BigEndian.AppendUint32(nil, sftpProtocolVersion)); err != nil {
+		return nil, err
+	}
+
+	packetType, _, err := c.receive()
+	if err != nil {
+		return nil, err
+	}
+	if packetType != sftpPacketVersion {
+		return nil, fmt.Errorf("unexpected sftp packet %d during init", packetType)
+	}
+
+	return c, nil
+}
+
+func (c *sftpClient) Close() error {
+	return c.rw.Close()
+}
+
+func (c *sftpClient) send(packetType byte, payload []byte) error {
+	packet := binary.BigEndian.AppendUint32(nil, uint32(len(payload)+1))
+	packet = append(packet, packetType)
+	packet = append(packet, payload...)
+
+	_, err := c.rw.Write(packet)
+	return err
+}
+
+func (c *sftpClient) receive() (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(c.rw, header[:]); err != nil {
+		return 0, nil, err
+	}
+
+	length := binary.BigEndian.Uint32(header[:4])
+	if length < 1 || length > sftpMaxPacket {
+		return 0, nil, fmt.Errorf("invalid sftp packet length %d", length)
+	}
+
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(c.rw, payload); err != nil {
+		return 0, nil, err
+	}
+
+	return header[4], payload, nil
+}
+
+// request sends a request and returns the type and payload of its response without the request id.
+func (c *sftpClient) request(packetType byte, payload []byte) (byte, []byte, error) {
+	c.nextId++
+	id := c.nextId
+
+	if err := c.send(packetType, append(binary.BigEndian.AppendUint32(nil, id), payload...)); err != nil {
+		return 0, nil, err
+	}
+
+	responseType, response, err := c.receive()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	responseId, response, ok := readUint32(response)
+	if !ok || responseId != id {
+		return 0, nil, fmt.Errorf("unexpected sftp response id")
+	}
+
+	return responseType, response, nil
+}
+
+func (c *sftpClient) stat(path string) (sftpFileInfo, error) {
+	responseType, response, err := c.request(sftpPacketStat, appendString(nil, path))
+	if err != nil {
+		return sftpFileInfo{}, err
+	}
+
+	switch responseType {
+	case sftpPacketAttrs:
+		return parseAttrs(response)
+	case sftpPacketStatus:
+		return sftpFileInfo{}, statusError(response)
+	default:
+		return sftpFileInfo{}, fmt.Errorf("unexpected sftp packet %d", responseType)
+	}
+}
+
+// readFile reads at most limit bytes of a file.
+func (c *sftpClient) readFile(path string, limit int) ([]byte, error) {
+	payload := appendString(nil, path)
+	payload = binary.BigEndian.AppendUint32(payload, sftpOpenRead)
+	payload = binary.BigEndian.AppendUint32(payload, 0) // no attributes
+
+	responseType, response, err := c.request(sftpPacketOpen, payload)
+	if err != nil {
+		return nil, err
+	}
+	if responseType == sftpPacketStatus {
+		return nil, statusError(response)
+	}
+	if responseType != sftpPacketHandle {
+		return nil, fmt.Errorf("unexpected sftp packet %d", responseType)
+	}
+
+	handle, _, ok := readString(response)
+	if !ok {
+		return nil, fmt.Errorf("invalid sftp handle")
+	}
+	defer c.request(sftpPacketClose, appendString(nil, handle))
+
+	var content []byte
+	for len(content) < limit {
+		chunk := min(sftpReadChunk, limit-len(content))
+
+		payload := appendString(nil, handle)
+		payload = binary.BigEndian.AppendUint64(payload, uint64(len(content)))
+		payload = binary.BigEndian.AppendUint32(payload, uint32(chunk))
+
+		responseType, response, err := c.request(sftpPacketRead, payload)
+		if err != nil {
+			return nil, err
+		}
+
+		if responseType == sftpPacketStatus {
+			if err := statusError(response); err != io.EOF {
+				return nil, err
+			}
+			break
+		}
+		if responseType != sftpPacketData {
+			return nil, fmt.Errorf("unexpected sftp packet %d", responseType)
+		}
+
+		data, _, ok := readString(response)
+		if !ok {
+			return nil, fmt.Errorf("invalid sftp data")
+		}
+		content = append(content, data...)
+	}
+
+	return content, nil
+}
+
+func statusError(payload []byte) error {
+	code, payload, ok := readUint32(payload)
+	if !ok {
+		return fmt.Errorf("invalid sftp status")
+	}
+
+	switch code {
+	case sftpStatusOK:
+		return nil
+	case sftpStatusEOF:
+		return io.EOF
+	case sftpStatusNoSuchFile:
+		return errFileNotFound
+	}
+
+	message, _, _ := readString(payload)
+	return fmt.Errorf("sftp error %d: %s", code, message)
+}
+
+func parseAttrs(payload []byte) (sftpFileInfo, error) {
+	var fi sftpFileInfo
+
+	flags, payload, ok := readUint32(payload)
+	if !ok {
+		return fi, fmt.Errorf("invalid sftp attributes")
+	}
+
+	if flags&sftpAttrSize != 0 {
+		if len(payload) < 8 {
+			return fi, fmt.Errorf("invalid sftp attributes")
+		}
+		fi.size = binary.BigEndian.Uint64(payload)
+		payload = payload[8:]
+	}
+	if flags&sftpAttrUidGid != 0 {
+		if len(payload) < 8 {
+			return fi, fmt.Errorf("invalid sftp attributes")
+		}
+		payload = payload[8:]
+	}
+	if flags&sftpAttrPermissions != 0 {
+		if fi.permissions, _, ok = readUint32(payload); !ok {
+			return fi, fmt.Errorf("invalid sftp attributes")
+		}
+	}
+
+	return fi, nil
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func readUint32(b []byte) (uint32, []byte, bool) {
+	if len(b) < 4 {
+		return 0, b, false
+	}
+	return binary.BigEndian.Uint32(b), b[4:], true
+}
+
+func readString(b []byte) (string, []byte, bool) {
+	length, b, ok := readUint32(b)
+	if !ok || uint32(len(b)) < length {
+		return "", b, false
+	}
+	return string(b[:length]), b[length:], true
+}
+
+func openSFTP(ctx context.Context, target Target) (*sftpClient, func(), error) {
+	rw, err := target.Subsystem(ctx, "sftp")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// closing the subsystem aborts pending requests once the context is done
+	stop := context.AfterFunc(ctx, func() { rw.Close() })
+
+	client, err := newSFTPClient(rw)
+	if err != nil {
+		stop()
+		rw.Close()
+		return nil, nil, err
+	}
+
+	return client, func() {
+		stop()
+		client.Close()
+	}, nil
+}
+
+// checkFileExists succeeds if the path of the task exists on the vm.
+func checkFileExists(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	client, closeClient, err := openSFTP(ctx, target)
+	if err != nil {
+		return Result{}, err
+	}
+	defer closeClient()
+
+	fi, err := client.stat(task.Path)
+	if err == errFileNotFound {
+		return Result{Output: "not found"}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	if fi.isDir() {
+		return Result{Output: "directory", Success: true}, nil
+	}
+	return Result{Output: "file", Success: true}, nil
+}
+
+// checkFileContent compares the content of the file at the path of the task to the expected output value.
+func checkFileContent(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	client, closeClient, err := openSFTP(ctx, target)
+	if err != nil {
+		return Result{}, err
+	}
+	defer closeClient()
+
+	content, err := client.readFile(task.Path, maxFileContentSize)
+	if err == errFileNotFound {
+		return Result{Output: "not found"}, nil
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	output := strings.TrimRight(string(content), "\r\n")
+
+	return Result{
+		Output:  output,
+		Success: matchText(task, output),
+	}, nil
+}
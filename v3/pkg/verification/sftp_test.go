@@ -0,0 +1,118 @@
+package verification
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveSFTP answers the sftp requests of the file checkers from an in-memory file system.
+func serveSFTP(t *testing.T, conn io.ReadWriteCloser, files map[string]string) {
+	server := &sftpClient{rw: conn}
+	defer conn.Close()
+
+	status := func(id uint32, code uint32) []byte {
+		payload := binary.BigEndian.AppendUint32(nil, id)
+		payload = binary.BigEndian.AppendUint32(payload, code)
+		payload = appendString(payload, "")
+		return appendString(payload, "")
+	}
+
+	for {
+		packetType, payload, err := server.receive()
+		if err != nil {
+			return
+		}
+
+		if packetType == sftpPacketInit {
+			server.send(sftpPacketVersion, binary.BigEndian.AppendUint32(nil, sftpProtocolVersion))
+			continue
+		}
+
+		id, payload, _ := readUint32(payload)
+		switch packetType {
+		case sftpPacketStat:
+			path, _, _ := readString(payload)
+			content, ok := files[path]
+			if !ok {
+				server.send(sftpPacketStatus, status(id, sftpStatusNoSuchFile))
+				continue
+			}
+			attrs := binary.BigEndian.AppendUint32(nil, id)
+			attrs = binary.BigEndian.AppendUint32(attrs, sftpAttrSize|sftpAttrPermissions)
+			attrs = binary.BigEndian.AppendUint64(attrs, uint64(len(content)))
+			attrs = binary.BigEndian.AppendUint32(attrs, 0100644)
+			server.send(sftpPacketAttrs, attrs)
+		case sftpPacketOpen:
+			path, _, _ := readString(payload)
+			if _, ok := files[path]; !ok {
+				server.send(sftpPacketStatus, status(id, sftpStatusNoSuchFile))
+				continue
+			}
+			server.send(sftpPacketHandle, appendString(binary.BigEndian.AppendUint32(nil, id), path))
+		case sftpPacketRead:
+			path, payload, _ := readString(payload)
+			offset := binary.BigEndian.Uint64(payload)
+			content := files[path]
+			if offset >= uint64(len(content)) {
+				server.send(sftpPacketStatus, status(id, sftpStatusEOF))
+				continue
+			}
+			server.send(sftpPacketData, appendString(binary.BigEndian.AppendUint32(nil, id), content[offset:]))
+		case sftpPacketClose:
+			server.send(sftpPacketStatus, status(id, sftpStatusOK))
+		default:
+			t.Errorf("unexpected sftp packet %d", packetType)
+			return
+		}
+	}
+}
+
+type sftpTarget struct {
+	fakeTarget
+	t     *testing.T
+	files map[string]string
+}
+
+func (s *sftpTarget) Subsystem(ctx context.Context, name string) (io.ReadWriteCloser, error) {
+	client, server := net.Pipe()
+	go serveSFTP(s.t, server, s.files)
+	return client, nil
+}
+
+func TestFileCheckers(t *testing.T) {
+	target := &sftpTarget{t: t, files: map[string]string{
+		"/etc/motd": "welcome to hobbyfarm\n",
+	}}
+
+	result, err := checkFileExists(context.Background(), target, hfv1.Task{Path: "/etc/motd"})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+
+	result, err = checkFileExists(context.Background(), target, hfv1.Task{Path: "/etc/missing"})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+
+	result, err = checkFileContent(context.Background(), target, hfv1.Task{
+		Path:                "/etc/motd",
+		ReturnType:          ReturnTypeMatchRegex,
+		ExpectedOutputValue: "hobbyfarm$",
+	})
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Equal(t, "welcome to hobbyfarm", result.Output)
+
+	result, err = checkFileContent(context.Background(), target, hfv1.Task{
+		Path:                "/etc/motd",
+		ReturnType:          ReturnTypeText,
+		ExpectedOutputValue: "welcome",
+	})
+	require.NoError(t, err)
+	assert.False(t, result.Success)
+}
@@ -0,0 +1,163 @@
+package verification
+
+import (
+	"context"
+	"io"
+	"net"
+	"strings"
+	"sync"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"golang.org/x/crypto/ssh"
+)
+
+// Target is the virtual machine a task is verified on.
+type Target interface {
+	// Run executes the command and returns its combined output and exit code.
+	Run(ctx context.Context, command string) (string, int, error)
+	// Dial opens a connection from inside the virtual machine.
+	Dial(ctx context.Context, network string, addr string) (net.Conn, error)
+	// Subsystem starts a subsystem like sftp on the virtual machine.
+	Subsystem(ctx context.Context, name string) (io.ReadWriteCloser, error)
+}
+
+// Result is the outcome of verifying a single task.
+type Result struct {
+	Output     string
+	ReturnCode int
+	Success    bool
+	Score      int
+	MaxScore   int
+	Error      string
+}
+
+// Checker verifies one type of task.
+type Checker interface {
+	Check(ctx context.Context, target Target, task hfv1.Task) (Result, error)
+}
+
+type CheckerFunc func(ctx context.Context, target Target, task hfv1.Task) (Result, error)
+
+func (f CheckerFunc) Check(ctx context.Context, target Target, task hfv1.Task) (Result, error) {
+	return f(ctx, target, task)
+}
+
+// Registry maps task types to the checkers verifying them.
+type Registry struct {
+	lock     sync.RWMutex
+	checkers map[string]Checker
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		checkers: make(map[string]Checker),
+	}
+}
+
+func (r *Registry) Register(taskType string, checker Checker) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.checkers[taskType] = checker
+}
+
+// Lookup returns the checker of a task type. Tasks without type are command tasks.
+func (r *Registry) Lookup(taskType string) (Checker, bool) {
+	if taskType == "" {
+		taskType = hfv1.TaskTypeCommand
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	checker, ok := r.checkers[taskType]
+	return checker, ok
+}
+
+// DefaultRegistry contains the built-in checkers.
+var DefaultRegistry = NewRegistry()
+
+func init() {
+	DefaultRegistry.Register(hfv1.TaskTypeCommand, CheckerFunc(checkCommand))
+	DefaultRegistry.Register(hfv1.TaskTypeJSONPath, CheckerFunc(checkJSONPath))
+	DefaultRegistry.Register(hfv1.TaskTypeFileExists, CheckerFunc(checkFileExists))
+	DefaultRegistry.Register(hfv1.TaskTypeFileContent, CheckerFunc(checkFileContent))
+	DefaultRegistry.Register(hfv1.TaskTypeHTTP, CheckerFunc(checkHTTP))
+}
+
+// Register adds a checker to the DefaultRegistry.
+func Register(taskType string, checker Checker) {
+	DefaultRegistry.Register(taskType, checker)
+}
+
+// SSHTarget verifies tasks over an established ssh connection.
+type SSHTarget struct {
+	Client *ssh.Client
+}
+
+func (t SSHTarget) Run(ctx context.Context, command string) (string, int, error) {
+	sess, err := t.Client.NewSession()
+	if err != nil {
+		return "", 0, err
+	}
+	defer sess.Close()
+
+	// closing the session aborts the command once the context is done
+	stop := context.AfterFunc(ctx, func() { sess.Close() })
+	defer stop()
+
+	out, err := sess.CombinedOutput(command)
+	output := strings.TrimRight(string(out), "\r\n")
+	if err != nil {
+		if exitErr, ok := err.(*ssh.ExitError); ok {
+			return output, exitErr.ExitStatus(), nil
+		}
+		if ctx.Err() != nil {
+			return output, 0, ctx.Err()
+		}
+		return output, 0, err
+	}
+
+	return output, 0, nil
+}
+
+func (t SSHTarget) Dial(ctx context.Context, network string, addr string) (net.Conn, error) {
+	return t.Client.DialContext(ctx, network, addr)
+}
+
+func (t SSHTarget) Subsystem(ctx context.Context, name string) (io.ReadWriteCloser, error) {
+	sess, err := t.Client.NewSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stdin, err := sess.StdinPipe()
+	if err != nil {
+		sess.Close()
+		return nil, err
+	}
+
+	stdout, err := sess.StdoutPipe()
+	if err != nil {
+		sess.Close()
+		return nil, err
+	}
+
+	if err := sess.RequestSubsystem(name); err != nil {
+		sess.Close()
+		return nil, err
+	}
+
+	return &subsystem{Reader: stdout, WriteCloser: stdin, sess: sess}, nil
+}
+
+type subsystem struct {
+	io.Reader
+	io.WriteCloser
+	sess *ssh.Session
+}
+
+func (s *subsystem) Close() error {
+	s.WriteCloser.Close()
+	return s.sess.Close()
+}
@@ -28,6 +28,7 @@ const (
 	ProgressSvc_DeleteProgress_FullMethodName           = "/progress.ProgressSvc/DeleteProgress"
 	ProgressSvc_DeleteCollectionProgress_FullMethodName = "/progress.ProgressSvc/DeleteCollectionProgress"
 	ProgressSvc_ListProgress_FullMethodName             = "/progress.ProgressSvc/ListProgress"
+	ProgressSvc_RecordTaskVerifications_FullMethodName  = "/progress.ProgressSvc/RecordTaskVerifications"
 )
 
 // ProgressSvcClient is the client API for ProgressSvc service.
@@ -41,6 +42,7 @@ type ProgressSvcClient interface {
 	DeleteProgress(ctx context.Context, in *general.ResourceId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteCollectionProgress(ctx context.Context, in *general.ListOptions, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListProgress(ctx context.Context, in *general.ListOptions, opts ...grpc.CallOption) (*ListProgressesResponse, error)
+	RecordTaskVerifications(ctx context.Context, in *RecordTaskVerificationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type progressSvcClient struct {
@@ -121,6 +123,16 @@ func (c *progressSvcClient) ListProgress(ctx context.Context, in *general.ListOp
 	return out, nil
 }
 
+func (c *progressSvcClient) RecordTaskVerifications(ctx context.Context, in *RecordTaskVerificationsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProgressSvc_RecordTaskVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProgressSvcServer is the server API for ProgressSvc service.
 // All implementations must embed UnimplementedProgressSvcServer
 // for forward compatibility.
@@ -132,6 +144,7 @@ type ProgressSvcServer interface {
 	DeleteProgress(context.Context, *general.ResourceId) (*emptypb.Empty, error)
 	DeleteCollectionProgress(context.Context, *general.ListOptions) (*emptypb.Empty, error)
 	ListProgress(context.Context, *general.ListOptions) (*ListProgressesResponse, error)
+	RecordTaskVerifications(context.Context, *RecordTaskVerificationsRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedProgressSvcServer()
 }
 
@@ -163,6 +176,9 @@ func (UnimplementedProgressSvcServer) DeleteCollectionProgress(context.Context,
 func (UnimplementedProgressSvcServer) ListProgress(context.Context, *general.ListOptions) (*ListProgressesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProgress not implemented")
 }
+func (UnimplementedProgressSvcServer) RecordTaskVerifications(context.Context, *RecordTaskVerificationsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordTaskVerifications not implemented")
+}
 func (UnimplementedProgressSvcServer) mustEmbedUnimplementedProgressSvcServer() {}
 func (UnimplementedProgressSvcServer) testEmbeddedByValue()                     {}
 
@@ -310,6 +326,24 @@ func _ProgressSvc_ListProgress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProgressSvc_RecordTaskVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordTaskVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgressSvcServer).RecordTaskVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProgressSvc_RecordTaskVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgressSvcServer).RecordTaskVerifications(ctx, req.(*RecordTaskVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProgressSvc_ServiceDesc is the grpc.ServiceDesc for ProgressSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -345,6 +379,10 @@ var ProgressSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListProgress",
 			Handler:    _ProgressSvc_ListProgress_Handler,
 		},
+		{
+			MethodName: "RecordTaskVerifications",
+			Handler:    _ProgressSvc_RecordTaskVerifications_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "progress/progress.proto",
@@ -114,8 +114,11 @@ type QuizEvaluationAttempt struct {
 	Attempt           uint32                          `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
 	Score             uint32                          `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
 	Pass              bool                            `protobuf:"varint,5,opt,name=pass,proto3" json:"pass,omitempty"`
-	Corrects          map[string]*general.StringArray `protobuf:"bytes,6,rep,name=corrects,proto3" json:"corrects,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // question id and answer ids of correct answers
-	Selects           map[string]*general.StringArray `protobuf:"bytes,7,rep,name=selects,proto3" json:"selects,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`   // answer ids of the answers chosen by the user
+	Corrects          map[string]*general.StringArray `protobuf:"bytes,6,rep,name=corrects,proto3" json:"corrects,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`            // question id and answer ids of correct answers
+	Selects           map[string]*general.StringArray `protobuf:"bytes,7,rep,name=selects,proto3" json:"selects,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`              // answer ids of the answers chosen by the user
+	Results           map[string]bool                 `protobuf:"bytes,8,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`             // question id and whether it was answered correctly
+	Verifications     map[string]bool                 `protobuf:"bytes,9,rep,name=verifications,proto3" json:"verifications,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // question id of command questions and whether the task was verified
+	TimedOut          bool                            `protobuf:"varint,10,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -199,15 +202,37 @@ func (x *QuizEvaluationAttempt) GetSelects() map[string]*general.StringArray {
 	return nil
 }
 
+func (x *QuizEvaluationAttempt) GetResults() map[string]bool {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *QuizEvaluationAttempt) GetVerifications() map[string]bool {
+	if x != nil {
+		return x.Verifications
+	}
+	return nil
+}
+
+func (x *QuizEvaluationAttempt) GetTimedOut() bool {
+	if x != nil {
+		return x.TimedOut
+	}
+	return false
+}
+
 type CreateQuizEvaluationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Quiz          string                 `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`
-	QuizUid       string                 `protobuf:"bytes,2,opt,name=quiz_uid,json=quizUid,proto3" json:"quiz_uid,omitempty"`
-	User          string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
-	Scenario      string                 `protobuf:"bytes,4,opt,name=scenario,proto3" json:"scenario,omitempty"`
-	Attempt       *QuizEvaluationAttempt `protobuf:"bytes,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Quiz           string                 `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	QuizUid        string                 `protobuf:"bytes,2,opt,name=quiz_uid,json=quizUid,proto3" json:"quiz_uid,omitempty"`
+	User           string                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Scenario       string                 `protobuf:"bytes,4,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Attempt        *QuizEvaluationAttempt `protobuf:"bytes,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	ScheduledEvent string                 `protobuf:"bytes,7,opt,name=scheduled_event,json=scheduledEvent,proto3" json:"scheduled_event,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateQuizEvaluationRequest) Reset() {
@@ -275,6 +300,13 @@ func (x *CreateQuizEvaluationRequest) GetAttempt() *QuizEvaluationAttempt {
 	return nil
 }
 
+func (x *CreateQuizEvaluationRequest) GetScheduledEvent() string {
+	if x != nil {
+		return x.ScheduledEvent
+	}
+	return ""
+}
+
 type UpdateQuizEvaluationRequest struct {
 	state         protoimpl.MessageState   `protogen:"open.v1"`
 	Id            string                   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -411,6 +443,83 @@ func (x *GetQuizEvaluationForUserRequest) GetScenario() string {
 	return ""
 }
 
+// Records the verification of the task of a command question in the current attempt of the user
+type RecordQuestionVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quiz          string                 `protobuf:"bytes,1,opt,name=quiz,proto3" json:"quiz,omitempty"`
+	User          string                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Scenario      string                 `protobuf:"bytes,3,opt,name=scenario,proto3" json:"scenario,omitempty"`
+	Question      string                 `protobuf:"bytes,4,opt,name=question,proto3" json:"question,omitempty"`
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordQuestionVerificationRequest) Reset() {
+	*x = RecordQuestionVerificationRequest{}
+	mi := &file_quiz_quizevaluation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordQuestionVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordQuestionVerificationRequest) ProtoMessage() {}
+
+func (x *RecordQuestionVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_quiz_quizevaluation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordQuestionVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RecordQuestionVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_quiz_quizevaluation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecordQuestionVerificationRequest) GetQuiz() string {
+	if x != nil {
+		return x.Quiz
+	}
+	return ""
+}
+
+func (x *RecordQuestionVerificationRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RecordQuestionVerificationRequest) GetScenario() string {
+	if x != nil {
+		return x.Scenario
+	}
+	return ""
+}
+
+func (x *RecordQuestionVerificationRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+func (x *RecordQuestionVerificationRequest) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 type ListQuizEvaluationsResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	QuizEvaluations []*QuizEvaluation      `protobuf:"bytes,1,rep,name=quiz_evaluations,json=quizEvaluations,proto3" json:"quiz_evaluations,omitempty"`
@@ -420,7 +529,7 @@ type ListQuizEvaluationsResponse struct {
 
 func (x *ListQuizEvaluationsResponse) Reset() {
 	*x = ListQuizEvaluationsResponse{}
-	mi := &file_quiz_quizevaluation_proto_msgTypes[5]
+	mi := &file_quiz_quizevaluation_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -432,7 +541,7 @@ func (x *ListQuizEvaluationsResponse) String() string {
 func (*ListQuizEvaluationsResponse) ProtoMessage() {}
 
 func (x *ListQuizEvaluationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_quiz_quizevaluation_proto_msgTypes[5]
+	mi := &file_quiz_quizevaluation_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -445,7 +554,7 @@ func (x *ListQuizEvaluationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListQuizEvaluationsResponse.ProtoReflect.Descriptor instead.
 func (*ListQuizEvaluationsResponse) Descriptor() ([]byte, []int) {
-	return file_quiz_quizevaluation_proto_rawDescGZIP(), []int{5}
+	return file_quiz_quizevaluation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListQuizEvaluationsResponse) GetQuizEvaluations() []*QuizEvaluation {
@@ -474,7 +583,7 @@ var file_quiz_quizevaluation_proto_rawDesc = string([]byte{
 	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c,
 	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61,
-	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0xd8, 0x03, 0x0a, 0x15, 0x51, 0x75, 0x69, 0x7a,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x8d, 0x06, 0x0a, 0x15, 0x51, 0x75, 0x69, 0x7a,
 	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
 	0x74, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63,
@@ -493,87 +602,124 @@ var file_quiz_quizevaluation_proto_rawDesc = string([]byte{
 	0x65, 0x63, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x71, 0x75, 0x69,
 	0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x1a, 0x51, 0x0a,
-	0x0d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x50, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0xb3, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69,
-	0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x19, 0x0a, 0x08, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x75,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x55, 0x69,
-	0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69,
-	0x6f, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76,
-	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52,
-	0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x37, 0x0a, 0x08,
-	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x73, 0x12, 0x42, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
 	0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a,
-	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22, 0x5e, 0x0a, 0x1b,
-	0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x71,
-	0x75, 0x69, 0x7a, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69,
-	0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x71, 0x75, 0x69,
-	0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xe3, 0x03, 0x0a,
-	0x11, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x76, 0x63, 0x12, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a,
-	0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x71, 0x75, 0x69,
-	0x7a, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c,
-	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71,
-	0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25,
-	0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61,
-	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69,
-	0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x54, 0x0a, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x64, 0x4f, 0x75, 0x74, 0x1a, 0x51, 0x0a, 0x0d, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x50, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdc, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x19, 0x0a, 0x08, 0x71,
+	0x75, 0x69, 0x7a, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71,
+	0x75, 0x69, 0x7a, 0x55, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63,
+	0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51,
+	0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43,
-	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c,
-	0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
-	0x21, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45,
-	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x68, 0x6f, 0x62, 0x62, 0x79, 0x66, 0x61, 0x72, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x67, 0x61,
-	0x6e, 0x74, 0x75, 0x61, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x71,
-	0x75, 0x69, 0x7a, 0x3b, 0x71, 0x75, 0x69, 0x7a, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x12, 0x37, 0x0a, 0x08, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x71,
+	0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x63, 0x65, 0x6e, 0x61, 0x72, 0x69, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x21, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x71, 0x75, 0x69, 0x7a, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x63, 0x65, 0x6e,
+	0x61, 0x72, 0x69, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x5e, 0x0a, 0x1b, 0x4c, 0x69,
+	0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x10, 0x71, 0x75, 0x69,
+	0x7a, 0x5f, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x71, 0x75, 0x69, 0x7a, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xc2, 0x04, 0x0a, 0x11, 0x51,
+	0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x76, 0x63,
+	0x12, 0x4e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64,
+	0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x75, 0x69,
+	0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x57, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x71,
+	0x75, 0x69, 0x7a, 0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x45,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x51,
+	0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x64, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x4d, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x2e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x21, 0x2e,
+	0x71, 0x75, 0x69, 0x7a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x5d, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x2e, 0x71, 0x75, 0x69, 0x7a, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
+	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6f,
+	0x62, 0x62, 0x79, 0x66, 0x61, 0x72, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x67, 0x61, 0x6e, 0x74, 0x75,
+	0x61, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x71, 0x75, 0x69, 0x7a,
+	0x3b, 0x71, 0x75, 0x69, 0x7a, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -588,48 +734,55 @@ func file_quiz_quizevaluation_proto_rawDescGZIP() []byte {
 	return file_quiz_quizevaluation_proto_rawDescData
 }
 
-var file_quiz_quizevaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_quiz_quizevaluation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_quiz_quizevaluation_proto_goTypes = []any{
-	(*QuizEvaluation)(nil),                  // 0: quiz.QuizEvaluation
-	(*QuizEvaluationAttempt)(nil),           // 1: quiz.QuizEvaluationAttempt
-	(*CreateQuizEvaluationRequest)(nil),     // 2: quiz.CreateQuizEvaluationRequest
-	(*UpdateQuizEvaluationRequest)(nil),     // 3: quiz.UpdateQuizEvaluationRequest
-	(*GetQuizEvaluationForUserRequest)(nil), // 4: quiz.GetQuizEvaluationForUserRequest
-	(*ListQuizEvaluationsResponse)(nil),     // 5: quiz.ListQuizEvaluationsResponse
-	nil,                                     // 6: quiz.QuizEvaluationAttempt.CorrectsEntry
-	nil,                                     // 7: quiz.QuizEvaluationAttempt.SelectsEntry
-	(*general.StringArray)(nil),             // 8: general.StringArray
-	(*general.GetRequest)(nil),              // 9: general.GetRequest
-	(*general.ResourceId)(nil),              // 10: general.ResourceId
-	(*general.ListOptions)(nil),             // 11: general.ListOptions
-	(*emptypb.Empty)(nil),                   // 12: google.protobuf.Empty
+	(*QuizEvaluation)(nil),                    // 0: quiz.QuizEvaluation
+	(*QuizEvaluationAttempt)(nil),             // 1: quiz.QuizEvaluationAttempt
+	(*CreateQuizEvaluationRequest)(nil),       // 2: quiz.CreateQuizEvaluationRequest
+	(*UpdateQuizEvaluationRequest)(nil),       // 3: quiz.UpdateQuizEvaluationRequest
+	(*GetQuizEvaluationForUserRequest)(nil),   // 4: quiz.GetQuizEvaluationForUserRequest
+	(*RecordQuestionVerificationRequest)(nil), // 5: quiz.RecordQuestionVerificationRequest
+	(*ListQuizEvaluationsResponse)(nil),       // 6: quiz.ListQuizEvaluationsResponse
+	nil,                                       // 7: quiz.QuizEvaluationAttempt.CorrectsEntry
+	nil,                                       // 8: quiz.QuizEvaluationAttempt.SelectsEntry
+	nil,                                       // 9: quiz.QuizEvaluationAttempt.ResultsEntry
+	nil,                                       // 10: quiz.QuizEvaluationAttempt.VerificationsEntry
+	(*general.StringArray)(nil),               // 11: general.StringArray
+	(*general.GetRequest)(nil),                // 12: general.GetRequest
+	(*general.ResourceId)(nil),                // 13: general.ResourceId
+	(*general.ListOptions)(nil),               // 14: general.ListOptions
+	(*emptypb.Empty)(nil),                     // 15: google.protobuf.Empty
 }
 var file_quiz_quizevaluation_proto_depIdxs = []int32{
 	1,  // 0: quiz.QuizEvaluation.attempts:type_name -> quiz.QuizEvaluationAttempt
-	6,  // 1: quiz.QuizEvaluationAttempt.corrects:type_name -> quiz.QuizEvaluationAttempt.CorrectsEntry
-	7,  // 2: quiz.QuizEvaluationAttempt.selects:type_name -> quiz.QuizEvaluationAttempt.SelectsEntry
-	1,  // 3: quiz.CreateQuizEvaluationRequest.attempt:type_name -> quiz.QuizEvaluationAttempt
-	1,  // 4: quiz.UpdateQuizEvaluationRequest.attempts:type_name -> quiz.QuizEvaluationAttempt
-	0,  // 5: quiz.ListQuizEvaluationsResponse.quiz_evaluations:type_name -> quiz.QuizEvaluation
-	8,  // 6: quiz.QuizEvaluationAttempt.CorrectsEntry.value:type_name -> general.StringArray
-	8,  // 7: quiz.QuizEvaluationAttempt.SelectsEntry.value:type_name -> general.StringArray
-	2,  // 8: quiz.QuizEvaluationSvc.CreateQuizEvaluation:input_type -> quiz.CreateQuizEvaluationRequest
-	9,  // 9: quiz.QuizEvaluationSvc.GetQuizEvaluation:input_type -> general.GetRequest
-	4,  // 10: quiz.QuizEvaluationSvc.GetQuizEvaluationForUser:input_type -> quiz.GetQuizEvaluationForUserRequest
-	3,  // 11: quiz.QuizEvaluationSvc.UpdateQuizEvaluation:input_type -> quiz.UpdateQuizEvaluationRequest
-	10, // 12: quiz.QuizEvaluationSvc.DeleteQuizEvaluation:input_type -> general.ResourceId
-	11, // 13: quiz.QuizEvaluationSvc.ListQuizEvaluation:input_type -> general.ListOptions
-	10, // 14: quiz.QuizEvaluationSvc.CreateQuizEvaluation:output_type -> general.ResourceId
-	0,  // 15: quiz.QuizEvaluationSvc.GetQuizEvaluation:output_type -> quiz.QuizEvaluation
-	0,  // 16: quiz.QuizEvaluationSvc.GetQuizEvaluationForUser:output_type -> quiz.QuizEvaluation
-	12, // 17: quiz.QuizEvaluationSvc.UpdateQuizEvaluation:output_type -> google.protobuf.Empty
-	12, // 18: quiz.QuizEvaluationSvc.DeleteQuizEvaluation:output_type -> google.protobuf.Empty
-	5,  // 19: quiz.QuizEvaluationSvc.ListQuizEvaluation:output_type -> quiz.ListQuizEvaluationsResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	7,  // 1: quiz.QuizEvaluationAttempt.corrects:type_name -> quiz.QuizEvaluationAttempt.CorrectsEntry
+	8,  // 2: quiz.QuizEvaluationAttempt.selects:type_name -> quiz.QuizEvaluationAttempt.SelectsEntry
+	9,  // 3: quiz.QuizEvaluationAttempt.results:type_name -> quiz.QuizEvaluationAttempt.ResultsEntry
+	10, // 4: quiz.QuizEvaluationAttempt.verifications:type_name -> quiz.QuizEvaluationAttempt.VerificationsEntry
+	1,  // 5: quiz.CreateQuizEvaluationRequest.attempt:type_name -> quiz.QuizEvaluationAttempt
+	1,  // 6: quiz.UpdateQuizEvaluationRequest.attempts:type_name -> quiz.QuizEvaluationAttempt
+	0,  // 7: quiz.ListQuizEvaluationsResponse.quiz_evaluations:type_name -> quiz.QuizEvaluation
+	11, // 8: quiz.QuizEvaluationAttempt.CorrectsEntry.value:type_name -> general.StringArray
+	11, // 9: quiz.QuizEvaluationAttempt.SelectsEntry.value:type_name -> general.StringArray
+	2,  // 10: quiz.QuizEvaluationSvc.CreateQuizEvaluation:input_type -> quiz.CreateQuizEvaluationRequest
+	12, // 11: quiz.QuizEvaluationSvc.GetQuizEvaluation:input_type -> general.GetRequest
+	4,  // 12: quiz.QuizEvaluationSvc.GetQuizEvaluationForUser:input_type -> quiz.GetQuizEvaluationForUserRequest
+	3,  // 13: quiz.QuizEvaluationSvc.UpdateQuizEvaluation:input_type -> quiz.UpdateQuizEvaluationRequest
+	13, // 14: quiz.QuizEvaluationSvc.DeleteQuizEvaluation:input_type -> general.ResourceId
+	14, // 15: quiz.QuizEvaluationSvc.ListQuizEvaluation:input_type -> general.ListOptions
+	5,  // 16: quiz.QuizEvaluationSvc.RecordQuestionVerification:input_type -> quiz.RecordQuestionVerificationRequest
+	13, // 17: quiz.QuizEvaluationSvc.CreateQuizEvaluation:output_type -> general.ResourceId
+	0,  // 18: quiz.QuizEvaluationSvc.GetQuizEvaluation:output_type -> quiz.QuizEvaluation
+	0,  // 19: quiz.QuizEvaluationSvc.GetQuizEvaluationForUser:output_type -> quiz.QuizEvaluation
+	15, // 20: quiz.QuizEvaluationSvc.UpdateQuizEvaluation:output_type -> google.protobuf.Empty
+	15, // 21: quiz.QuizEvaluationSvc.DeleteQuizEvaluation:output_type -> google.protobuf.Empty
+	6,  // 22: quiz.QuizEvaluationSvc.ListQuizEvaluation:output_type -> quiz.ListQuizEvaluationsResponse
+	15, // 23: quiz.QuizEvaluationSvc.RecordQuestionVerification:output_type -> google.protobuf.Empty
+	17, // [17:24] is the sub-list for method output_type
+	10, // [10:17] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_quiz_quizevaluation_proto_init() }
@@ -643,7 +796,7 @@ func file_quiz_quizevaluation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quiz_quizevaluation_proto_rawDesc), len(file_quiz_quizevaluation_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,12 +21,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	QuizEvaluationSvc_CreateQuizEvaluation_FullMethodName     = "/quiz.QuizEvaluationSvc/CreateQuizEvaluation"
-	QuizEvaluationSvc_GetQuizEvaluation_FullMethodName        = "/quiz.QuizEvaluationSvc/GetQuizEvaluation"
-	QuizEvaluationSvc_GetQuizEvaluationForUser_FullMethodName = "/quiz.QuizEvaluationSvc/GetQuizEvaluationForUser"
-	QuizEvaluationSvc_UpdateQuizEvaluation_FullMethodName     = "/quiz.QuizEvaluationSvc/UpdateQuizEvaluation"
-	QuizEvaluationSvc_DeleteQuizEvaluation_FullMethodName     = "/quiz.QuizEvaluationSvc/DeleteQuizEvaluation"
-	QuizEvaluationSvc_ListQuizEvaluation_FullMethodName       = "/quiz.QuizEvaluationSvc/ListQuizEvaluation"
+	QuizEvaluationSvc_CreateQuizEvaluation_FullMethodName       = "/quiz.QuizEvaluationSvc/CreateQuizEvaluation"
+	QuizEvaluationSvc_GetQuizEvaluation_FullMethodName          = "/quiz.QuizEvaluationSvc/GetQuizEvaluation"
+	QuizEvaluationSvc_GetQuizEvaluationForUser_FullMethodName   = "/quiz.QuizEvaluationSvc/GetQuizEvaluationForUser"
+	QuizEvaluationSvc_UpdateQuizEvaluation_FullMethodName       = "/quiz.QuizEvaluationSvc/UpdateQuizEvaluation"
+	QuizEvaluationSvc_DeleteQuizEvaluation_FullMethodName       = "/quiz.QuizEvaluationSvc/DeleteQuizEvaluation"
+	QuizEvaluationSvc_ListQuizEvaluation_FullMethodName         = "/quiz.QuizEvaluationSvc/ListQuizEvaluation"
+	QuizEvaluationSvc_RecordQuestionVerification_FullMethodName = "/quiz.QuizEvaluationSvc/RecordQuestionVerification"
 )
 
 // QuizEvaluationSvcClient is the client API for QuizEvaluationSvc service.
@@ -39,6 +40,7 @@ type QuizEvaluationSvcClient interface {
 	UpdateQuizEvaluation(ctx context.Context, in *UpdateQuizEvaluationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteQuizEvaluation(ctx context.Context, in *general.ResourceId, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListQuizEvaluation(ctx context.Context, in *general.ListOptions, opts ...grpc.CallOption) (*ListQuizEvaluationsResponse, error)
+	RecordQuestionVerification(ctx context.Context, in *RecordQuestionVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type quizEvaluationSvcClient struct {
@@ -109,6 +111,16 @@ func (c *quizEvaluationSvcClient) ListQuizEvaluation(ctx context.Context, in *ge
 	return out, nil
 }
 
+func (c *quizEvaluationSvcClient) RecordQuestionVerification(ctx context.Context, in *RecordQuestionVerificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, QuizEvaluationSvc_RecordQuestionVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QuizEvaluationSvcServer is the server API for QuizEvaluationSvc service.
 // All implementations must embed UnimplementedQuizEvaluationSvcServer
 // for forward compatibility.
@@ -119,6 +131,7 @@ type QuizEvaluationSvcServer interface {
 	UpdateQuizEvaluation(context.Context, *UpdateQuizEvaluationRequest) (*emptypb.Empty, error)
 	DeleteQuizEvaluation(context.Context, *general.ResourceId) (*emptypb.Empty, error)
 	ListQuizEvaluation(context.Context, *general.ListOptions) (*ListQuizEvaluationsResponse, error)
+	RecordQuestionVerification(context.Context, *RecordQuestionVerificationRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedQuizEvaluationSvcServer()
 }
 
@@ -147,6 +160,9 @@ func (UnimplementedQuizEvaluationSvcServer) DeleteQuizEvaluation(context.Context
 func (UnimplementedQuizEvaluationSvcServer) ListQuizEvaluation(context.Context, *general.ListOptions) (*ListQuizEvaluationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListQuizEvaluation not implemented")
 }
+func (UnimplementedQuizEvaluationSvcServer) RecordQuestionVerification(context.Context, *RecordQuestionVerificationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordQuestionVerification not implemented")
+}
 func (UnimplementedQuizEvaluationSvcServer) mustEmbedUnimplementedQuizEvaluationSvcServer() {}
 func (UnimplementedQuizEvaluationSvcServer) testEmbeddedByValue()                           {}
 
@@ -276,6 +292,24 @@ func _QuizEvaluationSvc_ListQuizEvaluation_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QuizEvaluationSvc_RecordQuestionVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordQuestionVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuizEvaluationSvcServer).RecordQuestionVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QuizEvaluationSvc_RecordQuestionVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuizEvaluationSvcServer).RecordQuestionVerification(ctx, req.(*RecordQuestionVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // QuizEvaluationSvc_ServiceDesc is the grpc.ServiceDesc for QuizEvaluationSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -307,6 +341,10 @@ var QuizEvaluationSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListQuizEvaluation",
 			Handler:    _QuizEvaluationSvc_ListQuizEvaluation_Handler,
 		},
+		{
+			MethodName: "RecordQuestionVerification",
+			Handler:    _QuizEvaluationSvc_RecordQuestionVerification_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "quiz/quizevaluation.proto",
@@ -14,6 +14,7 @@ import (
 	"github.com/gorilla/mux"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
 	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	settingUtil "github.com/hobbyfarm/gargantua/v3/pkg/setting"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
@@ -371,6 +372,11 @@ func (a AuthServer) ChangePassword(user *userpb.User, oldPassword string, newPas
 		return err
 	}
 
+	// tokens issued with the old password must not outlive it
+	if err := a.internalAuthnServer.revocations.Revoke(ctx, user.GetId()); err != nil {
+		return fmt.Errorf("error revoking tokens of user %s: %v", user.GetId(), err)
+	}
+
 	return nil
 }
 
@@ -554,19 +560,17 @@ func (a AuthServer) GenerateJWT(user *userpb.User) (string, error) {
 		tokenExpiration = time.Duration(s.Int64Value)
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	now := time.Now()
+
+	// the token identifies the user by id, and is signed by the active key of the signing key set. neither
+	// the email nor the password of the user affect whether the token is valid.
+	return a.internalAuthnServer.signingKeys.Sign(jwt.MapClaims{
+		"sub":   user.GetId(),
 		"email": user.GetEmail(),
-		"nbf":   time.Now().Unix(),                                  // not valid before now
-		"exp":   time.Now().Add(time.Hour * tokenExpiration).Unix(), // expire after [tokenExpiration] hours
+		"iat":   now.Unix(),
+		"nbf":   now.Unix(),                                  // not valid before now
+		"exp":   now.Add(time.Hour * tokenExpiration).Unix(), // expire after [tokenExpiration] hours
 	})
-
-	// Sign and get the complete encoded token as a string using the secret
-	tokenString, err := token.SignedString([]byte(user.GetPassword()))
-	if err != nil {
-		return "", err
-	}
-
-	return tokenString, nil
 }
 
 func (a *AuthServer) GetAccessSet(w http.ResponseWriter, r *http.Request) {
@@ -611,9 +615,49 @@ func (a AuthServer) DeleteUser(w http.ResponseWriter, r *http.Request) {
 
 	if err != nil {
 		util.ReturnHTTPMessage(w, r, http.StatusInternalServerError, "error", "Error during account deletion")
+		return
+	}
+
+	if err := a.internalAuthnServer.revocations.Revoke(r.Context(), user.GetId()); err != nil {
+		glog.Errorf("error revoking tokens of deleted user %s: %v", user.GetId(), err)
 	}
 }
 
+// RevokeTokensFunc revokes every token issued to a user so far. Users may revoke their own tokens,
+// revoking the tokens of another user requires permission to update users.
+func (a AuthServer) RevokeTokensFunc(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get("Authorization")
+	user, err := a.internalAuthnServer.AuthN(r.Context(), &authnpb.AuthNRequest{
+		Token: token,
+	})
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, http.StatusUnauthorized, "unauthorized", "unauthorized")
+		return
+	}
+
+	userId := mux.Vars(r)["user_id"]
+	if len(userId) == 0 {
+		userId = user.GetId()
+	}
+
+	if userId != user.GetId() {
+		authrResponse, err := rbac.AuthorizeSimple(r, a.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralUser, rbac.VerbUpdate))
+		if err != nil || !authrResponse.Success {
+			util.ReturnHTTPMessage(w, r, http.StatusForbidden, "forbidden", "no access to revoke tokens")
+			return
+		}
+	}
+
+	if err := a.internalAuthnServer.revocations.Revoke(r.Context(), userId); err != nil {
+		glog.Errorf("error revoking tokens of user %s: %v", userId, err)
+		util.ReturnHTTPMessage(w, r, http.StatusInternalServerError, "error", "error revoking tokens")
+		return
+	}
+
+	glog.V(2).Infof("user %s revoked tokens of user %s", user.GetId(), userId)
+	util.ReturnHTTPMessage(w, r, http.StatusOK, "success", "tokens revoked")
+}
+
 func (a AuthServer) ListScheduledEventsFunc(w http.ResponseWriter, r *http.Request) {
 	token := r.Header.Get("Authorization")
 	user, err := a.internalAuthnServer.AuthN(r.Context(), &authnpb.AuthNRequest{
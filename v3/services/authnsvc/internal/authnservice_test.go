@@ -0,0 +1,91 @@
+package authnservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/hobbyfarm/gargantua/v3/pkg/revocation"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// fakeUserClient serves a single user, calls of other methods panic.
+type fakeUserClient struct {
+	userpb.UserSvcClient
+	user *userpb.User
+}
+
+func (c *fakeUserClient) GetUserById(_ context.Context, _ *generalpb.GetRequest, _ ...grpc.CallOption) (*userpb.User, error) {
+	return c.user, nil
+}
+
+func (c *fakeUserClient) UpdateUser(_ context.Context, user *userpb.User, _ ...grpc.CallOption) (*userpb.User, error) {
+	c.user.Password = user.GetPassword()
+	return c.user, nil
+}
+
+func TestChangePasswordRevokesTokens(t *testing.T) {
+	ctx := context.Background()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("old-password"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	userClient := &fakeUserClient{user: &userpb.User{Id: "u-test", Password: string(hash)}}
+
+	keys, _, err := maintainSigningKeys(nil, time.Now(), time.Hour, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signingKeys := &SigningKeySet{}
+	signingKeys.setKeys(keys)
+
+	kubeClient := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(kubeClient, 0)
+	revocations := revocation.NewTokenRevocations(kubeClient, informerFactory, "hobbyfarm")
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	grpcServer := NewGrpcAuthNServer(userClient, signingKeys, revocations)
+	authServer := AuthServer{userClient: userClient, internalAuthnServer: grpcServer}
+
+	issuedAt := time.Now().Add(-time.Minute)
+	token, err := signingKeys.Sign(jwt.MapClaims{
+		"sub": "u-test",
+		"iat": issuedAt.Unix(),
+		"nbf": issuedAt.Unix(),
+		"exp": issuedAt.Add(time.Hour).Unix(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := grpcServer.validate(ctx, token); err != nil {
+		t.Fatalf("expected token to be valid before the password change, got %v", err)
+	}
+
+	user := &userpb.User{Id: "u-test", Password: string(hash)}
+	if err := authServer.ChangePassword(user, "old-password", "new-password", ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// the revocation reaches the validation through the informer
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		if _, err := grpcServer.validate(ctx, token); err != nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("expected token issued before the password change to be revoked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -4,22 +4,31 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/glog"
 	"github.com/hobbyfarm/gargantua/v3/pkg/errors"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	"github.com/hobbyfarm/gargantua/v3/pkg/revocation"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
 	"google.golang.org/grpc/codes"
 )
 
 type GrpcAuthnServer struct {
 	authnpb.UnimplementedAuthNServer
-	userClient userpb.UserSvcClient
+	userClient  userpb.UserSvcClient
+	signingKeys *SigningKeySet
+	revocations *revocation.TokenRevocations
 }
 
-func NewGrpcAuthNServer(userClient userpb.UserSvcClient) *GrpcAuthnServer {
-	return &GrpcAuthnServer{userClient: userClient}
+func NewGrpcAuthNServer(userClient userpb.UserSvcClient, signingKeys *SigningKeySet, revocations *revocation.TokenRevocations) *GrpcAuthnServer {
+	return &GrpcAuthnServer{
+		userClient:  userClient,
+		signingKeys: signingKeys,
+		revocations: revocations,
+	}
 }
 
 func (a *GrpcAuthnServer) AuthN(c context.Context, ar *authnpb.AuthNRequest) (*userpb.User, error) {
@@ -66,38 +75,40 @@ func (a *GrpcAuthnServer) validateToken(ctx context.Context, token string) (*use
 	return user, nil
 }
 
+// validate verifies the signature of the token against the signing key named by its kid header, and returns
+// the user identified by the sub claim. Tokens issued before a revocation of the user's tokens are rejected.
 func (a *GrpcAuthnServer) validate(ctx context.Context, tokenString string) (*userpb.User, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Don't forget to validate the alg is what you expect:
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		var user *userpb.User
-		if claims, ok := token.Claims.(jwt.MapClaims); ok {
-			var err error
-			user, err = a.userClient.GetUserByEmail(ctx, &userpb.GetUserByEmailRequest{Email: fmt.Sprint(claims["email"])})
-			if err != nil {
-				glog.Errorf("could not find user that matched token %s", fmt.Sprint(claims["email"]))
-				return &userpb.User{}, fmt.Errorf("could not find user that matched token %s", fmt.Sprint(claims["email"]))
-			}
-		}
-		// hmacSampleSecret is a []byte containing your secret, e.g. []byte("my_secret_key")
-		return []byte(user.Password), nil
-	})
-
+	token, err := jwt.Parse(tokenString, a.signingKeys.Keyfunc)
 	if err != nil {
 		glog.Errorf("error while validating user: %v", err)
 		return &userpb.User{}, err
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		user, err := a.userClient.GetUserByEmail(ctx, &userpb.GetUserByEmailRequest{Email: fmt.Sprint(claims["email"])})
-		if err != nil {
-			return &userpb.User{}, err
-		} else {
-			return user, nil
-		}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		glog.Errorf("error while validating user")
+		return &userpb.User{}, fmt.Errorf("error while validating user")
+	}
+
+	userId, ok := claims["sub"].(string)
+	if !ok || userId == "" {
+		return &userpb.User{}, fmt.Errorf("token has no subject")
 	}
-	glog.Errorf("error while validating user")
-	return &userpb.User{}, fmt.Errorf("error while validating user")
+
+	issuedAt, ok := claims["iat"].(float64)
+	if !ok {
+		return &userpb.User{}, fmt.Errorf("token has no issued at time")
+	}
+
+	if a.revocations.IsRevoked(userId, time.Unix(int64(issuedAt), 0)) {
+		return &userpb.User{}, fmt.Errorf("token for user %s has been revoked", userId)
+	}
+
+	user, err := a.userClient.GetUserById(ctx, &generalpb.GetRequest{Id: userId})
+	if err != nil {
+		glog.Errorf("could not find user that matched token %s", userId)
+		return &userpb.User{}, fmt.Errorf("could not find user that matched token %s", userId)
+	}
+
+	return user, nil
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	accesscodepb "github.com/hobbyfarm/gargantua/v3/protos/accesscode"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	rbacpb "github.com/hobbyfarm/gargantua/v3/protos/rbac"
 	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
 	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
@@ -12,6 +13,7 @@ import (
 
 type AuthServer struct {
 	acClient             accesscodepb.AccessCodeSvcClient
+	authrClient          authrpb.AuthRClient
 	rbacClient           rbacpb.RbacSvcClient
 	scheduledEventClient scheduledeventpb.ScheduledEventSvcClient
 	settingClient        settingpb.SettingSvcClient
@@ -21,6 +23,7 @@ type AuthServer struct {
 
 func NewAuthServer(
 	accesscodeClient accesscodepb.AccessCodeSvcClient,
+	authrClient authrpb.AuthRClient,
 	rbacClient rbacpb.RbacSvcClient,
 	scheduledEventClient scheduledeventpb.ScheduledEventSvcClient,
 	settingClient settingpb.SettingSvcClient,
@@ -29,6 +32,7 @@ func NewAuthServer(
 ) (AuthServer, error) {
 	a := AuthServer{}
 	a.acClient = accesscodeClient
+	a.authrClient = authrClient
 	a.rbacClient = rbacClient
 	a.scheduledEventClient = scheduledEventClient
 	a.settingClient = settingClient
@@ -50,5 +54,8 @@ func (a AuthServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/auth/access", a.GetAccessSet).Methods("GET")
 	r.HandleFunc("/auth/delete", a.DeleteUser).Methods("GET")
 	r.HandleFunc("/auth/scheduledevents", a.ListScheduledEventsFunc).Methods("GET")
+	r.HandleFunc("/auth/revoke", a.RevokeTokensFunc).Methods("POST")
+	r.HandleFunc("/auth/revoke/{user_id}", a.RevokeTokensFunc).Methods("POST")
+	r.HandleFunc("/auth/.well-known/jwks.json", a.internalAuthnServer.signingKeys.JWKSFunc).Methods("GET")
 	glog.V(2).Infof("set up route")
 }
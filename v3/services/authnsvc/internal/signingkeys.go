@@ -0,0 +1,349 @@
+package authnservice
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/glog"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	SigningKeySecretName = "hobbyfarm-token-signing-keys"
+
+	signingKeySecretKey     = "keys.json"
+	signingKeyBits          = 2048
+	signingKeyCheckInterval = time.Minute
+)
+
+// signingKey is a single RSA key used to sign tokens, as stored in the signing key Secret.
+type signingKey struct {
+	ID      string    `json:"kid"`
+	Created time.Time `json:"created"`
+	// Retired is the time at which this key was replaced by a newer key. Retired keys
+	// no longer sign tokens but still verify them until the grace period has passed.
+	Retired    time.Time `json:"retired,omitempty"`
+	PrivateKey string    `json:"privateKey"`
+
+	key *rsa.PrivateKey
+}
+
+// SigningKeySet holds the keys used to sign and verify user tokens. Keys are stored in a Kubernetes Secret
+// so that they are shared between replicas. A new key is generated every rotationInterval. The previous key
+// is kept for gracePeriod so that tokens signed with it remain valid until they expire.
+type SigningKeySet struct {
+	kubeClient       kubernetes.Interface
+	namespace        string
+	rotationInterval time.Duration
+	gracePeriod      time.Duration
+
+	lock sync.RWMutex
+	keys []*signingKey // ordered oldest to newest, the newest key is the active signing key
+}
+
+// jsonWebKey is the public part of a signing key as published in the JWKS. See RFC 7517.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+func NewSigningKeySet(
+	kubeClient kubernetes.Interface,
+	informerFactory informers.SharedInformerFactory,
+	namespace string,
+	rotationInterval time.Duration,
+	gracePeriod time.Duration,
+) (*SigningKeySet, error) {
+	ks := &SigningKeySet{
+		kubeClient:       kubeClient,
+		namespace:        namespace,
+		rotationInterval: rotationInterval,
+		gracePeriod:      gracePeriod,
+	}
+
+	// keys rotated by other replicas are picked up through the informer
+	_, err := informerFactory.Core().V1().Secrets().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			secret, ok := obj.(*corev1.Secret)
+			return ok && secret.Name == SigningKeySecretName
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				ks.load(obj.(*corev1.Secret))
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				ks.load(obj.(*corev1.Secret))
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ks, nil
+}
+
+// Run rotates and prunes keys until ctx is cancelled.
+func (ks *SigningKeySet) Run(ctx context.Context) {
+	ticker := time.NewTicker(signingKeyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := ks.Rotate(ctx); err != nil {
+				glog.Errorf("error rotating token signing keys: %s", err.Error())
+			}
+		}
+	}
+}
+
+// Rotate creates the signing key Secret if it does not exist, generates a new key if the active key is older
+// than the rotation interval, and removes retired keys whose grace period has passed.
+func (ks *SigningKeySet) Rotate(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := ks.kubeClient.CoreV1().Secrets(ks.namespace).Get(ctx, SigningKeySecretName, metav1.GetOptions{})
+		create := errors.IsNotFound(err)
+		if err != nil && !create {
+			return err
+		}
+
+		if create {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      SigningKeySecretName,
+					Namespace: ks.namespace,
+				},
+			}
+		}
+
+		keys, err := decodeSigningKeys(secret.Data[signingKeySecretKey])
+		if err != nil {
+			return err
+		}
+
+		keys, changed, err := maintainSigningKeys(keys, time.Now(), ks.rotationInterval, ks.gracePeriod)
+		if err != nil {
+			return err
+		}
+
+		if changed {
+			data, err := json.Marshal(keys)
+			if err != nil {
+				return err
+			}
+
+			secret.Data = map[string][]byte{signingKeySecretKey: data}
+
+			if create {
+				_, err = ks.kubeClient.CoreV1().Secrets(ks.namespace).Create(ctx, secret, metav1.CreateOptions{})
+				if errors.IsAlreadyExists(err) {
+					// created concurrently by another replica, retry to use its keys
+					return errors.NewConflict(corev1.Resource("secrets"), SigningKeySecretName, err)
+				}
+			} else {
+				_, err = ks.kubeClient.CoreV1().Secrets(ks.namespace).Update(ctx, secret, metav1.UpdateOptions{})
+			}
+
+			if err != nil {
+				return err
+			}
+
+			glog.V(2).Infof("token signing keys updated, active key is %s", keys[len(keys)-1].ID)
+		}
+
+		ks.setKeys(keys)
+
+		return nil
+	})
+}
+
+// Sign signs the claims with the active key, setting the kid header of the token.
+func (ks *SigningKeySet) Sign(claims jwt.MapClaims) (string, error) {
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	if len(ks.keys) == 0 {
+		return "", fmt.Errorf("no token signing key available")
+	}
+
+	active := ks.keys[len(ks.keys)-1]
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = active.ID
+
+	return token.SignedString(active.key)
+}
+
+// Keyfunc returns the public key matching the kid header of the token, for use with jwt.Parse.
+func (ks *SigningKeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, ok := token.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, fmt.Errorf("token has no kid header")
+	}
+
+	ks.lock.RLock()
+	defer ks.lock.RUnlock()
+
+	for _, k := range ks.keys {
+		if k.ID == kid {
+			return &k.key.PublicKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown signing key %s", kid)
+}
+
+// JWKSFunc publishes the public keys that are valid for verifying tokens as a JSON Web Key Set.
+func (ks *SigningKeySet) JWKSFunc(w http.ResponseWriter, r *http.Request) {
+	ks.lock.RLock()
+	var set = jsonWebKeySet{Keys: make([]jsonWebKey, 0, len(ks.keys))}
+	for _, k := range ks.keys {
+		set.Keys = append(set.Keys, jsonWebKey{
+			Kty: "RSA",
+			Use: "sig",
+			Alg: jwt.SigningMethodRS256.Alg(),
+			Kid: k.ID,
+			N:   base64.RawURLEncoding.EncodeToString(k.key.PublicKey.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.key.PublicKey.E)).Bytes()),
+		})
+	}
+	ks.lock.RUnlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	if err := json.NewEncoder(w).Encode(set); err != nil {
+		glog.Errorf("error encoding jwks: %s", err.Error())
+	}
+}
+
+func (ks *SigningKeySet) load(secret *corev1.Secret) {
+	keys, err := decodeSigningKeys(secret.Data[signingKeySecretKey])
+	if err != nil {
+		glog.Errorf("error loading token signing keys: %s", err.Error())
+		return
+	}
+
+	ks.setKeys(keys)
+}
+
+func (ks *SigningKeySet) setKeys(keys []*signingKey) {
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+
+	ks.keys = keys
+}
+
+// maintainSigningKeys generates a new key if there is none or the newest is older than rotationInterval, and
+// drops keys that were retired more than gracePeriod ago. Returns the new set of keys and whether it changed.
+func maintainSigningKeys(keys []*signingKey, now time.Time, rotationInterval time.Duration,
+	gracePeriod time.Duration) ([]*signingKey, bool, error) {
+	var changed = false
+
+	if len(keys) == 0 || now.Sub(keys[len(keys)-1].Created) >= rotationInterval {
+		key, err := newSigningKey(now)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(keys) > 0 {
+			keys[len(keys)-1].Retired = now
+		}
+
+		keys = append(keys, key)
+		changed = true
+	}
+
+	var out = make([]*signingKey, 0, len(keys))
+	for _, k := range keys {
+		if !k.Retired.IsZero() && now.After(k.Retired.Add(gracePeriod)) {
+			changed = true
+			continue
+		}
+
+		out = append(out, k)
+	}
+
+	return out, changed, nil
+}
+
+func newSigningKey(now time.Time) (*signingKey, error) {
+	key, err := rsa.GenerateKey(rand.Reader, signingKeyBits)
+	if err != nil {
+		return nil, err
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	return &signingKey{
+		ID:      hex.EncodeToString(id),
+		Created: now,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "RSA PRIVATE KEY",
+			Bytes: x509.MarshalPKCS1PrivateKey(key),
+		})),
+		key: key,
+	}, nil
+}
+
+func decodeSigningKeys(data []byte) ([]*signingKey, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var keys []*signingKey
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("error decoding signing keys: %s", err.Error())
+	}
+
+	for _, k := range keys {
+		block, _ := pem.Decode([]byte(k.PrivateKey))
+		if block == nil {
+			return nil, fmt.Errorf("signing key %s is not PEM encoded", k.ID)
+		}
+
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing signing key %s: %s", k.ID, err.Error())
+		}
+
+		k.key = key
+	}
+
+	return keys, nil
+}
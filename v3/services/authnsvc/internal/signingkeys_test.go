@@ -0,0 +1,127 @@
+package authnservice
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestMaintainSigningKeys(t *testing.T) {
+	rotation := 24 * time.Hour
+	grace := 2 * time.Hour
+	start := time.Now()
+
+	keys, changed, err := maintainSigningKeys(nil, start, rotation, grace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !changed || len(keys) != 1 {
+		t.Fatalf("expected a key to be generated for an empty set, got %d keys", len(keys))
+	}
+
+	keys, changed, _ = maintainSigningKeys(keys, start.Add(time.Hour), rotation, grace)
+	if changed || len(keys) != 1 {
+		t.Errorf("expected no rotation before the rotation interval, got %d keys", len(keys))
+	}
+
+	first := keys[0].ID
+	rotated := start.Add(rotation)
+	keys, changed, _ = maintainSigningKeys(keys, rotated, rotation, grace)
+	if !changed || len(keys) != 2 {
+		t.Fatalf("expected rotation after the rotation interval, got %d keys", len(keys))
+	}
+	if keys[0].ID != first || !keys[0].Retired.Equal(rotated) {
+		t.Errorf("expected previous key %s to be retired at %s", first, rotated)
+	}
+
+	keys, changed, _ = maintainSigningKeys(keys, rotated.Add(grace), rotation, grace)
+	if changed || len(keys) != 2 {
+		t.Errorf("expected retired key to be kept within the grace period, got %d keys", len(keys))
+	}
+
+	keys, changed, _ = maintainSigningKeys(keys, rotated.Add(grace+time.Second), rotation, grace)
+	if !changed || len(keys) != 1 || keys[0].ID == first {
+		t.Errorf("expected retired key to be pruned after the grace period")
+	}
+}
+
+func TestSignAndVerify(t *testing.T) {
+	keys, _, err := maintainSigningKeys(nil, time.Now(), time.Hour, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks := &SigningKeySet{}
+	ks.setKeys(keys)
+
+	signed, err := ks.Sign(jwt.MapClaims{"sub": "u-test", "iat": time.Now().Unix()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := jwt.Parse(signed, ks.Keyfunc)
+	if err != nil || !token.Valid {
+		t.Fatalf("expected token to verify, got %v", err)
+	}
+	if token.Header["kid"] != keys[0].ID {
+		t.Errorf("expected kid %s, got %v", keys[0].ID, token.Header["kid"])
+	}
+
+	// tokens signed with a key that has been pruned no longer verify
+	other, _, _ := maintainSigningKeys(nil, time.Now(), time.Hour, time.Hour)
+	ks.setKeys(other)
+	if _, err := jwt.Parse(signed, ks.Keyfunc); err == nil {
+		t.Error("expected token signed with unknown key to be rejected")
+	}
+
+	// tokens signed with the user's password (HS256) are rejected
+	legacy, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"email": "user@example.com"}).
+		SignedString([]byte("password-hash"))
+	if _, err := jwt.Parse(legacy, ks.Keyfunc); err == nil {
+		t.Error("expected HS256 token to be rejected")
+	}
+}
+
+func TestRotateConcurrentCreate(t *testing.T) {
+	ctx := context.Background()
+
+	// another replica creates the secret between our get and create
+	otherKeys, _, err := maintainSigningKeys(nil, time.Now(), time.Hour, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(otherKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		err := kubeClient.Tracker().Add(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: SigningKeySecretName, Namespace: "hobbyfarm"},
+			Data:       map[string][]byte{signingKeySecretKey: data},
+		})
+		if err != nil {
+			return true, nil, err
+		}
+		return true, nil, errors.NewAlreadyExists(corev1.Resource("secrets"), SigningKeySecretName)
+	})
+
+	ks := &SigningKeySet{kubeClient: kubeClient, namespace: "hobbyfarm", rotationInterval: time.Hour, gracePeriod: time.Hour}
+	if err := ks.Rotate(ctx); err != nil {
+		t.Fatalf("expected rotation to use the concurrently created secret, got %v", err)
+	}
+
+	if len(ks.keys) != 1 || ks.keys[0].ID != otherKeys[0].ID {
+		t.Errorf("expected key %s of the other replica to be used", otherKeys[0].ID)
+	}
+}
@@ -1,15 +1,24 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"sync"
+	"time"
 
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/revocation"
+	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/golang/glog"
 	authnservice "github.com/hobbyfarm/gargantua/services/authnsvc/v3/internal"
 
 	accesscodepb "github.com/hobbyfarm/gargantua/v3/protos/accesscode"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	rbacpb "github.com/hobbyfarm/gargantua/v3/protos/rbac"
 	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
 	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
@@ -18,15 +27,51 @@ import (
 
 var (
 	serviceConfig *microservices.ServiceConfig
+
+	signingKeyRotationInterval time.Duration
+	signingKeyGracePeriod      time.Duration
 )
 
 func init() {
+	flag.DurationVar(&signingKeyRotationInterval, "signingkeyrotationinterval", 30*24*time.Hour,
+		"Interval at which a new token signing key is generated")
+	flag.DurationVar(&signingKeyGracePeriod, "signingkeygraceperiod", 7*24*time.Hour,
+		"Period for which tokens signed with a rotated key remain valid. Should be at least the token expiration")
 	serviceConfig = microservices.BuildServiceConfig()
 }
 
 func main() {
+	cfg, _, _ := microservices.BuildClusterConfig(serviceConfig)
+
+	namespace := util.GetReleaseNamespace()
+	kubeClient, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		glog.Fatalf("Error building kubernetes clientset: %s", err.Error())
+	}
+	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, time.Second*30, informers.WithNamespace(namespace))
+
+	signingKeys, err := authnservice.NewSigningKeySet(kubeClient, kubeInformerFactory, namespace,
+		signingKeyRotationInterval, signingKeyGracePeriod)
+	if err != nil {
+		glog.Fatalf("Error building token signing key set: %s", err.Error())
+	}
+	revocations := revocation.NewTokenRevocations(kubeClient, kubeInformerFactory, namespace)
+
+	stopCh := signals.SetupSignalHandler()
+	kubeInformerFactory.Start(stopCh)
+	kubeInformerFactory.WaitForCacheSync(stopCh)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := signingKeys.Rotate(ctx); err != nil {
+		glog.Fatalf("Error initializing token signing keys: %s", err.Error())
+	}
+	go signingKeys.Run(ctx)
+
 	services := []microservices.MicroService{
 		microservices.AccessCode,
+		microservices.AuthR,
 		microservices.Rbac,
 		microservices.ScheduledEvent,
 		microservices.Setting,
@@ -38,13 +83,14 @@ func main() {
 	}
 
 	accesscodeClient := accesscodepb.NewAccessCodeSvcClient(connections[microservices.AccessCode])
+	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
 	rbacClient := rbacpb.NewRbacSvcClient(connections[microservices.Rbac])
 	scheduledEventClient := scheduledeventpb.NewScheduledEventSvcClient(connections[microservices.ScheduledEvent])
 	settingClient := settingpb.NewSettingSvcClient(connections[microservices.Setting])
 	userClient := userpb.NewUserSvcClient(connections[microservices.User])
 
 	gs := microservices.CreateGRPCServer(serviceConfig.ServerCert.Clone())
-	as := authnservice.NewGrpcAuthNServer(userClient)
+	as := authnservice.NewGrpcAuthNServer(userClient, signingKeys, revocations)
 	authnpb.RegisterAuthNServer(gs, as)
 
 	var wg sync.WaitGroup
@@ -58,7 +104,7 @@ func main() {
 
 	go func() {
 		defer wg.Done()
-		authServer, err := authnservice.NewAuthServer(accesscodeClient, rbacClient, scheduledEventClient, settingClient, userClient, as)
+		authServer, err := authnservice.NewAuthServer(accesscodeClient, authrClient, rbacClient, scheduledEventClient, settingClient, userClient, as)
 		if err != nil {
 			glog.Fatal(err)
 		}
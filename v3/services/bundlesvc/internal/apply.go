@@ -0,0 +1,205 @@
+package bundleservice
+
+import (
+	"context"
+	"fmt"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// Applier reads and writes the content resources of the cluster.
+type Applier struct {
+	hfClientSet hfClientset.Interface
+	namespace   string
+}
+
+func NewApplier(hfClientSet hfClientset.Interface, namespace string) Applier {
+	return Applier{hfClientSet: hfClientSet, namespace: namespace}
+}
+
+// Load returns the content resources of the cluster together with the git sync each of them is owned by.
+func (a Applier) Load(ctx context.Context) (*Bundle, map[Ref]string, error) {
+	current := NewBundle()
+	owners := make(map[Ref]string)
+	own := func(kind string, meta metav1.ObjectMeta) {
+		if owner, ok := meta.Labels[hflabels.GitSyncLabel]; ok {
+			owners[Ref{Kind: kind, Id: meta.Name}] = owner
+		}
+	}
+
+	v1 := a.hfClientSet.HobbyfarmV1()
+	vmTemplates, err := v1.VirtualMachineTemplates(a.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing vm templates: %v", err)
+	}
+	for _, vmTemplate := range vmTemplates.Items {
+		current.VMTemplates[vmTemplate.Name] = vmTemplate.Spec
+		own(KindVMTemplate, vmTemplate.ObjectMeta)
+	}
+	quizzes, err := v1.Quizes(a.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing quizzes: %v", err)
+	}
+	for _, quiz := range quizzes.Items {
+		current.Quizzes[quiz.Name] = quiz.Spec
+		own(KindQuiz, quiz.ObjectMeta)
+	}
+	scenarios, err := v1.Scenarios(a.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing scenarios: %v", err)
+	}
+	for _, scenario := range scenarios.Items {
+		current.Scenarios[scenario.Name] = scenario.Spec
+		own(KindScenario, scenario.ObjectMeta)
+	}
+	courses, err := v1.Courses(a.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error listing courses: %v", err)
+	}
+	for _, course := range courses.Items {
+		current.Courses[course.Name] = course.Spec
+		own(KindCourse, course.ObjectMeta)
+	}
+	return current, owners, nil
+}
+
+// Owned returns the resources owned by a git sync.
+func Owned(owners map[Ref]string, owner string) []Ref {
+	owned := make([]Ref, 0)
+	for ref, o := range owners {
+		if o == owner {
+			owned = append(owned, ref)
+		}
+	}
+	return owned
+}
+
+// setLabels sets the labels managed by bundles. Resources applied by a git sync are labeled with its name.
+func setLabels(meta *metav1.ObjectMeta, owner string) {
+	if owner == "" {
+		return
+	}
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	meta.Labels[hflabels.GitSyncLabel] = owner
+}
+
+// Apply carries out the changes of the plan, taking the resources from the bundle. The changes are applied in order
+// and the first failing change stops the apply.
+func (a Applier) Apply(ctx context.Context, desired *Bundle, plan Plan, owner string) error {
+	for _, change := range plan.Changes {
+		var err error
+		switch change.Action {
+		case ActionCreate:
+			err = a.create(ctx, desired, change.Ref, owner)
+		case ActionUpdate:
+			err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				return a.update(ctx, desired, change.Ref, owner)
+			})
+		case ActionDelete:
+			err = a.delete(ctx, change.Ref)
+		}
+		if err != nil {
+			return fmt.Errorf("error applying %s of %s: %v", change.Action, change.Ref, err)
+		}
+	}
+	return nil
+}
+
+func (a Applier) create(ctx context.Context, desired *Bundle, ref Ref, owner string) error {
+	meta := metav1.ObjectMeta{Name: ref.Id}
+	setLabels(&meta, owner)
+
+	v1 := a.hfClientSet.HobbyfarmV1()
+	var err error
+	switch ref.Kind {
+	case KindVMTemplate:
+		_, err = v1.VirtualMachineTemplates(a.namespace).Create(ctx, &hfv1.VirtualMachineTemplate{ObjectMeta: meta, Spec: desired.VMTemplates[ref.Id]}, metav1.CreateOptions{})
+	case KindQuiz:
+		_, err = v1.Quizes(a.namespace).Create(ctx, &hfv1.Quiz{ObjectMeta: meta, Spec: desired.Quizzes[ref.Id]}, metav1.CreateOptions{})
+	case KindScenario:
+		spec := desired.Scenarios[ref.Id]
+		meta.Labels = hflabels.UpdateCategoryLabels(labelsOf(meta), []string{}, spec.Categories)
+		_, err = v1.Scenarios(a.namespace).Create(ctx, &hfv1.Scenario{ObjectMeta: meta, Spec: spec}, metav1.CreateOptions{})
+	case KindCourse:
+		_, err = v1.Courses(a.namespace).Create(ctx, &hfv1.Course{ObjectMeta: meta, Spec: desired.Courses[ref.Id]}, metav1.CreateOptions{})
+	}
+	return err
+}
+
+func labelsOf(meta metav1.ObjectMeta) map[string]string {
+	if meta.Labels == nil {
+		return make(map[string]string)
+	}
+	return meta.Labels
+}
+
+func (a Applier) update(ctx context.Context, desired *Bundle, ref Ref, owner string) error {
+	v1 := a.hfClientSet.HobbyfarmV1()
+	switch ref.Kind {
+	case KindVMTemplate:
+		vmTemplate, err := v1.VirtualMachineTemplates(a.namespace).Get(ctx, ref.Id, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		vmTemplate.Spec = desired.VMTemplates[ref.Id]
+		setLabels(&vmTemplate.ObjectMeta, owner)
+		_, err = v1.VirtualMachineTemplates(a.namespace).Update(ctx, vmTemplate, metav1.UpdateOptions{})
+		return err
+	case KindQuiz:
+		quiz, err := v1.Quizes(a.namespace).Get(ctx, ref.Id, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		quiz.Spec = desired.Quizzes[ref.Id]
+		setLabels(&quiz.ObjectMeta, owner)
+		_, err = v1.Quizes(a.namespace).Update(ctx, quiz, metav1.UpdateOptions{})
+		return err
+	case KindScenario:
+		scenario, err := v1.Scenarios(a.namespace).Get(ctx, ref.Id, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		spec := desired.Scenarios[ref.Id]
+		scenario.Labels = hflabels.UpdateCategoryLabels(labelsOf(scenario.ObjectMeta), scenario.Spec.Categories, spec.Categories)
+		scenario.Spec = spec
+		setLabels(&scenario.ObjectMeta, owner)
+		_, err = v1.Scenarios(a.namespace).Update(ctx, scenario, metav1.UpdateOptions{})
+		return err
+	case KindCourse:
+		course, err := v1.Courses(a.namespace).Get(ctx, ref.Id, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		course.Spec = desired.Courses[ref.Id]
+		setLabels(&course.ObjectMeta, owner)
+		_, err = v1.Courses(a.namespace).Update(ctx, course, metav1.UpdateOptions{})
+		return err
+	}
+	return nil
+}
+
+func (a Applier) delete(ctx context.Context, ref Ref) error {
+	v1 := a.hfClientSet.HobbyfarmV1()
+	var err error
+	switch ref.Kind {
+	case KindVMTemplate:
+		err = v1.VirtualMachineTemplates(a.namespace).Delete(ctx, ref.Id, metav1.DeleteOptions{})
+	case KindQuiz:
+		err = v1.Quizes(a.namespace).Delete(ctx, ref.Id, metav1.DeleteOptions{})
+	case KindScenario:
+		err = v1.Scenarios(a.namespace).Delete(ctx, ref.Id, metav1.DeleteOptions{})
+	case KindCourse:
+		err = v1.Courses(a.namespace).Delete(ctx, ref.Id, metav1.DeleteOptions{})
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,166 @@
+package bundleservice
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	maxFileSize   = 10 << 20
+	maxBundleSize = 100 << 20
+)
+
+var errBundleTooLarge = errors.New("bundle exceeds the size limit")
+
+// bundleDirs are the top level directories of a bundle.
+var bundleDirs = map[string]bool{vmTemplateDir: true, quizDir: true, scenarioDir: true, courseDir: true}
+
+type fileCollector struct {
+	files map[string][]byte
+	size  int64
+}
+
+func (c *fileCollector) add(name string, size int64, r io.Reader) error {
+	if size > maxFileSize {
+		return fmt.Errorf("file %s exceeds the size limit", name)
+	}
+	if c.size += size; c.size > maxBundleSize {
+		return errBundleTooLarge
+	}
+	content, err := io.ReadAll(io.LimitReader(r, maxFileSize+1))
+	if err != nil {
+		return fmt.Errorf("error reading %s: %v", name, err)
+	}
+	if len(content) > maxFileSize {
+		return fmt.Errorf("file %s exceeds the size limit", name)
+	}
+	c.files[name] = content
+	return nil
+}
+
+// ReadTarball reads the files of a gzip compressed tarball. A single top level directory containing the bundle is
+// stripped, so archives of a bundle directory can be imported as they are.
+func ReadTarball(r io.Reader) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading gzip: %v", err)
+	}
+	defer gz.Close()
+
+	collector := &fileCollector{files: make(map[string][]byte)}
+	reader := tar.NewReader(gz)
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading tarball: %v", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		name := path.Clean(strings.TrimPrefix(header.Name, "./"))
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid file name %s", header.Name)
+		}
+		if err := collector.add(name, header.Size, reader); err != nil {
+			return nil, err
+		}
+	}
+	return stripTopLevelDir(collector.files), nil
+}
+
+func stripTopLevelDir(files map[string][]byte) map[string][]byte {
+	var top string
+	for name := range files {
+		dir, _, found := strings.Cut(name, "/")
+		if !found || bundleDirs[dir] || top != "" && dir != top {
+			return files
+		}
+		top = dir
+	}
+	stripped := make(map[string][]byte, len(files))
+	for name, content := range files {
+		stripped[strings.TrimPrefix(name, top+"/")] = content
+	}
+	return stripped
+}
+
+// WriteTarball writes the files as gzip compressed tarball.
+func WriteTarball(w io.Writer, files map[string][]byte) error {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	gz := gzip.NewWriter(w)
+	writer := tar.NewWriter(gz)
+	modTime := time.Now()
+	for _, name := range names {
+		header := &tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(files[name])),
+			ModTime: modTime,
+		}
+		if err := writer.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := writer.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadDir reads the files of a bundle directory, hidden files and directories like .git are skipped.
+func ReadDir(root string) (map[string][]byte, error) {
+	collector := &fileCollector{files: make(map[string][]byte)}
+	err := filepath.WalkDir(root, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if filePath != root && strings.HasPrefix(entry.Name(), ".") {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		relative, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		return collector.add(filepath.ToSlash(relative), info.Size(), file)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading bundle directory: %v", err)
+	}
+	return collector.files, nil
+}
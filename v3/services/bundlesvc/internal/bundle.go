@@ -0,0 +1,379 @@
+package bundleservice
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	KindVMTemplate = "vmtemplate"
+	KindQuiz       = "quiz"
+	KindScenario   = "scenario"
+	KindCourse     = "course"
+)
+
+// kinds in the order resources are created, resources only reference kinds before their own
+var kinds = []string{KindVMTemplate, KindQuiz, KindScenario, KindCourse}
+
+const (
+	vmTemplateDir    = "vmtemplates"
+	quizDir          = "quizzes"
+	scenarioDir      = "scenarios"
+	courseDir        = "courses"
+	scenarioFileName = "scenario.yaml"
+	stepDir          = "steps"
+)
+
+// Ref identifies a resource of a bundle.
+type Ref struct {
+	Kind string `json:"kind"`
+	Id   string `json:"id"`
+}
+
+func (r Ref) String() string {
+	return r.Kind + "/" + r.Id
+}
+
+// Bundle holds the content resources by id in the representation they are stored with in the cluster.
+type Bundle struct {
+	VMTemplates map[string]hfv1.VirtualMachineTemplateSpec
+	Quizzes     map[string]hfv1.QuizSpec
+	Scenarios   map[string]hfv1.ScenarioSpec
+	Courses     map[string]hfv1.CourseSpec
+}
+
+func NewBundle() *Bundle {
+	return &Bundle{
+		VMTemplates: make(map[string]hfv1.VirtualMachineTemplateSpec),
+		Quizzes:     make(map[string]hfv1.QuizSpec),
+		Scenarios:   make(map[string]hfv1.ScenarioSpec),
+		Courses:     make(map[string]hfv1.CourseSpec),
+	}
+}
+
+// scenarioFile is the scenario.yaml of a scenario directory. Texts are plain instead of base64 encoded and the
+// content of the steps is kept in markdown files.
+type scenarioFile struct {
+	Name              string                     `json:"name"`
+	Description       string                     `json:"description"`
+	Categories        []string                   `json:"categories,omitempty"`
+	Tags              []string                   `json:"tags,omitempty"`
+	VirtualMachines   []map[string]string        `json:"virtualmachines,omitempty"`
+	KeepAliveDuration string                     `json:"keepalive_duration,omitempty"`
+	PauseDuration     string                     `json:"pause_duration,omitempty"`
+	Pauseable         bool                       `json:"pauseable,omitempty"`
+	Tasks             []hfv1.VirtualMachineTasks `json:"vm_tasks,omitempty"`
+	Steps             []stepFile                 `json:"steps"`
+}
+
+type stepFile struct {
+	Title   string `json:"title"`
+	Content string `json:"content"` // markdown file relative to the scenario directory
+	Quiz    string `json:"quiz,omitempty"`
+}
+
+func encode(text string) string {
+	return base64.StdEncoding.EncodeToString([]byte(text))
+}
+
+// decode decodes base64 encoded texts, texts that are not encoded are returned as they are.
+func decode(text string) string {
+	decoded, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return text
+	}
+	return string(decoded)
+}
+
+// Refs returns every resource of the bundle in the order they are created.
+func (b *Bundle) Refs() []Ref {
+	refs := make([]Ref, 0)
+	for _, kind := range kinds {
+		ids := make([]string, 0)
+		switch kind {
+		case KindVMTemplate:
+			for id := range b.VMTemplates {
+				ids = append(ids, id)
+			}
+		case KindQuiz:
+			for id := range b.Quizzes {
+				ids = append(ids, id)
+			}
+		case KindScenario:
+			for id := range b.Scenarios {
+				ids = append(ids, id)
+			}
+		case KindCourse:
+			for id := range b.Courses {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			refs = append(refs, Ref{Kind: kind, Id: id})
+		}
+	}
+	return refs
+}
+
+func (b *Bundle) Has(ref Ref) bool {
+	var ok bool
+	switch ref.Kind {
+	case KindVMTemplate:
+		_, ok = b.VMTemplates[ref.Id]
+	case KindQuiz:
+		_, ok = b.Quizzes[ref.Id]
+	case KindScenario:
+		_, ok = b.Scenarios[ref.Id]
+	case KindCourse:
+		_, ok = b.Courses[ref.Id]
+	}
+	return ok
+}
+
+// copyResource copies a resource of the bundle into another bundle.
+func (b *Bundle) copyResource(ref Ref, to *Bundle) {
+	switch ref.Kind {
+	case KindVMTemplate:
+		to.VMTemplates[ref.Id] = b.VMTemplates[ref.Id]
+	case KindQuiz:
+		to.Quizzes[ref.Id] = b.Quizzes[ref.Id]
+	case KindScenario:
+		to.Scenarios[ref.Id] = b.Scenarios[ref.Id]
+	case KindCourse:
+		to.Courses[ref.Id] = b.Courses[ref.Id]
+	}
+}
+
+func stepFileName(index int) string {
+	return fmt.Sprintf("%s/%02d.md", stepDir, index+1)
+}
+
+// ResourceFiles returns the files of a resource keyed by their path within the bundle.
+func (b *Bundle) ResourceFiles(ref Ref) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	var content interface{}
+	var filePath string
+
+	switch ref.Kind {
+	case KindVMTemplate:
+		content, filePath = b.VMTemplates[ref.Id], path.Join(vmTemplateDir, ref.Id+".yaml")
+	case KindQuiz:
+		content, filePath = b.Quizzes[ref.Id], path.Join(quizDir, ref.Id+".yaml")
+	case KindCourse:
+		course := b.Courses[ref.Id]
+		course.Name = decode(course.Name)
+		course.Description = decode(course.Description)
+		content, filePath = course, path.Join(courseDir, ref.Id+".yaml")
+	case KindScenario:
+		scenario := b.Scenarios[ref.Id]
+		dir := path.Join(scenarioDir, ref.Id)
+		file := scenarioFile{
+			Name:              decode(scenario.Name),
+			Description:       decode(scenario.Description),
+			Categories:        scenario.Categories,
+			Tags:              scenario.Tags,
+			VirtualMachines:   scenario.VirtualMachines,
+			KeepAliveDuration: scenario.KeepAliveDuration,
+			PauseDuration:     scenario.PauseDuration,
+			Pauseable:         scenario.Pauseable,
+			Tasks:             scenario.Tasks,
+			Steps:             make([]stepFile, 0, len(scenario.Steps)),
+		}
+		for i, step := range scenario.Steps {
+			file.Steps = append(file.Steps, stepFile{Title: decode(step.Title), Content: stepFileName(i), Quiz: step.Quiz})
+			files[path.Join(dir, stepFileName(i))] = []byte(decode(step.Content))
+		}
+		content, filePath = file, path.Join(dir, scenarioFileName)
+	default:
+		return nil, fmt.Errorf("unknown kind %s", ref.Kind)
+	}
+
+	encoded, err := marshal(content)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding %s: %v", ref, err)
+	}
+	files[filePath] = encoded
+	return files, nil
+}
+
+// marshal encodes the content as yaml without null and empty values, so the files of a resource do not depend on
+// whether it was read from the cluster or from a bundle.
+func marshal(content interface{}) ([]byte, error) {
+	encoded, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err := json.Unmarshal(encoded, &generic); err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(withoutEmpty(generic))
+}
+
+func withoutEmpty(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			item = withoutEmpty(item)
+			if item == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = item
+		}
+		if len(v) == 0 {
+			return nil
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		for i, item := range v {
+			// keep the position of list items
+			if item = withoutEmpty(item); item == nil {
+				item = map[string]interface{}{}
+			}
+			v[i] = item
+		}
+	}
+	return value
+}
+
+// Files returns every file of the bundle keyed by its path within the bundle.
+func (b *Bundle) Files() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	for _, ref := range b.Refs() {
+		resourceFiles, err := b.ResourceFiles(ref)
+		if err != nil {
+			return nil, err
+		}
+		for filePath, content := range resourceFiles {
+			files[filePath] = content
+		}
+	}
+	return files, nil
+}
+
+func validateId(kind string, id string) error {
+	if errs := validation.IsDNS1123Subdomain(id); len(errs) > 0 {
+		return fmt.Errorf("invalid %s id %s: %s", kind, id, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+func unmarshalFile(files map[string][]byte, filePath string, into interface{}) error {
+	if err := yaml.UnmarshalStrict(files[filePath], into); err != nil {
+		return fmt.Errorf("error parsing %s: %v", filePath, err)
+	}
+	return nil
+}
+
+// ReadBundle reads a bundle from its files keyed by their slash separated path within the bundle. Files outside the
+// directories of the bundle are ignored, so repositories can keep a README or CI configuration next to the content.
+func ReadBundle(files map[string][]byte) (*Bundle, error) {
+	b := NewBundle()
+
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+
+	for _, filePath := range paths {
+		parts := strings.Split(filePath, "/")
+		switch {
+		case len(parts) == 2 && parts[0] == vmTemplateDir && strings.HasSuffix(parts[1], ".yaml"):
+			id := strings.TrimSuffix(parts[1], ".yaml")
+			if err := validateId(KindVMTemplate, id); err != nil {
+				return nil, err
+			}
+			var spec hfv1.VirtualMachineTemplateSpec
+			if err := unmarshalFile(files, filePath, &spec); err != nil {
+				return nil, err
+			}
+			b.VMTemplates[id] = spec
+		case len(parts) == 2 && parts[0] == quizDir && strings.HasSuffix(parts[1], ".yaml"):
+			id := strings.TrimSuffix(parts[1], ".yaml")
+			if err := validateId(KindQuiz, id); err != nil {
+				return nil, err
+			}
+			var spec hfv1.QuizSpec
+			if err := unmarshalFile(files, filePath, &spec); err != nil {
+				return nil, err
+			}
+			b.Quizzes[id] = spec
+		case len(parts) == 2 && parts[0] == courseDir && strings.HasSuffix(parts[1], ".yaml"):
+			id := strings.TrimSuffix(parts[1], ".yaml")
+			if err := validateId(KindCourse, id); err != nil {
+				return nil, err
+			}
+			var spec hfv1.CourseSpec
+			if err := unmarshalFile(files, filePath, &spec); err != nil {
+				return nil, err
+			}
+			spec.Name = encode(spec.Name)
+			spec.Description = encode(spec.Description)
+			b.Courses[id] = spec
+		case len(parts) == 3 && parts[0] == scenarioDir && parts[2] == scenarioFileName:
+			id := parts[1]
+			if err := validateId(KindScenario, id); err != nil {
+				return nil, err
+			}
+			spec, err := readScenario(files, path.Join(scenarioDir, id))
+			if err != nil {
+				return nil, err
+			}
+			b.Scenarios[id] = spec
+		}
+	}
+	return b, nil
+}
+
+func readScenario(files map[string][]byte, dir string) (hfv1.ScenarioSpec, error) {
+	var file scenarioFile
+	if err := unmarshalFile(files, path.Join(dir, scenarioFileName), &file); err != nil {
+		return hfv1.ScenarioSpec{}, err
+	}
+	if file.Name == "" {
+		return hfv1.ScenarioSpec{}, fmt.Errorf("%s: name is required", path.Join(dir, scenarioFileName))
+	}
+
+	spec := hfv1.ScenarioSpec{
+		Name:              encode(file.Name),
+		Description:       encode(file.Description),
+		Steps:             make([]hfv1.ScenarioStep, 0, len(file.Steps)),
+		Categories:        file.Categories,
+		Tags:              file.Tags,
+		VirtualMachines:   file.VirtualMachines,
+		KeepAliveDuration: file.KeepAliveDuration,
+		PauseDuration:     file.PauseDuration,
+		Pauseable:         file.Pauseable,
+		Tasks:             file.Tasks,
+	}
+	for i, step := range file.Steps {
+		// step files are relative to the scenario directory and must not leave the bundle
+		contentPath := path.Join(dir, step.Content)
+		if step.Content == "" || path.IsAbs(step.Content) || strings.HasPrefix(contentPath, "../") {
+			return hfv1.ScenarioSpec{}, fmt.Errorf("%s: invalid content file of step %d", path.Join(dir, scenarioFileName), i+1)
+		}
+		content, ok := files[contentPath]
+		if !ok {
+			return hfv1.ScenarioSpec{}, fmt.Errorf("%s: content file %s of step %d not found", path.Join(dir, scenarioFileName), contentPath, i+1)
+		}
+		spec.Steps = append(spec.Steps, hfv1.ScenarioStep{
+			Title:   encode(step.Title),
+			Content: encode(string(content)),
+			Quiz:    step.Quiz,
+		})
+	}
+	return spec, nil
+}
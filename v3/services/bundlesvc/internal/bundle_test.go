@@ -0,0 +1,140 @@
+package bundleservice
+
+import (
+	"bytes"
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testBundle returns a bundle as it is stored in the cluster, with base64 encoded texts.
+func testBundle() *Bundle {
+	b := NewBundle()
+	b.VMTemplates["ubuntu"] = hfv1.VirtualMachineTemplateSpec{
+		Name:      "Ubuntu",
+		Image:     "ubuntu-22.04",
+		ConfigMap: map[string]string{"cloud-config": "#cloud-config\npackages:\n  - git\n"},
+		Resources: hfv1.VirtualMachineResources{CPU: 2, Memory: 4096},
+	}
+	b.Quizzes["basics"] = hfv1.QuizSpec{
+		Title:            "Basics",
+		SuccessThreshold: 70,
+		Questions: []hfv1.QuizQuestion{
+			{Title: "Is this a test?", Type: "radio", Weight: 1, Answers: []hfv1.QuizAnswer{{Title: "yes", Correct: true}, {Title: "no"}}},
+		},
+	}
+	b.Scenarios["intro"] = hfv1.ScenarioSpec{
+		Name:            encode("Introduction"),
+		Description:     encode("Getting started"),
+		Categories:      []string{"beginner"},
+		VirtualMachines: []map[string]string{{"node": "ubuntu"}},
+		Steps: []hfv1.ScenarioStep{
+			{Title: encode("Welcome"), Content: encode("# Welcome\n\nRun `ls`.\n")},
+			{Title: encode("Quiz"), Content: encode("Answer the quiz."), Quiz: "basics"},
+		},
+	}
+	b.Courses["course"] = hfv1.CourseSpec{
+		Name:        encode("Course"),
+		Description: encode("A course"),
+		Scenarios:   []string{"intro"},
+	}
+	return b
+}
+
+func TestFilesLayout(t *testing.T) {
+	files, err := testBundle().Files()
+	require.NoError(t, err)
+
+	paths := make([]string, 0, len(files))
+	for filePath := range files {
+		paths = append(paths, filePath)
+	}
+	assert.ElementsMatch(t, []string{
+		"vmtemplates/ubuntu.yaml",
+		"quizzes/basics.yaml",
+		"scenarios/intro/scenario.yaml",
+		"scenarios/intro/steps/01.md",
+		"scenarios/intro/steps/02.md",
+		"courses/course.yaml",
+	}, paths)
+
+	// texts are stored plain instead of base64 encoded
+	assert.Equal(t, "# Welcome\n\nRun `ls`.\n", string(files["scenarios/intro/steps/01.md"]))
+	assert.Contains(t, string(files["scenarios/intro/scenario.yaml"]), "name: Introduction")
+	assert.Contains(t, string(files["courses/course.yaml"]), "name: Course")
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := testBundle()
+	files, err := original.Files()
+	require.NoError(t, err)
+
+	var tarball bytes.Buffer
+	require.NoError(t, WriteTarball(&tarball, files))
+	read, err := ReadTarball(&tarball)
+	require.NoError(t, err)
+	assert.Equal(t, files, read)
+
+	b, err := ReadBundle(read)
+	require.NoError(t, err)
+	assert.Equal(t, original.Refs(), b.Refs())
+	assert.Equal(t, original.Scenarios["intro"], b.Scenarios["intro"])
+	assert.Equal(t, original.Courses["course"].Name, b.Courses["course"].Name)
+
+	plan, err := NewPlan(b, original, nil)
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.Equal(t, 4, plan.Unchanged)
+}
+
+func TestReadTarballStripsTopLevelDir(t *testing.T) {
+	var tarball bytes.Buffer
+	require.NoError(t, WriteTarball(&tarball, map[string][]byte{
+		"content/quizzes/basics.yaml": []byte("title: Basics\n"),
+		"content/README.md":           []byte("readme"),
+	}))
+	files, err := ReadTarball(&tarball)
+	require.NoError(t, err)
+	assert.Contains(t, files, "quizzes/basics.yaml")
+	assert.Contains(t, files, "README.md")
+}
+
+func TestReadBundleErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		files map[string][]byte
+	}{
+		{name: "unknown field", files: map[string][]byte{"quizzes/basics.yaml": []byte("title: Basics\ntitel: typo\n")}},
+		{name: "invalid id", files: map[string][]byte{"quizzes/Basics_1.yaml": []byte("title: Basics\n")}},
+		{name: "missing name", files: map[string][]byte{"scenarios/intro/scenario.yaml": []byte("steps: []\n")}},
+		{
+			name:  "missing step",
+			files: map[string][]byte{"scenarios/intro/scenario.yaml": []byte("name: Intro\nsteps:\n- title: One\n  content: steps/01.md\n")},
+		},
+		{
+			name: "step outside bundle",
+			files: map[string][]byte{
+				"scenarios/intro/scenario.yaml": []byte("name: Intro\nsteps:\n- title: One\n  content: ../../../secret.md\n"),
+				"secret.md":                     []byte("secret"),
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ReadBundle(test.files)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestReadBundleIgnoresOtherFiles(t *testing.T) {
+	b, err := ReadBundle(map[string][]byte{
+		"README.md":              []byte("# Content"),
+		".github/workflows/a.md": []byte("ci"),
+		"quizzes/basics.yaml":    []byte("title: Basics\n"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Ref{{Kind: KindQuiz, Id: "basics"}}, b.Refs())
+}
@@ -0,0 +1,147 @@
+package bundleservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+)
+
+// contentResources are the resources bundles are made of.
+var contentResources = []string{
+	rbac.ResourcePluralVMTemplate,
+	rbac.ResourcePluralQuiz,
+	rbac.ResourcePluralScenario,
+	rbac.ResourcePluralCourse,
+}
+
+// authorizeContent checks that the user may use all verbs on all content resources.
+func (s BundleServer) authorizeContent(w http.ResponseWriter, r *http.Request, verbs ...string) bool {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return false
+	}
+
+	permissions := make([]*authrpb.Permission, 0, len(contentResources)*len(verbs))
+	for _, resource := range contentResources {
+		for _, verb := range verbs {
+			permissions = append(permissions, rbac.HobbyfarmPermission(resource, verb))
+		}
+	}
+	authrResponse, err := rbac.Authorize(r, s.authrClient, user.GetId(), permissions, rbac.OperatorAND)
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", fmt.Sprintf("no access to %s content", strings.Join(verbs, " and ")))
+		return false
+	}
+	return true
+}
+
+func splitQuery(r *http.Request, key string) []string {
+	values := make([]string, 0)
+	for _, value := range strings.Split(r.URL.Query().Get(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// ExportFunc returns the requested courses and scenarios with everything they reference as bundle tarball, all
+// content if nothing is requested.
+func (s BundleServer) ExportFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeContent(w, r, rbac.VerbList) {
+		return
+	}
+
+	current, _, err := s.applier.Load(r.Context())
+	if err != nil {
+		glog.Errorf("error loading content: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error loading content")
+		return
+	}
+	bundle, err := current.Collect(splitQuery(r, "courses"), splitQuery(r, "scenarios"))
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 404, "notfound", err.Error())
+		return
+	}
+	files, err := bundle.Files()
+	if err != nil {
+		glog.Errorf("error exporting bundle: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error exporting bundle")
+		return
+	}
+
+	// write into a buffer first, so errors can still be returned as such
+	var tarball bytes.Buffer
+	if err := WriteTarball(&tarball, files); err != nil {
+		glog.Errorf("error writing bundle tarball: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error exporting bundle")
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"bundle.tar.gz\"")
+	w.WriteHeader(http.StatusOK)
+	w.Write(tarball.Bytes())
+}
+
+// ImportFunc applies a bundle tarball. The plan of the import is returned, with dry_run=true the plan is only
+// computed. Imports create and update resources but never delete any.
+func (s BundleServer) ImportFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeContent(w, r, rbac.VerbCreate, rbac.VerbUpdate) {
+		return
+	}
+	dryRun := r.URL.Query().Get("dry_run") == "true"
+
+	files, err := ReadTarball(http.MaxBytesReader(w, r.Body, maxBundleSize))
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+	desired, err := ReadBundle(files)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	s.applyLock.Lock()
+	defer s.applyLock.Unlock()
+
+	current, _, err := s.applier.Load(r.Context())
+	if err != nil {
+		glog.Errorf("error loading content: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error loading content")
+		return
+	}
+	if err := desired.Validate(current, nil); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+	plan, err := NewPlan(desired, current, nil)
+	if err != nil {
+		glog.Errorf("error planning import: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error planning import")
+		return
+	}
+
+	if !dryRun {
+		if err := s.applier.Apply(r.Context(), desired, plan, ""); err != nil {
+			glog.Errorf("error importing bundle: %v", err)
+			util.ReturnHTTPMessage(w, r, 500, "error", err.Error())
+			return
+		}
+		glog.V(4).Infof("imported bundle with %d changes", len(plan.Changes))
+	}
+
+	encoded, err := json.Marshal(plan)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
@@ -0,0 +1,28 @@
+package bundleservice
+
+import (
+	"github.com/ebauman/crder"
+	v1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
+)
+
+// BundleCRDInstaller is a struct that can generate CRDs for git syncs.
+// It implements the CrdInstaller interface defined in "github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+type BundleCRDInstaller struct{}
+
+func (bi BundleCRDInstaller) GenerateCRDs() []crder.CRD {
+	return []crder.CRD{
+		crd.HobbyfarmCRD(&v1.GitSync{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.GitSync{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("Repository", ".spec.repository").
+						WithColumn("Branch", ".spec.branch").
+						WithColumn("Commit", ".status.commit").
+						WithColumn("LastSync", ".status.last_sync_time").
+						WithStatus()
+				})
+		}),
+	}
+}
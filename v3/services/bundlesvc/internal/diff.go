@@ -0,0 +1,284 @@
+package bundleservice
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// diffContext is the number of unchanged lines shown around changes.
+const diffContext = 3
+
+// maxDiffCells limits the size of the table used to compare two files, larger files are shown as replaced.
+const maxDiffCells = 4_000_000
+
+type Change struct {
+	Ref
+	Action Action `json:"action"`
+	Diff   string `json:"diff,omitempty"` // unified diff of the files of the resource
+}
+
+// Plan lists the changes needed to bring the current resources to the state of a bundle.
+type Plan struct {
+	Changes   []Change `json:"changes"`
+	Unchanged int      `json:"unchanged"`
+}
+
+func (p Plan) Count(action Action) int {
+	count := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// NewPlan compares the bundle with the current resources. Resources to prune are deleted unless they are part of
+// the bundle. Deletions are planned in reverse order, so resources are deleted before the resources they reference.
+func NewPlan(desired *Bundle, current *Bundle, prune []Ref) (Plan, error) {
+	plan := Plan{Changes: make([]Change, 0)}
+
+	for _, ref := range desired.Refs() {
+		desiredFiles, err := desired.ResourceFiles(ref)
+		if err != nil {
+			return Plan{}, err
+		}
+		if !current.Has(ref) {
+			plan.Changes = append(plan.Changes, Change{Ref: ref, Action: ActionCreate, Diff: diffFiles(nil, desiredFiles)})
+			continue
+		}
+		currentFiles, err := current.ResourceFiles(ref)
+		if err != nil {
+			return Plan{}, err
+		}
+		if diff := diffFiles(currentFiles, desiredFiles); diff != "" {
+			plan.Changes = append(plan.Changes, Change{Ref: ref, Action: ActionUpdate, Diff: diff})
+		} else {
+			plan.Unchanged++
+		}
+	}
+
+	deletions := make([]Ref, 0)
+	for _, ref := range prune {
+		if current.Has(ref) && !desired.Has(ref) {
+			deletions = append(deletions, ref)
+		}
+	}
+	sort.SliceStable(deletions, func(i, j int) bool {
+		return kindOrder(deletions[i].Kind) > kindOrder(deletions[j].Kind) ||
+			deletions[i].Kind == deletions[j].Kind && deletions[i].Id < deletions[j].Id
+	})
+	for _, ref := range deletions {
+		currentFiles, err := current.ResourceFiles(ref)
+		if err != nil {
+			return Plan{}, err
+		}
+		plan.Changes = append(plan.Changes, Change{Ref: ref, Action: ActionDelete, Diff: diffFiles(currentFiles, nil)})
+	}
+	return plan, nil
+}
+
+func kindOrder(kind string) int {
+	for i, k := range kinds {
+		if k == kind {
+			return i
+		}
+	}
+	return len(kinds)
+}
+
+// diffFiles returns the unified diff between two sets of files, or an empty string if they are equal.
+func diffFiles(from map[string][]byte, to map[string][]byte) string {
+	paths := make([]string, 0, len(from)+len(to))
+	for filePath := range from {
+		paths = append(paths, filePath)
+	}
+	for filePath := range to {
+		if _, ok := from[filePath]; !ok {
+			paths = append(paths, filePath)
+		}
+	}
+	sort.Strings(paths)
+
+	var diff strings.Builder
+	for _, filePath := range paths {
+		fromContent, inFrom := from[filePath]
+		toContent, inTo := to[filePath]
+		if inFrom && inTo && bytes.Equal(fromContent, toContent) {
+			continue
+		}
+		fromName, toName := "a/"+filePath, "b/"+filePath
+		if !inFrom {
+			fromName = "/dev/null"
+		}
+		if !inTo {
+			toName = "/dev/null"
+		}
+		fmt.Fprintf(&diff, "--- %s\n+++ %s\n", fromName, toName)
+		diff.WriteString(unifiedDiff(splitLines(fromContent), splitLines(toContent)))
+	}
+	return diff.String()
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+type diffLine struct {
+	op   byte // ' ', '-' or '+'
+	text string
+}
+
+// lineDiff returns the edit script between two lists of lines based on their longest common subsequence.
+func lineDiff(from []string, to []string) []diffLine {
+	// unchanged lines at the start and the end do not need the table
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	lines := make([]diffLine, 0, len(from)+len(to))
+	for _, line := range from[:prefix] {
+		lines = append(lines, diffLine{' ', line})
+	}
+
+	a, b := from[prefix:len(from)-suffix], to[prefix:len(to)-suffix]
+	if len(a)*len(b) > maxDiffCells {
+		for _, line := range a {
+			lines = append(lines, diffLine{'-', line})
+		}
+		for _, line := range b {
+			lines = append(lines, diffLine{'+', line})
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+		lcs := make([][]int, len(a)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(a) || j < len(b) {
+			switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				lines = append(lines, diffLine{' ', a[i]})
+				i++
+				j++
+			// removed lines go before added lines
+			case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+				lines = append(lines, diffLine{'-', a[i]})
+				i++
+			default:
+				lines = append(lines, diffLine{'+', b[j]})
+				j++
+			}
+		}
+	}
+
+	for _, line := range from[len(from)-suffix:] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	return lines
+}
+
+// unifiedDiff formats the changes between two lists of lines as hunks of a unified diff.
+func unifiedDiff(from []string, to []string) string {
+	lines := lineDiff(from, to)
+
+	var out strings.Builder
+	for start := 0; start < len(lines); {
+		// find the next change
+		for start < len(lines) && lines[start].op == ' ' {
+			start++
+		}
+		if start == len(lines) {
+			break
+		}
+
+		// extend the hunk until there are more unchanged lines than twice the context
+		end := start
+		for end < len(lines) {
+			if lines[end].op != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(lines) && lines[next].op == ' ' {
+				next++
+			}
+			if next == len(lines) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+
+		hunkStart := max(start-diffContext, 0)
+		hunkEnd := min(end+diffContext, len(lines))
+
+		// line numbers of the hunk start in both files
+		fromLine, toLine := 1, 1
+		for _, line := range lines[:hunkStart] {
+			if line.op != '+' {
+				fromLine++
+			}
+			if line.op != '-' {
+				toLine++
+			}
+		}
+		fromCount, toCount := 0, 0
+		for _, line := range lines[hunkStart:hunkEnd] {
+			if line.op != '+' {
+				fromCount++
+			}
+			if line.op != '-' {
+				toCount++
+			}
+		}
+		if fromCount == 0 {
+			fromLine--
+		}
+		if toCount == 0 {
+			toLine--
+		}
+
+		fmt.Fprintf(&out, "@@ -%d,%d +%d,%d @@\n", fromLine, fromCount, toLine, toCount)
+		for _, line := range lines[hunkStart:hunkEnd] {
+			out.WriteByte(line.op)
+			out.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				out.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		start = hunkEnd
+	}
+	return out.String()
+}
@@ -0,0 +1,66 @@
+package bundleservice
+
+import (
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	from := splitLines([]byte("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\n"))
+	to := splitLines([]byte("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk"))
+
+	assert.Equal(t, ""+
+		"@@ -1,5 +1,5 @@\n"+
+		" a\n"+
+		"-b\n"+
+		"+B\n"+
+		" c\n"+
+		" d\n"+
+		" e\n"+
+		"@@ -8,3 +8,4 @@\n"+
+		" h\n"+
+		" i\n"+
+		" j\n"+
+		"+k\n"+
+		"\\ No newline at end of file\n",
+		unifiedDiff(from, to))
+	assert.Empty(t, unifiedDiff(from, from))
+}
+
+func TestDiffFiles(t *testing.T) {
+	diff := diffFiles(nil, map[string][]byte{"quizzes/q.yaml": []byte("title: Q\n")})
+	assert.Equal(t, "--- /dev/null\n+++ b/quizzes/q.yaml\n@@ -0,0 +1,1 @@\n+title: Q\n", diff)
+
+	diff = diffFiles(map[string][]byte{"quizzes/q.yaml": []byte("title: Q\n")}, nil)
+	assert.Equal(t, "--- a/quizzes/q.yaml\n+++ /dev/null\n@@ -1,1 +0,0 @@\n-title: Q\n", diff)
+}
+
+func TestNewPlan(t *testing.T) {
+	current := testBundle()
+	desired := testBundle()
+
+	// changed step content, new quiz, removed course
+	intro := desired.Scenarios["intro"]
+	intro.Steps = append([]hfv1.ScenarioStep{}, intro.Steps...)
+	intro.Steps[0].Content = encode("# Welcome\n\nRun `ls -la`.\n")
+	desired.Scenarios["intro"] = intro
+	desired.Quizzes["advanced"] = hfv1.QuizSpec{Title: "Advanced"}
+	delete(desired.Courses, "course")
+
+	plan, err := NewPlan(desired, current, []Ref{{Kind: KindCourse, Id: "course"}, {Kind: KindQuiz, Id: "basics"}})
+	require.NoError(t, err)
+
+	require.Len(t, plan.Changes, 3)
+	assert.Equal(t, Change{Ref: Ref{Kind: KindQuiz, Id: "advanced"}, Action: ActionCreate, Diff: plan.Changes[0].Diff}, plan.Changes[0])
+	assert.Equal(t, Ref{Kind: KindScenario, Id: "intro"}, plan.Changes[1].Ref)
+	assert.Equal(t, ActionUpdate, plan.Changes[1].Action)
+	assert.Contains(t, plan.Changes[1].Diff, "--- a/scenarios/intro/steps/01.md\n")
+	assert.Contains(t, plan.Changes[1].Diff, "-Run `ls`.\n+Run `ls -la`.\n")
+	// basics is part of the bundle and therefore not pruned
+	assert.Equal(t, Ref{Kind: KindCourse, Id: "course"}, plan.Changes[2].Ref)
+	assert.Equal(t, ActionDelete, plan.Changes[2].Action)
+	assert.Equal(t, 2, plan.Unchanged)
+}
@@ -0,0 +1,107 @@
+package bundleservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// Credentials authenticate against https repositories.
+type Credentials struct {
+	Username string
+	Password string
+}
+
+// gitEnv returns the environment of git commands. Credentials are passed as configuration through the environment,
+// so they neither show up in the process list nor get stored in the checkout.
+func gitEnv(credentials *Credentials) []string {
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if credentials != nil {
+		auth := base64.StdEncoding.EncodeToString([]byte(credentials.Username + ":" + credentials.Password))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+auth,
+		)
+	}
+	return env
+}
+
+// ValidateRepository makes sure a repository is an https or ssh url. Other transports of git are able to read local
+// files or run commands, and a leading "-" would be passed to git as an option.
+func ValidateRepository(repository string) error {
+	if repository == "" {
+		return errors.New("repository is required")
+	}
+	if strings.HasPrefix(repository, "-") {
+		return errors.New("repository must not start with \"-\"")
+	}
+	parsed, err := url.Parse(repository)
+	if err != nil || parsed.Host == "" || parsed.Scheme != "https" && parsed.Scheme != "ssh" {
+		return errors.New("repository needs to be an https or ssh url")
+	}
+	return nil
+}
+
+func runGit(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("git %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// Checkout fetches the latest commit of a branch into dir and checks it out, the default branch is fetched if branch
+// is empty. The checkout in dir is reused by later calls, only the latest commit is fetched. It returns the commit.
+func Checkout(ctx context.Context, dir string, repository string, branch string, credentials *Credentials) (string, error) {
+	if err := ValidateRepository(repository); err != nil {
+		return "", err
+	}
+	return checkout(ctx, dir, repository, branch, credentials)
+}
+
+// checkout runs the git commands of Checkout without validating the repository.
+func checkout(ctx context.Context, dir string, repository string, branch string, credentials *Credentials) (string, error) {
+	env := gitEnv(credentials)
+
+	if _, err := os.Stat(filepath.Join(dir, ".git")); os.IsNotExist(err) {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return "", fmt.Errorf("error creating checkout directory: %v", err)
+		}
+		if _, err := runGit(ctx, dir, env, "init", "--quiet"); err != nil {
+			return "", err
+		}
+		if _, err := runGit(ctx, dir, env, "remote", "add", "origin", repository); err != nil {
+			return "", err
+		}
+	} else if _, err := runGit(ctx, dir, env, "remote", "set-url", "origin", repository); err != nil {
+		return "", err
+	}
+
+	ref := "HEAD"
+	if branch != "" {
+		ref = "refs/heads/" + branch
+	}
+	if _, err := runGit(ctx, dir, env, "fetch", "--quiet", "--depth", "1", "--no-tags", "origin", ref); err != nil {
+		return "", err
+	}
+	if _, err := runGit(ctx, dir, env, "checkout", "--quiet", "--force", "--detach", "FETCH_HEAD"); err != nil {
+		return "", err
+	}
+	if _, err := runGit(ctx, dir, env, "clean", "--quiet", "-ffdx"); err != nil {
+		return "", err
+	}
+	return runGit(ctx, dir, env, "rev-parse", "HEAD")
+}
@@ -0,0 +1,116 @@
+package bundleservice
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRepository creates a bare repository and a clone of it to commit into.
+func testRepository(t *testing.T) (string, string) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root := t.TempDir()
+	remote := filepath.Join(root, "remote.git")
+	work := filepath.Join(root, "work")
+	git(t, root, "init", "--quiet", "--bare", "--initial-branch", "main", remote)
+	git(t, root, "clone", "--quiet", remote, work)
+	git(t, work, "checkout", "--quiet", "-b", "main")
+	return remote, work
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	args = append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func commitFiles(t *testing.T, work string, files map[string][]byte) {
+	for name, content := range files {
+		filePath := filepath.Join(work, "content", filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.NoError(t, os.WriteFile(filePath, content, 0644))
+	}
+	git(t, work, "add", "--all")
+	git(t, work, "commit", "--quiet", "--message", "update content")
+	git(t, work, "push", "--quiet", "origin", "main")
+}
+
+func TestCheckout(t *testing.T) {
+	remote, work := testRepository(t)
+	files, err := testBundle().Files()
+	require.NoError(t, err)
+	commitFiles(t, work, files)
+
+	ctx := context.Background()
+	checkoutDir := filepath.Join(t.TempDir(), "checkout")
+	commit, err := checkout(ctx, checkoutDir, "file://"+remote, "main", nil)
+	require.NoError(t, err)
+	assert.Len(t, commit, 40)
+
+	dir, err := bundlePath(checkoutDir, "content")
+	require.NoError(t, err)
+	read, err := ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, files, read)
+
+	// the next checkout fetches the new commit into the same directory and drops local changes
+	require.NoError(t, os.WriteFile(filepath.Join(checkoutDir, "content", "local.md"), []byte("local"), 0644))
+	commitFiles(t, work, map[string][]byte{"quizzes/advanced.yaml": []byte("title: Advanced\n")})
+	next, err := checkout(ctx, checkoutDir, "file://"+remote, "main", nil)
+	require.NoError(t, err)
+	assert.NotEqual(t, commit, next)
+
+	read, err = ReadDir(dir)
+	require.NoError(t, err)
+	assert.Contains(t, read, "quizzes/advanced.yaml")
+	assert.NotContains(t, read, "local.md")
+
+	_, err = checkout(ctx, checkoutDir, "file://"+remote, "missing", nil)
+	assert.Error(t, err)
+
+	// Checkout only accepts https and ssh repositories
+	_, err = Checkout(ctx, checkoutDir, "file://"+remote, "main", nil)
+	assert.Error(t, err)
+}
+
+func TestValidateRepository(t *testing.T) {
+	assert.NoError(t, ValidateRepository("https://github.com/example/content.git"))
+	assert.NoError(t, ValidateRepository("ssh://git@github.com/example/content.git"))
+
+	for _, repository := range []string{
+		"",
+		"--upload-pack=touch /tmp/pwned",
+		"-c",
+		"file:///var/lib/content",
+		"ext::sh -c touch% /tmp/pwned",
+		"http://github.com/example/content.git",
+		"https://",
+		"/var/lib/content",
+	} {
+		assert.Error(t, ValidateRepository(repository), repository)
+	}
+}
+
+func TestBundlePath(t *testing.T) {
+	checkout := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(checkout, "content"), 0755))
+	require.NoError(t, os.Symlink(t.TempDir(), filepath.Join(checkout, "outside")))
+
+	_, err := bundlePath(checkout, "content")
+	assert.NoError(t, err)
+	_, err = bundlePath(checkout, "")
+	assert.NoError(t, err)
+	_, err = bundlePath(checkout, "../content")
+	assert.Error(t, err)
+	_, err = bundlePath(checkout, "outside")
+	assert.Error(t, err)
+}
@@ -0,0 +1,294 @@
+package bundleservice
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	listersv1 "github.com/hobbyfarm/gargantua/v3/pkg/client/listers/hobbyfarm.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	gitSyncCheckInterval   = 15 * time.Second
+	defaultGitSyncInterval = 5 * time.Minute
+	minGitSyncInterval     = 30 * time.Second
+	gitSyncTimeout         = 5 * time.Minute
+	syncErrLength          = 1024
+)
+
+// syncInterval returns the interval of a git sync, the default interval if none is set.
+func syncInterval(spec hfv1.GitSyncSpec) (time.Duration, error) {
+	if spec.Interval == "" {
+		return defaultGitSyncInterval, nil
+	}
+	interval, err := time.ParseDuration(spec.Interval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid interval: %v", err)
+	}
+	if interval < minGitSyncInterval {
+		return 0, fmt.Errorf("interval needs to be at least %s", minGitSyncInterval)
+	}
+	return interval, nil
+}
+
+func validatePath(bundle string) error {
+	for _, segment := range strings.Split(bundle, "/") {
+		if segment == ".." {
+			return fmt.Errorf("invalid path %s", bundle)
+		}
+	}
+	return nil
+}
+
+// bundlePath returns the directory of the bundle within a checkout. The path must stay inside the repository, also
+// when the repository contains symlinks.
+func bundlePath(checkout string, bundle string) (string, error) {
+	if err := validatePath(bundle); err != nil {
+		return "", err
+	}
+	dir, err := filepath.EvalSymlinks(filepath.Join(checkout, filepath.FromSlash(path.Clean("/"+bundle))))
+	if err != nil {
+		return "", fmt.Errorf("invalid path %s: %v", bundle, err)
+	}
+	root, err := filepath.EvalSymlinks(checkout)
+	if err != nil {
+		return "", err
+	}
+	if dir != root && !strings.HasPrefix(dir, root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid path %s", bundle)
+	}
+	return dir, nil
+}
+
+// GitSyncController reconciles the bundles of git repositories into the cluster. Each git sync is checked out into
+// its own directory below the work directory and synced once its interval has passed, its spec has changed or a sync
+// has been triggered. Resources applied by a git sync are labeled with its name, so pruning only ever deletes
+// resources the git sync has created.
+type GitSyncController struct {
+	hfClientSet   hfClientset.Interface
+	kubeClient    kubernetes.Interface
+	namespace     string
+	gitSyncLister listersv1.GitSyncLister
+	applier       Applier
+	workDir       string
+
+	// mu serializes syncs with each other and with imports, as both plan against the current resources
+	mu        *sync.Mutex
+	trigger   chan struct{}
+	triggered map[string]bool
+	lock      sync.Mutex
+}
+
+func NewGitSyncController(
+	hfClientSet hfClientset.Interface,
+	kubeClient kubernetes.Interface,
+	namespace string,
+	gitSyncLister listersv1.GitSyncLister,
+	applier Applier,
+	applyLock *sync.Mutex,
+	workDir string,
+) *GitSyncController {
+	return &GitSyncController{
+		hfClientSet:   hfClientSet,
+		kubeClient:    kubeClient,
+		namespace:     namespace,
+		gitSyncLister: gitSyncLister,
+		applier:       applier,
+		workDir:       workDir,
+		mu:            applyLock,
+		trigger:       make(chan struct{}, 1),
+		triggered:     make(map[string]bool),
+	}
+}
+
+// Trigger syncs the git sync with the next reconcile, regardless of its interval.
+func (c *GitSyncController) Trigger(name string) {
+	c.lock.Lock()
+	c.triggered[name] = true
+	c.lock.Unlock()
+
+	select {
+	case c.trigger <- struct{}{}:
+	default:
+		// a reconcile is already triggered
+	}
+}
+
+func (c *GitSyncController) takeTriggered() map[string]bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	triggered := c.triggered
+	c.triggered = make(map[string]bool)
+	return triggered
+}
+
+// Run reconciles the git syncs until the context is done.
+func (c *GitSyncController) Run(ctx context.Context) {
+	ticker := time.NewTicker(gitSyncCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		c.reconcile(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-c.trigger:
+		}
+	}
+}
+
+func (c *GitSyncController) reconcile(ctx context.Context) {
+	gitSyncs, err := c.gitSyncLister.GitSyncs(c.namespace).List(labels.Everything())
+	if err != nil {
+		glog.Errorf("error listing git syncs: %v", err)
+		return
+	}
+
+	triggered := c.takeTriggered()
+	for _, gitSync := range gitSyncs {
+		if !triggered[gitSync.Name] && !c.due(gitSync) {
+			continue
+		}
+		c.sync(ctx, gitSync)
+	}
+}
+
+// due returns if a git sync needs to be synced. Suspended git syncs are only synced when triggered.
+func (c *GitSyncController) due(gitSync *hfv1.GitSync) bool {
+	if gitSync.Spec.Suspend {
+		return false
+	}
+	if gitSync.Status.ObservedGeneration != gitSync.Generation {
+		return true
+	}
+	lastSync, err := time.Parse(time.UnixDate, gitSync.Status.LastSyncTime)
+	if err != nil {
+		return true
+	}
+	interval, err := syncInterval(gitSync.Spec)
+	if err != nil {
+		interval = defaultGitSyncInterval
+	}
+	return time.Since(lastSync) >= interval
+}
+
+func (c *GitSyncController) sync(ctx context.Context, gitSync *hfv1.GitSync) {
+	ctx, cancel := context.WithTimeout(ctx, gitSyncTimeout)
+	defer cancel()
+
+	status := hfv1.GitSyncStatus{
+		ObservedGeneration: gitSync.Generation,
+		Commit:             gitSync.Status.Commit,
+		LastSyncTime:       time.Now().Format(time.UnixDate),
+	}
+	plan, commit, err := c.apply(ctx, gitSync)
+	if err != nil {
+		glog.Errorf("error syncing git sync %s: %v", gitSync.Name, err)
+		status.Error = err.Error()
+		if len(status.Error) > syncErrLength {
+			status.Error = status.Error[:syncErrLength]
+		}
+	} else {
+		glog.V(4).Infof("synced git sync %s at commit %s", gitSync.Name, commit)
+		status.Commit = commit
+		status.Created = plan.Count(ActionCreate)
+		status.Updated = plan.Count(ActionUpdate)
+		status.Deleted = plan.Count(ActionDelete)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := c.hfClientSet.HobbyfarmV1().GitSyncs(c.namespace).Get(ctx, gitSync.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		latest.Status = status
+		_, err = c.hfClientSet.HobbyfarmV1().GitSyncs(c.namespace).UpdateStatus(ctx, latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		glog.Errorf("error updating status of git sync %s: %v", gitSync.Name, err)
+	}
+}
+
+// apply checks out the repository of the git sync and applies its bundle. It returns the applied plan and the commit.
+func (c *GitSyncController) apply(ctx context.Context, gitSync *hfv1.GitSync) (Plan, string, error) {
+	credentials, err := c.credentials(ctx, gitSync.Spec.CredentialsSecret)
+	if err != nil {
+		return Plan{}, "", err
+	}
+
+	checkout := filepath.Join(c.workDir, gitSync.Name)
+	commit, err := Checkout(ctx, checkout, gitSync.Spec.Repository, gitSync.Spec.Branch, credentials)
+	if err != nil {
+		return Plan{}, "", err
+	}
+	dir, err := bundlePath(checkout, gitSync.Spec.Path)
+	if err != nil {
+		return Plan{}, "", err
+	}
+	files, err := ReadDir(dir)
+	if err != nil {
+		return Plan{}, "", err
+	}
+	desired, err := ReadBundle(files)
+	if err != nil {
+		return Plan{}, "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	current, owners, err := c.applier.Load(ctx)
+	if err != nil {
+		return Plan{}, "", err
+	}
+	prune := make([]Ref, 0)
+	if gitSync.Spec.Prune {
+		prune = Owned(owners, gitSync.Name)
+	}
+	plan, err := NewPlan(desired, current, prune)
+	if err != nil {
+		return Plan{}, "", err
+	}
+	deleted := make([]Ref, 0)
+	for _, change := range plan.Changes {
+		if change.Action == ActionDelete {
+			deleted = append(deleted, change.Ref)
+		}
+	}
+	if err := desired.Validate(current, deleted); err != nil {
+		return Plan{}, "", err
+	}
+	if err := c.applier.Apply(ctx, desired, plan, gitSync.Name); err != nil {
+		return Plan{}, "", err
+	}
+	return plan, commit, nil
+}
+
+// credentials reads the credentials of a git sync from its secret, nil if the git sync has no secret.
+func (c *GitSyncController) credentials(ctx context.Context, secretName string) (*Credentials, error) {
+	if secretName == "" {
+		return nil, nil
+	}
+	secret, err := c.kubeClient.CoreV1().Secrets(c.namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials secret %s: %v", secretName, err)
+	}
+	return &Credentials{
+		Username: string(secret.Data["username"]),
+		Password: string(secret.Data["password"]),
+	}, nil
+}
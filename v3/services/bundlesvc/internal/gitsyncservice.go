@@ -0,0 +1,189 @@
+package bundleservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+type PreparedGitSync struct {
+	Id string `json:"id"`
+	hfv1.GitSyncSpec
+	Status hfv1.GitSyncStatus `json:"status"`
+}
+
+func validateGitSyncSpec(spec hfv1.GitSyncSpec) error {
+	if err := ValidateRepository(spec.Repository); err != nil {
+		return err
+	}
+	if _, err := syncInterval(spec); err != nil {
+		return err
+	}
+	return validatePath(spec.Path)
+}
+
+func (s BundleServer) authorizeGitSyncs(w http.ResponseWriter, r *http.Request, verb string) bool {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return false
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralGitSync, verb))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", fmt.Sprintf("no access to %s git syncs", verb))
+		return false
+	}
+	return true
+}
+
+func (s BundleServer) ListGitSyncsFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeGitSyncs(w, r, rbac.VerbList) {
+		return
+	}
+
+	gitSyncs, err := s.gitSyncLister.GitSyncs(s.namespace).List(labels.Everything())
+	if err != nil {
+		glog.Errorf("error listing git syncs: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error listing git syncs")
+		return
+	}
+
+	preparedGitSyncs := make([]PreparedGitSync, 0, len(gitSyncs))
+	for _, gitSync := range gitSyncs {
+		preparedGitSyncs = append(preparedGitSyncs, PreparedGitSync{Id: gitSync.Name, GitSyncSpec: gitSync.Spec, Status: gitSync.Status})
+	}
+	sort.Slice(preparedGitSyncs, func(i, j int) bool {
+		return preparedGitSyncs[i].Id < preparedGitSyncs[j].Id
+	})
+
+	encoded, err := json.Marshal(preparedGitSyncs)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+func (s BundleServer) CreateGitSyncFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeGitSyncs(w, r, rbac.VerbCreate) {
+		return
+	}
+
+	var spec hfv1.GitSyncSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid json body")
+		return
+	}
+	if err := validateGitSyncSpec(spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	// the same bundle can only be synced once, two git syncs would fight over its resources
+	gitSync := &hfv1.GitSync{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: util.GenerateResourceName("gs", spec.Repository+spec.Branch+spec.Path, 10),
+		},
+		Spec: spec,
+	}
+	created, err := s.hfClientSet.HobbyfarmV1().GitSyncs(s.namespace).Create(r.Context(), gitSync, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusConflict, "conflict", fmt.Sprintf("git sync %s already syncs this bundle", gitSync.Name))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error creating git sync: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error creating git sync")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 201, "created", created.Name)
+	glog.V(4).Infof("Created git sync %s", created.Name)
+}
+
+func (s BundleServer) UpdateGitSyncFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeGitSyncs(w, r, rbac.VerbUpdate) {
+		return
+	}
+
+	gitSyncId := mux.Vars(r)["id"]
+	var spec hfv1.GitSyncSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid json body")
+		return
+	}
+	if err := validateGitSyncSpec(spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		gitSync, err := s.hfClientSet.HobbyfarmV1().GitSyncs(s.namespace).Get(context.Background(), gitSyncId, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		gitSync.Spec = spec
+		_, err = s.hfClientSet.HobbyfarmV1().GitSyncs(s.namespace).Update(context.Background(), gitSync, metav1.UpdateOptions{})
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("git sync %s not found", gitSyncId))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error updating git sync %s: %v", gitSyncId, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error updating git sync")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 200, "updated", gitSyncId)
+}
+
+func (s BundleServer) DeleteGitSyncFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeGitSyncs(w, r, rbac.VerbDelete) {
+		return
+	}
+
+	// resources applied by the git sync are kept, they are no longer pruned
+	gitSyncId := mux.Vars(r)["id"]
+	err := s.hfClientSet.HobbyfarmV1().GitSyncs(s.namespace).Delete(r.Context(), gitSyncId, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("git sync %s not found", gitSyncId))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error deleting git sync %s: %v", gitSyncId, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error deleting git sync")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 200, "deleted", gitSyncId)
+}
+
+// SyncGitSyncFunc syncs a git sync right away, also if it is suspended.
+func (s BundleServer) SyncGitSyncFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeGitSyncs(w, r, rbac.VerbUpdate) {
+		return
+	}
+
+	gitSyncId := mux.Vars(r)["id"]
+	if _, err := s.gitSyncLister.GitSyncs(s.namespace).Get(gitSyncId); err != nil {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("git sync %s not found", gitSyncId))
+		return
+	}
+	s.controller.Trigger(gitSyncId)
+
+	util.ReturnHTTPMessage(w, r, 202, "accepted", gitSyncId)
+}
@@ -0,0 +1,113 @@
+package bundleservice
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// references returns the resources a resource of the bundle references.
+func (b *Bundle) references(ref Ref) []Ref {
+	refs := make([]Ref, 0)
+	vmTemplates := func(virtualMachines []map[string]string) {
+		for _, vmSet := range virtualMachines {
+			for _, vmTemplate := range vmSet {
+				refs = append(refs, Ref{Kind: KindVMTemplate, Id: vmTemplate})
+			}
+		}
+	}
+
+	switch ref.Kind {
+	case KindScenario:
+		scenario := b.Scenarios[ref.Id]
+		for _, step := range scenario.Steps {
+			if step.Quiz != "" {
+				refs = append(refs, Ref{Kind: KindQuiz, Id: step.Quiz})
+			}
+		}
+		vmTemplates(scenario.VirtualMachines)
+	case KindCourse:
+		course := b.Courses[ref.Id]
+		for _, scenario := range course.Scenarios {
+			refs = append(refs, Ref{Kind: KindScenario, Id: scenario})
+		}
+		vmTemplates(course.VirtualMachines)
+	}
+	return refs
+}
+
+// Collect returns the requested courses and scenarios of the bundle together with every resource they reference.
+// The whole bundle is returned if neither courses nor scenarios are requested.
+func (b *Bundle) Collect(courses []string, scenarios []string) (*Bundle, error) {
+	if len(courses) == 0 && len(scenarios) == 0 {
+		return b, nil
+	}
+
+	collected := NewBundle()
+	queue := make([]Ref, 0, len(courses)+len(scenarios))
+	for _, course := range courses {
+		queue = append(queue, Ref{Kind: KindCourse, Id: course})
+	}
+	for _, scenario := range scenarios {
+		queue = append(queue, Ref{Kind: KindScenario, Id: scenario})
+	}
+
+	missing := make([]string, 0)
+	for len(queue) > 0 {
+		ref := queue[0]
+		queue = queue[1:]
+		if collected.Has(ref) {
+			continue
+		}
+		if !b.Has(ref) {
+			missing = append(missing, ref.String())
+			continue
+		}
+		b.copyResource(ref, collected)
+		queue = append(queue, b.references(ref)...)
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("resources not found: %s", strings.Join(missing, ", "))
+	}
+	return collected, nil
+}
+
+// Validate checks that the references of the bundle resolve once the bundle has been applied onto the current
+// resources and the deleted resources are gone. Current resources that are not part of the bundle must not reference
+// deleted resources either. Other broken references of current resources are not the concern of the bundle.
+func (b *Bundle) Validate(current *Bundle, deleted []Ref) error {
+	isDeleted := make(map[Ref]bool, len(deleted))
+	for _, ref := range deleted {
+		isDeleted[ref] = true
+	}
+	resolves := func(ref Ref) bool {
+		return b.Has(ref) || current.Has(ref) && !isDeleted[ref]
+	}
+
+	problems := make([]string, 0)
+	for _, ref := range b.Refs() {
+		for _, reference := range b.references(ref) {
+			if !resolves(reference) {
+				problems = append(problems, fmt.Sprintf("%s references missing %s", ref, reference))
+			}
+		}
+	}
+	for _, ref := range current.Refs() {
+		if b.Has(ref) || isDeleted[ref] {
+			continue
+		}
+		for _, reference := range current.references(ref) {
+			if isDeleted[reference] {
+				problems = append(problems, fmt.Sprintf("%s references deleted %s", ref, reference))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fmt.Errorf("unresolved references: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package bundleservice
+
+import (
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollect(t *testing.T) {
+	b := testBundle()
+	b.Scenarios["other"] = hfv1.ScenarioSpec{Name: encode("Other")}
+
+	collected, err := b.Collect(nil, []string{"intro"})
+	require.NoError(t, err)
+	assert.Equal(t, []Ref{
+		{Kind: KindVMTemplate, Id: "ubuntu"},
+		{Kind: KindQuiz, Id: "basics"},
+		{Kind: KindScenario, Id: "intro"},
+	}, collected.Refs())
+
+	collected, err = b.Collect([]string{"course"}, nil)
+	require.NoError(t, err)
+	assert.Len(t, collected.Refs(), 4)
+
+	collected, err = b.Collect(nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, collected.Refs(), 5)
+
+	_, err = b.Collect([]string{"missing"}, nil)
+	assert.EqualError(t, err, "resources not found: course/missing")
+}
+
+func TestValidate(t *testing.T) {
+	current := testBundle()
+
+	// a scenario referencing a template that only exists in the cluster
+	desired := NewBundle()
+	desired.Scenarios["lab"] = hfv1.ScenarioSpec{Name: encode("Lab"), VirtualMachines: []map[string]string{{"node": "ubuntu"}}}
+	assert.NoError(t, desired.Validate(current, nil))
+
+	desired.Scenarios["lab"] = hfv1.ScenarioSpec{Name: encode("Lab"), Steps: []hfv1.ScenarioStep{{Quiz: "missing"}}}
+	assert.EqualError(t, desired.Validate(current, nil), "unresolved references: scenario/lab references missing quiz/missing")
+
+	// deleting a quiz still used by a scenario of the cluster
+	assert.EqualError(t, NewBundle().Validate(current, []Ref{{Kind: KindQuiz, Id: "basics"}}),
+		"unresolved references: scenario/intro references deleted quiz/basics")
+	assert.NoError(t, NewBundle().Validate(current, []Ref{
+		{Kind: KindQuiz, Id: "basics"},
+		{Kind: KindScenario, Id: "intro"},
+		{Kind: KindCourse, Id: "course"},
+	}))
+}
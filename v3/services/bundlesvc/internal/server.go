@@ -0,0 +1,56 @@
+package bundleservice
+
+import (
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	listersv1 "github.com/hobbyfarm/gargantua/v3/pkg/client/listers/hobbyfarm.io/v1"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+)
+
+type BundleServer struct {
+	authnClient   authnpb.AuthNClient
+	authrClient   authrpb.AuthRClient
+	hfClientSet   hfClientset.Interface
+	namespace     string
+	applier       Applier
+	applyLock     *sync.Mutex
+	gitSyncLister listersv1.GitSyncLister
+	controller    *GitSyncController
+}
+
+func NewBundleServer(
+	authnClient authnpb.AuthNClient,
+	authrClient authrpb.AuthRClient,
+	hfClientSet hfClientset.Interface,
+	namespace string,
+	applier Applier,
+	applyLock *sync.Mutex,
+	gitSyncLister listersv1.GitSyncLister,
+	controller *GitSyncController,
+) BundleServer {
+	return BundleServer{
+		authnClient:   authnClient,
+		authrClient:   authrClient,
+		hfClientSet:   hfClientSet,
+		namespace:     namespace,
+		applier:       applier,
+		applyLock:     applyLock,
+		gitSyncLister: gitSyncLister,
+		controller:    controller,
+	}
+}
+
+func (s BundleServer) SetupRoutes(r *mux.Router) {
+	r.HandleFunc("/a/bundle/export", s.ExportFunc).Methods("GET")
+	r.HandleFunc("/a/bundle/import", s.ImportFunc).Methods("POST")
+	r.HandleFunc("/a/gitsync/list", s.ListGitSyncsFunc).Methods("GET")
+	r.HandleFunc("/a/gitsync/create", s.CreateGitSyncFunc).Methods("POST")
+	r.HandleFunc("/a/gitsync/{id}/update", s.UpdateGitSyncFunc).Methods("PUT")
+	r.HandleFunc("/a/gitsync/{id}/delete", s.DeleteGitSyncFunc).Methods("DELETE")
+	r.HandleFunc("/a/gitsync/{id}/sync", s.SyncGitSyncFunc).Methods("POST")
+	glog.V(2).Infof("set up routes for BundleServer")
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
+	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+
+	bundleService "github.com/hobbyfarm/gargantua/services/bundlesvc/v3/internal"
+	hfInformers "github.com/hobbyfarm/gargantua/v3/pkg/client/informers/externalversions"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+)
+
+var (
+	serviceConfig *microservices.ServiceConfig
+)
+
+func init() {
+	serviceConfig = microservices.BuildServiceConfig()
+}
+
+func main() {
+	stopCh := signals.SetupSignalHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	// GITSYNC_WORKDIR holds the checkouts of the git syncs, they are fetched again if it is not persisted
+	workDir := os.Getenv("GITSYNC_WORKDIR")
+	if workDir == "" {
+		workDir = filepath.Join(os.TempDir(), "gitsync")
+	}
+
+	cfg, hfClient, kubeClient := microservices.BuildClusterConfig(serviceConfig)
+
+	namespace := util.GetReleaseNamespace()
+	hfInformerFactory := hfInformers.NewSharedInformerFactoryWithOptions(hfClient, time.Second*30, hfInformers.WithNamespace(namespace))
+
+	crd.InstallCrds(bundleService.BundleCRDInstaller{}, cfg, "bundle")
+
+	services := []microservices.MicroService{
+		microservices.AuthN,
+		microservices.AuthR,
+	}
+	connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
+	for _, conn := range connections {
+		defer conn.Close()
+	}
+
+	authnClient := authnpb.NewAuthNClient(connections[microservices.AuthN])
+	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
+
+	gitSyncLister := hfInformerFactory.Hobbyfarm().V1().GitSyncs().Lister()
+
+	applier := bundleService.NewApplier(hfClient, namespace)
+	applyLock := &sync.Mutex{}
+	controller := bundleService.NewGitSyncController(
+		hfClient,
+		kubeClient,
+		namespace,
+		gitSyncLister,
+		applier,
+		applyLock,
+		workDir,
+	)
+
+	hfInformerFactory.Start(stopCh)
+	hfInformerFactory.WaitForCacheSync(stopCh)
+
+	var wg sync.WaitGroup
+	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		controller.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		bundleServer := bundleService.NewBundleServer(
+			authnClient,
+			authrClient,
+			hfClient,
+			namespace,
+			applier,
+			applyLock,
+			gitSyncLister,
+			controller,
+		)
+		microservices.StartAPIServer(bundleServer)
+	}()
+
+	wg.Wait()
+}
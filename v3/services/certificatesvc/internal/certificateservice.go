@@ -0,0 +1,292 @@
+package certificateservice
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+type PreparedCertificate struct {
+	Id             string   `json:"id"`
+	VerificationId string   `json:"verification_id"`
+	Template       string   `json:"template"`
+	User           string   `json:"user"`
+	Course         string   `json:"course,omitempty"`
+	Scenario       string   `json:"scenario,omitempty"`
+	ScheduledEvent string   `json:"scheduled_event"`
+	Progresses     []string `json:"progresses"`
+	Title          string   `json:"title"`
+	Body           string   `json:"body"`
+	Issuer         string   `json:"issuer"`
+	IssuedAt       string   `json:"issued_at"`
+}
+
+// PreparedVerification is returned by the public verification endpoint, it does not expose ids of the user.
+type PreparedVerification struct {
+	Valid    bool   `json:"valid"`
+	Title    string `json:"title,omitempty"`
+	Body     string `json:"body,omitempty"`
+	Issuer   string `json:"issuer,omitempty"`
+	IssuedAt string `json:"issued_at,omitempty"`
+}
+
+func NewPreparedCertificate(certificate *hfv1.Certificate) PreparedCertificate {
+	return PreparedCertificate{
+		Id:             certificate.Name,
+		VerificationId: VerificationId(certificate),
+		Template:       certificate.Spec.Template,
+		User:           certificate.Spec.User,
+		Course:         certificate.Spec.Course,
+		Scenario:       certificate.Spec.Scenario,
+		ScheduledEvent: certificate.Spec.ScheduledEvent,
+		Progresses:     certificate.Spec.Progresses,
+		Title:          certificate.Spec.Title,
+		Body:           certificate.Spec.Body,
+		Issuer:         certificate.Spec.Issuer,
+		IssuedAt:       certificate.Spec.IssuedAt,
+	}
+}
+
+// VerifyFunc is public. It tells whether a verification id belongs to a certificate that was issued by hobbyfarm
+// and has not been altered since.
+func (s CertificateServer) VerifyFunc(w http.ResponseWriter, r *http.Request) {
+	verificationId := mux.Vars(r)["id"]
+	invalid, _ := json.Marshal(PreparedVerification{Valid: false})
+
+	name, suffix, err := ParseVerificationId(verificationId)
+	if err != nil {
+		util.ReturnHTTPContent(w, r, http.StatusNotFound, "notfound", invalid)
+		return
+	}
+
+	certificate, err := s.certificateLister.Certificates(s.namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPContent(w, r, http.StatusNotFound, "notfound", invalid)
+		return
+	}
+	if err != nil {
+		glog.Errorf("error retrieving certificate %s: %v", name, err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error verifying certificate")
+		return
+	}
+
+	if VerificationId(certificate) != name+"."+suffix || !s.signer.Valid(certificate) {
+		util.ReturnHTTPContent(w, r, http.StatusNotFound, "notfound", invalid)
+		return
+	}
+
+	encoded, err := json.Marshal(PreparedVerification{
+		Valid:    true,
+		Title:    certificate.Spec.Title,
+		Body:     certificate.Spec.Body,
+		Issuer:   certificate.Spec.Issuer,
+		IssuedAt: certificate.Spec.IssuedAt,
+	})
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+func (s CertificateServer) ListForUserFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	s.listBySelector(w, r, labels.SelectorFromSet(labels.Set{hflabels.UserLabel: user.GetId()}))
+}
+
+func (s CertificateServer) ListByScheduledEventFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralCertificate, rbac.VerbList))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list certificates")
+		return
+	}
+
+	scheduledEventId := mux.Vars(r)["id"]
+	if len(scheduledEventId) == 0 {
+		util.ReturnHTTPMessage(w, r, 400, "bad request", "no scheduled event id passed in")
+		return
+	}
+
+	s.listBySelector(w, r, labels.SelectorFromSet(labels.Set{hflabels.ScheduledEventLabel: scheduledEventId}))
+}
+
+func (s CertificateServer) listBySelector(w http.ResponseWriter, r *http.Request, selector labels.Selector) {
+	certificates, err := s.certificateLister.Certificates(s.namespace).List(selector)
+	if err != nil {
+		glog.Errorf("error listing certificates: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error listing certificates")
+		return
+	}
+
+	preparedCertificates := make([]PreparedCertificate, 0, len(certificates))
+	for _, certificate := range certificates {
+		preparedCertificates = append(preparedCertificates, NewPreparedCertificate(certificate))
+	}
+	sort.Slice(preparedCertificates, func(i, j int) bool {
+		return preparedCertificates[i].Id < preparedCertificates[j].Id
+	})
+
+	encoded, err := json.Marshal(preparedCertificates)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+// DownloadFunc renders a certificate as pdf or png. Users can download their own certificates,
+// certificates of other users require the permission to get certificates.
+func (s CertificateServer) DownloadFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	vars := mux.Vars(r)
+	certificateId := vars["id"]
+	format := vars["format"]
+
+	certificate, err := s.certificateLister.Certificates(s.namespace).Get(certificateId)
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("certificate %s not found", certificateId))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error retrieving certificate %s: %v", certificateId, err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error retrieving certificate")
+		return
+	}
+
+	if certificate.Spec.User != user.GetId() {
+		authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralCertificate, rbac.VerbGet))
+		if err != nil || !authrResponse.Success {
+			util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to get certificate")
+			return
+		}
+	}
+
+	content, contentType, err := Render(certificate, format)
+	if err != nil {
+		glog.Errorf("error rendering certificate %s: %v", certificateId, err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error rendering certificate")
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", "attachment; filename=\""+certificateId+"."+format+"\"")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(content); err != nil {
+		glog.Errorf("error writing certificate %s: %v", certificateId, err)
+	}
+}
+
+// ExportFunc returns a zip archive with every certificate issued in a scheduled event and an index.csv
+// that maps the certificates to the users.
+func (s CertificateServer) ExportFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralCertificate, rbac.VerbList))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list certificates")
+		return
+	}
+
+	scheduledEventId := mux.Vars(r)["id"]
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = FormatPDF
+	}
+	if format != FormatPDF && format != FormatPNG {
+		util.ReturnHTTPMessage(w, r, 400, "bad request", "format must be pdf or png")
+		return
+	}
+
+	certificates, err := s.certificateLister.Certificates(s.namespace).List(labels.SelectorFromSet(labels.Set{hflabels.ScheduledEventLabel: scheduledEventId}))
+	if err != nil {
+		glog.Errorf("error listing certificates: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error listing certificates")
+		return
+	}
+	if len(certificates) == 0 {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", "no certificates issued in scheduled event")
+		return
+	}
+	sort.Slice(certificates, func(i, j int) bool {
+		return certificates[i].Name < certificates[j].Name
+	})
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"certificates-"+scheduledEventId+".zip\"")
+	w.WriteHeader(http.StatusOK)
+
+	archive := zip.NewWriter(w)
+	defer archive.Close()
+
+	indexFile, err := archive.Create("index.csv")
+	if err != nil {
+		glog.Errorf("error writing certificate export: %v", err)
+		return
+	}
+	index := csv.NewWriter(indexFile)
+	index.Write([]string{"certificate", "verification_id", "user", "email", "title", "issued_at"})
+	emails := make(map[string]string)
+	for _, certificate := range certificates {
+		email, ok := emails[certificate.Spec.User]
+		if !ok {
+			u, err := s.userClient.GetUserById(r.Context(), &generalpb.GetRequest{Id: certificate.Spec.User, LoadFromCache: true})
+			if err != nil {
+				glog.Errorf("error retrieving user %s: %s", certificate.Spec.User, hferrors.GetErrorMessage(err))
+			}
+			email = u.GetEmail()
+			emails[certificate.Spec.User] = email
+		}
+		index.Write([]string{certificate.Name, VerificationId(certificate), certificate.Spec.User, email, certificate.Spec.Title, certificate.Spec.IssuedAt})
+	}
+	index.Flush()
+
+	for _, certificate := range certificates {
+		content, _, err := Render(certificate, format)
+		if err != nil {
+			glog.Errorf("error rendering certificate %s: %v", certificate.Name, err)
+			continue
+		}
+		file, err := archive.Create(certificate.Name + "." + format)
+		if err != nil {
+			glog.Errorf("error writing certificate export: %v", err)
+			return
+		}
+		if _, err := file.Write(content); err != nil {
+			glog.Errorf("error writing certificate export: %v", err)
+			return
+		}
+	}
+	glog.V(2).Infof("exported %d certificates of scheduled event %s", len(certificates), scheduledEventId)
+}
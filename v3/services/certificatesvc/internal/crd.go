@@ -0,0 +1,35 @@
+package certificateservice
+
+import (
+	"github.com/ebauman/crder"
+	v1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
+)
+
+// CertificateCRDInstaller is a struct that can generate CRDs for certificates and certificate templates.
+// It implements the CrdInstaller interface defined in "github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+type CertificateCRDInstaller struct{}
+
+func (ci CertificateCRDInstaller) GenerateCRDs() []crder.CRD {
+	return []crder.CRD{
+		crd.HobbyfarmCRD(&v1.CertificateTemplate{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.CertificateTemplate{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("Course", ".spec.course").
+						WithColumn("Scenario", ".spec.scenario")
+				})
+		}),
+		crd.HobbyfarmCRD(&v1.Certificate{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.Certificate{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("User", ".spec.user").
+						WithColumn("ScheduledEvent", ".spec.scheduled_event").
+						WithColumn("IssuedAt", ".spec.issued_at")
+				})
+		}),
+	}
+}
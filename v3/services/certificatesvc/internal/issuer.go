@@ -0,0 +1,423 @@
+package certificateservice
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	listersv1 "github.com/hobbyfarm/gargantua/v3/pkg/client/listers/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	coursepb "github.com/hobbyfarm/gargantua/v3/protos/course"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Issuer evaluates the finished progresses of a user against the certificate templates whenever a progress of the
+// user finishes or a template changes, and issues the certificates that were earned. Certificate names are derived
+// from template, user and scheduled event, which makes issuing idempotent.
+type Issuer struct {
+	hfClientSet          hfClientset.Interface
+	namespace            string
+	signer               *Signer
+	templateLister       listersv1.CertificateTemplateLister
+	certificateLister    listersv1.CertificateLister
+	progressClient       progresspb.ProgressSvcClient
+	quizEvaluationClient quizpb.QuizEvaluationSvcClient
+	scenarioClient       scenariopb.ScenarioSvcClient
+	courseClient         coursepb.CourseSvcClient
+	userClient           userpb.UserSvcClient
+	scheduledEventClient scheduledeventpb.ScheduledEventSvcClient
+	progressInformer     cache.SharedIndexInformer
+
+	// queue holds the ids of the users whose finished progresses are evaluated next
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	// evaluated remembers the progresses a template and user were last evaluated with,
+	// they are only evaluated again when a progress finishes or the template changes.
+	evaluated map[string]string
+}
+
+func NewIssuer(
+	hfClientSet hfClientset.Interface,
+	namespace string,
+	signer *Signer,
+	templateLister listersv1.CertificateTemplateLister,
+	certificateLister listersv1.CertificateLister,
+	progressClient progresspb.ProgressSvcClient,
+	quizEvaluationClient quizpb.QuizEvaluationSvcClient,
+	scenarioClient scenariopb.ScenarioSvcClient,
+	courseClient coursepb.CourseSvcClient,
+	userClient userpb.UserSvcClient,
+	scheduledEventClient scheduledeventpb.ScheduledEventSvcClient,
+	progressInformer cache.SharedIndexInformer,
+	templateInformer cache.SharedIndexInformer,
+) (*Issuer, error) {
+	i := &Issuer{
+		hfClientSet:          hfClientSet,
+		namespace:            namespace,
+		signer:               signer,
+		templateLister:       templateLister,
+		certificateLister:    certificateLister,
+		progressClient:       progressClient,
+		quizEvaluationClient: quizEvaluationClient,
+		scenarioClient:       scenarioClient,
+		courseClient:         courseClient,
+		userClient:           userClient,
+		scheduledEventClient: scheduledEventClient,
+		progressInformer:     progressInformer,
+		queue:                workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]()),
+		evaluated:            make(map[string]string),
+	}
+
+	// progresses are evaluated once, when they finish. the initial list enqueues every finished progress, so
+	// progresses finished while the service was down are evaluated on startup.
+	_, err := progressInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			i.enqueueFinished(nil, obj)
+		},
+		UpdateFunc: func(old, obj interface{}) {
+			i.enqueueFinished(old, obj)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// a changed template is evaluated against the finished progresses of every user
+	_, err = templateInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(_ interface{}) {
+			i.enqueueAll()
+		},
+		UpdateFunc: func(old, obj interface{}) {
+			if old.(*hfv1.CertificateTemplate).ResourceVersion != obj.(*hfv1.CertificateTemplate).ResourceVersion {
+				i.enqueueAll()
+			}
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func isFinished(progress *hfv1.Progress) bool {
+	return progress.Labels["finished"] == "true"
+}
+
+// enqueueFinished enqueues the user of a progress that has just finished.
+func (i *Issuer) enqueueFinished(old interface{}, obj interface{}) {
+	progress, ok := obj.(*hfv1.Progress)
+	if !ok || !isFinished(progress) {
+		return
+	}
+	if oldProgress, ok := old.(*hfv1.Progress); ok && isFinished(oldProgress) {
+		return
+	}
+	i.queue.Add(progress.Spec.UserId)
+}
+
+// enqueueAll enqueues every user with a finished progress.
+func (i *Issuer) enqueueAll() {
+	for _, obj := range i.progressInformer.GetStore().List() {
+		if progress, ok := obj.(*hfv1.Progress); ok && isFinished(progress) {
+			i.queue.Add(progress.Spec.UserId)
+		}
+	}
+}
+
+// Run evaluates the enqueued users until ctx is cancelled. Users are enqueued again with a backoff if issuing fails.
+func (i *Issuer) Run(ctx context.Context) {
+	go func() {
+		<-ctx.Done()
+		i.queue.ShutDown()
+	}()
+
+	for {
+		user, shutdown := i.queue.Get()
+		if shutdown {
+			return
+		}
+		if err := i.reconcileUser(ctx, user); err != nil {
+			glog.Errorf("error issuing certificates to user %s: %v", user, err)
+			i.queue.AddRateLimited(user)
+		} else {
+			i.queue.Forget(user)
+		}
+		i.queue.Done(user)
+	}
+}
+
+// reconcileUser issues the certificates the user earned with the finished progresses. Progresses are evaluated per
+// scheduled event, a user earns the certificate of a template once in every scheduled event.
+func (i *Issuer) reconcileUser(ctx context.Context, user string) error {
+	templates, err := i.templateLister.CertificateTemplates(i.namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	if len(templates) == 0 {
+		return nil
+	}
+
+	progressList, err := i.progressClient.ListProgress(ctx, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", "finished", "true", hflabels.UserLabel, user),
+		LoadFromCache: true,
+	})
+	if err != nil {
+		return fmt.Errorf("error listing finished progresses: %s", hferrors.GetErrorMessage(err))
+	}
+
+	progressesByEvent := make(map[string][]*progresspb.Progress)
+	for _, progress := range progressList.GetProgresses() {
+		scheduledEvent := progress.GetLabels()[hflabels.ScheduledEventLabel]
+		progressesByEvent[scheduledEvent] = append(progressesByEvent[scheduledEvent], progress)
+	}
+
+	var issueErr error
+	for _, template := range templates {
+		for scheduledEvent, progresses := range progressesByEvent {
+			candidates := make([]*progresspb.Progress, 0)
+			for _, progress := range progresses {
+				if template.Spec.Course != "" && progress.GetCourse() == template.Spec.Course ||
+					template.Spec.Course == "" && progress.GetScenario() == template.Spec.Scenario {
+					candidates = append(candidates, progress)
+				}
+			}
+			if len(candidates) == 0 {
+				continue
+			}
+
+			name := certificateName(template.Name, user, scheduledEvent)
+			if _, err := i.certificateLister.Certificates(i.namespace).Get(name); err == nil {
+				continue
+			}
+
+			fingerprint := evaluationFingerprint(template, candidates)
+			if i.evaluated[name] == fingerprint {
+				continue
+			}
+
+			earned, err := i.earnedWith(ctx, template, user, candidates)
+			if err != nil {
+				glog.Errorf("error evaluating template %s for user %s: %v", template.Name, user, err)
+				issueErr = err
+				continue
+			}
+			i.evaluated[name] = fingerprint
+			if len(earned) == 0 {
+				continue
+			}
+
+			if err := i.issue(ctx, template, user, scheduledEvent, earned); err != nil {
+				glog.Errorf("error issuing certificate of template %s to user %s: %v", template.Name, user, err)
+				delete(i.evaluated, name)
+				issueErr = err
+			}
+		}
+	}
+	return issueErr
+}
+
+// earnedWith returns the progresses the user earned the certificate of the template with, none if it was not earned.
+// A course certificate requires a progress fulfilling the rules for every scenario of the course.
+func (i *Issuer) earnedWith(ctx context.Context, template *hfv1.CertificateTemplate, user string, candidates []*progresspb.Progress) ([]*progresspb.Progress, error) {
+	scenarios := []string{template.Spec.Scenario}
+	if template.Spec.Course != "" {
+		course, err := i.courseClient.GetCourse(ctx, &generalpb.GetRequest{Id: template.Spec.Course, LoadFromCache: true})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving course %s: %s", template.Spec.Course, hferrors.GetErrorMessage(err))
+		}
+		scenarios = course.GetScenarios()
+		if len(scenarios) == 0 {
+			return nil, nil
+		}
+	}
+
+	earned := make([]*progresspb.Progress, 0)
+	for _, scenarioId := range scenarios {
+		scenario, err := i.scenarioClient.GetScenario(ctx, &generalpb.GetRequest{Id: scenarioId, LoadFromCache: true})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving scenario %s: %s", scenarioId, hferrors.GetErrorMessage(err))
+		}
+		quizzes := scenarioQuizzes(scenario)
+		evaluations, err := i.quizEvaluations(ctx, user, scenarioId, quizzes)
+		if err != nil {
+			return nil, err
+		}
+
+		var fulfilled *progresspb.Progress
+		for _, progress := range candidates {
+			if progress.GetScenario() != scenarioId {
+				continue
+			}
+			result := scenarioResult{progress: progress, quizzes: quizzes, evaluations: evaluations}
+			if fulfillsRules(template.Spec.Rules, result) {
+				fulfilled = progress
+				break
+			}
+		}
+		if fulfilled == nil {
+			return nil, nil
+		}
+		earned = append(earned, fulfilled)
+	}
+	return earned, nil
+}
+
+func (i *Issuer) quizEvaluations(ctx context.Context, user string, scenario string, quizzes []string) (map[string]*quizpb.QuizEvaluation, error) {
+	evaluations := make(map[string]*quizpb.QuizEvaluation)
+	for _, quizId := range quizzes {
+		evaluation, err := i.quizEvaluationClient.GetQuizEvaluationForUser(ctx, &quizpb.GetQuizEvaluationForUserRequest{
+			Quiz:     quizId,
+			User:     user,
+			Scenario: scenario,
+		})
+		if hferrors.IsGrpcNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving evaluation of quiz %s: %s", quizId, hferrors.GetErrorMessage(err))
+		}
+		evaluations[quizId] = evaluation
+	}
+	return evaluations, nil
+}
+
+func (i *Issuer) issue(ctx context.Context, template *hfv1.CertificateTemplate, user string, scheduledEvent string, progresses []*progresspb.Progress) error {
+	issuedAt := time.Now()
+
+	data, err := i.templateData(ctx, template, user, scheduledEvent, issuedAt)
+	if err != nil {
+		return err
+	}
+	title, err := renderText("title", template.Spec.Title, data)
+	if err != nil {
+		return fmt.Errorf("error rendering title: %v", err)
+	}
+	body, err := renderText("body", template.Spec.Body, data)
+	if err != nil {
+		return fmt.Errorf("error rendering body: %v", err)
+	}
+
+	progressIds := make([]string, 0, len(progresses))
+	for _, progress := range progresses {
+		progressIds = append(progressIds, progress.GetId())
+	}
+
+	certificate := &hfv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: certificateName(template.Name, user, scheduledEvent),
+			Labels: map[string]string{
+				hflabels.CertTemplateLabel:   template.Name,
+				hflabels.UserLabel:           user,
+				hflabels.ScheduledEventLabel: scheduledEvent,
+			},
+		},
+		Spec: hfv1.CertificateSpec{
+			Template:       template.Name,
+			User:           user,
+			Course:         template.Spec.Course,
+			Scenario:       template.Spec.Scenario,
+			ScheduledEvent: scheduledEvent,
+			Progresses:     progressIds,
+			Title:          title,
+			Body:           body,
+			Issuer:         template.Spec.Issuer,
+			IssuedAt:       issuedAt.Format(time.UnixDate),
+		},
+	}
+	certificate.Spec.Signature = i.signer.Sign(certificate)
+
+	_, err = i.hfClientSet.HobbyfarmV1().Certificates(i.namespace).Create(ctx, certificate, metav1.CreateOptions{})
+	if apierrors.IsAlreadyExists(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	glog.V(2).Infof("issued certificate %s of template %s to user %s", certificate.Name, template.Name, user)
+	return nil
+}
+
+func (i *Issuer) templateData(ctx context.Context, template *hfv1.CertificateTemplate, user string, scheduledEvent string, issuedAt time.Time) (TemplateData, error) {
+	data := TemplateData{Date: issuedAt.Format("January 2, 2006")}
+
+	u, err := i.userClient.GetUserById(ctx, &generalpb.GetRequest{Id: user, LoadFromCache: true})
+	if err != nil {
+		return data, fmt.Errorf("error retrieving user %s: %s", user, hferrors.GetErrorMessage(err))
+	}
+	data.User = u.GetEmail()
+
+	if template.Spec.Course != "" {
+		course, err := i.courseClient.GetCourse(ctx, &generalpb.GetRequest{Id: template.Spec.Course, LoadFromCache: true})
+		if err != nil {
+			return data, fmt.Errorf("error retrieving course %s: %s", template.Spec.Course, hferrors.GetErrorMessage(err))
+		}
+		data.Course = decodeName(course.GetName())
+	} else {
+		scenario, err := i.scenarioClient.GetScenario(ctx, &generalpb.GetRequest{Id: template.Spec.Scenario, LoadFromCache: true})
+		if err != nil {
+			return data, fmt.Errorf("error retrieving scenario %s: %s", template.Spec.Scenario, hferrors.GetErrorMessage(err))
+		}
+		data.Scenario = decodeName(scenario.GetName())
+	}
+
+	if scheduledEvent != "" {
+		event, err := i.scheduledEventClient.GetScheduledEvent(ctx, &generalpb.GetRequest{Id: scheduledEvent, LoadFromCache: true})
+		if err != nil && !hferrors.IsGrpcNotFound(err) {
+			return data, fmt.Errorf("error retrieving scheduled event %s: %s", scheduledEvent, hferrors.GetErrorMessage(err))
+		}
+		data.Event = event.GetName()
+	}
+	return data, nil
+}
+
+func certificateName(template string, user string, scheduledEvent string) string {
+	return util.GenerateResourceName("cert", template+"/"+user+"/"+scheduledEvent, 16)
+}
+
+// evaluationFingerprint changes whenever the template is updated or a progress is added to the candidates.
+func evaluationFingerprint(template *hfv1.CertificateTemplate, progresses []*progresspb.Progress) string {
+	ids := make([]string, 0, len(progresses))
+	for _, progress := range progresses {
+		ids = append(ids, progress.GetId())
+	}
+	sort.Strings(ids)
+	return template.ResourceVersion + "/" + strings.Join(ids, ",")
+}
+
+func scenarioQuizzes(scenario *scenariopb.Scenario) []string {
+	quizzes := make([]string, 0)
+	for _, step := range scenario.GetSteps() {
+		if step.GetQuiz() != "" {
+			quizzes = append(quizzes, step.GetQuiz())
+		}
+	}
+	return quizzes
+}
+
+// decodeName decodes the base64 encoded names of scenarios and courses.
+func decodeName(name string) string {
+	decoded, err := base64.StdEncoding.DecodeString(name)
+	if err != nil {
+		return name
+	}
+	return string(decoded)
+}
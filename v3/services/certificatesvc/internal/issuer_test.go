@@ -0,0 +1,45 @@
+package certificateservice
+
+import (
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func testProgress(user string, finished string) *hfv1.Progress {
+	return &hfv1.Progress{
+		ObjectMeta: metav1.ObjectMeta{Name: "progress-" + user, Labels: map[string]string{"finished": finished}},
+		Spec:       hfv1.ProgressSpec{UserId: user},
+	}
+}
+
+func TestEnqueueFinished(t *testing.T) {
+	i := &Issuer{queue: workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[string]())}
+	defer i.queue.ShutDown()
+
+	// progresses in progress and resyncs of finished progresses are not evaluated again
+	i.enqueueFinished(nil, testProgress("u-active", "false"))
+	i.enqueueFinished(testProgress("u-resync", "true"), testProgress("u-resync", "true"))
+	assert.Equal(t, 0, i.queue.Len())
+
+	i.enqueueFinished(testProgress("u-finished", "false"), testProgress("u-finished", "true"))
+	i.enqueueFinished(nil, testProgress("u-listed", "true"))
+	assert.Equal(t, 2, i.queue.Len())
+
+	user, _ := i.queue.Get()
+	assert.Equal(t, "u-finished", user)
+	user, _ = i.queue.Get()
+	assert.Equal(t, "u-listed", user)
+}
+
+func TestCertificateName(t *testing.T) {
+	name := certificateName("ct-template", "u-user", "se-event")
+	assert.Equal(t, name, certificateName("ct-template", "u-user", "se-event"))
+	assert.NotEqual(t, name, certificateName("ct-template", "u-user", "se-other"))
+	assert.NotEqual(t, name, certificateName("ct-template", "u-other", "se-event"))
+	// the fields are separated, moving characters between them changes the name
+	assert.NotEqual(t, certificateName("ct-a", "b", ""), certificateName("ct-", "ab", ""))
+}
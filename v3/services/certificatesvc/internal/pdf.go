@@ -0,0 +1,135 @@
+package certificateservice
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// A4 landscape in points
+const (
+	pdfPageWidth  = 842
+	pdfPageHeight = 595
+	pdfMargin     = 60
+)
+
+// helveticaWidths are the widths of the printable ascii characters of Helvetica in 1/1000 of the font size.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// pdfTextWidth approximates the width of the text in points. Bold text is about 5% wider.
+func pdfTextWidth(text string, size float64, bold bool) float64 {
+	width := 0
+	for _, c := range text {
+		if c >= 32 && c <= 126 {
+			width += helveticaWidths[c-32]
+		} else {
+			width += 556
+		}
+	}
+	result := float64(width) * size / 1000
+	if bold {
+		result *= 1.05
+	}
+	return result
+}
+
+// pdfString encodes the text as pdf literal string in WinAnsiEncoding, characters outside of latin-1 are replaced.
+func pdfString(text string) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range text {
+		switch {
+		case c == '(' || c == ')' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(c)
+		case c >= 32 && c <= 126:
+			b.WriteRune(c)
+		case c >= 160 && c <= 255:
+			fmt.Fprintf(&b, "\\%03o", c)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+type pdfContent struct {
+	bytes.Buffer
+}
+
+// centered writes a line of text centered horizontally with its baseline at y.
+func (c *pdfContent) centered(text string, y float64, size float64, bold bool) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	x := (pdfPageWidth - pdfTextWidth(text, size, bold)) / 2
+	fmt.Fprintf(c, "BT /%s %.1f Tf %.2f %.2f Td %s Tj ET\n", font, size, x, y, pdfString(text))
+}
+
+// renderPDF lays out the certificate on a single landscape A4 page using the standard Helvetica fonts.
+func renderPDF(doc document) []byte {
+	var content pdfContent
+
+	// double border
+	content.WriteString("0.15 0.25 0.45 RG\n")
+	fmt.Fprintf(&content, "4 w 20 20 %d %d re S\n", pdfPageWidth-40, pdfPageHeight-40)
+	fmt.Fprintf(&content, "1 w 30 30 %d %d re S\n", pdfPageWidth-60, pdfPageHeight-60)
+
+	content.WriteString("0.15 0.25 0.45 rg\n")
+	y := float64(pdfPageHeight - 130)
+	titleWidth := func(s string) float64 { return pdfTextWidth(s, 30, true) }
+	for _, line := range wrapText(doc.Title, pdfPageWidth-2*pdfMargin, titleWidth) {
+		content.centered(line, y, 30, true)
+		y -= 38
+	}
+
+	content.WriteString("0 0 0 rg\n")
+	y -= 20
+	bodyWidth := func(s string) float64 { return pdfTextWidth(s, 15, false) }
+	for _, line := range wrapText(doc.Body, pdfPageWidth-2*pdfMargin, bodyWidth) {
+		if y < 170 {
+			break
+		}
+		content.centered(line, y, 15, false)
+		y -= 22
+	}
+
+	content.centered(doc.Issuer, 130, 16, true)
+	content.centered(doc.Date, 108, 12, false)
+
+	content.WriteString("0.4 0.4 0.4 rg\n")
+	content.centered("Verification ID: "+doc.VerificationId, 50, 9, false)
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> /Contents 4 0 R >>", pdfPageWidth, pdfPageHeight),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
@@ -0,0 +1,89 @@
+package certificateservice
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"unicode/utf8"
+)
+
+const (
+	pngWidth  = 1200
+	pngHeight = 848
+	pngMargin = 80
+)
+
+var (
+	pngBackground = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	pngAccent     = color.RGBA{R: 38, G: 64, B: 115, A: 255}
+	pngText       = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+	pngMuted      = color.RGBA{R: 102, G: 102, B: 102, A: 255}
+)
+
+// pngTextWidth returns the width of the text in pixels, glyphs are separated by one scaled pixel.
+func pngTextWidth(text string, scale int) float64 {
+	return float64(utf8.RuneCountInString(text) * (glyphWidth + 1) * scale)
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, c color.Color) {
+	draw.Draw(img, rect, &image.Uniform{C: c}, image.Point{}, draw.Src)
+}
+
+// drawCentered draws a line of text centered horizontally with its top at y.
+func drawCentered(img *image.RGBA, text string, y int, scale int, c color.Color) {
+	x := (pngWidth - int(pngTextWidth(text, scale))) / 2
+	for _, r := range text {
+		g := glyph(r)
+		for row := 0; row < glyphHeight; row++ {
+			for col := 0; col < glyphWidth; col++ {
+				if g[row][col] != '#' {
+					continue
+				}
+				px := x + col*scale
+				py := y + row*scale
+				fillRect(img, image.Rect(px, py, px+scale, py+scale), c)
+			}
+		}
+		x += (glyphWidth + 1) * scale
+	}
+}
+
+// renderPNG lays out the certificate with the embedded bitmap font.
+func renderPNG(doc document) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, pngWidth, pngHeight))
+	fillRect(img, img.Bounds(), pngBackground)
+
+	// double border
+	fillRect(img, image.Rect(24, 24, pngWidth-24, pngHeight-24), pngAccent)
+	fillRect(img, image.Rect(32, 32, pngWidth-32, pngHeight-32), pngBackground)
+	fillRect(img, image.Rect(44, 44, pngWidth-44, pngHeight-44), pngAccent)
+	fillRect(img, image.Rect(46, 46, pngWidth-46, pngHeight-46), pngBackground)
+
+	maxWidth := float64(pngWidth - 2*pngMargin)
+	y := 130
+	for _, line := range wrapText(doc.Title, maxWidth, func(s string) float64 { return pngTextWidth(s, 6) }) {
+		drawCentered(img, line, y, 6, pngAccent)
+		y += 56
+	}
+
+	y += 30
+	for _, line := range wrapText(doc.Body, maxWidth, func(s string) float64 { return pngTextWidth(s, 3) }) {
+		if y > pngHeight-260 {
+			break
+		}
+		drawCentered(img, line, y, 3, pngText)
+		y += 32
+	}
+
+	drawCentered(img, doc.Issuer, pngHeight-200, 4, pngAccent)
+	drawCentered(img, doc.Date, pngHeight-160, 3, pngText)
+	drawCentered(img, "VERIFICATION ID: "+doc.VerificationId, pngHeight-90, 2, pngMuted)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
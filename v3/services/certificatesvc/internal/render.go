@@ -0,0 +1,95 @@
+package certificateservice
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+)
+
+const (
+	FormatPDF = "pdf"
+	FormatPNG = "png"
+)
+
+// TemplateData is available in the title and body templates of a certificate template.
+type TemplateData struct {
+	User     string // email of the user
+	Course   string // name of the course, empty for scenario certificates
+	Scenario string // name of the scenario, empty for course certificates
+	Event    string // name of the scheduled event
+	Date     string // date of issuance
+}
+
+// renderText executes a title or body template of a certificate template.
+func renderText(name string, text string, data TemplateData) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// document is the content of an issued certificate that is laid out into a pdf or png.
+type document struct {
+	Title          string
+	Body           string
+	Issuer         string
+	Date           string
+	VerificationId string
+}
+
+func newDocument(certificate *hfv1.Certificate) document {
+	date := certificate.Spec.IssuedAt
+	if issuedAt, err := time.Parse(time.UnixDate, certificate.Spec.IssuedAt); err == nil {
+		date = issuedAt.Format("January 2, 2006")
+	}
+	return document{
+		Title:          certificate.Spec.Title,
+		Body:           certificate.Spec.Body,
+		Issuer:         certificate.Spec.Issuer,
+		Date:           date,
+		VerificationId: VerificationId(certificate),
+	}
+}
+
+// Render lays out the certificate in the given format and returns the file content and its content type.
+func Render(certificate *hfv1.Certificate, format string) ([]byte, string, error) {
+	doc := newDocument(certificate)
+	switch format {
+	case FormatPNG:
+		content, err := renderPNG(doc)
+		return content, "image/png", err
+	default:
+		return renderPDF(doc), "application/pdf", nil
+	}
+}
+
+// wrapText breaks the text into lines no wider than maxWidth. Line breaks of the text are kept.
+func wrapText(text string, maxWidth float64, width func(string) float64) []string {
+	lines := make([]string, 0)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+		line := words[0]
+		for _, word := range words[1:] {
+			if width(line+" "+word) > maxWidth {
+				lines = append(lines, line)
+				line = word
+				continue
+			}
+			line += " " + word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
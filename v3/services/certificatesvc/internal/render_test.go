@@ -0,0 +1,66 @@
+package certificateservice
+
+import (
+	"bytes"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderText(t *testing.T) {
+	data := TemplateData{User: "user@example.com", Scenario: "Kubernetes Basics", Date: "January 2, 2006"}
+
+	text, err := renderText("body", "{{ .User }} completed {{ .Scenario }} on {{ .Date }}", data)
+	assert.NoError(t, err)
+	assert.Equal(t, "user@example.com completed Kubernetes Basics on January 2, 2006", text)
+
+	_, err = renderText("body", "{{ .Unknown }}", data)
+	assert.Error(t, err)
+	_, err = renderText("body", "{{ .User ", data)
+	assert.Error(t, err)
+}
+
+func TestWrapText(t *testing.T) {
+	width := func(s string) float64 { return float64(len(s)) }
+
+	lines := wrapText("the quick brown fox\njumps", 10, width)
+	assert.Equal(t, []string{"the quick", "brown fox", "jumps"}, lines)
+
+	// words longer than the line are not broken
+	lines = wrapText("incomprehensibilities", 10, width)
+	assert.Equal(t, []string{"incomprehensibilities"}, lines)
+}
+
+func TestRender(t *testing.T) {
+	signer, _ := NewSigner(testSigningKey)
+	certificate := signedCertificate(t, signer)
+	certificate.Spec.Title = "Certificate (of) Completion – ü"
+
+	pdf, contentType, err := Render(certificate, FormatPDF)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/pdf", contentType)
+	assert.True(t, bytes.HasPrefix(pdf, []byte("%PDF-1.4")))
+	assert.True(t, strings.HasSuffix(string(pdf), "%%EOF\n"))
+	assert.Contains(t, string(pdf), "(Certificate \\(of\\) Completion ? \\374)")
+	assert.Contains(t, string(pdf), VerificationId(certificate))
+
+	content, contentType, err := Render(certificate, FormatPNG)
+	assert.NoError(t, err)
+	assert.Equal(t, "image/png", contentType)
+	img, err := png.Decode(bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, pngWidth, img.Bounds().Dx())
+	assert.Equal(t, pngHeight, img.Bounds().Dy())
+}
+
+func TestGlyphs(t *testing.T) {
+	for c, g := range glyphs {
+		for _, row := range g {
+			assert.Len(t, row, glyphWidth, string(c))
+		}
+	}
+	assert.Equal(t, glyphs['A'], glyph('a'))
+	assert.Equal(t, glyphs['?'], glyph('ß'))
+}
@@ -0,0 +1,64 @@
+package certificateservice
+
+import (
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+)
+
+// scenarioResult is everything the rules of a template are evaluated against for one scenario played by a user.
+type scenarioResult struct {
+	progress    *progresspb.Progress
+	quizzes     []string                          // quiz ids referenced by the steps of the scenario
+	evaluations map[string]*quizpb.QuizEvaluation // key is the quiz id, missing if the user never started the quiz
+}
+
+// fulfillsRules returns whether the finished progress of a scenario earns a certificate.
+func fulfillsRules(rules hfv1.CertificateRules, result scenarioResult) bool {
+	if rules.RequireAllSteps && !visitedAllSteps(result.progress) {
+		return false
+	}
+
+	if rules.RequirePassedQuizzes {
+		for _, quizId := range result.quizzes {
+			if !passedQuiz(result.evaluations[quizId]) {
+				return false
+			}
+		}
+	}
+
+	if rules.MinTaskScore > 0 && taskScorePercentage(result.progress) < float64(rules.MinTaskScore) {
+		return false
+	}
+
+	return true
+}
+
+// visitedAllSteps returns whether the user visited every step, steps are counted from 0.
+func visitedAllSteps(progress *progresspb.Progress) bool {
+	return progress.GetTotalStep() > 0 && progress.GetMaxStep()+1 >= progress.GetTotalStep()
+}
+
+// passedQuiz returns whether any recorded attempt of the evaluation passed the success threshold of the quiz.
+func passedQuiz(evaluation *quizpb.QuizEvaluation) bool {
+	for _, attempt := range evaluation.GetAttempts() {
+		if attempt.GetTimestamp() != "" && attempt.GetPass() && !attempt.GetTimedOut() {
+			return true
+		}
+	}
+	return false
+}
+
+// taskScorePercentage returns the score of the latest task verifications relative to their maximum score.
+// Tasks that did not succeed are recorded without score.
+func taskScorePercentage(progress *progresspb.Progress) float64 {
+	var score, maxScore int32
+	for _, verification := range progress.GetTaskVerifications() {
+		score += verification.GetScore()
+		maxScore += verification.GetMaxScore()
+	}
+	if maxScore <= 0 {
+		return 0
+	}
+	return float64(score) * 100 / float64(maxScore)
+}
@@ -0,0 +1,77 @@
+package certificateservice
+
+import (
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFulfillsRules(t *testing.T) {
+	complete := &progresspb.Progress{
+		MaxStep:   2,
+		TotalStep: 3,
+		TaskVerifications: []*progresspb.TaskVerification{
+			{Success: true, Score: 3, MaxScore: 3},
+			{Success: false, Score: 0, MaxScore: 1},
+		},
+	}
+	incomplete := &progresspb.Progress{MaxStep: 1, TotalStep: 3}
+	passed := &quizpb.QuizEvaluation{Attempts: []*quizpb.QuizEvaluationAttempt{
+		{Timestamp: "Mon Jan  2 15:04:05 MST 2006", Pass: false},
+		{Timestamp: "Mon Jan  2 16:04:05 MST 2006", Pass: true},
+	}}
+	failed := &quizpb.QuizEvaluation{Attempts: []*quizpb.QuizEvaluationAttempt{
+		{Timestamp: "Mon Jan  2 15:04:05 MST 2006", Pass: false},
+		// in progress
+		{CreationTimestamp: "Mon Jan  2 16:04:05 MST 2006"},
+	}}
+	timedOut := &quizpb.QuizEvaluation{Attempts: []*quizpb.QuizEvaluationAttempt{
+		{Timestamp: "Mon Jan  2 15:04:05 MST 2006", Pass: true, TimedOut: true},
+	}}
+
+	tests := []struct {
+		name   string
+		rules  hfv1.CertificateRules
+		result scenarioResult
+		want   bool
+	}{
+		{name: "no rules", rules: hfv1.CertificateRules{}, result: scenarioResult{progress: incomplete}, want: true},
+		{name: "all steps", rules: hfv1.CertificateRules{RequireAllSteps: true}, result: scenarioResult{progress: complete}, want: true},
+		{name: "missing steps", rules: hfv1.CertificateRules{RequireAllSteps: true}, result: scenarioResult{progress: incomplete}, want: false},
+		{
+			name:   "passed quiz",
+			rules:  hfv1.CertificateRules{RequirePassedQuizzes: true},
+			result: scenarioResult{progress: complete, quizzes: []string{"q"}, evaluations: map[string]*quizpb.QuizEvaluation{"q": passed}},
+			want:   true,
+		},
+		{
+			name:   "failed quiz",
+			rules:  hfv1.CertificateRules{RequirePassedQuizzes: true},
+			result: scenarioResult{progress: complete, quizzes: []string{"q"}, evaluations: map[string]*quizpb.QuizEvaluation{"q": failed}},
+			want:   false,
+		},
+		{
+			name:   "timed out quiz",
+			rules:  hfv1.CertificateRules{RequirePassedQuizzes: true},
+			result: scenarioResult{progress: complete, quizzes: []string{"q"}, evaluations: map[string]*quizpb.QuizEvaluation{"q": timedOut}},
+			want:   false,
+		},
+		{
+			name:   "quiz never started",
+			rules:  hfv1.CertificateRules{RequirePassedQuizzes: true},
+			result: scenarioResult{progress: complete, quizzes: []string{"q"}, evaluations: map[string]*quizpb.QuizEvaluation{}},
+			want:   false,
+		},
+		{name: "task score reached", rules: hfv1.CertificateRules{MinTaskScore: 75}, result: scenarioResult{progress: complete}, want: true},
+		{name: "task score missed", rules: hfv1.CertificateRules{MinTaskScore: 80}, result: scenarioResult{progress: complete}, want: false},
+		{name: "no task verifications", rules: hfv1.CertificateRules{MinTaskScore: 1}, result: scenarioResult{progress: incomplete}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fulfillsRules(tt.rules, tt.result))
+		})
+	}
+}
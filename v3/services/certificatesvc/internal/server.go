@@ -0,0 +1,57 @@
+package certificateservice
+
+import (
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	listersv1 "github.com/hobbyfarm/gargantua/v3/pkg/client/listers/hobbyfarm.io/v1"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
+)
+
+type CertificateServer struct {
+	authnClient       authnpb.AuthNClient
+	authrClient       authrpb.AuthRClient
+	userClient        userpb.UserSvcClient
+	hfClientSet       hfClientset.Interface
+	namespace         string
+	signer            *Signer
+	templateLister    listersv1.CertificateTemplateLister
+	certificateLister listersv1.CertificateLister
+}
+
+func NewCertificateServer(
+	authnClient authnpb.AuthNClient,
+	authrClient authrpb.AuthRClient,
+	userClient userpb.UserSvcClient,
+	hfClientSet hfClientset.Interface,
+	namespace string,
+	signer *Signer,
+	templateLister listersv1.CertificateTemplateLister,
+	certificateLister listersv1.CertificateLister,
+) CertificateServer {
+	return CertificateServer{
+		authnClient:       authnClient,
+		authrClient:       authrClient,
+		userClient:        userClient,
+		hfClientSet:       hfClientSet,
+		namespace:         namespace,
+		signer:            signer,
+		templateLister:    templateLister,
+		certificateLister: certificateLister,
+	}
+}
+
+func (s CertificateServer) SetupRoutes(r *mux.Router) {
+	r.HandleFunc("/certificate/list", s.ListForUserFunc).Methods("GET")
+	r.HandleFunc("/certificate/{id}/verify", s.VerifyFunc).Methods("GET")
+	r.HandleFunc("/certificate/{id}/{format:pdf|png}", s.DownloadFunc).Methods("GET")
+	r.HandleFunc("/a/certificate/scheduledevent/{id}", s.ListByScheduledEventFunc).Methods("GET")
+	r.HandleFunc("/a/certificate/scheduledevent/{id}/export", s.ExportFunc).Methods("GET")
+	r.HandleFunc("/a/certificate/template/list", s.ListTemplatesFunc).Methods("GET")
+	r.HandleFunc("/a/certificate/template/create", s.CreateTemplateFunc).Methods("POST")
+	r.HandleFunc("/a/certificate/template/{id}/update", s.UpdateTemplateFunc).Methods("PUT")
+	r.HandleFunc("/a/certificate/template/{id}/delete", s.DeleteTemplateFunc).Methods("DELETE")
+	glog.V(2).Infof("set up routes for CertificateServer")
+}
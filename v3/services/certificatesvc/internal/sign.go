@@ -0,0 +1,83 @@
+package certificateservice
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+)
+
+// verificationSuffixLength is the number of signature characters appended to the certificate name in its verification id.
+// The certificate names are derived from public ids, the suffix makes verification ids impossible to guess.
+const verificationSuffixLength = 16
+
+var ErrInvalidVerificationId = errors.New("invalid verification id")
+
+// Signer signs certificates with a HMAC-SHA256 over all issued fields.
+type Signer struct {
+	key []byte
+}
+
+func NewSigner(key string) (*Signer, error) {
+	if len(key) < 32 {
+		return nil, errors.New("signing key must be at least 32 characters long")
+	}
+	return &Signer{key: []byte(key)}, nil
+}
+
+// Sign returns the hex encoded signature of the certificate.
+func (s *Signer) Sign(certificate *hfv1.Certificate) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(canonicalCertificate(certificate)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Valid returns whether the stored signature matches the fields of the certificate.
+func (s *Signer) Valid(certificate *hfv1.Certificate) bool {
+	expected := s.Sign(certificate)
+	return hmac.Equal([]byte(expected), []byte(certificate.Spec.Signature))
+}
+
+// VerificationId returns the public id of a signed certificate.
+func VerificationId(certificate *hfv1.Certificate) string {
+	signature := certificate.Spec.Signature
+	if len(signature) > verificationSuffixLength {
+		signature = signature[:verificationSuffixLength]
+	}
+	return certificate.Name + "." + signature
+}
+
+// ParseVerificationId splits a verification id into the certificate name and the signature prefix.
+// Verification ids are case-insensitive, the png certificates print them in uppercase.
+func ParseVerificationId(verificationId string) (string, string, error) {
+	name, suffix, found := strings.Cut(strings.ToLower(verificationId), ".")
+	if !found || name == "" || len(suffix) != verificationSuffixLength {
+		return "", "", ErrInvalidVerificationId
+	}
+	return name, suffix, nil
+}
+
+// canonicalCertificate joins every signed field, the fields can not contain the separator.
+func canonicalCertificate(certificate *hfv1.Certificate) string {
+	spec := certificate.Spec
+	fields := []string{
+		certificate.Name,
+		spec.Template,
+		spec.User,
+		spec.Course,
+		spec.Scenario,
+		spec.ScheduledEvent,
+		strings.Join(spec.Progresses, ","),
+		spec.Title,
+		spec.Body,
+		spec.Issuer,
+		spec.IssuedAt,
+	}
+	for i, field := range fields {
+		fields[i] = strings.ReplaceAll(field, "\x00", "")
+	}
+	return strings.Join(fields, "\x00")
+}
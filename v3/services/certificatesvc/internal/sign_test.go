@@ -0,0 +1,78 @@
+package certificateservice
+
+import (
+	"strings"
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testSigningKey = "0123456789abcdef0123456789abcdef"
+
+func signedCertificate(t *testing.T, signer *Signer) *hfv1.Certificate {
+	t.Helper()
+	certificate := &hfv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: certificateName("ct-template", "u-user", "se-event")},
+		Spec: hfv1.CertificateSpec{
+			Template:       "ct-template",
+			User:           "u-user",
+			Scenario:       "s-scenario",
+			ScheduledEvent: "se-event",
+			Progresses:     []string{"progress-1"},
+			Title:          "Certificate of Completion",
+			Body:           "user@example.com completed Scenario",
+			Issuer:         "HobbyFarm",
+			IssuedAt:       "Mon Jan  2 15:04:05 UTC 2006",
+		},
+	}
+	certificate.Spec.Signature = signer.Sign(certificate)
+	return certificate
+}
+
+func TestNewSignerRequiresKey(t *testing.T) {
+	_, err := NewSigner("")
+	assert.Error(t, err)
+	_, err = NewSigner("too-short")
+	assert.Error(t, err)
+	_, err = NewSigner(testSigningKey)
+	assert.NoError(t, err)
+}
+
+func TestSignerValid(t *testing.T) {
+	signer, _ := NewSigner(testSigningKey)
+	certificate := signedCertificate(t, signer)
+	assert.True(t, signer.Valid(certificate))
+
+	tampered := certificate.DeepCopy()
+	tampered.Spec.Body = "someone else completed Scenario"
+	assert.False(t, signer.Valid(tampered))
+
+	otherEvent := certificate.DeepCopy()
+	otherEvent.Spec.ScheduledEvent = "se-other"
+	assert.False(t, signer.Valid(otherEvent))
+
+	other, _ := NewSigner("fedcba9876543210fedcba9876543210")
+	assert.False(t, other.Valid(certificate))
+}
+
+func TestVerificationId(t *testing.T) {
+	signer, _ := NewSigner(testSigningKey)
+	certificate := signedCertificate(t, signer)
+
+	verificationId := VerificationId(certificate)
+	name, suffix, err := ParseVerificationId(verificationId)
+	assert.NoError(t, err)
+	assert.Equal(t, certificate.Name, name)
+	assert.Equal(t, certificate.Spec.Signature[:verificationSuffixLength], suffix)
+
+	name, _, err = ParseVerificationId(strings.ToUpper(verificationId))
+	assert.NoError(t, err)
+	assert.Equal(t, certificate.Name, name)
+
+	for _, invalid := range []string{"", certificate.Name, certificate.Name + ".abc", "." + suffix} {
+		_, _, err := ParseVerificationId(invalid)
+		assert.ErrorIs(t, err, ErrInvalidVerificationId, invalid)
+	}
+}
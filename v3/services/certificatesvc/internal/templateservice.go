@@ -0,0 +1,180 @@
+package certificateservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+type PreparedCertificateTemplate struct {
+	Id string `json:"id"`
+	hfv1.CertificateTemplateSpec
+}
+
+func validateTemplateSpec(spec hfv1.CertificateTemplateSpec) error {
+	if (spec.Course == "") == (spec.Scenario == "") {
+		return errors.New("either course or scenario is required")
+	}
+	if spec.Title == "" {
+		return errors.New("title is required")
+	}
+	if spec.Rules.MinTaskScore > 100 {
+		return errors.New("min task score needs to be between 0 and 100")
+	}
+
+	example := TemplateData{User: "user@example.com", Course: "Course", Scenario: "Scenario", Event: "Event", Date: "January 2, 2006"}
+	if _, err := renderText("title", spec.Title, example); err != nil {
+		return fmt.Errorf("invalid title: %v", err)
+	}
+	if _, err := renderText("body", spec.Body, example); err != nil {
+		return fmt.Errorf("invalid body: %v", err)
+	}
+	return nil
+}
+
+func (s CertificateServer) authorizeTemplates(w http.ResponseWriter, r *http.Request, verb string) bool {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return false
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralCertTemplate, verb))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", fmt.Sprintf("no access to %s certificate templates", verb))
+		return false
+	}
+	return true
+}
+
+func (s CertificateServer) ListTemplatesFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeTemplates(w, r, rbac.VerbList) {
+		return
+	}
+
+	templates, err := s.templateLister.CertificateTemplates(s.namespace).List(labels.Everything())
+	if err != nil {
+		glog.Errorf("error listing certificate templates: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "error", "error listing certificate templates")
+		return
+	}
+
+	preparedTemplates := make([]PreparedCertificateTemplate, 0, len(templates))
+	for _, template := range templates {
+		preparedTemplates = append(preparedTemplates, PreparedCertificateTemplate{Id: template.Name, CertificateTemplateSpec: template.Spec})
+	}
+	sort.Slice(preparedTemplates, func(i, j int) bool {
+		return preparedTemplates[i].Id < preparedTemplates[j].Id
+	})
+
+	encoded, err := json.Marshal(preparedTemplates)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+func (s CertificateServer) CreateTemplateFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeTemplates(w, r, rbac.VerbCreate) {
+		return
+	}
+
+	var spec hfv1.CertificateTemplateSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid json body")
+		return
+	}
+	if err := validateTemplateSpec(spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	template := &hfv1.CertificateTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: util.GenerateResourceName("ct", util.RandStringRunes(16), 10),
+		},
+		Spec: spec,
+	}
+	created, err := s.hfClientSet.HobbyfarmV1().CertificateTemplates(s.namespace).Create(r.Context(), template, metav1.CreateOptions{})
+	if err != nil {
+		glog.Errorf("error creating certificate template: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error creating certificate template")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 201, "created", created.Name)
+	glog.V(4).Infof("Created certificate template %s", created.Name)
+}
+
+func (s CertificateServer) UpdateTemplateFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeTemplates(w, r, rbac.VerbUpdate) {
+		return
+	}
+
+	templateId := mux.Vars(r)["id"]
+	var spec hfv1.CertificateTemplateSpec
+	if err := json.NewDecoder(r.Body).Decode(&spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid json body")
+		return
+	}
+	if err := validateTemplateSpec(spec); err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	// issued certificates keep the content they were rendered with
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		template, err := s.hfClientSet.HobbyfarmV1().CertificateTemplates(s.namespace).Get(context.Background(), templateId, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		template.Spec = spec
+		_, err = s.hfClientSet.HobbyfarmV1().CertificateTemplates(s.namespace).Update(context.Background(), template, metav1.UpdateOptions{})
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("certificate template %s not found", templateId))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error updating certificate template %s: %v", templateId, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error updating certificate template")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 200, "updated", templateId)
+}
+
+func (s CertificateServer) DeleteTemplateFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeTemplates(w, r, rbac.VerbDelete) {
+		return
+	}
+
+	// issued certificates stay valid when their template is deleted
+	templateId := mux.Vars(r)["id"]
+	err := s.hfClientSet.HobbyfarmV1().CertificateTemplates(s.namespace).Delete(r.Context(), templateId, metav1.DeleteOptions{})
+	if apierrors.IsNotFound(err) {
+		util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("certificate template %s not found", templateId))
+		return
+	}
+	if err != nil {
+		glog.Errorf("error deleting certificate template %s: %v", templateId, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error deleting certificate template")
+		return
+	}
+
+	util.ReturnHTTPMessage(w, r, 200, "deleted", templateId)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
+	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+
+	certificateService "github.com/hobbyfarm/gargantua/services/certificatesvc/v3/internal"
+	hfInformers "github.com/hobbyfarm/gargantua/v3/pkg/client/informers/externalversions"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	coursepb "github.com/hobbyfarm/gargantua/v3/protos/course"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	scenariopb "github.com/hobbyfarm/gargantua/v3/protos/scenario"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
+)
+
+var (
+	serviceConfig *microservices.ServiceConfig
+)
+
+func init() {
+	serviceConfig = microservices.BuildServiceConfig()
+}
+
+func main() {
+	stopCh := signals.SetupSignalHandler()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	// CERTIFICATE_SIGNING_KEY signs the issued certificates, changing it invalidates every certificate issued before
+	signer, err := certificateService.NewSigner(os.Getenv("CERTIFICATE_SIGNING_KEY"))
+	if err != nil {
+		glog.Fatalf("error reading CERTIFICATE_SIGNING_KEY: %v", err)
+	}
+
+	cfg, hfClient, _ := microservices.BuildClusterConfig(serviceConfig)
+
+	namespace := util.GetReleaseNamespace()
+	hfInformerFactory := hfInformers.NewSharedInformerFactoryWithOptions(hfClient, time.Second*30, hfInformers.WithNamespace(namespace))
+
+	crd.InstallCrds(certificateService.CertificateCRDInstaller{}, cfg, "certificate")
+
+	services := []microservices.MicroService{
+		microservices.AuthN,
+		microservices.AuthR,
+		microservices.Course,
+		microservices.Progress,
+		microservices.Quiz,
+		microservices.Scenario,
+		microservices.ScheduledEvent,
+		microservices.User,
+	}
+	connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
+	for _, conn := range connections {
+		defer conn.Close()
+	}
+
+	authnClient := authnpb.NewAuthNClient(connections[microservices.AuthN])
+	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
+	courseClient := coursepb.NewCourseSvcClient(connections[microservices.Course])
+	progressClient := progresspb.NewProgressSvcClient(connections[microservices.Progress])
+	quizEvaluationClient := quizpb.NewQuizEvaluationSvcClient(connections[microservices.Quiz])
+	scenarioClient := scenariopb.NewScenarioSvcClient(connections[microservices.Scenario])
+	scheduledEventClient := scheduledeventpb.NewScheduledEventSvcClient(connections[microservices.ScheduledEvent])
+	userClient := userpb.NewUserSvcClient(connections[microservices.User])
+
+	templateInformer := hfInformerFactory.Hobbyfarm().V1().CertificateTemplates()
+	templateLister := templateInformer.Lister()
+	certificateLister := hfInformerFactory.Hobbyfarm().V1().Certificates().Lister()
+	progressInformer := hfInformerFactory.Hobbyfarm().V1().Progresses().Informer()
+
+	issuer, err := certificateService.NewIssuer(
+		hfClient,
+		namespace,
+		signer,
+		templateLister,
+		certificateLister,
+		progressClient,
+		quizEvaluationClient,
+		scenarioClient,
+		courseClient,
+		userClient,
+		scheduledEventClient,
+		progressInformer,
+		templateInformer.Informer(),
+	)
+	if err != nil {
+		glog.Fatalf("error creating certificate issuer: %v", err)
+	}
+
+	hfInformerFactory.Start(stopCh)
+	hfInformerFactory.WaitForCacheSync(stopCh)
+
+	var wg sync.WaitGroup
+	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		issuer.Run(ctx)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		certificateServer := certificateService.NewCertificateServer(
+			authnClient,
+			authrClient,
+			userClient,
+			hfClient,
+			namespace,
+			signer,
+			templateLister,
+			certificateLister,
+		)
+		microservices.StartAPIServer(certificateServer)
+	}()
+
+	wg.Wait()
+}
@@ -36,6 +36,7 @@ type PreparedEnvironment struct {
 	IPTranslationMap     map[string]string            `json:"ip_translation_map"`
 	WsEndpoint           string                       `json:"ws_endpoint"`
 	CountCapacity        map[string]uint32            `json:"count_capacity"`
+	ResourceCapacity     *generalpb.Resources         `json:"resource_capacity,omitempty"`
 }
 
 type PreparedListEnvironment struct {
@@ -90,6 +91,7 @@ func (e EnvironmentServer) GetFunc(w http.ResponseWriter, r *http.Request) {
 		IPTranslationMap:     environment.GetIpTranslationMap(),
 		WsEndpoint:           environment.GetWsEndpoint(),
 		CountCapacity:        environment.GetCountCapacity(),
+		ResourceCapacity:     environment.GetResourceCapacity(),
 	}
 
 	encodedEnvironment, err := json.Marshal(preparedEnvironment)
@@ -201,6 +203,8 @@ func (e EnvironmentServer) CreateFunc(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	resourceCapacity := r.PostFormValue("resource_capacity") // optional
+
 	environmentId, err := e.internalEnvironmentServer.CreateEnvironment(r.Context(), &environmentpb.CreateEnvironmentRequest{
 		DisplayName:          displayName,
 		Dnssuffix:            dnssuffix,
@@ -210,6 +214,7 @@ func (e EnvironmentServer) CreateFunc(w http.ResponseWriter, r *http.Request) {
 		IpTranslationMap:     ipTranslationMap,
 		WsEndpoint:           wsEndpoint,
 		CountCapacity:        countCapacity,
+		ResourceCapacityRaw:  resourceCapacity,
 	})
 
 	if err != nil {
@@ -251,6 +256,7 @@ func (e EnvironmentServer) UpdateFunc(w http.ResponseWriter, r *http.Request) {
 	ipTranslationMap := r.PostFormValue("ip_translation_map")
 	wsEndpoint := r.PostFormValue("ws_endpoint")
 	countCapacity := r.PostFormValue("count_capacity")
+	resourceCapacity := r.PostFormValue("resource_capacity")
 
 	_, err = e.internalEnvironmentServer.UpdateEnvironment(r.Context(), &environmentpb.UpdateEnvironmentRequest{
 		Id:                   environmentId,
@@ -262,6 +268,7 @@ func (e EnvironmentServer) UpdateFunc(w http.ResponseWriter, r *http.Request) {
 		IpTranslationMap:     ipTranslationMap,
 		WsEndpoint:           wsEndpoint,
 		CountCapacity:        countCapacity,
+		ResourceCapacityRaw:  resourceCapacity,
 	})
 
 	if err != nil {
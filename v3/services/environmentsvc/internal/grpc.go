@@ -49,6 +49,7 @@ func (s *GrpcEnvironmentServer) CreateEnvironment(ctx context.Context, req *envi
 	ipTranslationMapRaw := req.GetIpTranslationMap()
 	wsEndpoint := req.GetWsEndpoint()
 	countCapacityRaw := req.GetCountCapacity()
+	resourceCapacityRaw := req.GetResourceCapacityRaw() // optional
 
 	requiredStringParams := map[string]string{
 		"displayName":          displayName,
@@ -81,6 +82,13 @@ func (s *GrpcEnvironmentServer) CreateEnvironment(ctx context.Context, req *envi
 	if err != nil {
 		return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "ipTranslationMap")
 	}
+	var resourceCapacity hfv1.VirtualMachineResources
+	if resourceCapacityRaw != "" {
+		resourceCapacity, err = util.ParseResources(resourceCapacityRaw, "resourceCapacity")
+		if err != nil {
+			return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "resourceCapacity")
+		}
+	}
 
 	hasher := sha256.New()
 	hasher.Write([]byte(time.Now().String())) // generate random name
@@ -100,6 +108,7 @@ func (s *GrpcEnvironmentServer) CreateEnvironment(ctx context.Context, req *envi
 			IPTranslationMap:     ipTranslationMap,
 			WsEndpoint:           wsEndpoint,
 			CountCapacity:        countCapacity,
+			ResourceCapacity:     resourceCapacity,
 		},
 	}
 
@@ -136,6 +145,7 @@ func (s *GrpcEnvironmentServer) GetEnvironment(ctx context.Context, req *general
 		IpTranslationMap:     environment.Spec.IPTranslationMap,
 		WsEndpoint:           environment.Spec.WsEndpoint,
 		CountCapacity:        util.ConvertIntMap[int, uint32](environment.Spec.CountCapacity),
+		ResourceCapacity:     util.ConvertToResources(environment.Spec.ResourceCapacity),
 		Annotations:          environment.Annotations,
 	}, nil
 }
@@ -153,6 +163,7 @@ func (s *GrpcEnvironmentServer) UpdateEnvironment(ctx context.Context, req *envi
 	ipTranslationMapRaw := req.GetIpTranslationMap()
 	wsEndpoint := req.GetWsEndpoint()
 	countCapacityRaw := req.GetCountCapacity()
+	resourceCapacityRaw := req.GetResourceCapacityRaw()
 
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		environment, err := s.environmentClient.Get(ctx, id, metav1.GetOptions{})
@@ -205,6 +216,13 @@ func (s *GrpcEnvironmentServer) UpdateEnvironment(ctx context.Context, req *envi
 			}
 			environment.Spec.CountCapacity = countCapacity
 		}
+		if resourceCapacityRaw != "" {
+			resourceCapacity, err := util.ParseResources(resourceCapacityRaw, "resourceCapacity")
+			if err != nil {
+				return hferrors.GrpcParsingError(req, "resourceCapacity")
+			}
+			environment.Spec.ResourceCapacity = resourceCapacity
+		}
 
 		_, updateErr := s.environmentClient.Update(ctx, environment, metav1.UpdateOptions{})
 		return updateErr
@@ -267,6 +285,7 @@ func (s *GrpcEnvironmentServer) ListEnvironment(ctx context.Context, listOptions
 			IpTranslationMap:     environment.Spec.IPTranslationMap,
 			WsEndpoint:           environment.Spec.WsEndpoint,
 			CountCapacity:        util.ConvertIntMap[int, uint32](environment.Spec.CountCapacity),
+			ResourceCapacity:     util.ConvertToResources(environment.Spec.ResourceCapacity),
 			Annotations:          environment.Annotations,
 		})
 	}
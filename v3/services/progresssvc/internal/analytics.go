@@ -0,0 +1,285 @@
+package progressservice
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+)
+
+// DwellTime summarizes the seconds users spent on a step.
+type DwellTime struct {
+	Samples       int     `json:"samples"`
+	MedianSeconds float64 `json:"median_seconds"`
+	P90Seconds    float64 `json:"p90_seconds"`
+}
+
+type StepAnalytics struct {
+	Step        uint32    `json:"step"`
+	Reached     int       `json:"reached"`       // progresses that visited the step
+	Dropped     int       `json:"dropped"`       // finished progresses that did not get past the step
+	DropOffRate float64   `json:"drop_off_rate"` // dropped relative to reached
+	Dwell       DwellTime `json:"dwell"`
+}
+
+// ScenarioAnalytics is the step funnel of a scenario.
+type ScenarioAnalytics struct {
+	Scenario       string          `json:"scenario"`
+	TotalSteps     uint32          `json:"total_steps"`
+	Progresses     int             `json:"progresses"`
+	Completed      int             `json:"completed"` // progresses that visited every step
+	CompletionRate float64         `json:"completion_rate"`
+	Steps          []StepAnalytics `json:"steps"`
+}
+
+type ScenarioCompletion struct {
+	Scenario       string  `json:"scenario"`
+	Progresses     int     `json:"progresses"`
+	Completed      int     `json:"completed"`
+	CompletionRate float64 `json:"completion_rate"`
+}
+
+// CourseAnalytics relates the users that started a course to the users that completed every scenario of it.
+type CourseAnalytics struct {
+	Course         string               `json:"course"`
+	Users          int                  `json:"users"`
+	CompletedUsers int                  `json:"completed_users"`
+	CompletionRate float64              `json:"completion_rate"`
+	Scenarios      []ScenarioCompletion `json:"scenarios"`
+}
+
+// ScheduledEventAnalytics summarizes a scheduled event so it can be compared with other events.
+type ScheduledEventAnalytics struct {
+	ScheduledEvent          string               `json:"scheduled_event"`
+	Users                   int                  `json:"users"`
+	Progresses              int                  `json:"progresses"`
+	Completed               int                  `json:"completed"`
+	CompletionRate          float64              `json:"completion_rate"`
+	MedianCompletionSeconds float64              `json:"median_completion_seconds"` // from start until the last step was reached
+	Scenarios               []ScenarioCompletion `json:"scenarios"`
+}
+
+// completed returns whether every step was visited, steps are counted from 0.
+func completed(progress *progresspb.Progress) bool {
+	return progress.GetTotalStep() > 0 && progress.GetMaxStep()+1 >= progress.GetTotalStep()
+}
+
+func finished(progress *progresspb.Progress) bool {
+	return progress.GetFinished() == "true"
+}
+
+func rate(part int, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total)
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func newDwellTime(seconds []float64) DwellTime {
+	sort.Float64s(seconds)
+	return DwellTime{
+		Samples:       len(seconds),
+		MedianSeconds: percentile(seconds, 0.5),
+		P90Seconds:    percentile(seconds, 0.9),
+	}
+}
+
+// stepDwellTimes returns the seconds spent on each step of a progress. The time on a step lasts until the next
+// step was visited, so the last visit is not counted: its end is unknown while the session is running and the
+// session may have idled until it expired. Multiple visits of a step add up.
+func stepDwellTimes(progress *progresspb.Progress) map[uint32]float64 {
+	dwell := make(map[uint32]float64)
+	steps := progress.GetSteps()
+	for i := 0; i+1 < len(steps); i++ {
+		start, err := time.Parse(time.UnixDate, steps[i].GetTimestamp())
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.UnixDate, steps[i+1].GetTimestamp())
+		if err != nil || end.Before(start) {
+			continue
+		}
+		dwell[steps[i].GetStep()] += end.Sub(start).Seconds()
+	}
+	return dwell
+}
+
+// completionSeconds returns the seconds from the start of the progress until its last step was reached the first time.
+func completionSeconds(progress *progresspb.Progress) (float64, bool) {
+	if !completed(progress) {
+		return 0, false
+	}
+	started, err := time.Parse(time.UnixDate, progress.GetStarted())
+	if err != nil {
+		return 0, false
+	}
+	for _, step := range progress.GetSteps() {
+		if step.GetStep()+1 < progress.GetTotalStep() {
+			continue
+		}
+		reached, err := time.Parse(time.UnixDate, step.GetTimestamp())
+		if err != nil || reached.Before(started) {
+			return 0, false
+		}
+		return reached.Sub(started).Seconds(), true
+	}
+	return 0, false
+}
+
+// NewScenarioAnalytics aggregates the progresses of a scenario into its step funnel.
+func NewScenarioAnalytics(scenario string, progresses []*progresspb.Progress) ScenarioAnalytics {
+	analytics := ScenarioAnalytics{Scenario: scenario, Steps: []StepAnalytics{}}
+
+	for _, progress := range progresses {
+		if progress.GetTotalStep() > analytics.TotalSteps {
+			analytics.TotalSteps = progress.GetTotalStep()
+		}
+	}
+
+	reached := make([]int, analytics.TotalSteps)
+	dropped := make([]int, analytics.TotalSteps)
+	dwell := make([][]float64, analytics.TotalSteps)
+	for _, progress := range progresses {
+		analytics.Progresses++
+		if completed(progress) {
+			analytics.Completed++
+		}
+		if analytics.TotalSteps == 0 {
+			continue
+		}
+
+		maxStep := min(progress.GetMaxStep(), analytics.TotalSteps-1)
+		for step := uint32(0); step <= maxStep; step++ {
+			reached[step]++
+		}
+		if finished(progress) && !completed(progress) {
+			dropped[maxStep]++
+		}
+		for step, seconds := range stepDwellTimes(progress) {
+			if step < analytics.TotalSteps {
+				dwell[step] = append(dwell[step], seconds)
+			}
+		}
+	}
+	analytics.CompletionRate = rate(analytics.Completed, analytics.Progresses)
+
+	for step := uint32(0); step < analytics.TotalSteps; step++ {
+		analytics.Steps = append(analytics.Steps, StepAnalytics{
+			Step:        step,
+			Reached:     reached[step],
+			Dropped:     dropped[step],
+			DropOffRate: rate(dropped[step], reached[step]),
+			Dwell:       newDwellTime(dwell[step]),
+		})
+	}
+	return analytics
+}
+
+// scenarioCompletions returns the completion rate per scenario, sorted by scenario id.
+func scenarioCompletions(progresses []*progresspb.Progress) []ScenarioCompletion {
+	byScenario := make(map[string]*ScenarioCompletion)
+	for _, progress := range progresses {
+		completion, ok := byScenario[progress.GetScenario()]
+		if !ok {
+			completion = &ScenarioCompletion{Scenario: progress.GetScenario()}
+			byScenario[progress.GetScenario()] = completion
+		}
+		completion.Progresses++
+		if completed(progress) {
+			completion.Completed++
+		}
+	}
+
+	completions := make([]ScenarioCompletion, 0, len(byScenario))
+	for _, completion := range byScenario {
+		completion.CompletionRate = rate(completion.Completed, completion.Progresses)
+		completions = append(completions, *completion)
+	}
+	sort.Slice(completions, func(i, j int) bool {
+		return completions[i].Scenario < completions[j].Scenario
+	})
+	return completions
+}
+
+// NewCourseAnalytics aggregates the progresses made within a course. A user completed the course after completing
+// every scenario of it, possibly across multiple sessions.
+func NewCourseAnalytics(course string, scenarios []string, progresses []*progresspb.Progress) CourseAnalytics {
+	analytics := CourseAnalytics{Course: course}
+
+	completedByUser := make(map[string]map[string]bool)
+	for _, progress := range progresses {
+		user := progress.GetUser()
+		if _, ok := completedByUser[user]; !ok {
+			completedByUser[user] = make(map[string]bool)
+		}
+		if completed(progress) {
+			completedByUser[user][progress.GetScenario()] = true
+		}
+	}
+
+	analytics.Users = len(completedByUser)
+	for _, completedScenarios := range completedByUser {
+		completedAll := len(scenarios) > 0
+		for _, scenario := range scenarios {
+			if !completedScenarios[scenario] {
+				completedAll = false
+				break
+			}
+		}
+		if completedAll {
+			analytics.CompletedUsers++
+		}
+	}
+	analytics.CompletionRate = rate(analytics.CompletedUsers, analytics.Users)
+	analytics.Scenarios = scenarioCompletions(progresses)
+	return analytics
+}
+
+// NewScheduledEventAnalytics summarizes the progresses of a scheduled event.
+func NewScheduledEventAnalytics(scheduledEvent string, progresses []*progresspb.Progress) ScheduledEventAnalytics {
+	analytics := ScheduledEventAnalytics{ScheduledEvent: scheduledEvent}
+
+	users := make(map[string]bool)
+	completionTimes := make([]float64, 0)
+	for _, progress := range progresses {
+		users[progress.GetUser()] = true
+		analytics.Progresses++
+		if completed(progress) {
+			analytics.Completed++
+		}
+		if seconds, ok := completionSeconds(progress); ok {
+			completionTimes = append(completionTimes, seconds)
+		}
+	}
+	sort.Float64s(completionTimes)
+
+	analytics.Users = len(users)
+	analytics.CompletionRate = rate(analytics.Completed, analytics.Progresses)
+	analytics.MedianCompletionSeconds = percentile(completionTimes, 0.5)
+	analytics.Scenarios = scenarioCompletions(progresses)
+	return analytics
+}
+
+// groupByScheduledEvent groups the progresses by the scheduled event they were made in.
+func groupByScheduledEvent(progresses []*progresspb.Progress) map[string][]*progresspb.Progress {
+	groups := make(map[string][]*progresspb.Progress)
+	for _, progress := range progresses {
+		scheduledEvent := progress.GetLabels()[hflabels.ScheduledEventLabel]
+		groups[scheduledEvent] = append(groups[scheduledEvent], progress)
+	}
+	return groups
+}
@@ -0,0 +1,135 @@
+package progressservice
+
+import (
+	"testing"
+	"time"
+
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+	"github.com/stretchr/testify/assert"
+)
+
+var analyticsStart = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// newTestProgress creates a progress that visited the steps in order, one visit per entry of offsets in seconds.
+func newTestProgress(user string, scenario string, scheduledEvent string, totalStep uint32, finished bool, visits []uint32, offsets []int) *progresspb.Progress {
+	steps := make([]*progresspb.ProgressStep, 0, len(visits))
+	var maxStep uint32
+	for i, step := range visits {
+		steps = append(steps, &progresspb.ProgressStep{
+			Step:      step,
+			Timestamp: analyticsStart.Add(time.Duration(offsets[i]) * time.Second).Format(time.UnixDate),
+		})
+		maxStep = max(maxStep, step)
+	}
+	finishedLabel := "false"
+	if finished {
+		finishedLabel = "true"
+	}
+	return &progresspb.Progress{
+		User:      user,
+		Scenario:  scenario,
+		MaxStep:   maxStep,
+		TotalStep: totalStep,
+		Started:   analyticsStart.Format(time.UnixDate),
+		Finished:  finishedLabel,
+		Steps:     steps,
+		Labels:    map[string]string{hflabels.ScheduledEventLabel: scheduledEvent},
+	}
+}
+
+func TestNewScenarioAnalytics(t *testing.T) {
+	progresses := []*progresspb.Progress{
+		// completed
+		newTestProgress("u1", "s", "se1", 3, true, []uint32{0, 1, 2}, []int{0, 60, 180}),
+		// went back to step 0 before completing, both visits of step 0 add up
+		newTestProgress("u2", "s", "se1", 3, true, []uint32{0, 1, 0, 1, 2}, []int{0, 30, 60, 90, 100}),
+		// dropped at step 1
+		newTestProgress("u3", "s", "se1", 3, true, []uint32{0, 1}, []int{0, 120}),
+		// still active at step 1, not dropped
+		newTestProgress("u4", "s", "se1", 3, false, []uint32{0, 1}, []int{0, 300}),
+	}
+
+	analytics := NewScenarioAnalytics("s", progresses)
+
+	assert.Equal(t, uint32(3), analytics.TotalSteps)
+	assert.Equal(t, 4, analytics.Progresses)
+	assert.Equal(t, 2, analytics.Completed)
+	assert.InDelta(t, 0.5, analytics.CompletionRate, 0.001)
+
+	assert.Len(t, analytics.Steps, 3)
+	step0, step1, step2 := analytics.Steps[0], analytics.Steps[1], analytics.Steps[2]
+
+	assert.Equal(t, 4, step0.Reached)
+	assert.Equal(t, 0, step0.Dropped)
+	assert.Equal(t, 4, step0.Dwell.Samples)
+	// 60, 60 (30+30), 120, 300
+	assert.Equal(t, float64(60), step0.Dwell.MedianSeconds)
+	assert.Equal(t, float64(300), step0.Dwell.P90Seconds)
+
+	assert.Equal(t, 4, step1.Reached)
+	assert.Equal(t, 1, step1.Dropped)
+	assert.InDelta(t, 0.25, step1.DropOffRate, 0.001)
+	// 120 and 40 (30+10), the last visits of u3 and u4 are not counted
+	assert.Equal(t, 2, step1.Dwell.Samples)
+	assert.Equal(t, float64(40), step1.Dwell.MedianSeconds)
+
+	assert.Equal(t, 2, step2.Reached)
+	assert.Equal(t, 0, step2.Dwell.Samples)
+}
+
+func TestNewScenarioAnalyticsWithoutProgress(t *testing.T) {
+	analytics := NewScenarioAnalytics("s", nil)
+
+	assert.Equal(t, 0, analytics.Progresses)
+	assert.Equal(t, float64(0), analytics.CompletionRate)
+	assert.Empty(t, analytics.Steps)
+}
+
+func TestNewCourseAnalytics(t *testing.T) {
+	progresses := []*progresspb.Progress{
+		newTestProgress("u1", "s1", "se", 2, true, []uint32{0, 1}, []int{0, 10}),
+		newTestProgress("u1", "s2", "se", 2, true, []uint32{0, 1}, []int{0, 10}),
+		newTestProgress("u2", "s1", "se", 2, true, []uint32{0, 1}, []int{0, 10}),
+		newTestProgress("u2", "s2", "se", 2, true, []uint32{0}, []int{0}),
+		newTestProgress("u3", "s1", "se", 2, false, []uint32{0}, []int{0}),
+	}
+
+	analytics := NewCourseAnalytics("c", []string{"s1", "s2"}, progresses)
+
+	assert.Equal(t, 3, analytics.Users)
+	assert.Equal(t, 1, analytics.CompletedUsers)
+	assert.InDelta(t, float64(1)/3, analytics.CompletionRate, 0.001)
+	assert.Equal(t, []ScenarioCompletion{
+		{Scenario: "s1", Progresses: 3, Completed: 2, CompletionRate: float64(2) / 3},
+		{Scenario: "s2", Progresses: 2, Completed: 1, CompletionRate: 0.5},
+	}, analytics.Scenarios)
+}
+
+func TestNewScheduledEventAnalytics(t *testing.T) {
+	progresses := []*progresspb.Progress{
+		newTestProgress("u1", "s", "se1", 2, true, []uint32{0, 1}, []int{0, 100}),
+		newTestProgress("u2", "s", "se1", 2, true, []uint32{0, 1, 0, 1}, []int{0, 200, 250, 300}),
+		newTestProgress("u2", "s", "se1", 2, true, []uint32{0}, []int{0}),
+		newTestProgress("u3", "s", "se2", 2, true, []uint32{0, 1}, []int{0, 50}),
+	}
+
+	groups := groupByScheduledEvent(progresses)
+	assert.Len(t, groups, 2)
+
+	analytics := NewScheduledEventAnalytics("se1", groups["se1"])
+	assert.Equal(t, 2, analytics.Users)
+	assert.Equal(t, 3, analytics.Progresses)
+	assert.Equal(t, 2, analytics.Completed)
+	// the first time the last step was reached counts
+	assert.Equal(t, float64(100), analytics.MedianCompletionSeconds)
+	assert.Len(t, analytics.Scenarios, 1)
+}
+
+func TestPercentile(t *testing.T) {
+	assert.Equal(t, float64(0), percentile(nil, 0.5))
+	assert.Equal(t, float64(2), percentile([]float64{1, 2, 3, 4}, 0.5))
+	assert.Equal(t, float64(3), percentile([]float64{1, 2, 3, 4, 5}, 0.5))
+	assert.Equal(t, float64(9), percentile([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 0.9))
+	assert.Equal(t, float64(1), percentile([]float64{1}, 0.9))
+}
@@ -0,0 +1,273 @@
+package progressservice
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
+)
+
+func (s ProgressServer) authorizeAnalytics(w http.ResponseWriter, r *http.Request) bool {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return false
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(resourcePlural, rbac.VerbList))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list progress")
+		return false
+	}
+	return true
+}
+
+// listForAnalytics lists the progresses from the cache, optionally limited to a scheduled event and to the
+// creation time range given by the "from" and "to" query parameters.
+func (s ProgressServer) listForAnalytics(r *http.Request, scheduledEvent string) ([]*progresspb.Progress, error) {
+	var from, to time.Time
+	var err error
+	if fromString := r.URL.Query().Get("from"); fromString != "" {
+		if from, err = time.Parse(time.UnixDate, fromString); err != nil {
+			return nil, fmt.Errorf("error parsing start time")
+		}
+	}
+	if toString := r.URL.Query().Get("to"); toString != "" {
+		if to, err = time.Parse(time.UnixDate, toString); err != nil {
+			return nil, fmt.Errorf("error parsing end time")
+		}
+	}
+
+	var labelSelector string
+	if scheduledEvent != "" {
+		labelSelector = fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, scheduledEvent)
+	}
+	progressList, err := s.internalProgressServer.ListProgress(r.Context(), &generalpb.ListOptions{
+		LabelSelector: labelSelector,
+		LoadFromCache: true,
+	})
+	if err != nil {
+		glog.Errorf("error while retrieving progress: %s", hferrors.GetErrorMessage(err))
+		return nil, fmt.Errorf("error retrieving progress")
+	}
+
+	progresses := make([]*progresspb.Progress, 0, len(progressList.GetProgresses()))
+	for _, progress := range progressList.GetProgresses() {
+		creationTimestamp := progress.GetCreationTimestamp().AsTime()
+		if !from.IsZero() && creationTimestamp.Before(from) || !to.IsZero() && to.Before(creationTimestamp) {
+			continue
+		}
+		progresses = append(progresses, progress)
+	}
+	return progresses, nil
+}
+
+/*
+Step funnel, dwell times and drop-off of a scenario
+
+	Vars:
+	- id : The scenario id
+	Query:
+	- scheduledevent : Only progress made in the scheduled event
+	- from, to : Only progress created within the range, time.UnixDate
+	- format : json (default) or csv
+*/
+func (s ProgressServer) ScenarioAnalyticsFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAnalytics(w, r) {
+		return
+	}
+
+	scenarioId := mux.Vars(r)["id"]
+	progresses, err := s.listForAnalytics(r, r.URL.Query().Get("scheduledevent"))
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	scenarioProgresses := make([]*progresspb.Progress, 0)
+	for _, progress := range progresses {
+		if progress.GetScenario() == scenarioId {
+			scenarioProgresses = append(scenarioProgresses, progress)
+		}
+	}
+	analytics := NewScenarioAnalytics(scenarioId, scenarioProgresses)
+
+	if r.URL.Query().Get("format") == "csv" {
+		rows := [][]string{{"scenario", "step", "reached", "dropped", "drop_off_rate", "dwell_samples", "dwell_median_seconds", "dwell_p90_seconds"}}
+		for _, step := range analytics.Steps {
+			rows = append(rows, []string{
+				analytics.Scenario,
+				strconv.FormatUint(uint64(step.Step), 10),
+				strconv.Itoa(step.Reached),
+				strconv.Itoa(step.Dropped),
+				formatFloat(step.DropOffRate),
+				strconv.Itoa(step.Dwell.Samples),
+				formatFloat(step.Dwell.MedianSeconds),
+				formatFloat(step.Dwell.P90Seconds),
+			})
+		}
+		returnCSV(w, "scenario-"+scenarioId, rows)
+		return
+	}
+
+	encoded, err := json.Marshal(analytics)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+/*
+Completion rates of a course
+
+	Vars:
+	- id : The course id
+	Query:
+	- scheduledevent : Only progress made in the scheduled event
+	- from, to : Only progress created within the range, time.UnixDate
+	- format : json (default) or csv
+*/
+func (s ProgressServer) CourseAnalyticsFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAnalytics(w, r) {
+		return
+	}
+
+	courseId := mux.Vars(r)["id"]
+	course, err := s.courseClient.GetCourse(r.Context(), &generalpb.GetRequest{Id: courseId, LoadFromCache: true})
+	if err != nil {
+		glog.Errorf("error while retrieving course: %s", hferrors.GetErrorMessage(err))
+		if hferrors.IsGrpcNotFound(err) {
+			util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", fmt.Sprintf("course %s not found", courseId))
+			return
+		}
+		util.ReturnHTTPMessage(w, r, 500, "error", "error retrieving course")
+		return
+	}
+
+	progresses, err := s.listForAnalytics(r, r.URL.Query().Get("scheduledevent"))
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+
+	courseProgresses := make([]*progresspb.Progress, 0)
+	for _, progress := range progresses {
+		if progress.GetCourse() == courseId {
+			courseProgresses = append(courseProgresses, progress)
+		}
+	}
+	analytics := NewCourseAnalytics(courseId, course.GetScenarios(), courseProgresses)
+
+	if r.URL.Query().Get("format") == "csv" {
+		rows := [][]string{{"course", "users", "completed_users", "course_completion_rate", "scenario", "progresses", "completed", "completion_rate"}}
+		for _, scenario := range analytics.Scenarios {
+			rows = append(rows, []string{
+				analytics.Course,
+				strconv.Itoa(analytics.Users),
+				strconv.Itoa(analytics.CompletedUsers),
+				formatFloat(analytics.CompletionRate),
+				scenario.Scenario,
+				strconv.Itoa(scenario.Progresses),
+				strconv.Itoa(scenario.Completed),
+				formatFloat(scenario.CompletionRate),
+			})
+		}
+		returnCSV(w, "course-"+courseId, rows)
+		return
+	}
+
+	encoded, err := json.Marshal(analytics)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+/*
+Compare scheduled events
+
+	Query:
+	- ids : Comma separated scheduled event ids, all scheduled events if empty
+	- from, to : Only progress created within the range, time.UnixDate
+	- format : json (default) or csv
+*/
+func (s ProgressServer) ScheduledEventAnalyticsFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAnalytics(w, r) {
+		return
+	}
+
+	progresses, err := s.listForAnalytics(r, "")
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", err.Error())
+		return
+	}
+	groups := groupByScheduledEvent(progresses)
+
+	scheduledEvents := make([]string, 0)
+	if ids := r.URL.Query().Get("ids"); ids != "" {
+		for _, id := range strings.Split(ids, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				scheduledEvents = append(scheduledEvents, id)
+			}
+		}
+	} else {
+		for scheduledEvent := range groups {
+			scheduledEvents = append(scheduledEvents, scheduledEvent)
+		}
+		sort.Strings(scheduledEvents)
+	}
+
+	analytics := make([]ScheduledEventAnalytics, 0, len(scheduledEvents))
+	for _, scheduledEvent := range scheduledEvents {
+		analytics = append(analytics, NewScheduledEventAnalytics(scheduledEvent, groups[scheduledEvent]))
+	}
+
+	if r.URL.Query().Get("format") == "csv" {
+		rows := [][]string{{"scheduled_event", "users", "progresses", "completed", "completion_rate", "median_completion_seconds"}}
+		for _, event := range analytics {
+			rows = append(rows, []string{
+				event.ScheduledEvent,
+				strconv.Itoa(event.Users),
+				strconv.Itoa(event.Progresses),
+				strconv.Itoa(event.Completed),
+				formatFloat(event.CompletionRate),
+				formatFloat(event.MedianCompletionSeconds),
+			})
+		}
+		returnCSV(w, "scheduledevents", rows)
+		return
+	}
+
+	encoded, err := json.Marshal(analytics)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encoded)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', 4, 64)
+}
+
+func returnCSV(w http.ResponseWriter, name string, rows [][]string) {
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"progress-analytics-"+name+".csv\"")
+	w.WriteHeader(http.StatusOK)
+	writer := csv.NewWriter(w)
+	if err := writer.WriteAll(rows); err != nil {
+		glog.Errorf("error writing csv: %v", err)
+	}
+}
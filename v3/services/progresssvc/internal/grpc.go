@@ -120,6 +120,7 @@ func (s *GrpcProgressServer) GetProgress(ctx context.Context, req *generalpb.Get
 		LastUpdate:        progress.Spec.LastUpdate,
 		Finished:          progress.Spec.Finished,
 		Steps:             progressSteps,
+		TaskVerifications: taskVerificationsToPb(progress.Spec.TaskVerifications),
 		Labels:            progress.Labels,
 		CreationTimestamp: creationTimeStamp,
 	}, nil
@@ -207,6 +208,67 @@ func (s *GrpcProgressServer) UpdateCollectionProgress(ctx context.Context, req *
 	return &emptypb.Empty{}, nil
 }
 
+// RecordTaskVerifications stores the latest verification result of each task of a progress.
+// A result replaces the previous result of the same task on the same vm in the same step.
+func (s *GrpcProgressServer) RecordTaskVerifications(ctx context.Context, req *progresspb.RecordTaskVerificationsRequest) (*emptypb.Empty, error) {
+	id := req.GetId()
+	if len(id) == 0 {
+		return &emptypb.Empty{}, hferrors.GrpcIdNotSpecifiedError(req)
+	}
+
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		progress, err := s.progressClient.Get(ctx, id, metav1.GetOptions{})
+		if err != nil {
+			glog.Error(err)
+			return hferrors.GrpcError(
+				codes.Internal,
+				"error while retrieving progress %s",
+				req,
+				req.GetId(),
+			)
+		}
+
+		for _, v := range req.GetTaskVerifications() {
+			verification := hfv1.TaskVerification{
+				Step:      int(v.GetStep()),
+				VMName:    v.GetVmName(),
+				Task:      v.GetTask(),
+				Success:   v.GetSuccess(),
+				Score:     int(v.GetScore()),
+				MaxScore:  int(v.GetMaxScore()),
+				Output:    v.GetOutput(),
+				Error:     v.GetError(),
+				Timestamp: v.GetTimestamp(),
+			}
+
+			replaced := false
+			for i, existing := range progress.Spec.TaskVerifications {
+				if existing.Step == verification.Step && existing.VMName == verification.VMName && existing.Task == verification.Task {
+					progress.Spec.TaskVerifications[i] = verification
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				progress.Spec.TaskVerifications = append(progress.Spec.TaskVerifications, verification)
+			}
+		}
+
+		_, updateErr := s.progressClient.Update(ctx, progress, metav1.UpdateOptions{})
+		return updateErr
+	})
+
+	if retryErr != nil {
+		return &emptypb.Empty{}, hferrors.GrpcError(
+			codes.Internal,
+			"error attempting to update",
+			req,
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *GrpcProgressServer) DeleteProgress(ctx context.Context, req *generalpb.ResourceId) (*emptypb.Empty, error) {
 	return util.DeleteHfResource(ctx, req, s.progressClient, "progress")
 }
@@ -263,6 +325,7 @@ func (s *GrpcProgressServer) ListProgress(ctx context.Context, listOptions *gene
 			LastUpdate:        progress.Spec.LastUpdate,
 			Finished:          progress.Spec.Finished,
 			Steps:             progressSteps,
+			TaskVerifications: taskVerificationsToPb(progress.Spec.TaskVerifications),
 			Labels:            progress.Labels,
 			CreationTimestamp: creationTimeStamp,
 		})
@@ -322,3 +385,21 @@ func (s *GrpcProgressServer) updateProgress(
 	_, updateErr := s.progressClient.Update(ctx, progress, metav1.UpdateOptions{})
 	return updateErr
 }
+
+func taskVerificationsToPb(verifications []hfv1.TaskVerification) []*progresspb.TaskVerification {
+	out := []*progresspb.TaskVerification{}
+	for _, v := range verifications {
+		out = append(out, &progresspb.TaskVerification{
+			Step:      uint32(v.Step),
+			VmName:    v.VMName,
+			Task:      v.Task,
+			Success:   v.Success,
+			Score:     int32(v.Score),
+			MaxScore:  int32(v.MaxScore),
+			Output:    v.Output,
+			Error:     v.Error,
+			Timestamp: v.Timestamp,
+		})
+	}
+	return out
+}
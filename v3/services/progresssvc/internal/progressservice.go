@@ -27,18 +27,19 @@ const (
 )
 
 type AdminPreparedProgress struct {
-	ID          string                     `json:"id"`
-	Session     string                     `json:"session"`
-	CurrentStep uint32                     `json:"current_step"`
-	MaxStep     uint32                     `json:"max_step"`
-	TotalStep   uint32                     `json:"total_step"`
-	Course      string                     `json:"course"`
-	Scenario    string                     `json:"scenario"`
-	UserId      string                     `json:"user"`
-	Started     string                     `json:"started"`
-	LastUpdate  string                     `json:"last_update"`
-	Finished    string                     `json:"finished"`
-	Steps       []*progresspb.ProgressStep `json:"steps"`
+	ID                string                         `json:"id"`
+	Session           string                         `json:"session"`
+	CurrentStep       uint32                         `json:"current_step"`
+	MaxStep           uint32                         `json:"max_step"`
+	TotalStep         uint32                         `json:"total_step"`
+	Course            string                         `json:"course"`
+	Scenario          string                         `json:"scenario"`
+	UserId            string                         `json:"user"`
+	Started           string                         `json:"started"`
+	LastUpdate        string                         `json:"last_update"`
+	Finished          string                         `json:"finished"`
+	Steps             []*progresspb.ProgressStep     `json:"steps"`
+	TaskVerifications []*progresspb.TaskVerification `json:"task_verifications"`
 }
 
 type AdminPreparedProgressWithScheduledEvent struct {
@@ -246,18 +247,19 @@ func (s ProgressServer) ListByRange(w http.ResponseWriter, r *http.Request, star
 		}
 		pProgressWithEventId := AdminPreparedProgressWithScheduledEvent{
 			AdminPreparedProgress: AdminPreparedProgress{
-				ID:          p.GetId(),
-				Session:     p.GetLabels()[hflabels.SessionLabel],
-				CurrentStep: p.GetCurrentStep(),
-				MaxStep:     p.GetMaxStep(),
-				TotalStep:   p.GetTotalStep(),
-				Course:      p.GetCourse(),
-				Scenario:    p.GetScenario(),
-				UserId:      p.GetUser(),
-				Started:     p.GetStarted(),
-				LastUpdate:  p.GetLastUpdate(),
-				Finished:    p.GetFinished(),
-				Steps:       p.GetSteps(),
+				ID:                p.GetId(),
+				Session:           p.GetLabels()[hflabels.SessionLabel],
+				CurrentStep:       p.GetCurrentStep(),
+				MaxStep:           p.GetMaxStep(),
+				TotalStep:         p.GetTotalStep(),
+				Course:            p.GetCourse(),
+				Scenario:          p.GetScenario(),
+				UserId:            p.GetUser(),
+				Started:           p.GetStarted(),
+				LastUpdate:        p.GetLastUpdate(),
+				Finished:          p.GetFinished(),
+				Steps:             p.GetSteps(),
+				TaskVerifications: p.GetTaskVerifications(),
 			},
 			ScheduledEvent: p.GetLabels()[hflabels.ScheduledEventLabel],
 		}
@@ -289,18 +291,19 @@ func (s ProgressServer) ListByLabel(w http.ResponseWriter, r *http.Request, labe
 	preparedProgress := []AdminPreparedProgress{}
 	for _, p := range progressList.GetProgresses() {
 		pProgress := AdminPreparedProgress{
-			ID:          p.GetId(),
-			Session:     p.GetLabels()[hflabels.SessionLabel],
-			CurrentStep: p.GetCurrentStep(),
-			MaxStep:     p.GetMaxStep(),
-			TotalStep:   p.GetTotalStep(),
-			Course:      p.GetCourse(),
-			Scenario:    p.GetScenario(),
-			UserId:      p.GetUser(),
-			Started:     p.GetStarted(),
-			LastUpdate:  p.GetLastUpdate(),
-			Finished:    p.GetFinished(),
-			Steps:       p.GetSteps(),
+			ID:                p.GetId(),
+			Session:           p.GetLabels()[hflabels.SessionLabel],
+			CurrentStep:       p.GetCurrentStep(),
+			MaxStep:           p.GetMaxStep(),
+			TotalStep:         p.GetTotalStep(),
+			Course:            p.GetCourse(),
+			Scenario:          p.GetScenario(),
+			UserId:            p.GetUser(),
+			Started:           p.GetStarted(),
+			LastUpdate:        p.GetLastUpdate(),
+			Finished:          p.GetFinished(),
+			Steps:             p.GetSteps(),
+			TaskVerifications: p.GetTaskVerifications(),
 		}
 		preparedProgress = append(preparedProgress, pProgress)
 	}
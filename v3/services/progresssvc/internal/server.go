@@ -3,25 +3,33 @@ package progressservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	coursepb "github.com/hobbyfarm/gargantua/v3/protos/course"
 )
 
 type ProgressServer struct {
 	authnClient            authnpb.AuthNClient
 	authrClient            authrpb.AuthRClient
+	courseClient           coursepb.CourseSvcClient
 	internalProgressServer *GrpcProgressServer
+	progressFeed           *changefeed.Feed
 }
 
 func NewProgressServer(
 	authnClient authnpb.AuthNClient,
 	authrClient authrpb.AuthRClient,
+	courseClient coursepb.CourseSvcClient,
 	internalProgressServer *GrpcProgressServer,
+	progressFeed *changefeed.Feed,
 ) ProgressServer {
 	return ProgressServer{
 		authnClient:            authnClient,
 		authrClient:            authrClient,
+		courseClient:           courseClient,
 		internalProgressServer: internalProgressServer,
+		progressFeed:           progressFeed,
 	}
 }
 
@@ -30,6 +38,10 @@ func (s ProgressServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/a/progress/user/{id}", s.ListByUserFunc).Methods("GET")
 	r.HandleFunc("/a/progress/count", s.CountByScheduledEvent).Methods("GET")
 	r.HandleFunc("/a/progress/range", s.ListByRangeFunc).Methods("GET")
+	r.HandleFunc("/a/progress/watch", s.WatchFunc).Methods("GET")
+	r.HandleFunc("/a/progress/analytics/scenario/{id}", s.ScenarioAnalyticsFunc).Methods("GET")
+	r.HandleFunc("/a/progress/analytics/course/{id}", s.CourseAnalyticsFunc).Methods("GET")
+	r.HandleFunc("/a/progress/analytics/scheduledevents", s.ScheduledEventAnalyticsFunc).Methods("GET")
 	r.HandleFunc("/progress/update/{id}", s.Update).Methods("POST")
 	r.HandleFunc("/progress/list", s.ListForUserFunc).Methods("GET")
 	glog.V(2).Infof("set up routes for ProgressServer")
@@ -0,0 +1,58 @@
+package progressservice
+
+import (
+	"net/http"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// watchedProgress is a progress as it is sent on the change feed
+type watchedProgress struct {
+	Id     string            `json:"id"`
+	Labels map[string]string `json:"labels"`
+	hfv1.ProgressSpec
+}
+
+// PrepareProgressEvent converts a progress of the informer for the change feed.
+func PrepareProgressEvent(obj metav1.Object) interface{} {
+	progress, ok := obj.(*hfv1.Progress)
+	if !ok {
+		return nil
+	}
+	return watchedProgress{
+		Id:           progress.Name,
+		Labels:       progress.Labels,
+		ProgressSpec: progress.Spec,
+	}
+}
+
+/*
+Stream changes of progress as server-sent events
+
+	Query:
+	- scheduledevent : Only changes of progress in the scheduled event
+	- snapshot : Unless "false", the stream starts with every progress followed by a "synced" event
+*/
+func (s ProgressServer) WatchFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	impersonatedUserId := user.GetId()
+	authrResponse, err := rbac.Authorize(r, s.authrClient, impersonatedUserId, []*authrpb.Permission{
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbList),
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbWatch),
+	}, rbac.OperatorAND)
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to watch progress")
+		return
+	}
+
+	s.progressFeed.Stream(w, r, r.URL.Query().Get("scheduledevent"))
+}
@@ -4,8 +4,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 
@@ -13,6 +16,7 @@ import (
 	hfInformers "github.com/hobbyfarm/gargantua/v3/pkg/client/informers/externalversions"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	coursepb "github.com/hobbyfarm/gargantua/v3/protos/course"
 	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
 )
 
@@ -36,6 +40,7 @@ func main() {
 	services := []microservices.MicroService{
 		microservices.AuthN,
 		microservices.AuthR,
+		microservices.Course,
 	}
 	connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
 	for _, conn := range connections {
@@ -44,12 +49,18 @@ func main() {
 
 	authnClient := authnpb.NewAuthNClient(connections[microservices.AuthN])
 	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
+	courseClient := coursepb.NewCourseSvcClient(connections[microservices.Course])
 
 	gs := microservices.CreateGRPCServer(serviceConfig.ServerCert.Clone())
 
 	ps := progressService.NewGrpcProgressServer(hfClient, hfInformerFactory)
 	progresspb.RegisterProgressSvcServer(gs, ps)
 
+	progressFeed, err := changefeed.NewFeed(rbac.ResourcePluralProgress, hfInformerFactory.Hobbyfarm().V1().Progresses().Informer(), progressService.PrepareProgressEvent)
+	if err != nil {
+		glog.Fatalf("failed creating progress change feed: %s", err.Error())
+	}
+
 	var wg sync.WaitGroup
 	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
 	wg.Add(1)
@@ -65,7 +76,9 @@ func main() {
 		progressServer := progressService.NewProgressServer(
 			authnClient,
 			authrClient,
+			courseClient,
 			ps,
+			progressFeed,
 		)
 		microservices.StartAPIServer(progressServer)
 	}()
@@ -1,6 +1,8 @@
 package quiz
 
 import (
+	"math/rand"
+
 	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
@@ -29,6 +31,7 @@ func NewPreparedQuiz(quiz *quizpb.Quiz, showCorrect bool) PreparedQuiz {
 		MaxAttempts:      quiz.GetMaxAttempts(),
 		SuccessThreshold: quiz.GetSuccessThreshold(),
 		ValidationType:   parseValidationType(quiz.GetValidationType()),
+		TimeLimit:        quiz.GetTimeLimit(),
 		Questions:        questions,
 	}
 }
@@ -38,7 +41,8 @@ func NewPreparedQuestion(question *quizpb.QuizQuestion, showCorrect bool) Prepar
 	for i, answer := range question.GetAnswers() {
 		answers[i] = NewPreparedAnswer(answer, showCorrect)
 	}
-	return PreparedQuestion{
+
+	preparedQuestion := PreparedQuestion{
 		Id:             question.GetId(),
 		Title:          question.GetTitle(),
 		Description:    question.GetDescription(),
@@ -48,6 +52,63 @@ func NewPreparedQuestion(question *quizpb.QuizQuestion, showCorrect bool) Prepar
 		SuccessMessage: question.GetSuccessMessage(),
 		Weight:         question.GetWeight(),
 		Answers:        answers,
+		Command:        NewPreparedCommandCheck(question.GetCommand(), showCorrect),
+	}
+
+	if showCorrect {
+		preparedQuestion.Numeric = NewPreparedNumericAnswer(question.GetNumeric())
+		preparedQuestion.Text = NewPreparedTextAnswer(question.GetText())
+	} else if question.GetType() == hfv1.QuizQuestionTypeOrdering {
+		// the answers are defined in the correct order
+		rand.Shuffle(len(answers), func(i, j int) {
+			answers[i], answers[j] = answers[j], answers[i]
+		})
+	}
+
+	return preparedQuestion
+}
+
+func NewPreparedNumericAnswer(numeric *quizpb.QuizNumericAnswer) *PreparedNumericAnswer {
+	if numeric == nil {
+		return nil
+	}
+	return &PreparedNumericAnswer{
+		Value:     numeric.GetValue(),
+		Tolerance: numeric.GetTolerance(),
+	}
+}
+
+func NewPreparedTextAnswer(text *quizpb.QuizTextAnswer) *PreparedTextAnswer {
+	if text == nil {
+		return nil
+	}
+	return &PreparedTextAnswer{
+		Alternatives:  text.GetAlternatives(),
+		Regex:         text.GetRegex(),
+		CaseSensitive: text.GetCaseSensitive(),
+	}
+}
+
+// NewPreparedCommandCheck only contains the vm if the task is not shown, the user needs to know where to run the command.
+func NewPreparedCommandCheck(command *quizpb.QuizCommandCheck, showTask bool) *PreparedCommandCheck {
+	if command == nil {
+		return nil
+	}
+	if !showTask {
+		return &PreparedCommandCheck{VMName: command.GetVmName()}
+	}
+	return &PreparedCommandCheck{
+		VMName:              command.GetVmName(),
+		Name:                command.GetName(),
+		Command:             command.GetCommand(),
+		ExpectedOutputValue: command.GetExpectedOutputValue(),
+		ExpectedReturnCode:  command.GetExpectedReturnCode(),
+		ReturnType:          command.GetReturnType(),
+		Type:                command.GetType(),
+		Path:                command.GetPath(),
+		Url:                 command.GetUrl(),
+		JsonPath:            command.GetJsonPath(),
+		TimeoutSeconds:      command.GetTimeoutSeconds(),
 	}
 }
 
@@ -77,6 +138,7 @@ func NewPBCreateQuiz(quiz PreparedQuiz) *quizpb.CreateQuizRequest {
 		MaxAttempts:      quiz.MaxAttempts,
 		SuccessThreshold: quiz.SuccessThreshold,
 		ValidationType:   parseValidationType(quiz.ValidationType),
+		TimeLimit:        quiz.TimeLimit,
 		Questions:        questions,
 	}
 }
@@ -95,6 +157,9 @@ func NewPBCreateQuizQuestion(question PreparedQuestion) *quizpb.CreateQuizQuesti
 		SuccessMessage: question.SuccessMessage,
 		Weight:         question.Weight,
 		Answers:        answers,
+		Numeric:        NewPBQuizNumericAnswer(question.Numeric),
+		Text:           NewPBQuizTextAnswer(question.Text),
+		Command:        NewPBQuizCommandCheck(question.Command),
 	}
 }
 
@@ -105,6 +170,46 @@ func NewPBCreateQuizAnswer(answer PreparedAnswer) *quizpb.CreateQuizAnswer {
 	}
 }
 
+func NewPBQuizNumericAnswer(numeric *PreparedNumericAnswer) *quizpb.QuizNumericAnswer {
+	if numeric == nil {
+		return nil
+	}
+	return &quizpb.QuizNumericAnswer{
+		Value:     numeric.Value,
+		Tolerance: numeric.Tolerance,
+	}
+}
+
+func NewPBQuizTextAnswer(text *PreparedTextAnswer) *quizpb.QuizTextAnswer {
+	if text == nil {
+		return nil
+	}
+	return &quizpb.QuizTextAnswer{
+		Alternatives:  text.Alternatives,
+		Regex:         text.Regex,
+		CaseSensitive: text.CaseSensitive,
+	}
+}
+
+func NewPBQuizCommandCheck(command *PreparedCommandCheck) *quizpb.QuizCommandCheck {
+	if command == nil {
+		return nil
+	}
+	return &quizpb.QuizCommandCheck{
+		VmName:              command.VMName,
+		Name:                command.Name,
+		Command:             command.Command,
+		ExpectedOutputValue: command.ExpectedOutputValue,
+		ExpectedReturnCode:  command.ExpectedReturnCode,
+		ReturnType:          command.ReturnType,
+		Type:                command.Type,
+		Path:                command.Path,
+		Url:                 command.Url,
+		JsonPath:            command.JsonPath,
+		TimeoutSeconds:      command.TimeoutSeconds,
+	}
+}
+
 func NewPBUpdateQuiz(id string, quiz PreparedQuiz) *quizpb.UpdateQuizRequest {
 	questions := make([]*quizpb.UpdateQuizQuestion, len(quiz.Questions))
 	for i, question := range quiz.Questions {
@@ -119,6 +224,7 @@ func NewPBUpdateQuiz(id string, quiz PreparedQuiz) *quizpb.UpdateQuizRequest {
 		MaxAttempts:      quiz.MaxAttempts,
 		SuccessThreshold: quiz.SuccessThreshold,
 		ValidationType:   parseValidationType(quiz.ValidationType),
+		TimeLimit:        quiz.TimeLimit,
 		Questions:        questions,
 	}
 }
@@ -137,6 +243,9 @@ func NewPBUpdateQuizQuestion(question PreparedQuestion) *quizpb.UpdateQuizQuesti
 		SuccessMessage: question.SuccessMessage,
 		Weight:         question.Weight,
 		Answers:        answers,
+		Numeric:        NewPBQuizNumericAnswer(question.Numeric),
+		Text:           NewPBQuizTextAnswer(question.Text),
+		Command:        NewPBQuizCommandCheck(question.Command),
 	}
 }
 
@@ -180,6 +289,9 @@ func NewPBQuiz(quiz *hfv1.Quiz) *quizpb.Quiz {
 			SuccessMessage: question.SuccessMessage,
 			Weight:         question.Weight,
 			Answers:        answers,
+			Numeric:        newPBQuizNumericAnswerFromSpec(question.Numeric),
+			Text:           newPBQuizTextAnswerFromSpec(question.Text),
+			Command:        newPBQuizCommandCheckFromSpec(question.Command),
 		}
 	}
 
@@ -193,10 +305,51 @@ func NewPBQuiz(quiz *hfv1.Quiz) *quizpb.Quiz {
 		MaxAttempts:      quiz.Spec.MaxAttempts,
 		SuccessThreshold: quiz.Spec.SuccessThreshold,
 		ValidationType:   parseValidationType(quiz.Spec.ValidationType),
+		TimeLimit:        quiz.Spec.TimeLimit,
 		Questions:        questions,
 	}
 }
 
+func newPBQuizNumericAnswerFromSpec(numeric *hfv1.QuizNumericAnswer) *quizpb.QuizNumericAnswer {
+	if numeric == nil {
+		return nil
+	}
+	return &quizpb.QuizNumericAnswer{
+		Value:     numeric.Value,
+		Tolerance: numeric.Tolerance,
+	}
+}
+
+func newPBQuizTextAnswerFromSpec(text *hfv1.QuizTextAnswer) *quizpb.QuizTextAnswer {
+	if text == nil {
+		return nil
+	}
+	return &quizpb.QuizTextAnswer{
+		Alternatives:  text.Alternatives,
+		Regex:         text.Regex,
+		CaseSensitive: text.CaseSensitive,
+	}
+}
+
+func newPBQuizCommandCheckFromSpec(command *hfv1.QuizCommandCheck) *quizpb.QuizCommandCheck {
+	if command == nil {
+		return nil
+	}
+	return &quizpb.QuizCommandCheck{
+		VmName:              command.VMName,
+		Name:                command.Task.Name,
+		Command:             command.Task.Command,
+		ExpectedOutputValue: command.Task.ExpectedOutputValue,
+		ExpectedReturnCode:  int32(command.Task.ExpectedReturnCode),
+		ReturnType:          command.Task.ReturnType,
+		Type:                command.Task.Type,
+		Path:                command.Task.Path,
+		Url:                 command.Task.URL,
+		JsonPath:            command.Task.JSONPath,
+		TimeoutSeconds:      int32(command.Task.TimeoutSeconds),
+	}
+}
+
 func NewQuizFromCreate(id string, quiz *quizpb.CreateQuizRequest) *hfv1.Quiz {
 	questions := make([]hfv1.QuizQuestion, len(quiz.GetQuestions()))
 	for i, question := range quiz.GetQuestions() {
@@ -218,6 +371,9 @@ func NewQuizFromCreate(id string, quiz *quizpb.CreateQuizRequest) *hfv1.Quiz {
 			SuccessMessage: question.GetSuccessMessage(),
 			Weight:         question.GetWeight(),
 			Answers:        answers,
+			Numeric:        newQuizNumericAnswer(question.GetNumeric()),
+			Text:           newQuizTextAnswer(question.GetText()),
+			Command:        newQuizCommandCheck(question.GetCommand()),
 		}
 	}
 
@@ -233,6 +389,7 @@ func NewQuizFromCreate(id string, quiz *quizpb.CreateQuizRequest) *hfv1.Quiz {
 			MaxAttempts:      quiz.GetMaxAttempts(),
 			SuccessThreshold: quiz.GetSuccessThreshold(),
 			ValidationType:   parseValidationType(quiz.GetValidationType()),
+			TimeLimit:        quiz.GetTimeLimit(),
 			Questions:        questions,
 		},
 	}
@@ -246,6 +403,7 @@ func NewQuizFromUpdate(req *quizpb.UpdateQuizRequest, source *hfv1.Quiz) *hfv1.Q
 	source.Spec.MaxAttempts = req.GetMaxAttempts()
 	source.Spec.SuccessThreshold = req.GetSuccessThreshold()
 	source.Spec.ValidationType = parseValidationType(req.GetValidationType())
+	source.Spec.TimeLimit = req.GetTimeLimit()
 
 	questions := make([]hfv1.QuizQuestion, len(req.GetQuestions()))
 
@@ -268,6 +426,9 @@ func NewQuizFromUpdate(req *quizpb.UpdateQuizRequest, source *hfv1.Quiz) *hfv1.Q
 			SuccessMessage: question.GetSuccessMessage(),
 			Weight:         question.GetWeight(),
 			Answers:        answers,
+			Numeric:        newQuizNumericAnswer(question.GetNumeric()),
+			Text:           newQuizTextAnswer(question.GetText()),
+			Command:        newQuizCommandCheck(question.GetCommand()),
 		}
 	}
 
@@ -275,3 +436,50 @@ func NewQuizFromUpdate(req *quizpb.UpdateQuizRequest, source *hfv1.Quiz) *hfv1.Q
 
 	return source
 }
+
+func newQuizNumericAnswer(numeric *quizpb.QuizNumericAnswer) *hfv1.QuizNumericAnswer {
+	if numeric == nil {
+		return nil
+	}
+	return &hfv1.QuizNumericAnswer{
+		Value:     numeric.GetValue(),
+		Tolerance: numeric.GetTolerance(),
+	}
+}
+
+func newQuizTextAnswer(text *quizpb.QuizTextAnswer) *hfv1.QuizTextAnswer {
+	if text == nil {
+		return nil
+	}
+	return &hfv1.QuizTextAnswer{
+		Alternatives:  text.GetAlternatives(),
+		Regex:         text.GetRegex(),
+		CaseSensitive: text.GetCaseSensitive(),
+	}
+}
+
+func newQuizCommandCheck(command *quizpb.QuizCommandCheck) *hfv1.QuizCommandCheck {
+	if command == nil {
+		return nil
+	}
+	return &hfv1.QuizCommandCheck{
+		VMName: command.GetVmName(),
+		Task:   newTask(command),
+	}
+}
+
+// newTask returns the task verified for a command question.
+func newTask(command *quizpb.QuizCommandCheck) hfv1.Task {
+	return hfv1.Task{
+		Name:                command.GetName(),
+		Command:             command.GetCommand(),
+		ExpectedOutputValue: command.GetExpectedOutputValue(),
+		ExpectedReturnCode:  int(command.GetExpectedReturnCode()),
+		ReturnType:          command.GetReturnType(),
+		Type:                command.GetType(),
+		Path:                command.GetPath(),
+		URL:                 command.GetUrl(),
+		JSONPath:            command.GetJsonPath(),
+		TimeoutSeconds:      int(command.GetTimeoutSeconds()),
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
 	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
@@ -219,6 +221,41 @@ func validatePreparedQuiz(preparedQuiz PreparedQuiz) error {
 		if question.Weight == 0 {
 			return errors.New("question weight needs to be greater than 0")
 		}
+		if err := validatePreparedQuestion(question); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePreparedQuestion(question PreparedQuestion) error {
+	switch question.Type {
+	case hfv1.QuizQuestionTypeOrdering:
+		if len(question.Answers) < 2 {
+			return errors.New("ordering questions need at least two answers")
+		}
+	case hfv1.QuizQuestionTypeNumeric:
+		if question.Numeric == nil {
+			return errors.New("numeric questions need a numeric answer")
+		}
+		if question.Numeric.Tolerance < 0 {
+			return errors.New("tolerance of numeric answers can not be negative")
+		}
+	case hfv1.QuizQuestionTypeText:
+		if question.Text == nil || (len(question.Text.Alternatives) == 0 && question.Text.Regex == "") {
+			return errors.New("text questions need alternatives or a regex")
+		}
+		if _, err := regexp.Compile(question.Text.Regex); err != nil {
+			return fmt.Errorf("invalid regex of text question: %v", err)
+		}
+	case hfv1.QuizQuestionTypeCommand:
+		if question.Command == nil || question.Command.VMName == "" {
+			return errors.New("command questions need a vm name")
+		}
+		if question.Command.Command == "" && question.Command.Path == "" && question.Command.Url == "" {
+			return errors.New("command questions need a command, path or url")
+		}
+	default:
 		var hasCorrect bool
 		for _, answer := range question.Answers {
 			if util.DerefOrDefault(answer.Correct) {
@@ -13,6 +13,7 @@ type PreparedQuiz struct {
 	MaxAttempts      uint32             `json:"max_attempts"`
 	SuccessThreshold uint32             `json:"success_threshold"`
 	ValidationType   string             `json:"validation_type"`
+	TimeLimit        uint32             `json:"time_limit"`
 	Questions        []PreparedQuestion `json:"questions"`
 }
 
@@ -26,6 +27,10 @@ type PreparedQuestion struct {
 	SuccessMessage string           `json:"success_message"`
 	Weight         uint32           `json:"weight"`
 	Answers        []PreparedAnswer `json:"answers"`
+	// the expected answers are only shown to admins, users only get the vm of command questions
+	Numeric *PreparedNumericAnswer `json:"numeric,omitempty"`
+	Text    *PreparedTextAnswer    `json:"text,omitempty"`
+	Command *PreparedCommandCheck  `json:"command,omitempty"`
 }
 
 type PreparedAnswer struct {
@@ -34,6 +39,31 @@ type PreparedAnswer struct {
 	Correct *bool  `json:"correct,omitempty"`
 }
 
+type PreparedNumericAnswer struct {
+	Value     float64 `json:"value"`
+	Tolerance float64 `json:"tolerance"`
+}
+
+type PreparedTextAnswer struct {
+	Alternatives  []string `json:"alternatives"`
+	Regex         string   `json:"regex"`
+	CaseSensitive bool     `json:"case_sensitive"`
+}
+
+type PreparedCommandCheck struct {
+	VMName              string `json:"vm_name"`
+	Name                string `json:"name,omitempty"`
+	Command             string `json:"command,omitempty"`
+	ExpectedOutputValue string `json:"expected_output_value,omitempty"`
+	ExpectedReturnCode  int32  `json:"expected_return_code,omitempty"`
+	ReturnType          string `json:"return_type,omitempty"`
+	Type                string `json:"type,omitempty"`
+	Path                string `json:"path,omitempty"`
+	Url                 string `json:"url,omitempty"`
+	JsonPath            string `json:"json_path,omitempty"`
+	TimeoutSeconds      int32  `json:"timeout_seconds,omitempty"`
+}
+
 type ValidationType = string
 
 const (
@@ -0,0 +1,149 @@
+package quizevaluation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+)
+
+const (
+	// share of the best and the worst attempts compared for the discrimination of questions
+	discriminationGroupShare = 0.27
+	// questions need this many attempts before warnings are given
+	minAttemptsForWarnings = 5
+
+	tooHardDifficulty = 0.2
+	tooEasyDifficulty = 0.95
+	lowDiscrimination = 0.1
+)
+
+// Warnings about questions which are likely broken
+const (
+	WarningTooHard           = "too_hard"
+	WarningTooEasy           = "too_easy"
+	WarningLowDiscrimination = "low_discrimination" // the question is answered correctly by bad and good attempts alike
+)
+
+type questionOutcome struct {
+	asked   bool
+	correct bool
+}
+
+type scoredAttempt struct {
+	score    uint32
+	outcomes map[string]questionOutcome
+}
+
+// NewQuizAnalytics computes the analytics of the quiz over the recorded attempts of the evaluations.
+// Attempts which are still in progress are ignored. The questions of timed out attempts have not been evaluated,
+// these attempts only count towards the score and pass rate.
+func NewQuizAnalytics(quiz *quizpb.Quiz, scheduledEvent string, evaluations []*quizpb.QuizEvaluation) PreparedQuizAnalytics {
+	analytics := PreparedQuizAnalytics{
+		Quiz:           quiz.GetId(),
+		ScheduledEvent: scheduledEvent,
+		Questions:      make([]PreparedQuestionAnalytics, 0, len(quiz.GetQuestions())),
+	}
+
+	var totalScore uint32
+	var passed uint32
+	scoredAttempts := make([]scoredAttempt, 0)
+	for _, evaluation := range evaluations {
+		for _, attempt := range evaluation.GetAttempts() {
+			if attempt.GetTimestamp() == "" {
+				continue
+			}
+			analytics.Attempts++
+			totalScore += attempt.GetScore()
+			if attempt.GetPass() {
+				passed++
+			}
+			if attempt.GetTimedOut() {
+				continue
+			}
+			scoredAttempts = append(scoredAttempts, scoredAttempt{
+				score:    attempt.GetScore(),
+				outcomes: attemptOutcomes(attempt),
+			})
+		}
+	}
+
+	if analytics.Attempts > 0 {
+		analytics.AverageScore = float64(totalScore) / float64(analytics.Attempts)
+		analytics.PassRate = float64(passed) / float64(analytics.Attempts)
+	}
+
+	sort.SliceStable(scoredAttempts, func(i, j int) bool {
+		return scoredAttempts[i].score > scoredAttempts[j].score
+	})
+	groupSize := int(math.Ceil(float64(len(scoredAttempts)) * discriminationGroupShare))
+	upper := scoredAttempts[:groupSize]
+	lower := scoredAttempts[len(scoredAttempts)-groupSize:]
+
+	for _, question := range quiz.GetQuestions() {
+		questionAnalytics := PreparedQuestionAnalytics{
+			Id:       question.GetId(),
+			Title:    question.GetTitle(),
+			Type:     question.GetType(),
+			Warnings: []string{},
+		}
+		questionAnalytics.Attempts, questionAnalytics.Correct = countOutcomes(scoredAttempts, question.GetId())
+		if questionAnalytics.Attempts > 0 {
+			questionAnalytics.Difficulty = float64(questionAnalytics.Correct) / float64(questionAnalytics.Attempts)
+		}
+		questionAnalytics.Discrimination = shareCorrect(upper, question.GetId()) - shareCorrect(lower, question.GetId())
+
+		if questionAnalytics.Attempts >= minAttemptsForWarnings {
+			if questionAnalytics.Difficulty < tooHardDifficulty {
+				questionAnalytics.Warnings = append(questionAnalytics.Warnings, WarningTooHard)
+			}
+			if questionAnalytics.Difficulty > tooEasyDifficulty {
+				questionAnalytics.Warnings = append(questionAnalytics.Warnings, WarningTooEasy)
+			}
+			if questionAnalytics.Discrimination < lowDiscrimination {
+				questionAnalytics.Warnings = append(questionAnalytics.Warnings, WarningLowDiscrimination)
+			}
+		}
+
+		analytics.Questions = append(analytics.Questions, questionAnalytics)
+	}
+
+	return analytics
+}
+
+// attemptOutcomes returns whether the questions of the attempt have been answered correctly. Attempts recorded
+// before the results were stored are evaluated by comparing the selected with the correct answers.
+func attemptOutcomes(attempt *quizpb.QuizEvaluationAttempt) map[string]questionOutcome {
+	outcomes := make(map[string]questionOutcome)
+	for questionId, selects := range attempt.GetSelects() {
+		correct, exists := attempt.GetResults()[questionId]
+		if !exists {
+			correct = util.SliceSortEqual(selects.GetValues(), attempt.GetCorrects()[questionId].GetValues())
+		}
+		outcomes[questionId] = questionOutcome{asked: true, correct: correct}
+	}
+	return outcomes
+}
+
+func countOutcomes(attempts []scoredAttempt, questionId string) (asked uint32, correct uint32) {
+	for _, attempt := range attempts {
+		outcome := attempt.outcomes[questionId]
+		if !outcome.asked {
+			continue
+		}
+		asked++
+		if outcome.correct {
+			correct++
+		}
+	}
+	return asked, correct
+}
+
+func shareCorrect(attempts []scoredAttempt, questionId string) float64 {
+	asked, correct := countOutcomes(attempts, questionId)
+	if asked == 0 {
+		return 0
+	}
+	return float64(correct) / float64(asked)
+}
@@ -0,0 +1,123 @@
+package quizevaluation
+
+import (
+	"fmt"
+	"testing"
+
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	"github.com/stretchr/testify/assert"
+)
+
+func recordedAttempt(score uint32, pass bool, results map[string]bool) *quizpb.QuizEvaluationAttempt {
+	selects := make(map[string]*generalpb.StringArray)
+	for questionId := range results {
+		selects[questionId] = &generalpb.StringArray{Values: []string{}}
+	}
+	return &quizpb.QuizEvaluationAttempt{
+		Timestamp: "Mon Jan  2 15:04:05 MST 2006",
+		Score:     score,
+		Pass:      pass,
+		Selects:   selects,
+		Results:   results,
+	}
+}
+
+func TestNewQuizAnalytics(t *testing.T) {
+	quiz := &quizpb.Quiz{
+		Id: "quiz-id",
+		Questions: []*quizpb.QuizQuestion{
+			{Id: "discriminating"},
+			{Id: "easy"},
+			{Id: "hard"},
+			{Id: "unused"},
+		},
+	}
+
+	evaluations := make([]*quizpb.QuizEvaluation, 0)
+	for i := 0; i < 5; i++ {
+		evaluations = append(evaluations, &quizpb.QuizEvaluation{
+			Id:   fmt.Sprintf("good-%d", i),
+			Quiz: quiz.GetId(),
+			Attempts: []*quizpb.QuizEvaluationAttempt{
+				recordedAttempt(66, true, map[string]bool{"discriminating": true, "easy": true, "hard": false}),
+			},
+		})
+		evaluations = append(evaluations, &quizpb.QuizEvaluation{
+			Id:   fmt.Sprintf("bad-%d", i),
+			Quiz: quiz.GetId(),
+			Attempts: []*quizpb.QuizEvaluationAttempt{
+				recordedAttempt(33, false, map[string]bool{"discriminating": false, "easy": true, "hard": false}),
+				// in progress
+				{CreationTimestamp: "Mon Jan  2 15:04:05 MST 2006"},
+			},
+		})
+	}
+	// a legacy attempt without results, evaluated by its corrects
+	evaluations = append(evaluations, &quizpb.QuizEvaluation{
+		Id:   "legacy",
+		Quiz: quiz.GetId(),
+		Attempts: []*quizpb.QuizEvaluationAttempt{
+			{
+				Timestamp: "Mon Jan  2 15:04:05 MST 2006",
+				Score:     0,
+				Corrects:  map[string]*generalpb.StringArray{"easy": {Values: []string{"a"}}},
+				Selects:   map[string]*generalpb.StringArray{"easy": {Values: []string{"a"}}},
+			},
+		},
+	})
+	// a timed out attempt only counts towards score and pass rate
+	evaluations = append(evaluations, &quizpb.QuizEvaluation{
+		Id:   "timed-out",
+		Quiz: quiz.GetId(),
+		Attempts: []*quizpb.QuizEvaluationAttempt{
+			{Timestamp: "Mon Jan  2 15:04:05 MST 2006", TimedOut: true},
+		},
+	})
+
+	analytics := NewQuizAnalytics(quiz, "se-id", evaluations)
+
+	assert.Equal(t, "quiz-id", analytics.Quiz)
+	assert.Equal(t, "se-id", analytics.ScheduledEvent)
+	assert.Equal(t, uint32(12), analytics.Attempts)
+	assert.InDelta(t, float64(5*66+5*33)/12, analytics.AverageScore, 0.001)
+	assert.InDelta(t, float64(5)/12, analytics.PassRate, 0.001)
+
+	questions := make(map[string]PreparedQuestionAnalytics)
+	for _, question := range analytics.Questions {
+		questions[question.Id] = question
+	}
+
+	discriminating := questions["discriminating"]
+	assert.Equal(t, uint32(10), discriminating.Attempts)
+	assert.Equal(t, uint32(5), discriminating.Correct)
+	assert.InDelta(t, 0.5, discriminating.Difficulty, 0.001)
+	assert.InDelta(t, 1, discriminating.Discrimination, 0.001)
+	assert.Empty(t, discriminating.Warnings)
+
+	easy := questions["easy"]
+	assert.Equal(t, uint32(11), easy.Attempts)
+	assert.Equal(t, uint32(11), easy.Correct)
+	assert.Equal(t, []string{WarningTooEasy, WarningLowDiscrimination}, easy.Warnings)
+
+	hard := questions["hard"]
+	assert.Equal(t, uint32(0), hard.Correct)
+	assert.Equal(t, []string{WarningTooHard, WarningLowDiscrimination}, hard.Warnings)
+
+	unused := questions["unused"]
+	assert.Equal(t, uint32(0), unused.Attempts)
+	assert.Empty(t, unused.Warnings)
+}
+
+func TestNewQuizAnalyticsWithoutAttempts(t *testing.T) {
+	quiz := &quizpb.Quiz{
+		Id:        "quiz-id",
+		Questions: []*quizpb.QuizQuestion{{Id: "question"}},
+	}
+
+	analytics := NewQuizAnalytics(quiz, "", nil)
+
+	assert.Equal(t, uint32(0), analytics.Attempts)
+	assert.Equal(t, float64(0), analytics.PassRate)
+	assert.Len(t, analytics.Questions, 1)
+}
@@ -7,7 +7,6 @@ import (
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
 	"math/rand"
-	"reflect"
 	"time"
 )
 
@@ -88,36 +87,24 @@ func orderedSelects(quiz *quizpb.Quiz) map[string]*generalpb.StringArray {
 	return selects
 }
 
-func NewPBQuizEvaluationAttemptForRecord(attempt uint32, evaluation PreparedRecordQuizEvaluation, quiz *quizpb.Quiz) *quizpb.QuizEvaluationAttempt {
+// NewPBQuizEvaluationAttemptForRecord scores the answers of the user. Verifications contains the results of the
+// tasks of command questions, which have been verified on the vms of the user during the attempt.
+func NewPBQuizEvaluationAttemptForRecord(attempt uint32, evaluation PreparedRecordQuizEvaluation, quiz *quizpb.Quiz, verifications map[string]bool) *quizpb.QuizEvaluationAttempt {
 	var achievable uint32
 	var actual uint32
 	selects := make(map[string]*generalpb.StringArray)
 	corrects := make(map[string]*generalpb.StringArray)
+	results := make(map[string]bool)
 
 	for _, question := range quiz.GetQuestions() {
 		if selectedAnswers, exists := evaluation.Answers[question.GetId()]; exists {
 			achievable += question.GetWeight()
 
 			selects[question.GetId()] = &generalpb.StringArray{Values: selectedAnswers}
-			selectedAnswerIds := make(map[string]struct{})
-			for _, selectedAnswerId := range selectedAnswers {
-				selectedAnswerIds[selectedAnswerId] = struct{}{}
-			}
-
-			correctAnswers := make([]string, 0)
-			for _, answer := range question.GetAnswers() {
-				if answer.GetCorrect() {
-					correctAnswers = append(correctAnswers, answer.GetId())
-				}
-			}
-			corrects[question.GetId()] = &generalpb.StringArray{Values: correctAnswers}
+			corrects[question.GetId()] = &generalpb.StringArray{Values: correctAnswers(question)}
 
-			correctAnswerIds := make(map[string]struct{})
-			for _, correctAnswerId := range correctAnswers {
-				correctAnswerIds[correctAnswerId] = struct{}{}
-			}
-
-			if reflect.DeepEqual(selectedAnswerIds, correctAnswerIds) {
+			results[question.GetId()] = evaluateQuestion(question, selectedAnswers, verifications)
+			if results[question.GetId()] {
 				actual += question.GetWeight()
 			}
 		}
@@ -128,26 +115,49 @@ func NewPBQuizEvaluationAttemptForRecord(attempt uint32, evaluation PreparedReco
 	passed := achievedPercent >= float32(quiz.SuccessThreshold)
 
 	return &quizpb.QuizEvaluationAttempt{
-		Timestamp: time.Now().Format(time.UnixDate),
-		Attempt:   attempt,
-		Score:     uint32(achievedPercent), // always round down
-		Pass:      passed,
-		Corrects:  corrects,
-		Selects:   selects,
+		Timestamp:     time.Now().Format(time.UnixDate),
+		Attempt:       attempt,
+		Score:         uint32(achievedPercent), // always round down
+		Pass:          passed,
+		Corrects:      corrects,
+		Selects:       selects,
+		Results:       results,
+		Verifications: verifications,
 	}
 }
 
-func NewPreparedStartQuizEvaluationResult(id, quiz, scenario string, attempt *quizpb.QuizEvaluationAttempt) PreparedStartQuizEvaluationResult {
+// NewPBQuizEvaluationAttemptForTimeout records an attempt which exceeded the time limit of the quiz, it fails
+// regardless of the answers.
+func NewPBQuizEvaluationAttemptForTimeout(attempt uint32, evaluation PreparedRecordQuizEvaluation, verifications map[string]bool) *quizpb.QuizEvaluationAttempt {
+	selects := make(map[string]*generalpb.StringArray)
+	for questionId, selectedAnswers := range evaluation.Answers {
+		selects[questionId] = &generalpb.StringArray{Values: selectedAnswers}
+	}
+
+	return &quizpb.QuizEvaluationAttempt{
+		Timestamp:     time.Now().Format(time.UnixDate),
+		Attempt:       attempt,
+		Score:         0,
+		Pass:          false,
+		Corrects:      map[string]*generalpb.StringArray{},
+		Selects:       selects,
+		Verifications: verifications,
+		TimedOut:      true,
+	}
+}
+
+func NewPreparedStartQuizEvaluationResult(id string, quiz *quizpb.Quiz, scenario string, attempt *quizpb.QuizEvaluationAttempt) PreparedStartQuizEvaluationResult {
 	questions := make([]string, 0, len(attempt.GetSelects()))
 	for questionId, _ := range attempt.GetSelects() {
 		questions = append(questions, questionId)
 	}
 	return PreparedStartQuizEvaluationResult{
 		Id:                id,
-		Quiz:              quiz,
+		Quiz:              quiz.GetId(),
 		Scenario:          scenario,
 		CreationTimestamp: attempt.GetCreationTimestamp(),
 		Attempt:           attempt.GetAttempt(),
+		TimeLimit:         quiz.GetTimeLimit(),
 		Questions:         questions,
 	}
 }
@@ -168,6 +178,13 @@ func NewPreparedAttempt(validationType quiz.ValidationType, attempt *quizpb.Quiz
 		}
 
 		for questionId, selectedAnswerIDs := range selects {
+			if result, exists := attempt.GetResults()[questionId]; exists {
+				// attempts are evaluated per question type since results have been recorded
+				if result {
+					corrects[questionId] = allCorrects[questionId]
+				}
+				continue
+			}
 			if _, exists := allCorrects[questionId]; exists {
 				if util.SliceSortEqual(selectedAnswerIDs, allCorrects[questionId]) {
 					corrects[questionId] = selectedAnswerIDs
@@ -189,6 +206,7 @@ func NewPreparedAttempt(validationType quiz.ValidationType, attempt *quizpb.Quiz
 		Pass:              attempt.GetPass(),
 		Corrects:          corrects,
 		Selects:           selects,
+		TimedOut:          attempt.GetTimedOut(),
 	}
 }
 
@@ -222,6 +240,9 @@ func NewPBQuizEvaluation(quizEvaluations *hfv1.QuizEvaluation) *quizpb.QuizEvalu
 			Pass:              attempt.Pass,
 			Corrects:          corrects,
 			Selects:           selects,
+			Results:           attempt.Results,
+			Verifications:     attempt.Verifications,
+			TimedOut:          attempt.TimedOut,
 		}
 	}
 
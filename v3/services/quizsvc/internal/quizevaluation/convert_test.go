@@ -3,6 +3,7 @@ package quizevaluation
 import (
 	"fmt"
 	"github.com/hobbyfarm/gargantua/services/quizsvc/v3/internal/quiz"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
 	"github.com/stretchr/testify/assert"
@@ -215,7 +216,7 @@ func TestNewPBQuizEvaluationAttemptForRecord(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			actual := NewPBQuizEvaluationAttemptForRecord(1, tt.evaluation, quiz)
+			actual := NewPBQuizEvaluationAttemptForRecord(1, tt.evaluation, quiz, nil)
 			assert.Equal(t, tt.want.Score, actual.Score, fmt.Sprintf("Score(%d)", tt.want.Score))
 			assert.Equal(t, tt.want.Pass, actual.Pass, fmt.Sprintf("Pass(%t)", tt.want.Pass))
 
@@ -230,6 +231,95 @@ func TestNewPBQuizEvaluationAttemptForRecord(t *testing.T) {
 	}
 }
 
+func TestNewPBQuizEvaluationAttemptForRecordQuestionTypes(t *testing.T) {
+	quiz := &quizpb.Quiz{
+		Id:               "quiz-id",
+		SuccessThreshold: 50,
+		Questions: []*quizpb.QuizQuestion{
+			{
+				Id:     "ordering",
+				Type:   hfv1.QuizQuestionTypeOrdering,
+				Weight: 1,
+				Answers: []*quizpb.QuizAnswer{
+					{Id: "ordering-answer-1"},
+					{Id: "ordering-answer-2"},
+					{Id: "ordering-answer-3"},
+				},
+			},
+			{
+				Id:      "numeric",
+				Type:    hfv1.QuizQuestionTypeNumeric,
+				Weight:  1,
+				Numeric: &quizpb.QuizNumericAnswer{Value: 3.14, Tolerance: 0.01},
+			},
+			{
+				Id:     "text",
+				Type:   hfv1.QuizQuestionTypeText,
+				Weight: 1,
+				Text:   &quizpb.QuizTextAnswer{Alternatives: []string{"kubectl"}, Regex: "k(ube)?ctl get pods?"},
+			},
+			{
+				Id:      "command",
+				Type:    hfv1.QuizQuestionTypeCommand,
+				Weight:  1,
+				Command: &quizpb.QuizCommandCheck{VmName: "vm", Command: "test -f /tmp/done"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		answers       map[string][]string
+		verifications map[string]bool
+		wantScore     uint32
+		wantResults   map[string]bool
+	}{
+		{
+			name: "all correct",
+			answers: map[string][]string{
+				"ordering": {"ordering-answer-1", "ordering-answer-2", "ordering-answer-3"},
+				"numeric":  {" 3.145 "},
+				"text":     {"KubeCtl"},
+				"command":  {},
+			},
+			verifications: map[string]bool{"command": true},
+			wantScore:     100,
+			wantResults:   map[string]bool{"ordering": true, "numeric": true, "text": true, "command": true},
+		},
+		{
+			name: "all false",
+			answers: map[string][]string{
+				"ordering": {"ordering-answer-2", "ordering-answer-1", "ordering-answer-3"},
+				"numeric":  {"3.2"},
+				"text":     {"kubectl get nodes"},
+				"command":  {},
+			},
+			verifications: map[string]bool{"command": false},
+			wantScore:     0,
+			wantResults:   map[string]bool{"ordering": false, "numeric": false, "text": false, "command": false},
+		},
+		{
+			name: "regex and unverified command",
+			answers: map[string][]string{
+				"ordering": {"ordering-answer-1", "ordering-answer-2"},
+				"numeric":  {"pi"},
+				"text":     {"kctl get pod"},
+				"command":  {},
+			},
+			wantScore:   25,
+			wantResults: map[string]bool{"ordering": false, "numeric": false, "text": true, "command": false},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluation := PreparedRecordQuizEvaluation{Quiz: quiz.GetId(), Answers: tt.answers}
+			actual := NewPBQuizEvaluationAttemptForRecord(1, evaluation, quiz, tt.verifications)
+			assert.Equal(t, tt.wantScore, actual.GetScore())
+			assert.Equal(t, tt.wantResults, actual.GetResults())
+		})
+	}
+}
+
 func TestNewPreparedAttempt(t *testing.T) {
 	tests := []struct {
 		name           string
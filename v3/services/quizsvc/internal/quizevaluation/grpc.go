@@ -9,10 +9,12 @@ import (
 	hfInformers "github.com/hobbyfarm/gargantua/v3/pkg/client/informers/externalversions"
 	listersv1 "github.com/hobbyfarm/gargantua/v3/pkg/client/listers/hobbyfarm.io/v1"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	"github.com/hobbyfarm/gargantua/v3/pkg/labels"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -44,31 +46,21 @@ func resourceName(quiz, user, scenario string) string {
 func (gqes GrpcQuizEvaluationServer) CreateQuizEvaluation(ctx context.Context, req *quizpb.CreateQuizEvaluationRequest) (*generalpb.ResourceId, error) {
 	quizEvaluationId := resourceName(req.Quiz, req.User, req.Scenario)
 
-	corrects := make(map[string][]string)
-	for questionId, answerIds := range req.GetAttempt().GetCorrects() {
-		corrects[questionId] = answerIds.GetValues()
+	attempts := []hfv1.QuizEvaluationAttempt{
+		newQuizEvaluationAttempt(req.GetAttempt()),
 	}
 
-	selects := make(map[string][]string)
-	for questionId, answerIds := range req.GetAttempt().GetSelects() {
-		selects[questionId] = answerIds.GetValues()
+	evaluationLabels := map[string]string{
+		labels.QuizLabel: req.GetQuiz(),
 	}
-
-	attempts := []hfv1.QuizEvaluationAttempt{
-		{
-			CreationTimestamp: req.GetAttempt().GetCreationTimestamp(),
-			Timestamp:         req.GetAttempt().GetTimestamp(),
-			Attempt:           req.GetAttempt().GetAttempt(),
-			Score:             req.GetAttempt().GetScore(),
-			Pass:              req.GetAttempt().GetPass(),
-			Corrects:          corrects,
-			Selects:           selects,
-		},
+	if req.GetScheduledEvent() != "" {
+		evaluationLabels[labels.ScheduledEventLabel] = req.GetScheduledEvent()
 	}
 
 	quizEvaluation := &hfv1.QuizEvaluation{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: quizEvaluationId,
+			Name:   quizEvaluationId,
+			Labels: evaluationLabels,
 			OwnerReferences: []metav1.OwnerReference{
 				{
 					APIVersion: "hobbyfarm.io/v1",
@@ -135,25 +127,7 @@ func (gqes GrpcQuizEvaluationServer) UpdateQuizEvaluation(ctx context.Context, r
 
 		attempts := make([]hfv1.QuizEvaluationAttempt, len(req.GetAttempts()))
 		for i, attempt := range req.GetAttempts() {
-			corrects := make(map[string][]string)
-			for questionId, answerIds := range attempt.GetCorrects() {
-				corrects[questionId] = answerIds.GetValues()
-			}
-
-			selects := make(map[string][]string)
-			for questionId, answerIds := range attempt.GetSelects() {
-				selects[questionId] = answerIds.GetValues()
-			}
-
-			attempts[i] = hfv1.QuizEvaluationAttempt{
-				CreationTimestamp: attempt.GetCreationTimestamp(),
-				Timestamp:         attempt.GetTimestamp(),
-				Attempt:           attempt.GetAttempt(),
-				Score:             attempt.GetScore(),
-				Pass:              attempt.GetPass(),
-				Corrects:          corrects,
-				Selects:           selects,
-			}
+			attempts[i] = newQuizEvaluationAttempt(attempt)
 		}
 		quizEvaluation.Spec.Attempts = attempts
 
@@ -172,6 +146,88 @@ func (gqes GrpcQuizEvaluationServer) UpdateQuizEvaluation(ctx context.Context, r
 	return &emptypb.Empty{}, nil
 }
 
+func newQuizEvaluationAttempt(attempt *quizpb.QuizEvaluationAttempt) hfv1.QuizEvaluationAttempt {
+	corrects := make(map[string][]string)
+	for questionId, answerIds := range attempt.GetCorrects() {
+		corrects[questionId] = answerIds.GetValues()
+	}
+
+	selects := make(map[string][]string)
+	for questionId, answerIds := range attempt.GetSelects() {
+		selects[questionId] = answerIds.GetValues()
+	}
+
+	return hfv1.QuizEvaluationAttempt{
+		CreationTimestamp: attempt.GetCreationTimestamp(),
+		Timestamp:         attempt.GetTimestamp(),
+		Attempt:           attempt.GetAttempt(),
+		Score:             attempt.GetScore(),
+		Pass:              attempt.GetPass(),
+		Corrects:          corrects,
+		Selects:           selects,
+		Results:           attempt.GetResults(),
+		Verifications:     attempt.GetVerifications(),
+		TimedOut:          attempt.GetTimedOut(),
+	}
+}
+
+// RecordQuestionVerification stores the result of the verification of a command question in the current attempt.
+// The result is evaluated once the attempt is recorded.
+func (gqes GrpcQuizEvaluationServer) RecordQuestionVerification(ctx context.Context, req *quizpb.RecordQuestionVerificationRequest) (*emptypb.Empty, error) {
+	if req.GetQuiz() == "" || req.GetUser() == "" || req.GetQuestion() == "" {
+		return &emptypb.Empty{}, hferrors.GrpcError(
+			codes.InvalidArgument,
+			"quiz, user and question are required",
+			req,
+		)
+	}
+	id := resourceName(req.GetQuiz(), req.GetUser(), req.GetScenario())
+
+	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		quizEvaluation, err := gqes.client.Get(ctx, id, metav1.GetOptions{})
+		if err != nil {
+			glog.Error(err)
+			return hferrors.GrpcError(
+				codes.Internal,
+				"error while retrieving quiz evaluation %s",
+				req,
+				id,
+			)
+		}
+
+		current := len(quizEvaluation.Spec.Attempts) - 1
+		if current < 0 || quizEvaluation.Spec.Attempts[current].Timestamp != "" {
+			return hferrors.GrpcError(
+				codes.FailedPrecondition,
+				"quiz evaluation %s has no attempt in progress",
+				req,
+				id,
+			)
+		}
+
+		if quizEvaluation.Spec.Attempts[current].Verifications == nil {
+			quizEvaluation.Spec.Attempts[current].Verifications = make(map[string]bool)
+		}
+		quizEvaluation.Spec.Attempts[current].Verifications[req.GetQuestion()] = req.GetSuccess()
+
+		_, updateErr := gqes.client.Update(ctx, quizEvaluation, metav1.UpdateOptions{})
+		return updateErr
+	})
+
+	if retryErr != nil {
+		if _, ok := status.FromError(retryErr); ok {
+			return &emptypb.Empty{}, retryErr
+		}
+		return &emptypb.Empty{}, hferrors.GrpcError(
+			codes.Internal,
+			"error attempting to update",
+			req,
+		)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (gqes GrpcQuizEvaluationServer) DeleteQuizEvaluation(ctx context.Context, req *generalpb.ResourceId) (*emptypb.Empty, error) {
 	return util.DeleteHfResource(ctx, req, gqes.client, "quizevaluation")
 }
@@ -0,0 +1,111 @@
+package quizevaluation
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+)
+
+// timeLimitGracePeriod accounts for the latency between submitting and recording an attempt.
+const timeLimitGracePeriod = 10 * time.Second
+
+// exceedsTimeLimit returns whether the attempt is recorded after the time limit of the quiz has passed.
+func exceedsTimeLimit(quiz *quizpb.Quiz, attempt *quizpb.QuizEvaluationAttempt, now time.Time) bool {
+	if quiz.GetTimeLimit() == 0 {
+		return false
+	}
+	started, err := time.Parse(time.UnixDate, attempt.GetCreationTimestamp())
+	if err != nil {
+		glog.Errorf("error parsing creation timestamp of attempt %d: %v", attempt.GetAttempt(), err)
+		return false
+	}
+	deadline := started.Add(time.Duration(quiz.GetTimeLimit())*time.Second + timeLimitGracePeriod)
+	return now.After(deadline)
+}
+
+// evaluateQuestion returns whether the question is answered correctly by the selects of the user.
+// Numeric and text questions expect the answer of the user as first select, command questions are answered
+// by verifying their task on the vm of the user.
+func evaluateQuestion(question *quizpb.QuizQuestion, selects []string, verifications map[string]bool) bool {
+	switch question.GetType() {
+	case hfv1.QuizQuestionTypeOrdering:
+		return slices.Equal(selects, correctAnswers(question))
+	case hfv1.QuizQuestionTypeNumeric:
+		if len(selects) == 0 {
+			return false
+		}
+		value, err := strconv.ParseFloat(strings.TrimSpace(selects[0]), 64)
+		if err != nil {
+			return false
+		}
+		return math.Abs(value-question.GetNumeric().GetValue()) <= question.GetNumeric().GetTolerance()
+	case hfv1.QuizQuestionTypeText:
+		if len(selects) == 0 {
+			return false
+		}
+		return matchesText(question.GetText(), selects[0])
+	case hfv1.QuizQuestionTypeCommand:
+		return verifications[question.GetId()]
+	default:
+		return util.SliceSortEqual(selects, correctAnswers(question))
+	}
+}
+
+// correctAnswers returns the correct answers of the question. These are the answer ids in the correct order for
+// ordering questions, the expected value for numeric questions and the alternatives for text questions.
+func correctAnswers(question *quizpb.QuizQuestion) []string {
+	corrects := make([]string, 0)
+	switch question.GetType() {
+	case hfv1.QuizQuestionTypeOrdering:
+		for _, answer := range question.GetAnswers() {
+			corrects = append(corrects, answer.GetId())
+		}
+	case hfv1.QuizQuestionTypeNumeric:
+		corrects = append(corrects, strconv.FormatFloat(question.GetNumeric().GetValue(), 'f', -1, 64))
+	case hfv1.QuizQuestionTypeText:
+		corrects = append(corrects, question.GetText().GetAlternatives()...)
+	case hfv1.QuizQuestionTypeCommand:
+		// the task is verified on the vm, there is nothing to select
+	default:
+		for _, answer := range question.GetAnswers() {
+			if answer.GetCorrect() {
+				corrects = append(corrects, answer.GetId())
+			}
+		}
+	}
+	return corrects
+}
+
+func matchesText(text *quizpb.QuizTextAnswer, answer string) bool {
+	answer = strings.TrimSpace(answer)
+	for _, alternative := range text.GetAlternatives() {
+		alternative = strings.TrimSpace(alternative)
+		if text.GetCaseSensitive() && answer == alternative {
+			return true
+		}
+		if !text.GetCaseSensitive() && strings.EqualFold(answer, alternative) {
+			return true
+		}
+	}
+
+	if text.GetRegex() == "" {
+		return false
+	}
+	expression := "^(?:" + text.GetRegex() + ")$"
+	if !text.GetCaseSensitive() {
+		expression = "(?i)" + expression
+	}
+	regex, err := regexp.Compile(expression)
+	if err != nil {
+		return false
+	}
+	return regex.MatchString(answer)
+}
@@ -0,0 +1,34 @@
+package quizevaluation
+
+import (
+	"testing"
+	"time"
+
+	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExceedsTimeLimit(t *testing.T) {
+	started := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	attempt := &quizpb.QuizEvaluationAttempt{CreationTimestamp: started.Format(time.UnixDate)}
+
+	tests := []struct {
+		name      string
+		timeLimit uint32
+		attempt   *quizpb.QuizEvaluationAttempt
+		now       time.Time
+		want      bool
+	}{
+		{name: "no time limit", timeLimit: 0, attempt: attempt, now: started.Add(24 * time.Hour), want: false},
+		{name: "within time limit", timeLimit: 60, attempt: attempt, now: started.Add(59 * time.Second), want: false},
+		{name: "within grace period", timeLimit: 60, attempt: attempt, now: started.Add(65 * time.Second), want: false},
+		{name: "exceeded", timeLimit: 60, attempt: attempt, now: started.Add(71 * time.Second), want: true},
+		{name: "invalid creation timestamp", timeLimit: 60, attempt: &quizpb.QuizEvaluationAttempt{}, now: started.Add(time.Hour), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quiz := &quizpb.Quiz{TimeLimit: tt.timeLimit}
+			assert.Equal(t, tt.want, exceedsTimeLimit(quiz, tt.attempt, tt.now))
+		})
+	}
+}
@@ -5,23 +5,27 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
 	"github.com/hobbyfarm/gargantua/services/quizsvc/v3/internal/quiz"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	"github.com/hobbyfarm/gargantua/v3/pkg/labels"
 	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 	"google.golang.org/grpc/status"
 )
 
 type QuizEvaluationService struct {
 	authnClient        authnpb.AuthNClient
 	authrClient        authrpb.AuthRClient
+	sessionClient      sessionpb.SessionSvcClient
 	internalServer     *GrpcQuizEvaluationServer
 	internalQuizServer *quiz.GrpcQuizServer
 }
@@ -29,12 +33,14 @@ type QuizEvaluationService struct {
 func NewQuizEvaluationService(
 	authnClient authnpb.AuthNClient,
 	authrClient authrpb.AuthRClient,
+	sessionClient sessionpb.SessionSvcClient,
 	internalQuizEvaluationServer *GrpcQuizEvaluationServer,
 	internalQuizServer *quiz.GrpcQuizServer,
 ) *QuizEvaluationService {
 	return &QuizEvaluationService{
 		authnClient:        authnClient,
 		authrClient:        authrClient,
+		sessionClient:      sessionClient,
 		internalServer:     internalQuizEvaluationServer,
 		internalQuizServer: internalQuizServer,
 	}
@@ -185,17 +191,34 @@ func (qes QuizEvaluationService) StartFunc(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	var scheduledEvent string
+	if preparedStartQuizEvaluation.Session != "" {
+		session, err := qes.sessionClient.GetSession(r.Context(), &generalpb.GetRequest{Id: preparedStartQuizEvaluation.Session})
+		if err != nil {
+			glog.Errorf("error while retrieving session: %s", hferrors.GetErrorMessage(err))
+			errMsg := fmt.Sprintf("error retrieving session %s", preparedStartQuizEvaluation.Session)
+			util.ReturnHTTPMessage(w, r, http.StatusInternalServerError, "error", errMsg)
+			return
+		}
+		if session.GetUser() != impersonatedUserId {
+			util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to session")
+			return
+		}
+		scheduledEvent = session.GetLabels()[labels.ScheduledEventLabel]
+	}
+
 	quizEvaluation, err := qes.internalServer.GetQuizEvaluationForUser(r.Context(), req)
 	if err != nil {
 		if hferrors.IsGrpcNotFound(err) {
 			// create
 			quizEvaluationAttempt := NewPBQuizEvaluationAttemptForStart(1, existing)
 			createQuizEvaluation := &quizpb.CreateQuizEvaluationRequest{
-				Quiz:     req.GetQuiz(),
-				QuizUid:  existing.Uid,
-				User:     req.GetUser(),
-				Scenario: req.GetScenario(),
-				Attempt:  quizEvaluationAttempt,
+				Quiz:           req.GetQuiz(),
+				QuizUid:        existing.Uid,
+				User:           req.GetUser(),
+				Scenario:       req.GetScenario(),
+				Attempt:        quizEvaluationAttempt,
+				ScheduledEvent: scheduledEvent,
 			}
 			quizEvaluationId, err := qes.internalServer.CreateQuizEvaluation(r.Context(), createQuizEvaluation)
 			if err != nil {
@@ -211,7 +234,7 @@ func (qes QuizEvaluationService) StartFunc(w http.ResponseWriter, r *http.Reques
 			}
 
 			preparedStartQuizEvaluationResult := NewPreparedStartQuizEvaluationResult(
-				quizEvaluationId.GetId(), req.GetQuiz(), req.GetScenario(), quizEvaluationAttempt)
+				quizEvaluationId.GetId(), existing, req.GetScenario(), quizEvaluationAttempt)
 			encodedStartQuizEvaluationResult, err := json.Marshal(preparedStartQuizEvaluationResult)
 			if err != nil {
 				glog.Errorf("error marshalling prepared quiz evaluation: %v", err)
@@ -246,7 +269,7 @@ func (qes QuizEvaluationService) StartFunc(w http.ResponseWriter, r *http.Reques
 	}
 
 	preparedStartQuizEvaluationResult := NewPreparedStartQuizEvaluationResult(
-		quizEvaluation.GetId(), req.GetQuiz(), req.GetScenario(), quizEvaluationAttempt)
+		quizEvaluation.GetId(), existing, req.GetScenario(), quizEvaluationAttempt)
 	encodedStartQuizEvaluationResult, err := json.Marshal(preparedStartQuizEvaluationResult)
 	if err != nil {
 		glog.Errorf("error marshalling prepared quiz evaluation: %v", err)
@@ -308,7 +331,20 @@ func (qes QuizEvaluationService) RecordFunc(w http.ResponseWriter, r *http.Reque
 	}
 
 	attempt := uint32(len(quizEvaluation.GetAttempts()))
-	quizEvaluationAttempt := NewPBQuizEvaluationAttemptForRecord(attempt, preparedRecordQuizEvaluation, existing)
+	var currentAttempt *quizpb.QuizEvaluationAttempt
+	for _, quizAttempt := range quizEvaluation.GetAttempts() {
+		if quizAttempt.GetAttempt() == attempt {
+			currentAttempt = quizAttempt
+		}
+	}
+
+	var quizEvaluationAttempt *quizpb.QuizEvaluationAttempt
+	if exceedsTimeLimit(existing, currentAttempt, time.Now()) {
+		glog.V(4).Infof("attempt %d of quiz evaluation %s exceeded the time limit", attempt, quizEvaluation.GetId())
+		quizEvaluationAttempt = NewPBQuizEvaluationAttemptForTimeout(attempt, preparedRecordQuizEvaluation, currentAttempt.GetVerifications())
+	} else {
+		quizEvaluationAttempt = NewPBQuizEvaluationAttemptForRecord(attempt, preparedRecordQuizEvaluation, existing, currentAttempt.GetVerifications())
+	}
 
 	attempts := make([]*quizpb.QuizEvaluationAttempt, len(quizEvaluation.GetAttempts()))
 	for i := 0; i < len(quizEvaluation.GetAttempts()); i++ {
@@ -352,6 +388,70 @@ func (qes QuizEvaluationService) RecordFunc(w http.ResponseWriter, r *http.Reque
 	glog.V(4).Infof("Recorded quiz evaluation %s", quizEvaluation.GetId())
 }
 
+func (qes QuizEvaluationService) AnalyticsFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, qes.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	impersonatedUserId := user.GetId()
+	authrResponse, err := rbac.AuthorizeSimple(r, qes.authrClient, impersonatedUserId, rbac.HobbyfarmPermission(rbac.ResourcePluralQuizEvaluation, rbac.VerbList))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to list quiz evaluations")
+		return
+	}
+
+	vars := mux.Vars(r)
+	quizId := vars["id"]
+	if len(quizId) == 0 {
+		util.ReturnHTTPMessage(w, r, 400, "bad request", "no quiz id passed in")
+		return
+	}
+	scheduledEvent := r.URL.Query().Get("scheduledevent")
+
+	existing, err := qes.getQuiz(r.Context(), quizId)
+	if err != nil {
+		glog.Errorf("error while retrieving quiz: %s", hferrors.GetErrorMessage(err))
+		if hferrors.IsGrpcNotFound(err) {
+			errMsg := fmt.Sprintf("quiz %s not found", quizId)
+			util.ReturnHTTPMessage(w, r, http.StatusNotFound, "not found", errMsg)
+			return
+		}
+		errMsg := fmt.Sprintf("error retrieving quiz %s", quizId)
+		util.ReturnHTTPMessage(w, r, http.StatusInternalServerError, "error", errMsg)
+		return
+	}
+
+	var labelSelector string
+	if scheduledEvent != "" {
+		labelSelector = fmt.Sprintf("%s=%s", labels.ScheduledEventLabel, scheduledEvent)
+	}
+	quizEvaluationList, err := qes.internalServer.ListQuizEvaluation(r.Context(), &generalpb.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		glog.Errorf("error while listing quiz evaluations: %s", hferrors.GetErrorMessage(err))
+		util.ReturnHTTPMessage(w, r, 500, "error", "error listing quiz evaluations")
+		return
+	}
+
+	// evaluations recorded before the quiz label was introduced are only related by their spec
+	quizEvaluations := make([]*quizpb.QuizEvaluation, 0)
+	for _, quizEvaluation := range quizEvaluationList.GetQuizEvaluations() {
+		if quizEvaluation.GetQuiz() == quizId {
+			quizEvaluations = append(quizEvaluations, quizEvaluation)
+		}
+	}
+
+	analytics := NewQuizAnalytics(existing, scheduledEvent, quizEvaluations)
+	encodedAnalytics, err := json.Marshal(analytics)
+	if err != nil {
+		glog.Error(err)
+	}
+	util.ReturnHTTPContent(w, r, 200, "success", encodedAnalytics)
+
+	glog.V(2).Infof("retrieved analytics of quiz %s", quizId)
+}
+
 func (qes QuizEvaluationService) getQuiz(ctx context.Context, quizId string) (*quizpb.Quiz, error) {
 	return qes.internalQuizServer.GetQuiz(ctx, &generalpb.GetRequest{Id: quizId})
 }
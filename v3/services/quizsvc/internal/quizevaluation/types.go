@@ -14,8 +14,9 @@ type PreparedAttempt struct {
 	Attempt           uint32              `json:"attempt"`
 	Score             uint32              `json:"score"`
 	Pass              bool                `json:"pass"`
-	Corrects          map[string][]string `json:"corrects,omitempty"` // key is question id and values are correct answer ids
-	Selects           map[string][]string `json:"selects"`            // key is question id and values are answer ids of the answers chosen by the user
+	Corrects          map[string][]string `json:"corrects,omitempty"`  // key is question id and values are correct answer ids
+	Selects           map[string][]string `json:"selects"`             // key is question id and values are answer ids of the answers chosen by the user
+	TimedOut          bool                `json:"timed_out,omitempty"` // the attempt was recorded after the time limit
 }
 
 type PreparedStartQuizEvaluationResult struct {
@@ -24,7 +25,8 @@ type PreparedStartQuizEvaluationResult struct {
 	Scenario          string   `json:"scenario"` // the scenario id
 	CreationTimestamp string   `json:"creation_timestamp"`
 	Attempt           uint32   `json:"attempt"`
-	Questions         []string `json:"questions"` // the selected question ids by the backend
+	TimeLimit         uint32   `json:"time_limit,omitempty"` // time limit of the attempt in seconds
+	Questions         []string `json:"questions"`            // the selected question ids by the backend
 }
 
 type PreparedRecordQuizEvaluationResult struct {
@@ -35,8 +37,9 @@ type PreparedRecordQuizEvaluationResult struct {
 }
 
 type PreparedStartQuizEvaluation struct {
-	Quiz     string `json:"quiz"`     // the quiz id
-	Scenario string `json:"scenario"` // the scenario id
+	Quiz     string `json:"quiz"`              // the quiz id
+	Scenario string `json:"scenario"`          // the scenario id
+	Session  string `json:"session,omitempty"` // the session id, relates the evaluation to the scheduled event of the session
 }
 
 type PreparedRecordQuizEvaluation struct {
@@ -44,3 +47,23 @@ type PreparedRecordQuizEvaluation struct {
 	Scenario string              `json:"scenario"` // the scenario id
 	Answers  map[string][]string `json:"answers"`  // key is question id and values are answer ids
 }
+
+type PreparedQuizAnalytics struct {
+	Quiz           string                      `json:"quiz"`                      // the quiz id
+	ScheduledEvent string                      `json:"scheduled_event,omitempty"` // the scheduled event id if the analytics are restricted to it
+	Attempts       uint32                      `json:"attempts"`                  // amount of recorded attempts
+	AverageScore   float64                     `json:"average_score"`
+	PassRate       float64                     `json:"pass_rate"` // share of passed attempts [0, 1]
+	Questions      []PreparedQuestionAnalytics `json:"questions"`
+}
+
+type PreparedQuestionAnalytics struct {
+	Id             string   `json:"id"`
+	Title          string   `json:"title"`
+	Type           string   `json:"type"`
+	Attempts       uint32   `json:"attempts"`       // amount of attempts which contained the question
+	Correct        uint32   `json:"correct"`        // amount of attempts which answered the question correctly
+	Difficulty     float64  `json:"difficulty"`     // share of correct answers [0, 1], lower values are harder
+	Discrimination float64  `json:"discrimination"` // difference of the difficulty within the best and the worst attempts [-1, 1]
+	Warnings       []string `json:"warnings"`
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/hobbyfarm/gargantua/services/quizsvc/v3/internal/quizevaluation"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
@@ -18,12 +19,13 @@ type QuizServer struct {
 func NewQuizServer(
 	authnClient authnpb.AuthNClient,
 	authrClient authrpb.AuthRClient,
+	sessionClient sessionpb.SessionSvcClient,
 	internalQuizServer *quiz.GrpcQuizServer,
 	internalQuizEvaluationServer *quizevaluation.GrpcQuizEvaluationServer,
 ) QuizServer {
 	return QuizServer{
 		internalQuizService:           quiz.NewQuizService(authnClient, authrClient, internalQuizServer),
-		internalQuizEvaluationService: quizevaluation.NewQuizEvaluationService(authnClient, authrClient, internalQuizEvaluationServer, internalQuizServer),
+		internalQuizEvaluationService: quizevaluation.NewQuizEvaluationService(authnClient, authrClient, sessionClient, internalQuizEvaluationServer, internalQuizServer),
 	}
 }
 
@@ -34,6 +36,7 @@ func (qs QuizServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/a/quiz/create", qs.internalQuizService.CreateFunc).Methods("POST")
 	r.HandleFunc("/a/quiz/{id}/update", qs.internalQuizService.UpdateFunc).Methods("PUT")
 	r.HandleFunc("/a/quiz/{id}/delete", qs.internalQuizService.DeleteFunc).Methods("DELETE")
+	r.HandleFunc("/a/quiz/{id}/analytics", qs.internalQuizEvaluationService.AnalyticsFunc).Methods("GET")
 	r.HandleFunc("/quiz/{id}", qs.internalQuizService.GetForUserFunc).Methods("GET")
 	// quiz score
 	r.HandleFunc("/a/quiz/evaluation/{id}", qs.internalQuizEvaluationService.GetFunc).Methods("GET")
@@ -16,6 +16,7 @@ import (
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	quizpb "github.com/hobbyfarm/gargantua/v3/protos/quiz"
+	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 )
 
 var (
@@ -39,6 +40,7 @@ func main() {
 	services := []microservices.MicroService{
 		microservices.AuthN,
 		microservices.AuthR,
+		microservices.Session,
 	}
 	connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
 	for _, conn := range connections {
@@ -46,6 +48,7 @@ func main() {
 	}
 	authnClient := authnpb.NewAuthNClient(connections[microservices.AuthN])
 	authrClient := authrpb.NewAuthRClient(connections[microservices.AuthR])
+	sessionClient := sessionpb.NewSessionSvcClient(connections[microservices.Session])
 
 	gs := microservices.CreateGRPCServer(serviceConfig.ServerCert.Clone())
 
@@ -70,6 +73,7 @@ func main() {
 		quizServer := quizservice.NewQuizServer(
 			authnClient,
 			authrClient,
+			sessionClient,
 			qs,
 			qes,
 		)
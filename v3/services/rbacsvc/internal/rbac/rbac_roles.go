@@ -47,6 +47,7 @@ func list() []Role {
 				addRule([]string{"hobbyfarm.io"}, []string{"list"}, []string{"environments"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"list", "get"}, []string{"scenarios", "courses", "virtualmachinetemplates", "virtualmachinesets", "users"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"list", "get", "watch"}, []string{"progresses", "virtualmachines", "virtualmachineclaims"}).
+				addRule([]string{"hobbyfarm.io"}, []string{"get", "update"}, []string{"virtualmachines/shell"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"update", "delete", "list", "get"}, []string{"sessions"})
 		}),
 		// ScheduledEvent Proctor is allowed to view scheduled events + dashboards
@@ -55,6 +56,7 @@ func list() []Role {
 				addRule([]string{"hobbyfarm.io"}, []string{"list", "get"}, []string{"scheduledevents", "accesscodes", "scenarios", "courses", "environments", "virtualmachinetemplates", "virtualmachinesets", "users"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"list"}, []string{"environments"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"list", "get", "watch"}, []string{"progresses", "virtualmachines", "virtualmachineclaims"}).
+				addRule([]string{"hobbyfarm.io"}, []string{"get", "update"}, []string{"virtualmachines/shell"}).
 				addRule([]string{"hobbyfarm.io"}, []string{"update", "delete", "list", "get"}, []string{"sessions"})
 		}),
 		// User Manager can update and delete users
@@ -157,6 +157,13 @@ func (s *GrpcScenarioServer) GetScenario(ctx context.Context, req *generalpb.Get
 				ExpectedOutputValue: task.ExpectedOutputValue,
 				ExpectedReturnCode:  int32(task.ExpectedReturnCode),
 				ReturnType:          task.ReturnType,
+				Type:                task.Type,
+				Path:                task.Path,
+				Url:                 task.URL,
+				JsonPath:            task.JSONPath,
+				DependsOn:           task.DependsOn,
+				TimeoutSeconds:      int32(task.TimeoutSeconds),
+				Points:              int32(task.Points),
 			})
 		}
 		vmTasks = append(vmTasks, &scenariopb.VirtualMachineTasks{
@@ -333,6 +340,13 @@ func (s *GrpcScenarioServer) ListScenario(ctx context.Context, listOptions *gene
 					ExpectedOutputValue: task.ExpectedOutputValue,
 					ExpectedReturnCode:  int32(task.ExpectedReturnCode),
 					ReturnType:          task.ReturnType,
+					Type:                task.Type,
+					Path:                task.Path,
+					Url:                 task.URL,
+					JsonPath:            task.JSONPath,
+					DependsOn:           task.DependsOn,
+					TimeoutSeconds:      int32(task.TimeoutSeconds),
+					Points:              int32(task.Points),
 				})
 			}
 			vmTasks = append(vmTasks, &scenariopb.VirtualMachineTasks{
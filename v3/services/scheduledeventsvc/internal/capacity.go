@@ -0,0 +1,102 @@
+package eventservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/capacity"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
+)
+
+// checkCapacity allocates the spread vms of the scheduled event to its environments and checks the scheduled event
+// against the capacity of its environments, taking all other scheduled events into account.
+// The new spread allocation is set in the status of the scheduled event.
+func checkCapacity(ctx context.Context, internalScheduledEventServer *GrpcScheduledEventServer, checker *capacity.Checker, se *scheduledeventpb.ScheduledEvent) (capacity.Report, error) {
+	if se.Status == nil {
+		se.Status = &scheduledeventpb.ScheduledEventStatus{}
+	}
+	scheduledEventList, err := internalScheduledEventServer.ListScheduledEvent(ctx, &generalpb.ListOptions{})
+	if err != nil {
+		return capacity.Report{}, fmt.Errorf("error retrieving scheduled events: %s", hferrors.GetErrorMessage(err))
+	}
+
+	spreadAllocation, err := checker.AllocateSpread(ctx, se, scheduledEventList.GetScheduledevents(), se.GetStatus().GetFailedEnvironments())
+	if err != nil {
+		return capacity.Report{}, err
+	}
+	se.Status.SpreadAllocation = spreadAllocation
+
+	return checker.CheckScheduledEvent(ctx, se, scheduledEventList.GetScheduledevents())
+}
+
+// parseRequiredVms parses the raw required_vms form value which maps environments to vm templates to counts.
+func parseRequiredVms(requiredVmsRaw string) (map[string]*scheduledeventpb.VMTemplateCountMap, error) {
+	requiredVms, err := util.GenericUnmarshal[map[string]map[string]int](requiredVmsRaw, "required_vms")
+	if err != nil {
+		return nil, err
+	}
+	return util.ConvertToVMTemplateCountMaps(requiredVms), nil
+}
+
+// parseSpreadVms parses the raw spread_vms form value which lists vm templates to spread across environments.
+func parseSpreadVms(spreadVmsRaw string) ([]*scheduledeventpb.VMSpread, error) {
+	spreadVms, err := util.ParseSpreadVirtualMachines(spreadVmsRaw, "spread_vms")
+	if err != nil {
+		return nil, err
+	}
+	return util.ConvertToVMSpreads(spreadVms), nil
+}
+
+// allocateScheduledEvent allocates the spread vms of the scheduled event again, as the capacity of the environments
+// might have changed since the scheduled event was created, and logs every window in which the scheduled event exceeds
+// the capacity of its environments. Overbooked scheduled events are still provisioned, they were either accepted when
+// they were created or became overbooked because of changes to their environments or vm templates.
+// If the capacity can not be checked, the previous spread allocation is kept.
+func (sc *ScheduledEventController) allocateScheduledEvent(se *scheduledeventpb.ScheduledEvent) {
+	previous := se.GetStatus().GetSpreadAllocation()
+
+	report, err := checkCapacity(sc.Context, sc.internalScheduledEventServer, sc.capacityChecker, se)
+	if err != nil {
+		glog.Errorf("error checking capacity of scheduled event %s: %v", se.GetId(), err)
+		se.Status.SpreadAllocation = previous
+		return
+	}
+
+	for _, overflow := range report.Overflows {
+		glog.Warningf("provisioning overbooked scheduled event %s: %s", se.GetId(), overflow)
+	}
+}
+
+// checkOverbooking checks the scheduled event against the capacity of its environments. Overbooked scheduled events
+// are rejected with a conflict listing the overflows, unless overbooking is allowed. Returns whether the scheduled event
+// is overbooked and whether it may be saved.
+func (s ScheduledEventServer) checkOverbooking(w http.ResponseWriter, r *http.Request, se *scheduledeventpb.ScheduledEvent, allowOverbooking bool) (bool, bool) {
+	report, err := checkCapacity(r.Context(), s.internalScheduledEventServer, s.capacityChecker, se)
+	if err != nil {
+		glog.Errorf("error checking capacity of scheduled event: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error checking capacity of scheduled event")
+		return false, false
+	}
+
+	if !report.Overbooked() {
+		return false, true
+	}
+
+	if !allowOverbooking {
+		encodedReport, err := json.Marshal(report)
+		if err != nil {
+			glog.Error(err)
+		}
+		util.ReturnHTTPContent(w, r, 409, "overbooked", encodedReport)
+		return true, false
+	}
+
+	glog.Warningf("scheduled event %s is overbooked: %s", se.GetName(), report)
+	return true, true
+}
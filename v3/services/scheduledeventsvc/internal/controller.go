@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/capacity"
 	hfInformers "github.com/hobbyfarm/gargantua/v3/pkg/client/informers/externalversions"
 	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
 	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
 	settingUtil "github.com/hobbyfarm/gargantua/v3/pkg/setting"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/golang/glog"
@@ -25,6 +28,7 @@ import (
 	scheduledeventpb "github.com/hobbyfarm/gargantua/v3/protos/scheduledevent"
 	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
+	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
 	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 	"k8s.io/client-go/kubernetes"
@@ -40,9 +44,11 @@ type ScheduledEventController struct {
 	progressClient               progresspb.ProgressSvcClient
 	environmentClient            environmentpb.EnvironmentSvcClient
 	dbConfigClient               dbconfigpb.DynamicBindConfigSvcClient
+	vmClient                     vmpb.VMSvcClient
 	vmSetClient                  vmsetpb.VMSetSvcClient
 	vmTemplateClient             vmtemplatepb.VMTemplateSvcClient
 	settingClient                settingpb.SettingSvcClient
+	capacityChecker              *capacity.Checker
 }
 
 var baseNameScheduledPrefix string
@@ -70,6 +76,7 @@ func NewScheduledEventController(
 	environmentClient environmentpb.EnvironmentSvcClient,
 	progressClient progresspb.ProgressSvcClient,
 	sessionClient sessionpb.SessionSvcClient,
+	vmClient vmpb.VMSvcClient,
 	vmSetClient vmsetpb.VMSetSvcClient,
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient,
 	settingClient settingpb.SettingSvcClient,
@@ -93,9 +100,11 @@ func NewScheduledEventController(
 		environmentClient:               environmentClient,
 		progressClient:                  progressClient,
 		sessionClient:                   sessionClient,
+		vmClient:                        vmClient,
 		vmSetClient:                     vmSetClient,
 		vmTemplateClient:                vmTemplateClient,
 		settingClient:                   settingClient,
+		capacityChecker:                 capacity.NewChecker(environmentClient, vmTemplateClient),
 	}
 	scheduledEventController.SetReconciler(scheduledEventController)
 	scheduledEventController.SetWorkScheduler(scheduledEventController)
@@ -176,6 +185,67 @@ func (sc *ScheduledEventController) deleteVMSetsFromScheduledEvent(se *scheduled
 	return err
 }
 
+// deleteStaleVMSets deletes all vmsets of the scheduled event which are not in use. Warm pool vmsets are scaled down first.
+func (sc *ScheduledEventController) deleteStaleVMSets(se *scheduledeventpb.ScheduledEvent, vmSets []string) error {
+	vmSetList, err := sc.vmSetClient.ListVMSet(sc.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, se.GetId()),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, vmSet := range vmSetList.GetVmsets() {
+		if slices.Contains(vmSets, vmSet.GetId()) {
+			continue
+		}
+		if vmSet.GetLabels()[hflabels.WarmPoolLabel] == "true" {
+			err = sc.retireWarmPoolVMSet(se, vmSet)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		glog.V(4).Infof("deleting vmset %s of scheduled event %s which is no longer required", vmSet.GetId(), se.GetId())
+		_, err = sc.vmSetClient.DeleteVMSet(sc.Context, &generalpb.ResourceId{Id: vmSet.GetId()})
+		if err != nil && !hferrors.IsGrpcNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// retireWarmPoolVMSet removes a warm pool vmset which is no longer required. Vms claimed from the warm pool are still
+// owned by the vmset, deleting it would delete the vms of active sessions. The vmset is scaled down to 0 instead,
+// which only deletes its unclaimed vms, and is deleted once the claimed vms are gone.
+func (sc *ScheduledEventController) retireWarmPoolVMSet(se *scheduledeventpb.ScheduledEvent, vmSet *vmsetpb.VMSet) error {
+	vmList, err := sc.vmClient.ListVM(sc.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("vmset=%s", vmSet.GetId()),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(vmList.GetVms()) == 0 {
+		glog.V(4).Infof("deleting warm pool vmset %s of scheduled event %s which is no longer required", vmSet.GetId(), se.GetId())
+		_, err = sc.vmSetClient.DeleteVMSet(sc.Context, &generalpb.ResourceId{Id: vmSet.GetId()})
+		if err != nil && !hferrors.IsGrpcNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if vmSet.GetCount() == 0 {
+		return nil
+	}
+
+	glog.V(4).Infof("scaling down warm pool vmset %s of scheduled event %s which is no longer required", vmSet.GetId(), se.GetId())
+	_, err = sc.vmSetClient.UpdateVMSet(sc.Context, &vmsetpb.UpdateVMSetRequest{
+		Id:    vmSet.GetId(),
+		Count: wrapperspb.UInt32(0),
+	})
+	return err
+}
+
 func (sc *ScheduledEventController) deleteProgressFromScheduledEvent(se *scheduledeventpb.ScheduledEvent) error {
 	// for each vmset that belongs to this to-be-stopped scheduled event, delete that vmset
 	_, err := sc.progressClient.DeleteCollectionProgress(sc.Context, &generalpb.ListOptions{
@@ -230,81 +300,36 @@ func (sc *ScheduledEventController) provisionScheduledEvent(se *scheduledeventpb
 	vmSets := []string{}
 
 	/**
-	The general flow here is to calculate how much resources (cpu, mem, storage) are currently
-	being used, and then compare that to what is needed. If needed > used, we're going to still
-	provision (for some reason), but at least we'll tell the user about it
-		e.g. --> glog.Errorf("we are overprovisioning this environment %s by CPU...
+	The general flow here is to calculate how much resources (count, cpu, mem, storage) are reserved
+	by other scheduled events, and then compare that to what is needed. If reserved + needed > capacity,
+	we're going to still provision, but at least we'll tell the user about it.
+	Spread vms are split across their environments by what is left of their capacity.
 	*/
+	sc.allocateScheduledEvent(se)
 
-	// begin by calculating what is currently being used in the environment
-	for envId, vmtMap := range se.GetRequiredVms() {
-		// TODO: actually check for capacity usage
-		// get the environment we're provisioning into (envName)
-		// env, err := sc.environmentClient.GetEnvironment(sc.Context, &general.GetRequest{Id: envId})
-		// if err != nil {
-		// 	glog.Errorf("error retreiving environment %s", err.Error())
-		// 	return err
-		// }
-
-		// create virtualmachinesets if not on demand
-		if !se.GetOnDemand() {
-			for templateName, count := range vmtMap.GetVmTemplateCounts() {
-				if count > 0 { // only setup vmsets if > 0 VMs are requested, and they aren't ondemand
-					//1. Find existing VMset that match this SE and the current environment
-					existingVMSetsList, err := sc.vmSetClient.ListVMSet(sc.Context, &generalpb.ListOptions{
-						LabelSelector: fmt.Sprintf("%s=%s,%s=%s,virtualmachinetemplate.hobbyfarm.io/%s=true", hflabels.ScheduledEventLabel, se.GetId(), hflabels.EnvironmentLabel, envId, templateName),
-					})
-
-					if err != nil || len(existingVMSetsList.GetVmsets()) == 0 { // create new vmset if no existing one was found
-						vmsRand := fmt.Sprintf("%s-%08x", baseNameScheduledPrefix, rand.Uint32())
-						vmsId := strings.Join([]string{"se", se.Id, "vms", vmsRand}, "-")
-						vmSets = append(vmSets, vmsId)
-						_, err = sc.vmSetClient.CreateVMSet(sc.Context, &vmsetpb.CreateVMSetRequest{
-							Id:                  vmsId,
-							Count:               count,
-							Environment:         envId,
-							VmTemplate:          templateName,
-							BaseName:            vmsRand,
-							RestrictedBind:      se.GetRestrictedBind(),
-							RestrictedBindValue: se.GetRestrictedBindValue(),
-							SeName:              se.GetId(),
-							SeUid:               se.GetUid(),
-							Labels: map[string]string{
-								hflabels.EnvironmentLabel:    envId,
-								hflabels.ScheduledEventLabel: se.GetId(),
-								fmt.Sprintf("virtualmachinetemplate.hobbyfarm.io/%s", templateName): "true",
-							},
-						})
-						if err != nil {
-							glog.Error(err)
-							return err
-						}
-					} else { // update existing vmset
-						// Todo support multiple VM Sets
-						existingVMSet := existingVMSetsList.GetVmsets()[0]
-						vmSets = append(vmSets, existingVMSet.GetId())
-
-						_, err = sc.vmSetClient.UpdateVMSet(sc.Context, &vmsetpb.UpdateVMSetRequest{
-							Id:             existingVMSet.GetId(),
-							Count:          wrapperspb.UInt32(count),
-							RestrictedBind: wrapperspb.Bool(se.GetRestrictedBind()),
-							Environment:    envId,
-						})
-						if err != nil {
-							glog.Errorf("error updating vmset config %s", err.Error())
-							return err
-						}
-					}
-				}
-			}
-		}
+	// Delete existing DynamicBindConfigurations, including the ones of environments no longer required
+	_, err := sc.dbConfigClient.DeleteCollectionDynamicBindConfig(sc.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, se.GetId()),
+	})
+	if err != nil {
+		return err
+	}
 
-		// Delete existing DynamicBindConfigurations
-		_, err := sc.dbConfigClient.DeleteCollectionDynamicBindConfig(sc.Context, &generalpb.ListOptions{
-			LabelSelector: fmt.Sprintf("%s=%s,environment=%s", hflabels.ScheduledEventLabel, se.GetId(), envId),
-		})
-		if err != nil {
-			return err
+	for envId, vmtMap := range util.RequiredVirtualMachines(se) {
+		for templateName, count := range vmtMap.GetVmTemplateCounts() {
+			// static events provision all vms upfront, on demand events only keep their warm pool provisioned
+			warmPool := se.GetOnDemand()
+			if warmPool {
+				count = min(count, se.GetWarmPool()[envId].GetVmTemplateCounts()[templateName])
+			}
+			if count == 0 { // only setup vmsets if > 0 VMs are requested
+				continue
+			}
+			vmSetId, err := sc.provisionVMSet(se, envId, templateName, count, warmPool)
+			if err != nil {
+				return err
+			}
+			vmSets = append(vmSets, vmSetId)
 		}
 
 		// create the dynamic bind configurations
@@ -321,8 +346,14 @@ func (sc *ScheduledEventController) provisionScheduledEvent(se *scheduledeventpb
 		}
 	}
 
+	// vmsets of environments and templates which are no longer required have to go, e.g. after the spread vms were rebalanced
+	err = sc.deleteStaleVMSets(se, vmSets)
+	if err != nil {
+		return err
+	}
+
 	// Delete AccessCode if it exists
-	_, err := sc.accessCodeClient.GetAc(sc.Context, &generalpb.GetRequest{
+	_, err = sc.accessCodeClient.GetAc(sc.Context, &generalpb.GetRequest{
 		Id: se.GetAccessCode(),
 	})
 	if err == nil {
@@ -345,6 +376,9 @@ func (sc *ScheduledEventController) provisionScheduledEvent(se *scheduledeventpb
 		Provisioned: wrapperspb.Bool(true),
 		Ready:       wrapperspb.Bool(false),
 		Finished:    wrapperspb.Bool(false),
+		SpreadAllocation: &scheduledeventpb.SpreadAllocationWrapper{
+			Value: se.GetStatus().GetSpreadAllocation(),
+		},
 	})
 	glog.V(4).Infof("updated result for scheduled event %s", se.GetId())
 	if err != nil {
@@ -354,6 +388,65 @@ func (sc *ScheduledEventController) provisionScheduledEvent(se *scheduledeventpb
 	return nil
 }
 
+// provisionVMSet creates or updates the vmset of the scheduled event for the environment and template and returns its id.
+// Warm pool vmsets of on demand scheduled events keep count unclaimed vms provisioned.
+func (sc *ScheduledEventController) provisionVMSet(se *scheduledeventpb.ScheduledEvent, envId string, templateName string, count uint32, warmPool bool) (string, error) {
+	warmPoolSelector := fmt.Sprintf("%s!=true", hflabels.WarmPoolLabel)
+	if warmPool {
+		warmPoolSelector = fmt.Sprintf("%s=true", hflabels.WarmPoolLabel)
+	}
+
+	//1. Find existing VMset that match this SE and the current environment
+	existingVMSetsList, err := sc.vmSetClient.ListVMSet(sc.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s,virtualmachinetemplate.hobbyfarm.io/%s=true,%s", hflabels.ScheduledEventLabel, se.GetId(), hflabels.EnvironmentLabel, envId, templateName, warmPoolSelector),
+	})
+
+	if err != nil || len(existingVMSetsList.GetVmsets()) == 0 { // create new vmset if no existing one was found
+		vmsRand := fmt.Sprintf("%s-%08x", baseNameScheduledPrefix, rand.Uint32())
+		vmsId := strings.Join([]string{"se", se.Id, "vms", vmsRand}, "-")
+		labels := map[string]string{
+			hflabels.EnvironmentLabel:    envId,
+			hflabels.ScheduledEventLabel: se.GetId(),
+			fmt.Sprintf("virtualmachinetemplate.hobbyfarm.io/%s", templateName): "true",
+		}
+		if warmPool {
+			labels[hflabels.WarmPoolLabel] = "true"
+		}
+		_, err = sc.vmSetClient.CreateVMSet(sc.Context, &vmsetpb.CreateVMSetRequest{
+			Id:                  vmsId,
+			Count:               count,
+			Environment:         envId,
+			VmTemplate:          templateName,
+			BaseName:            vmsRand,
+			RestrictedBind:      se.GetRestrictedBind(),
+			RestrictedBindValue: se.GetRestrictedBindValue(),
+			SeName:              se.GetId(),
+			SeUid:               se.GetUid(),
+			Labels:              labels,
+		})
+		if err != nil {
+			glog.Error(err)
+			return "", err
+		}
+		return vmsId, nil
+	}
+
+	// update existing vmset
+	// there is one vmset per environment and template, additional ones are deleted as stale vmsets
+	existingVMSet := existingVMSetsList.GetVmsets()[0]
+	_, err = sc.vmSetClient.UpdateVMSet(sc.Context, &vmsetpb.UpdateVMSetRequest{
+		Id:             existingVMSet.GetId(),
+		Count:          wrapperspb.UInt32(count),
+		RestrictedBind: wrapperspb.Bool(se.GetRestrictedBind()),
+		Environment:    envId,
+	})
+	if err != nil {
+		glog.Errorf("error updating vmset config %s", err.Error())
+		return "", err
+	}
+	return existingVMSet.GetId(), nil
+}
+
 func (sc *ScheduledEventController) createAccessCode(se *scheduledeventpb.ScheduledEvent) error {
 	_, err := sc.accessCodeClient.CreateAc(sc.Context, &accesscodepb.CreateAcRequest{
 		AcName:              se.GetAccessCode(),
@@ -377,6 +470,12 @@ func (sc *ScheduledEventController) createAccessCode(se *scheduledeventpb.Schedu
 func (sc *ScheduledEventController) verifyScheduledEvent(se *scheduledeventpb.ScheduledEvent) error {
 	// check the state of the vmset and mark the sevent as ready if everything is OK
 	glog.V(6).Infof("ScheduledEvent %s is in provisioned status, checking status of VMSet Provisioning", se.GetId())
+
+	rebalanced, err := sc.rebalanceSpreadVMs(se)
+	if err != nil || rebalanced {
+		return err
+	}
+
 	vmsList, err := sc.vmSetClient.ListVMSet(sc.Context, &generalpb.ListOptions{
 		LabelSelector: fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, se.GetId()),
 	})
@@ -423,6 +522,79 @@ func (sc *ScheduledEventController) verifyScheduledEvent(se *scheduledeventpb.Sc
 	return nil
 }
 
+// rebalanceSpreadVMs marks environments of spread vms as failed if vms of the scheduled event did not start running
+// there within the environment failure timeout. The scheduled event is then provisioned again, which moves the spread
+// vms of failed environments to the remaining environments. Returns whether the scheduled event is rebalanced.
+func (sc *ScheduledEventController) rebalanceSpreadVMs(se *scheduledeventpb.ScheduledEvent) (bool, error) {
+	if len(se.GetSpreadVms()) == 0 {
+		return false, nil
+	}
+
+	failed := make(map[string]bool)
+	for _, environment := range se.GetStatus().GetFailedEnvironments() {
+		failed[environment] = true
+	}
+	spreadEnvironments := make(map[string]bool)
+	for _, spread := range se.GetSpreadVms() {
+		for _, preference := range spread.GetEnvironments() {
+			spreadEnvironments[preference.GetEnvironment()] = true
+		}
+	}
+
+	vmList, err := sc.vmClient.ListVM(sc.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, se.GetId()),
+		LoadFromCache: true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	timeout := sc.environmentFailureTimeout()
+	failedEnvironments := se.GetStatus().GetFailedEnvironments()
+	for _, vm := range vmList.GetVms() {
+		environment := vm.GetLabels()[hflabels.EnvironmentLabel]
+		if !spreadEnvironments[environment] || failed[environment] {
+			continue
+		}
+		if vm.GetDeletionTimestamp() != nil || vm.GetCreationTimestamp() == nil || vm.GetStatus().GetStatus() == string(hfv1.VmStatusRunning) {
+			continue
+		}
+		if time.Since(vm.GetCreationTimestamp().AsTime()) < timeout {
+			continue
+		}
+		glog.Warningf("vm %s of scheduled event %s is not running after %s, no longer using environment %s for spread vms", vm.GetId(), se.GetId(), timeout, environment)
+		failed[environment] = true
+		failedEnvironments = append(failedEnvironments, environment)
+	}
+
+	if len(failedEnvironments) == len(se.GetStatus().GetFailedEnvironments()) {
+		return false, nil
+	}
+
+	_, err = sc.internalScheduledEventServer.UpdateScheduledEventStatus(sc.Context, &scheduledeventpb.UpdateScheduledEventStatusRequest{
+		Id:                 se.GetId(),
+		Provisioned:        wrapperspb.Bool(false),
+		Ready:              wrapperspb.Bool(false),
+		FailedEnvironments: &scheduledeventpb.EnvironmentsWrapper{Value: failedEnvironments},
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// environmentFailureTimeout is how long vms may take to start running before their environment is considered failed.
+func (sc *ScheduledEventController) environmentFailureTimeout() time.Duration {
+	timeout := 30 * time.Minute
+	setting, err := sc.settingClient.GetSettingValue(sc.Context, &generalpb.ResourceId{Id: string(settingUtil.ScheduledEventEnvironmentFailureTimeout)})
+	if set, ok := setting.GetValue().(*settingpb.SettingValue_Int64Value); err != nil || !ok || set.Int64Value < 1 {
+		glog.Errorf("error retrieving environment failure timeout setting, using %s", timeout)
+	} else {
+		timeout = time.Duration(set.Int64Value) * time.Minute
+	}
+	return timeout
+}
+
 func (sc *ScheduledEventController) reconcileScheduledEvent(seName string) error {
 	glog.V(4).Infof("reconciling scheduled event %s", seName)
 
@@ -493,16 +665,3 @@ func (sc *ScheduledEventController) reconcileScheduledEvent(seName string) error
 
 	return nil
 }
-
-// @TODO: Integrate this function if it should be used or remove it if not.
-func calculateUsedCapacity(env *hfv1.Environment, vmsList *hfv1.VirtualMachineSetList, templates *hfv1.VirtualMachineTemplateList) map[string]int {
-	usedCount := map[string]int{}
-	for _, vms := range vmsList.Items {
-		for _, t := range templates.Items {
-			if t.Name == vms.Spec.VMTemplate {
-				usedCount[t.Name] = usedCount[t.Name] + vms.Spec.Count
-			}
-		}
-	}
-	return usedCount
-}
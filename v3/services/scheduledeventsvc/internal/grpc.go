@@ -68,15 +68,17 @@ func (s *GrpcScheduledEventServer) CreateScheduledEvent(ctx context.Context, req
 	scenariosRaw := req.GetScenariosRaw()
 	coursesRaw := req.GetCoursesRaw()
 	labels := req.GetLabels()
+	overbooked := req.GetOverbooked()
+	spreadVmsRaw := req.GetSpreadVmsRaw()
+	warmPoolRaw := req.GetWarmPoolRaw()
 
 	requiredStringParams := map[string]string{
-		"name":           name,
-		"description":    description,
-		"creator":        creator,
-		"startTime":      startTime,
-		"endTime":        endTime,
-		"requiredVmsRaw": reqVmsRaw,
-		"accessCode":     accessCode,
+		"name":        name,
+		"description": description,
+		"creator":     creator,
+		"startTime":   startTime,
+		"endTime":     endTime,
+		"accessCode":  accessCode,
 	}
 	for param, value := range requiredStringParams {
 		if value == "" {
@@ -88,9 +90,31 @@ func (s *GrpcScheduledEventServer) CreateScheduledEvent(ctx context.Context, req
 		return &generalpb.ResourceId{}, hferrors.GrpcError(codes.InvalidArgument, "no courses or scenarios provided", req)
 	}
 
-	requiredVms, err := util.GenericUnmarshal[map[string]map[string]int](reqVmsRaw, "required_vms_raw")
-	if err != nil {
-		return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "required_vms_raw")
+	if reqVmsRaw == "" && spreadVmsRaw == "" {
+		return &generalpb.ResourceId{}, hferrors.GrpcError(codes.InvalidArgument, "no required vms or spread vms provided", req)
+	}
+
+	var err error
+	var requiredVms map[string]map[string]int
+	if reqVmsRaw != "" {
+		requiredVms, err = util.GenericUnmarshal[map[string]map[string]int](reqVmsRaw, "required_vms_raw")
+		if err != nil {
+			return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "required_vms_raw")
+		}
+	}
+	var spreadVms []hfv1.VirtualMachineSpread
+	if spreadVmsRaw != "" {
+		spreadVms, err = util.ParseSpreadVirtualMachines(spreadVmsRaw, "spread_vms_raw")
+		if err != nil {
+			return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "spread_vms_raw")
+		}
+	}
+	var warmPool map[string]map[string]int
+	if warmPoolRaw != "" {
+		warmPool, err = util.GenericUnmarshal[map[string]map[string]int](warmPoolRaw, "warm_pool_raw")
+		if err != nil {
+			return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "warm_pool_raw")
+		}
 	}
 
 	random := util.RandStringRunes(16)
@@ -109,6 +133,8 @@ func (s *GrpcScheduledEventServer) CreateScheduledEvent(ctx context.Context, req
 			EndTime:                 endTime,
 			OnDemand:                onDemand,
 			RequiredVirtualMachines: requiredVms,
+			SpreadVirtualMachines:   spreadVms,
+			WarmPool:                warmPool,
 			AccessCode:              accessCode,
 			RestrictedBind:          restrictedBind,
 			Printable:               printable,
@@ -119,6 +145,13 @@ func (s *GrpcScheduledEventServer) CreateScheduledEvent(ctx context.Context, req
 		event.Spec.RestrictedBindValue = event.Name
 	}
 
+	if overbooked {
+		if event.ObjectMeta.Labels == nil {
+			event.ObjectMeta.Labels = make(map[string]string)
+		}
+		event.ObjectMeta.Labels[hflabels.OverbookedLabel] = "true"
+	}
+
 	if coursesRaw != "" {
 		courses, err := util.GenericUnmarshal[[]string](coursesRaw, "courses_raw")
 		if err != nil {
@@ -152,16 +185,13 @@ func (s *GrpcScheduledEventServer) GetScheduledEvent(ctx context.Context, req *g
 	}
 
 	status := &scheduledeventpb.ScheduledEventStatus{
-		Vmsets:      event.Status.VirtualMachineSets,
-		Active:      event.Status.Active,
-		Provisioned: event.Status.Provisioned,
-		Ready:       event.Status.Ready,
-		Finished:    event.Status.Finished,
-	}
-
-	requiredVms := make(map[string]*scheduledeventpb.VMTemplateCountMap)
-	for environment, vmTemplateCountMap := range event.Spec.RequiredVirtualMachines {
-		requiredVms[environment] = &scheduledeventpb.VMTemplateCountMap{VmTemplateCounts: util.ConvertIntMap[int, uint32](vmTemplateCountMap)}
+		Vmsets:             event.Status.VirtualMachineSets,
+		Active:             event.Status.Active,
+		Provisioned:        event.Status.Provisioned,
+		Ready:              event.Status.Ready,
+		Finished:           event.Status.Finished,
+		SpreadAllocation:   util.ConvertToVMTemplateCountMaps(event.Status.SpreadAllocation),
+		FailedEnvironments: event.Status.FailedEnvironments,
 	}
 
 	return &scheduledeventpb.ScheduledEvent{
@@ -176,12 +206,14 @@ func (s *GrpcScheduledEventServer) GetScheduledEvent(ctx context.Context, req *g
 		Printable:           event.Spec.Printable,
 		RestrictedBind:      event.Spec.RestrictedBind,
 		RestrictedBindValue: event.Spec.RestrictedBindValue,
-		RequiredVms:         requiredVms,
+		RequiredVms:         util.ConvertToVMTemplateCountMaps(event.Spec.RequiredVirtualMachines),
 		AccessCode:          event.Spec.AccessCode,
 		Scenarios:           event.Spec.Scenarios,
 		Courses:             event.Spec.Courses,
 		Labels:              event.Labels,
 		Status:              status,
+		SpreadVms:           util.ConvertToVMSpreads(event.Spec.SpreadVirtualMachines),
+		WarmPool:            util.ConvertToVMTemplateCountMaps(event.Spec.WarmPool),
 	}, nil
 }
 
@@ -201,6 +233,9 @@ func (s *GrpcScheduledEventServer) UpdateScheduledEvent(ctx context.Context, req
 	accessCode := req.GetAccessCode()
 	scenariosRaw := req.GetScenariosRaw()
 	coursesRaw := req.GetCoursesRaw()
+	overbooked := req.GetOverbooked()
+	spreadVmsRaw := req.GetSpreadVmsRaw()
+	warmPoolRaw := req.GetWarmPoolRaw()
 
 	scheduledEventLabelSelector := fmt.Sprintf("%s=%s", hflabels.ScheduledEventLabel, id)
 
@@ -261,6 +296,20 @@ func (s *GrpcScheduledEventServer) UpdateScheduledEvent(ctx context.Context, req
 			}
 			event.Spec.RequiredVirtualMachines = requiredVms
 		}
+		if spreadVmsRaw != "" {
+			spreadVms, err := util.ParseSpreadVirtualMachines(spreadVmsRaw, "spread_vms_raw")
+			if err != nil {
+				return hferrors.GrpcParsingError(req, "spread_vms_raw")
+			}
+			event.Spec.SpreadVirtualMachines = spreadVms
+		}
+		if warmPoolRaw != "" {
+			warmPool, err := util.GenericUnmarshal[map[string]map[string]int](warmPoolRaw, "warm_pool_raw")
+			if err != nil {
+				return hferrors.GrpcParsingError(req, "warm_pool_raw")
+			}
+			event.Spec.WarmPool = warmPool
+		}
 		if accessCode != "" {
 			event.Spec.AccessCode = accessCode
 		}
@@ -278,6 +327,16 @@ func (s *GrpcScheduledEventServer) UpdateScheduledEvent(ctx context.Context, req
 			}
 			event.Spec.Courses = courses
 		}
+		if overbooked != nil {
+			if overbooked.GetValue() {
+				if event.ObjectMeta.Labels == nil {
+					event.ObjectMeta.Labels = make(map[string]string)
+				}
+				event.ObjectMeta.Labels[hflabels.OverbookedLabel] = "true"
+			} else {
+				delete(event.ObjectMeta.Labels, hflabels.OverbookedLabel)
+			}
+		}
 
 		// if our event is already provisioned, we need to undo that and delete the corresponding access code(s) and DBC(s)
 		// our scheduledeventcontroller will then provision our scheduledevent with the updated values
@@ -346,6 +405,8 @@ func (s *GrpcScheduledEventServer) UpdateScheduledEventStatus(ctx context.Contex
 	provisioned := req.GetProvisioned()
 	ready := req.GetReady()
 	finished := req.GetFinished()
+	spreadAllocation := req.GetSpreadAllocation()
+	failedEnvironments := req.GetFailedEnvironments()
 
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		event, err := s.eventClient.Get(ctx, id, metav1.GetOptions{})
@@ -383,6 +444,15 @@ func (s *GrpcScheduledEventServer) UpdateScheduledEventStatus(ctx context.Contex
 		if finished != nil {
 			event.Status.Finished = finished.GetValue()
 		}
+		if spreadAllocation != nil {
+			event.Status.SpreadAllocation = make(map[string]map[string]int, len(spreadAllocation.GetValue()))
+			for environment, vmTemplateCountMap := range spreadAllocation.GetValue() {
+				event.Status.SpreadAllocation[environment] = util.ConvertIntMap[uint32, int](vmTemplateCountMap.GetVmTemplateCounts())
+			}
+		}
+		if failedEnvironments != nil {
+			event.Status.FailedEnvironments = failedEnvironments.GetValue()
+		}
 
 		_, updateErr := s.eventClient.UpdateStatus(ctx, event, metav1.UpdateOptions{})
 		if updateErr != nil {
@@ -432,16 +502,13 @@ func (s *GrpcScheduledEventServer) ListScheduledEvent(ctx context.Context, listO
 
 	for _, event := range events {
 		status := &scheduledeventpb.ScheduledEventStatus{
-			Vmsets:      event.Status.VirtualMachineSets,
-			Active:      event.Status.Active,
-			Provisioned: event.Status.Provisioned,
-			Ready:       event.Status.Ready,
-			Finished:    event.Status.Finished,
-		}
-
-		requiredVms := make(map[string]*scheduledeventpb.VMTemplateCountMap)
-		for environment, vmTemplateCountMap := range event.Spec.RequiredVirtualMachines {
-			requiredVms[environment] = &scheduledeventpb.VMTemplateCountMap{VmTemplateCounts: util.ConvertIntMap[int, uint32](vmTemplateCountMap)}
+			Vmsets:             event.Status.VirtualMachineSets,
+			Active:             event.Status.Active,
+			Provisioned:        event.Status.Provisioned,
+			Ready:              event.Status.Ready,
+			Finished:           event.Status.Finished,
+			SpreadAllocation:   util.ConvertToVMTemplateCountMaps(event.Status.SpreadAllocation),
+			FailedEnvironments: event.Status.FailedEnvironments,
 		}
 
 		preparedEvents = append(preparedEvents, &scheduledeventpb.ScheduledEvent{
@@ -456,12 +523,14 @@ func (s *GrpcScheduledEventServer) ListScheduledEvent(ctx context.Context, listO
 			Printable:           event.Spec.Printable,
 			RestrictedBind:      event.Spec.RestrictedBind,
 			RestrictedBindValue: event.Spec.RestrictedBindValue,
-			RequiredVms:         requiredVms,
+			RequiredVms:         util.ConvertToVMTemplateCountMaps(event.Spec.RequiredVirtualMachines),
 			AccessCode:          event.Spec.AccessCode,
 			Scenarios:           event.Spec.Scenarios,
 			Courses:             event.Spec.Courses,
 			Labels:              event.Labels,
 			Status:              status,
+			SpreadVms:           util.ConvertToVMSpreads(event.Spec.SpreadVirtualMachines),
+			WarmPool:            util.ConvertToVMTemplateCountMaps(event.Spec.WarmPool),
 		})
 	}
 
@@ -42,6 +42,9 @@ type PreparedScheduledEvent struct {
 	Printable               bool                         `json:"printable"`
 	Scenarios               []string                     `json:"scenarios"`
 	Courses                 []string                     `json:"courses"`
+	SpreadVirtualMachines   []*scheduledeventpb.VMSpread `json:"spread_vms"` // vm templates split across several environments
+	WarmPool                map[string]map[string]uint32 `json:"warm_pool"`  // map of environment to vm template to the count of vms kept provisioned for on demand events
+	Overbooked              bool                         `json:"overbooked"` // whether the scheduled event exceeds the capacity of its environments
 	*scheduledeventpb.ScheduledEventStatus
 }
 
@@ -68,6 +71,9 @@ func (s ScheduledEventServer) getPreparedScheduledEvent(scheduledEvent *schedule
 		Printable:               scheduledEvent.GetPrintable(),
 		Scenarios:               scheduledEvent.GetScenarios(),
 		Courses:                 scheduledEvent.GetCourses(),
+		SpreadVirtualMachines:   scheduledEvent.GetSpreadVms(),
+		WarmPool:                util.ConvertMapStruct(scheduledEvent.GetWarmPool(), util.GetRawVMTemplateCountMap),
+		Overbooked:              scheduledEvent.GetLabels()[hflabels.OverbookedLabel] == "true",
 		ScheduledEventStatus:    scheduledEvent.GetStatus(),
 	}
 
@@ -192,10 +198,12 @@ func (s ScheduledEventServer) CreateFunc(w http.ResponseWriter, r *http.Request)
 		return
 	}
 	requiredVM := r.PostFormValue("required_vms")
-	if requiredVM == "" {
+	spreadVM := r.PostFormValue("spread_vms")
+	if requiredVM == "" && spreadVM == "" {
 		util.ReturnHTTPMessage(w, r, 400, "badrequest", "no required vm map passed in")
 		return
 	}
+	warmPool := r.PostFormValue("warm_pool")
 	accessCode := r.PostFormValue("access_code")
 	if accessCode == "" {
 		util.ReturnHTTPMessage(w, r, 400, "badrequest", "no access code passed in")
@@ -247,6 +255,40 @@ func (s ScheduledEventServer) CreateFunc(w http.ResponseWriter, r *http.Request)
 		}
 	}
 
+	// overbooking the environments has to be allowed explicitly
+	allowOverbooking := strings.ToLower(r.PostFormValue("allow_overbooking")) == "true"
+
+	scheduledEvent := &scheduledeventpb.ScheduledEvent{
+		Name:      name,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+	if requiredVM != "" {
+		scheduledEvent.RequiredVms, err = parseRequiredVms(requiredVM)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for required_vms")
+			return
+		}
+	}
+	if spreadVM != "" {
+		scheduledEvent.SpreadVms, err = parseSpreadVms(spreadVM)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for spread_vms")
+			return
+		}
+	}
+	if warmPool != "" {
+		_, err = parseRequiredVms(warmPool)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for warm_pool")
+			return
+		}
+	}
+	overbooked, ok := s.checkOverbooking(w, r, scheduledEvent, allowOverbooking)
+	if !ok {
+		return
+	}
+
 	eventId, err := s.internalScheduledEventServer.CreateScheduledEvent(r.Context(), &scheduledeventpb.CreateScheduledEventRequest{
 		Name:           name,
 		Description:    description,
@@ -260,6 +302,9 @@ func (s ScheduledEventServer) CreateFunc(w http.ResponseWriter, r *http.Request)
 		AccessCode:     accessCode,
 		ScenariosRaw:   scenariosRaw,
 		CoursesRaw:     coursesRaw,
+		Overbooked:     overbooked,
+		SpreadVmsRaw:   spreadVM,
+		WarmPoolRaw:    warmPool,
 	})
 
 	if err != nil {
@@ -277,6 +322,9 @@ func (s ScheduledEventServer) CreateFunc(w http.ResponseWriter, r *http.Request)
 		Provisioned: wrapperspb.Bool(false),
 		Ready:       wrapperspb.Bool(false),
 		Finished:    wrapperspb.Bool(false),
+		SpreadAllocation: &scheduledeventpb.SpreadAllocationWrapper{
+			Value: scheduledEvent.GetStatus().GetSpreadAllocation(),
+		},
 	})
 
 	if err != nil {
@@ -315,6 +363,8 @@ func (s ScheduledEventServer) UpdateFunc(w http.ResponseWriter, r *http.Request)
 	startTime := r.PostFormValue("start_time")
 	endTime := r.PostFormValue("end_time")
 	requiredVM := r.PostFormValue("required_vms")
+	spreadVM := r.PostFormValue("spread_vms")
+	warmPool := r.PostFormValue("warm_pool")
 	accessCode := r.PostFormValue("access_code")
 	scenariosRaw := r.PostFormValue("scenarios")
 	coursesRaw := r.PostFormValue("courses")
@@ -349,6 +399,50 @@ func (s ScheduledEventServer) UpdateFunc(w http.ResponseWriter, r *http.Request)
 		restrictedBindWrapper = wrapperspb.Bool(restrictedBind)
 	}
 
+	// the capacity is checked for the scheduled event as it will be after the update
+	scheduledEvent, err := s.internalScheduledEventServer.GetScheduledEvent(r.Context(), &generalpb.GetRequest{Id: id})
+	if err != nil {
+		glog.Errorf("error retrieving scheduled event %s: %s", id, hferrors.GetErrorMessage(err))
+		if hferrors.IsGrpcNotFound(err) {
+			util.ReturnHTTPMessage(w, r, 404, "not found", fmt.Sprintf("scheduled event %s not found", id))
+			return
+		}
+		util.ReturnHTTPMessage(w, r, 500, "error", "error attempting to update")
+		return
+	}
+	if startTime != "" {
+		scheduledEvent.StartTime = startTime
+	}
+	if endTime != "" {
+		scheduledEvent.EndTime = endTime
+	}
+	if requiredVM != "" {
+		scheduledEvent.RequiredVms, err = parseRequiredVms(requiredVM)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for required_vms")
+			return
+		}
+	}
+	if spreadVM != "" {
+		scheduledEvent.SpreadVms, err = parseSpreadVms(spreadVM)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for spread_vms")
+			return
+		}
+	}
+	if warmPool != "" {
+		_, err = parseRequiredVms(warmPool)
+		if err != nil {
+			util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid value for warm_pool")
+			return
+		}
+	}
+	allowOverbooking := strings.ToLower(r.PostFormValue("allow_overbooking")) == "true"
+	overbooked, ok := s.checkOverbooking(w, r, scheduledEvent, allowOverbooking)
+	if !ok {
+		return
+	}
+
 	_, err = s.internalScheduledEventServer.UpdateScheduledEvent(r.Context(), &scheduledeventpb.UpdateScheduledEventRequest{
 		Id:             id,
 		Name:           name,
@@ -362,6 +456,9 @@ func (s ScheduledEventServer) UpdateFunc(w http.ResponseWriter, r *http.Request)
 		AccessCode:     accessCode,
 		ScenariosRaw:   scenariosRaw,
 		CoursesRaw:     coursesRaw,
+		Overbooked:     wrapperspb.Bool(overbooked),
+		SpreadVmsRaw:   spreadVM,
+		WarmPoolRaw:    warmPool,
 	})
 
 	if err != nil {
@@ -370,6 +467,16 @@ func (s ScheduledEventServer) UpdateFunc(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	_, err = s.internalScheduledEventServer.UpdateScheduledEventStatus(r.Context(), &scheduledeventpb.UpdateScheduledEventStatusRequest{
+		Id: id,
+		SpreadAllocation: &scheduledeventpb.SpreadAllocationWrapper{
+			Value: scheduledEvent.GetStatus().GetSpreadAllocation(),
+		},
+	})
+	if err != nil {
+		glog.Errorf("error updating spread allocation of scheduled event %s: %s", id, hferrors.GetErrorMessage(err))
+	}
+
 	util.ReturnHTTPMessage(w, r, 200, "updated", "")
 }
 
@@ -3,13 +3,16 @@ package eventservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/capacity"
 	accesscodepb "github.com/hobbyfarm/gargantua/v3/protos/accesscode"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	dbconfigpb "github.com/hobbyfarm/gargantua/v3/protos/dbconfig"
+	environmentpb "github.com/hobbyfarm/gargantua/v3/protos/environment"
 	progresspb "github.com/hobbyfarm/gargantua/v3/protos/progress"
 	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
+	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 )
 
 // session
@@ -23,6 +26,7 @@ type ScheduledEventServer struct {
 	progressClient               progresspb.ProgressSvcClient
 	sessionClient                sessionpb.SessionSvcClient
 	vmsetClient                  vmsetpb.VMSetSvcClient
+	capacityChecker              *capacity.Checker
 	internalScheduledEventServer *GrpcScheduledEventServer
 }
 
@@ -34,6 +38,8 @@ func NewScheduledEventServer(
 	progressClient progresspb.ProgressSvcClient,
 	sessionClient sessionpb.SessionSvcClient,
 	vmsetClient vmsetpb.VMSetSvcClient,
+	environmentClient environmentpb.EnvironmentSvcClient,
+	vmTemplateClient vmtemplatepb.VMTemplateSvcClient,
 	internalScheduledEventServer *GrpcScheduledEventServer,
 ) ScheduledEventServer {
 	return ScheduledEventServer{
@@ -44,6 +50,7 @@ func NewScheduledEventServer(
 		progressClient:               progressClient,
 		sessionClient:                sessionClient,
 		vmsetClient:                  vmsetClient,
+		capacityChecker:              capacity.NewChecker(environmentClient, vmTemplateClient),
 		internalScheduledEventServer: internalScheduledEventServer,
 	}
 }
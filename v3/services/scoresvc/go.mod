@@ -4,6 +4,7 @@ replace github.com/hobbyfarm/gargantua/v3 => ../../
 
 replace (
 	k8s.io/api => k8s.io/api v0.32.1
+	k8s.io/apiextensions-apiserver => k8s.io/apiextensions-apiserver v0.32.1
 	k8s.io/apimachinery => k8s.io/apimachinery v0.32.1
 	k8s.io/client-go => k8s.io/client-go v0.32.1
 )
@@ -11,11 +12,15 @@ replace (
 go 1.23.0
 
 require (
+	github.com/ebauman/crder v0.3.3
 	github.com/golang/glog v1.2.4
 	github.com/gorilla/mux v1.8.1
 	github.com/hobbyfarm/gargantua/v3 v3.2.5
 	github.com/patrickmn/go-cache v2.1.0+incompatible
 	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+	google.golang.org/grpc v1.70.0
+	k8s.io/apimachinery v0.32.2
+	k8s.io/client-go v12.0.0+incompatible
 )
 
 require (
@@ -70,14 +75,12 @@ require (
 	golang.org/x/text v0.22.0 // indirect
 	golang.org/x/time v0.10.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
-	google.golang.org/grpc v1.70.0 // indirect
 	google.golang.org/protobuf v1.36.5 // indirect
 	gopkg.in/evanphx/json-patch.v4 v4.12.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 	k8s.io/api v0.32.2 // indirect
-	k8s.io/apimachinery v0.32.2 // indirect
-	k8s.io/client-go v12.0.0+incompatible // indirect
+	k8s.io/apiextensions-apiserver v0.32.2 // indirect
 	k8s.io/klog/v2 v2.130.1 // indirect
 	k8s.io/kube-openapi v0.0.0-20241212222426-2c72e554b1e7 // indirect
 	k8s.io/utils v0.0.0-20241210054802-24370beab758 // indirect
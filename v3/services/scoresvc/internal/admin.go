@@ -0,0 +1,98 @@
+package scoreservice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+)
+
+// authorizeAdmin checks whether the user of the request is allowed to perform the verb on leaderboards.
+// The admin API is disabled for standalone arcades, which are not connected to the authn and authr services.
+func (s *ScoreServer) authorizeAdmin(w http.ResponseWriter, r *http.Request, verb string) bool {
+	if s.authnClient == nil || s.authrClient == nil {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "admin api is disabled")
+		return false
+	}
+
+	user, err := rbac.AuthenticateRequest(r, s.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return false
+	}
+
+	authrResponse, err := rbac.AuthorizeSimple(r, s.authrClient, user.GetId(), rbac.HobbyfarmPermission(rbac.ResourcePluralLeaderboard, verb))
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", fmt.Sprintf("no access to %s leaderboards", verb))
+		return false
+	}
+	return true
+}
+
+func (s *ScoreServer) ListBoardsFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAdmin(w, r, rbac.VerbList) {
+		return
+	}
+
+	boards, err := s.store.ListBoards(r.Context())
+	if err != nil {
+		glog.Errorf("Error listing leaderboards: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error listing leaderboards")
+		return
+	}
+
+	encodedBoards, err := json.Marshal(boards)
+	if err != nil {
+		glog.Errorf("Error marshalling leaderboards: %v", err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error listing leaderboards")
+		return
+	}
+
+	util.ReturnHTTPContent(w, r, 200, "success", encodedBoards)
+}
+
+// ResetBoardFunc removes all scores of the board selected by the language and the scheduledevent and board query parameters.
+func (s *ScoreServer) ResetBoardFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAdmin(w, r, rbac.VerbDelete) {
+		return
+	}
+
+	key := boardKey(r)
+	err := s.store.ResetBoard(r.Context(), key)
+	if err != nil {
+		glog.Errorf("Error resetting leaderboard %v: %v", key, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error resetting leaderboard")
+		return
+	}
+
+	glog.Infof("Reset leaderboard %v", key)
+	util.ReturnHTTPMessage(w, r, 200, "reset", "leaderboard reset")
+}
+
+// RemoveScoreFunc removes a single score, e.g. one with an offensive name, from the selected board.
+func (s *ScoreServer) RemoveScoreFunc(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeAdmin(w, r, rbac.VerbUpdate) {
+		return
+	}
+
+	key := boardKey(r)
+	id := mux.Vars(r)["id"]
+	err := s.store.RemoveScore(r.Context(), key, id)
+	if errors.Is(err, ErrScoreNotFound) {
+		util.ReturnHTTPMessage(w, r, 404, "notfound", "score not found")
+		return
+	}
+	if err != nil {
+		glog.Errorf("Error removing score %s from leaderboard %v: %v", id, key, err)
+		util.ReturnHTTPMessage(w, r, 500, "internalerror", "error removing score")
+		return
+	}
+
+	glog.Infof("Removed score %s from leaderboard %v", id, key)
+	util.ReturnHTTPMessage(w, r, 200, "deleted", "score removed")
+}
@@ -0,0 +1,111 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	userpb "github.com/hobbyfarm/gargantua/v3/protos/user"
+	"google.golang.org/grpc"
+)
+
+// fakeAuthNClient authenticates the token "Bearer admin" as user admin.
+type fakeAuthNClient struct {
+	authnpb.AuthNClient
+}
+
+func (f *fakeAuthNClient) AuthN(_ context.Context, in *authnpb.AuthNRequest, _ ...grpc.CallOption) (*userpb.User, error) {
+	if in.GetToken() != "Bearer admin" {
+		return nil, errors.New("invalid token")
+	}
+	return &userpb.User{Id: "admin"}, nil
+}
+
+// fakeAuthRClient grants the verbs on leaderboards and records the requested permissions.
+type fakeAuthRClient struct {
+	authrpb.AuthRClient
+	verbs     map[string]bool
+	requested []*authrpb.Permission
+}
+
+func (f *fakeAuthRClient) AuthR(_ context.Context, in *authrpb.AuthRRequest, _ ...grpc.CallOption) (*authrpb.AuthRResponse, error) {
+	success := in.GetUserName() == "admin"
+	for _, permission := range in.GetRequest().GetPermissions() {
+		f.requested = append(f.requested, permission)
+		success = success && permission.GetApiGroup() == rbac.HobbyfarmGroup &&
+			permission.GetResource() == rbac.ResourcePluralLeaderboard && f.verbs[permission.GetVerb()]
+	}
+	return &authrpb.AuthRResponse{Success: success}, nil
+}
+
+func adminRequest(t *testing.T, s *ScoreServer, method string, url string, token string) *httptest.ResponseRecorder {
+	t.Helper()
+	router := mux.NewRouter()
+	s.SetupRoutes(router)
+
+	r := httptest.NewRequest(method, url, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func Test_AdminDisabled(t *testing.T) {
+	s, _ := NewScoreServer(NewMemoryStore(), nil, nil, nil)
+
+	w := adminRequest(t, s, http.MethodGet, "/score/admin/leaderboards", "admin")
+	if w.Code != http.StatusForbidden {
+		t.Errorf("expected admin api to be disabled without authn and authr, got %d", w.Code)
+	}
+}
+
+func Test_AdminAuthorization(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		token  string
+		verbs  map[string]bool
+		code   int
+		verb   string
+	}{
+		{"list boards", http.MethodGet, "/score/admin/leaderboards", "admin", map[string]bool{rbac.VerbList: true}, http.StatusOK, rbac.VerbList},
+		{"unauthenticated", http.MethodGet, "/score/admin/leaderboards", "", map[string]bool{rbac.VerbList: true}, http.StatusUnauthorized, ""},
+		{"invalid token", http.MethodGet, "/score/admin/leaderboards", "player", map[string]bool{rbac.VerbList: true}, http.StatusUnauthorized, ""},
+		{"list forbidden", http.MethodGet, "/score/admin/leaderboards", "admin", map[string]bool{rbac.VerbDelete: true}, http.StatusForbidden, rbac.VerbList},
+		{"reset board", http.MethodDelete, "/score/admin/leaderboard/bash", "admin", map[string]bool{rbac.VerbDelete: true}, http.StatusOK, rbac.VerbDelete},
+		{"reset forbidden", http.MethodDelete, "/score/admin/leaderboard/bash", "admin", map[string]bool{rbac.VerbList: true}, http.StatusForbidden, rbac.VerbDelete},
+		{"remove score", http.MethodDelete, "/score/admin/leaderboard/bash/a", "admin", map[string]bool{rbac.VerbUpdate: true}, http.StatusOK, rbac.VerbUpdate},
+		{"remove unknown score", http.MethodDelete, "/score/admin/leaderboard/bash/x", "admin", map[string]bool{rbac.VerbUpdate: true}, http.StatusNotFound, rbac.VerbUpdate},
+		{"remove forbidden", http.MethodDelete, "/score/admin/leaderboard/bash/a", "admin", map[string]bool{rbac.VerbDelete: true}, http.StatusForbidden, rbac.VerbUpdate},
+	}
+
+	for _, tt := range tests {
+		store := NewMemoryStore()
+		addScores(t, store, defaultBoard, testScore("a", 10))
+		authrClient := &fakeAuthRClient{verbs: tt.verbs}
+		s, _ := NewScoreServer(store, nil, &fakeAuthNClient{}, authrClient)
+
+		w := adminRequest(t, s, tt.method, tt.url, tt.token)
+		if w.Code != tt.code {
+			t.Errorf("%s: expected status %d, got %d: %s", tt.name, tt.code, w.Code, w.Body.String())
+		}
+		if tt.verb == "" {
+			if len(authrClient.requested) != 0 {
+				t.Errorf("%s: expected unauthenticated request to not be authorized", tt.name)
+			}
+			continue
+		}
+		if len(authrClient.requested) != 1 || authrClient.requested[0].GetVerb() != tt.verb {
+			t.Errorf("%s: expected %s on leaderboards to be authorized, got %v", tt.name, tt.verb, authrClient.requested)
+		}
+	}
+}
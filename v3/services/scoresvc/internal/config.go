@@ -0,0 +1,161 @@
+package scoreservice
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/golang/glog"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	settingUtil "github.com/hobbyfarm/gargantua/v3/pkg/setting"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
+)
+
+const (
+	DEFAULT_NOTIFICATION_TEMPLATE     = "Scanned: {{ .DecodedCode }}"
+	DEFAULT_NOTIFICATION_MAX_ATTEMPTS = 10
+)
+
+// NotificationConfig configures the channels notified about scanned badges. A channel is enabled by configuring its
+// url or server.
+type NotificationConfig struct {
+	BaseUrl         string // base url of the scoreservice, used to link the qr code of the badge
+	Template        string // text/template of the message
+	MaxAttempts     int    // attempts per channel before giving up
+	TeamsWebhookUrl string
+	SlackWebhookUrl string
+	WebhookUrl      string
+	WebhookSecret   string // signs the body of generic webhooks with HMAC-SHA256
+	SmtpServer      string // host:port
+	SmtpUsername    string
+	SmtpPassword    string
+	EmailFrom       string
+	EmailTo         []string
+}
+
+// ConfigSource provides the notification config. It is read before every delivery, so changes apply without restart.
+type ConfigSource interface {
+	NotificationConfig(ctx context.Context) (NotificationConfig, error)
+}
+
+// SettingsConfigSource reads the notification config from the settingservice.
+type SettingsConfigSource struct {
+	settingClient settingpb.SettingSvcClient
+}
+
+func NewSettingsConfigSource(settingClient settingpb.SettingSvcClient) *SettingsConfigSource {
+	return &SettingsConfigSource{
+		settingClient: settingClient,
+	}
+}
+
+func (s *SettingsConfigSource) NotificationConfig(ctx context.Context) (NotificationConfig, error) {
+	// the config is only usable if every setting could be read, a missing setting could disable a channel
+	var err error
+	getString := func(name settingUtil.SettingName) string {
+		if err != nil {
+			return ""
+		}
+		var value string
+		value, err = s.getString(ctx, name)
+		return value
+	}
+
+	config := NotificationConfig{
+		BaseUrl:         getString(settingUtil.ArcadeBaseUrl),
+		Template:        getString(settingUtil.ArcadeNotificationTemplate),
+		MaxAttempts:     DEFAULT_NOTIFICATION_MAX_ATTEMPTS,
+		TeamsWebhookUrl: getString(settingUtil.ArcadeTeamsWebhookUrl),
+		SlackWebhookUrl: getString(settingUtil.ArcadeSlackWebhookUrl),
+		WebhookUrl:      getString(settingUtil.ArcadeWebhookUrl),
+		WebhookSecret:   getString(settingUtil.ArcadeWebhookSecret),
+		SmtpServer:      getString(settingUtil.ArcadeSmtpServer),
+		SmtpUsername:    getString(settingUtil.ArcadeSmtpUsername),
+		SmtpPassword:    getString(settingUtil.ArcadeSmtpPassword),
+		EmailFrom:       getString(settingUtil.ArcadeEmailFrom),
+		EmailTo:         splitList(getString(settingUtil.ArcadeEmailTo)),
+	}
+	if err != nil {
+		return NotificationConfig{}, err
+	}
+
+	setting, err := s.settingClient.GetSettingValue(ctx, &generalpb.ResourceId{Id: string(settingUtil.ArcadeNotificationMaxAttempts)})
+	if set, ok := setting.GetValue().(*settingpb.SettingValue_Int64Value); err != nil || !ok || set.Int64Value < 1 {
+		glog.Errorf("error retrieving notification max attempts setting, using %d", config.MaxAttempts)
+	} else {
+		config.MaxAttempts = int(set.Int64Value)
+	}
+
+	if config.Template == "" {
+		config.Template = DEFAULT_NOTIFICATION_TEMPLATE
+	}
+	return config, nil
+}
+
+// getString returns the value of the string setting.
+func (s *SettingsConfigSource) getString(ctx context.Context, name settingUtil.SettingName) (string, error) {
+	setting, err := s.settingClient.GetSettingValue(ctx, &generalpb.ResourceId{Id: string(name)})
+	if err != nil {
+		return "", fmt.Errorf("error retrieving setting %s: %s", name, hferrors.GetErrorMessage(err))
+	}
+	set, ok := setting.GetValue().(*settingpb.SettingValue_StringValue)
+	if !ok {
+		return "", fmt.Errorf("setting %s is not a string", name)
+	}
+	return set.StringValue, nil
+}
+
+// EnvConfigSource reads the notification config from environment variables, named like the settings in upper case,
+// e.g. ARCADE_SLACK_WEBHOOK_URL. This is meant for a standalone arcade without settingservice.
+// WEBHOOK_URL and BASE_URL are still supported for the Microsoft Teams webhook and the base url.
+type EnvConfigSource struct{}
+
+func NewEnvConfigSource() *EnvConfigSource {
+	return &EnvConfigSource{}
+}
+
+func (e *EnvConfigSource) NotificationConfig(ctx context.Context) (NotificationConfig, error) {
+	config := NotificationConfig{
+		BaseUrl:         getEnv(settingUtil.ArcadeBaseUrl, os.Getenv("BASE_URL")),
+		Template:        getEnv(settingUtil.ArcadeNotificationTemplate, DEFAULT_NOTIFICATION_TEMPLATE),
+		MaxAttempts:     DEFAULT_NOTIFICATION_MAX_ATTEMPTS,
+		TeamsWebhookUrl: getEnv(settingUtil.ArcadeTeamsWebhookUrl, os.Getenv("WEBHOOK_URL")),
+		SlackWebhookUrl: getEnv(settingUtil.ArcadeSlackWebhookUrl, ""),
+		WebhookUrl:      getEnv(settingUtil.ArcadeWebhookUrl, ""),
+		WebhookSecret:   getEnv(settingUtil.ArcadeWebhookSecret, ""),
+		SmtpServer:      getEnv(settingUtil.ArcadeSmtpServer, ""),
+		SmtpUsername:    getEnv(settingUtil.ArcadeSmtpUsername, ""),
+		SmtpPassword:    getEnv(settingUtil.ArcadeSmtpPassword, ""),
+		EmailFrom:       getEnv(settingUtil.ArcadeEmailFrom, ""),
+		EmailTo:         splitList(getEnv(settingUtil.ArcadeEmailTo, "")),
+	}
+
+	maxAttempts, err := strconv.Atoi(getEnv(settingUtil.ArcadeNotificationMaxAttempts, ""))
+	if err == nil && maxAttempts > 0 {
+		config.MaxAttempts = maxAttempts
+	}
+	return config, nil
+}
+
+// getEnv returns the environment variable of the setting, the default value if it is not set.
+func getEnv(name settingUtil.SettingName, defaultValue string) string {
+	value, found := os.LookupEnv(strings.ToUpper(strings.ReplaceAll(string(name), "-", "_")))
+	if !found {
+		return defaultValue
+	}
+	return value
+}
+
+// splitList splits a comma separated list and drops empty entries.
+func splitList(list string) []string {
+	entries := []string{}
+	for _, entry := range strings.Split(list, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,44 @@
+package scoreservice
+
+import (
+	"github.com/ebauman/crder"
+	v1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
+)
+
+// ScoreCRDInstaller is a struct that can generate CRDs for leaderboards, arcade scores and arcade scans.
+// It implements the CrdInstaller interface defined in "github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+type ScoreCRDInstaller struct{}
+
+func (si ScoreCRDInstaller) GenerateCRDs() []crder.CRD {
+	return []crder.CRD{
+		crd.HobbyfarmCRD(&v1.Leaderboard{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.Leaderboard{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("Language", ".spec.language").
+						WithColumn("ScheduledEvent", ".spec.scheduled_event").
+						WithColumn("Board", ".spec.board")
+				})
+		}),
+		crd.HobbyfarmCRD(&v1.ArcadeScore{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.ArcadeScore{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("Leaderboard", ".spec.leaderboard").
+						WithColumn("Name", ".spec.name").
+						WithColumn("Score", ".spec.score")
+				})
+		}),
+		crd.HobbyfarmCRD(&v1.ArcadeScan{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v1", &v1.ArcadeScan{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("Cooldown", ".spec.cooldown")
+				})
+		}),
+	}
+}
@@ -0,0 +1,343 @@
+package scoreservice
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hfClientset "github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// KubernetesStore keeps every leaderboard in a Leaderboard resource, every score in an ArcadeScore resource labeled
+// with its leaderboard and every scanned code in an ArcadeScan resource, so all replicas share the scores and they
+// survive restarts. Scores are separate resources, so boards can grow without hitting the size limit of a single object.
+type KubernetesStore struct {
+	hfClientSet hfClientset.Interface
+	namespace   string
+}
+
+func NewKubernetesStore(hfClientSet hfClientset.Interface, namespace string) *KubernetesStore {
+	return &KubernetesStore{
+		hfClientSet: hfClientSet,
+		namespace:   namespace,
+	}
+}
+
+func leaderboardName(key BoardKey) string {
+	return util.GenerateResourceName("lb", strings.Join([]string{key.ScheduledEvent, key.Board, key.Language}, "/"), 16)
+}
+
+func scoreName(id string) string {
+	return util.GenerateResourceName("score", id, 16)
+}
+
+// getOrCreateLeaderboard returns the leaderboard, creating it if it does not exist yet.
+func (k *KubernetesStore) getOrCreateLeaderboard(ctx context.Context, key BoardKey) (*hfv1.Leaderboard, error) {
+	lb, err := k.hfClientSet.HobbyfarmV1().Leaderboards(k.namespace).Get(ctx, leaderboardName(key), metav1.GetOptions{})
+	if !errors.IsNotFound(err) {
+		return lb, err
+	}
+
+	lb = &hfv1.Leaderboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   leaderboardName(key),
+			Labels: map[string]string{},
+		},
+		Spec: hfv1.LeaderboardSpec{
+			Language:       key.Language,
+			ScheduledEvent: key.ScheduledEvent,
+			Board:          key.Board,
+		},
+	}
+	if key.ScheduledEvent != "" {
+		lb.Labels[hflabels.ScheduledEventLabel] = key.ScheduledEvent
+	}
+	created, err := k.hfClientSet.HobbyfarmV1().Leaderboards(k.namespace).Create(ctx, lb, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		// another replica added the first score concurrently
+		return k.hfClientSet.HobbyfarmV1().Leaderboards(k.namespace).Get(ctx, leaderboardName(key), metav1.GetOptions{})
+	}
+	return created, err
+}
+
+// listScores returns the ArcadeScores of the leaderboard.
+func (k *KubernetesStore) listScores(ctx context.Context, key BoardKey) ([]hfv1.ArcadeScore, error) {
+	scoreList, err := k.hfClientSet.HobbyfarmV1().ArcadeScores(k.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", hflabels.LeaderboardLabel, leaderboardName(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return scoreList.Items, nil
+}
+
+func (k *KubernetesStore) AddScore(ctx context.Context, key BoardKey, score Score) ([]Score, error) {
+	lb, err := k.getOrCreateLeaderboard(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := k.listScores(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	arcadeScore := &hfv1.ArcadeScore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: scoreName(score.Id),
+			Labels: map[string]string{
+				hflabels.LeaderboardLabel: lb.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "hobbyfarm.io/v1",
+					Kind:       "Leaderboard",
+					Name:       lb.Name,
+					UID:        lb.UID,
+				},
+			},
+		},
+		Spec: hfv1.ArcadeScoreSpec{
+			Leaderboard: lb.Name,
+			Id:          score.Id,
+			Name:        score.Name,
+			Score:       score.Score,
+			Code:        score.Code,
+			Timestamp:   score.Timestamp.Format(time.RFC3339),
+		},
+	}
+	_, err = k.hfClientSet.HobbyfarmV1().ArcadeScores(k.namespace).Create(ctx, arcadeScore, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return convertScores(previous), nil
+}
+
+func (k *KubernetesStore) GetScores(ctx context.Context, key BoardKey) ([]Score, error) {
+	scores, err := k.listScores(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return convertScores(scores), nil
+}
+
+func (k *KubernetesStore) ListBoards(ctx context.Context) ([]BoardKey, error) {
+	lbList, err := k.hfClientSet.HobbyfarmV1().Leaderboards(k.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []BoardKey{}
+	for _, lb := range lbList.Items {
+		keys = append(keys, BoardKey{
+			Language:       lb.Spec.Language,
+			ScheduledEvent: lb.Spec.ScheduledEvent,
+			Board:          lb.Spec.Board,
+		})
+	}
+	return keys, nil
+}
+
+// ResetBoard deletes the scores of the board before the board itself. The scores are owned by the board, but the
+// garbage collector removes them asynchronously and they would still show up on the board for a while.
+func (k *KubernetesStore) ResetBoard(ctx context.Context, key BoardKey) error {
+	scores, err := k.listScores(ctx, key)
+	if err != nil {
+		return err
+	}
+	for _, score := range scores {
+		err := k.hfClientSet.HobbyfarmV1().ArcadeScores(k.namespace).Delete(ctx, score.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	err = k.hfClientSet.HobbyfarmV1().Leaderboards(k.namespace).Delete(ctx, leaderboardName(key), metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func (k *KubernetesStore) RemoveScore(ctx context.Context, key BoardKey, id string) error {
+	score, err := k.hfClientSet.HobbyfarmV1().ArcadeScores(k.namespace).Get(ctx, scoreName(id), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return ErrScoreNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if score.Labels[hflabels.LeaderboardLabel] != leaderboardName(key) {
+		// the score belongs to another board
+		return ErrScoreNotFound
+	}
+
+	err = k.hfClientSet.HobbyfarmV1().ArcadeScores(k.namespace).Delete(ctx, score.Name, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return ErrScoreNotFound
+	}
+	return err
+}
+
+func (k *KubernetesStore) GetCooldown(ctx context.Context, code string) (time.Time, error) {
+	scan, err := k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Get(ctx, util.GenerateResourceName("scan", code, 16), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	cooldown, err := time.Parse(time.RFC3339, scan.Spec.Cooldown)
+	if err != nil || cooldown.Before(time.Now()) {
+		return time.Time{}, nil
+	}
+	return cooldown, nil
+}
+
+func (k *KubernetesStore) Scan(ctx context.Context, code string, cooldown time.Time) (bool, error) {
+	name := util.GenerateResourceName("scan", code, 16)
+
+	first := false
+	isRetriable := func(err error) bool {
+		return errors.IsConflict(err) || errors.IsAlreadyExists(err)
+	}
+	err := retry.OnError(retry.DefaultRetry, isRetriable, func() error {
+		scan, err := k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			scan = &hfv1.ArcadeScan{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: name,
+				},
+				Spec: hfv1.ArcadeScanSpec{
+					Code:     code,
+					Cooldown: cooldown.Format(time.RFC3339),
+				},
+			}
+			_, err = k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Create(ctx, scan, metav1.CreateOptions{})
+			first = err == nil
+			return err
+		}
+		if err != nil {
+			return err
+		}
+
+		scan.Spec.Cooldown = cooldown.Format(time.RFC3339)
+		_, err = k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Update(ctx, scan, metav1.UpdateOptions{})
+		return err
+	})
+	return first, err
+}
+
+func (k *KubernetesStore) AddNotification(ctx context.Context, notification Notification) error {
+	return k.updateNotification(ctx, notification)
+}
+
+func (k *KubernetesStore) PendingNotifications(ctx context.Context) ([]Notification, error) {
+	scanList, err := k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=true", hflabels.NotificationPending),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pending := []Notification{}
+	for _, scan := range scanList.Items {
+		if scan.Spec.Notification == nil || scan.Spec.Notification.Done {
+			continue
+		}
+		pending = append(pending, convertNotification(scan.Spec.Code, scan.Spec.Notification))
+	}
+	return pending, nil
+}
+
+func (k *KubernetesStore) UpdateNotification(ctx context.Context, notification Notification) error {
+	return k.updateNotification(ctx, notification)
+}
+
+// updateNotification stores the notification in the ArcadeScan of its code and labels the scan as long as the
+// notification is pending.
+func (k *KubernetesStore) updateNotification(ctx context.Context, notification Notification) error {
+	name := util.GenerateResourceName("scan", notification.Code, 16)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		scan, err := k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		deliveries := make(map[string]hfv1.ArcadeScanDelivery, len(notification.Deliveries))
+		for channel, delivery := range notification.Deliveries {
+			nextAttempt := ""
+			if !delivery.NextAttempt.IsZero() {
+				nextAttempt = delivery.NextAttempt.Format(time.RFC3339)
+			}
+			deliveries[channel] = hfv1.ArcadeScanDelivery{
+				Attempts:    delivery.Attempts,
+				Delivered:   delivery.Delivered,
+				Failed:      delivery.Failed,
+				NextAttempt: nextAttempt,
+				LastError:   delivery.LastError,
+			}
+		}
+		scan.Spec.Notification = &hfv1.ArcadeScanNotification{
+			Timestamp:  notification.Timestamp.Format(time.RFC3339),
+			Done:       notification.Done,
+			Deliveries: deliveries,
+		}
+
+		if scan.Labels == nil {
+			scan.Labels = map[string]string{}
+		}
+		scan.Labels[hflabels.NotificationPending] = strconv.FormatBool(!notification.Done)
+
+		_, err = k.hfClientSet.HobbyfarmV1().ArcadeScans(k.namespace).Update(ctx, scan, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func convertNotification(code string, scanNotification *hfv1.ArcadeScanNotification) Notification {
+	timestamp, _ := time.Parse(time.RFC3339, scanNotification.Timestamp)
+	notification := Notification{
+		Code:       code,
+		Timestamp:  timestamp,
+		Done:       scanNotification.Done,
+		Deliveries: make(map[string]Delivery, len(scanNotification.Deliveries)),
+	}
+	for channel, scanDelivery := range scanNotification.Deliveries {
+		// deliveries without a valid next attempt are retried right away
+		nextAttempt, _ := time.Parse(time.RFC3339, scanDelivery.NextAttempt)
+		notification.Deliveries[channel] = Delivery{
+			Attempts:    scanDelivery.Attempts,
+			Delivered:   scanDelivery.Delivered,
+			Failed:      scanDelivery.Failed,
+			NextAttempt: nextAttempt,
+			LastError:   scanDelivery.LastError,
+		}
+	}
+	return notification
+}
+
+func convertScores(arcadeScores []hfv1.ArcadeScore) []Score {
+	scores := make([]Score, 0, len(arcadeScores))
+	for _, arcadeScore := range arcadeScores {
+		// scores without a valid timestamp only show up in unlimited windows
+		timestamp, _ := time.Parse(time.RFC3339, arcadeScore.Spec.Timestamp)
+		scores = append(scores, Score{
+			Id:        arcadeScore.Spec.Id,
+			Name:      arcadeScore.Spec.Name,
+			Score:     arcadeScore.Spec.Score,
+			Code:      arcadeScore.Spec.Code,
+			Timestamp: timestamp,
+		})
+	}
+	return scores
+}
@@ -0,0 +1,162 @@
+package scoreservice
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	cache "github.com/patrickmn/go-cache"
+)
+
+const (
+	boardCachePrefix        = "board_"
+	notificationCachePrefix = "notification_"
+)
+
+type memoryBoard struct {
+	Key    BoardKey
+	Scores []Score
+}
+
+// MemoryStore keeps the leaderboards in memory. Scores are lost on restart and are not shared between replicas,
+// which is fine for a standalone arcade.
+type MemoryStore struct {
+	cache *cache.Cache
+	mutex sync.Mutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		cache: cache.New(cache.NoExpiration, cache.NoExpiration),
+	}
+}
+
+func boardCacheId(key BoardKey) string {
+	return boardCachePrefix + strings.Join([]string{key.ScheduledEvent, key.Board, key.Language}, "/")
+}
+
+func (m *MemoryStore) AddScore(ctx context.Context, key BoardKey, score Score) ([]Score, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	board := memoryBoard{Key: key}
+	if temp, found := m.cache.Get(boardCacheId(key)); found {
+		board = temp.(memoryBoard)
+	}
+	previous := board.Scores
+
+	// copy the scores, the previous scores are still handed out
+	board.Scores = append(append([]Score{}, previous...), score)
+	m.cache.Set(boardCacheId(key), board, cache.NoExpiration)
+
+	return previous, nil
+}
+
+func (m *MemoryStore) GetScores(ctx context.Context, key BoardKey) ([]Score, error) {
+	temp, found := m.cache.Get(boardCacheId(key))
+	if !found {
+		return []Score{}, nil
+	}
+	return append([]Score{}, temp.(memoryBoard).Scores...), nil
+}
+
+func (m *MemoryStore) ListBoards(ctx context.Context) ([]BoardKey, error) {
+	keys := []BoardKey{}
+	for id, item := range m.cache.Items() {
+		if strings.HasPrefix(id, boardCachePrefix) {
+			keys = append(keys, item.Object.(memoryBoard).Key)
+		}
+	}
+	return keys, nil
+}
+
+func (m *MemoryStore) ResetBoard(ctx context.Context, key BoardKey) error {
+	m.cache.Delete(boardCacheId(key))
+	return nil
+}
+
+func (m *MemoryStore) RemoveScore(ctx context.Context, key BoardKey, id string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	temp, found := m.cache.Get(boardCacheId(key))
+	if !found {
+		return ErrScoreNotFound
+	}
+	board := temp.(memoryBoard)
+
+	scores, removed := removeScore(board.Scores, id)
+	if !removed {
+		return ErrScoreNotFound
+	}
+	board.Scores = scores
+	m.cache.Set(boardCacheId(key), board, cache.NoExpiration)
+	return nil
+}
+
+func (m *MemoryStore) GetCooldown(ctx context.Context, code string) (time.Time, error) {
+	_, exp, found := m.cache.GetWithExpiration("scan_" + code + "_cooldown")
+	if !found {
+		return time.Time{}, nil
+	}
+	return exp, nil
+}
+
+func (m *MemoryStore) Scan(ctx context.Context, code string, cooldown time.Time) (bool, error) {
+	m.cache.Set("scan_"+code+"_cooldown", true, time.Until(cooldown))
+
+	// Add only succeeds if the code was never scanned
+	err := m.cache.Add("scan_"+code, true, cache.NoExpiration)
+	return err == nil, nil
+}
+
+func (m *MemoryStore) AddNotification(ctx context.Context, notification Notification) error {
+	m.cache.Set(notificationCachePrefix+notification.Code, copyNotification(notification), cache.NoExpiration)
+	return nil
+}
+
+func (m *MemoryStore) PendingNotifications(ctx context.Context) ([]Notification, error) {
+	pending := []Notification{}
+	for id, item := range m.cache.Items() {
+		if !strings.HasPrefix(id, notificationCachePrefix) {
+			continue
+		}
+		notification := item.Object.(Notification)
+		if !notification.Done {
+			pending = append(pending, copyNotification(notification))
+		}
+	}
+	return pending, nil
+}
+
+func (m *MemoryStore) UpdateNotification(ctx context.Context, notification Notification) error {
+	if notification.Done {
+		// delivered notifications do not have to be kept in memory
+		m.cache.Delete(notificationCachePrefix + notification.Code)
+		return nil
+	}
+	m.cache.Set(notificationCachePrefix+notification.Code, copyNotification(notification), cache.NoExpiration)
+	return nil
+}
+
+// copyNotification copies the deliveries of the notification, so the cached notification is not modified by callers.
+func copyNotification(notification Notification) Notification {
+	deliveries := make(map[string]Delivery, len(notification.Deliveries))
+	for channel, delivery := range notification.Deliveries {
+		deliveries[channel] = delivery
+	}
+	notification.Deliveries = deliveries
+	return notification
+}
+
+// removeScore returns a copy of the scores without the score with the given id and whether it was found.
+func removeScore(scores []Score, id string) ([]Score, bool) {
+	remaining := make([]Score, 0, len(scores))
+	for _, score := range scores {
+		if score.Id != id {
+			remaining = append(remaining, score)
+		}
+	}
+	return remaining, len(remaining) < len(scores)
+}
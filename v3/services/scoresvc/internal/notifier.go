@@ -0,0 +1,343 @@
+package scoreservice
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	notifierTimeout = 10 * time.Second
+	signatureHeader = "X-Hobbyfarm-Signature"
+)
+
+// ScanMessage is the data of a notification about a scanned badge. It is passed to the message template.
+type ScanMessage struct {
+	Code        string    `json:"code"`         // the code as scanned, base64 encoded
+	DecodedCode string    `json:"decoded_code"` // the content of the badge
+	QRCodeUrl   string    `json:"qrcode_url,omitempty"`
+	Timestamp   time.Time `json:"timestamp"`
+	Text        string    `json:"text"` // the rendered message template
+}
+
+// Notifier delivers messages about scanned badges to a single channel.
+type Notifier interface {
+	// Name identifies the channel, deliveries are tracked per name.
+	Name() string
+	Notify(ctx context.Context, message ScanMessage) error
+}
+
+// buildNotifiers returns a notifier for every channel enabled in the config.
+func buildNotifiers(config NotificationConfig) []Notifier {
+	client := &http.Client{Timeout: notifierTimeout}
+
+	var notifiers []Notifier
+	if config.TeamsWebhookUrl != "" {
+		notifiers = append(notifiers, &TeamsNotifier{client: client, url: config.TeamsWebhookUrl})
+	}
+	if config.SlackWebhookUrl != "" {
+		notifiers = append(notifiers, &SlackNotifier{client: client, url: config.SlackWebhookUrl})
+	}
+	if config.WebhookUrl != "" {
+		notifiers = append(notifiers, &WebhookNotifier{client: client, url: config.WebhookUrl, secret: config.WebhookSecret})
+	}
+	if config.SmtpServer != "" && config.EmailFrom != "" && len(config.EmailTo) > 0 {
+		notifiers = append(notifiers, &EmailNotifier{
+			server:   config.SmtpServer,
+			username: config.SmtpUsername,
+			password: config.SmtpPassword,
+			from:     config.EmailFrom,
+			to:       config.EmailTo,
+		})
+	}
+	return notifiers
+}
+
+// buildScanMessage decodes the code and renders the message template.
+func buildScanMessage(config NotificationConfig, notification Notification) (ScanMessage, error) {
+	decodedCode, err := base64.StdEncoding.DecodeString(notification.Code)
+	if err != nil {
+		return ScanMessage{}, fmt.Errorf("error decoding code: %v", err)
+	}
+
+	message := ScanMessage{
+		Code:        notification.Code,
+		DecodedCode: string(decodedCode),
+		Timestamp:   notification.Timestamp,
+	}
+	if config.BaseUrl != "" {
+		message.QRCodeUrl = strings.TrimSuffix(config.BaseUrl, "/") + "/score/qrcode/" + notification.Code
+	}
+
+	tmpl, err := template.New("notification").Parse(config.Template)
+	if err != nil {
+		return ScanMessage{}, fmt.Errorf("error parsing notification template: %v", err)
+	}
+	text := &strings.Builder{}
+	if err := tmpl.Execute(text, message); err != nil {
+		return ScanMessage{}, fmt.Errorf("error rendering notification template: %v", err)
+	}
+	message.Text = text.String()
+
+	return message, nil
+}
+
+// postJSON posts the body to the url and fails on any non 2xx response, e.g. when the endpoint throttles.
+func postJSON(ctx context.Context, client *http.Client, url string, body []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, string(respBody))
+	}
+	return nil
+}
+
+// TeamsNotifier posts an adaptive card to a Microsoft Teams webhook.
+type TeamsNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func (t *TeamsNotifier) Name() string {
+	return "teams"
+}
+
+func (t *TeamsNotifier) Notify(ctx context.Context, message ScanMessage) error {
+	body := []map[string]string{
+		{
+			"type": "TextBlock",
+			"text": message.Text,
+		},
+	}
+	if message.QRCodeUrl != "" {
+		body = append(body, map[string]string{
+			"type": "Image",
+			"url":  message.QRCodeUrl,
+		})
+	}
+
+	card := map[string]interface{}{
+		"type": "message",
+		"attachments": []map[string]interface{}{
+			{
+				"contentType": "application/vnd.microsoft.card.adaptive",
+				"content": map[string]interface{}{
+					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
+					"type":    "AdaptiveCard",
+					"version": "1.2",
+					"body":    body,
+				},
+			},
+		},
+	}
+
+	jsonData, err := json.Marshal(card)
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, t.client, t.url, jsonData, nil)
+}
+
+// SlackNotifier posts to a Slack compatible incoming webhook.
+type SlackNotifier struct {
+	client *http.Client
+	url    string
+}
+
+func (s *SlackNotifier) Name() string {
+	return "slack"
+}
+
+func (s *SlackNotifier) Notify(ctx context.Context, message ScanMessage) error {
+	blocks := []map[string]interface{}{
+		{
+			"type": "section",
+			"text": map[string]string{
+				"type": "mrkdwn",
+				"text": message.Text,
+			},
+		},
+	}
+	if message.QRCodeUrl != "" {
+		blocks = append(blocks, map[string]interface{}{
+			"type":      "image",
+			"image_url": message.QRCodeUrl,
+			"alt_text":  "qr code of the badge",
+		})
+	}
+
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"text":   message.Text, // fallback for clients without block support
+		"blocks": blocks,
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, s.client, s.url, jsonData, nil)
+}
+
+// WebhookNotifier posts the ScanMessage as JSON. If a secret is configured the body is signed with HMAC-SHA256,
+// the signature is sent hex encoded as "sha256=<signature>" in the X-Hobbyfarm-Signature header.
+type WebhookNotifier struct {
+	client *http.Client
+	url    string
+	secret string
+}
+
+func (wn *WebhookNotifier) Name() string {
+	return "webhook"
+}
+
+func (wn *WebhookNotifier) Notify(ctx context.Context, message ScanMessage) error {
+	jsonData, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{}
+	if wn.secret != "" {
+		headers[signatureHeader] = "sha256=" + sign(wn.secret, jsonData)
+	}
+	return postJSON(ctx, wn.client, wn.url, jsonData, headers)
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the body.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// EmailNotifier sends the message as plain text email via SMTP.
+type EmailNotifier struct {
+	server   string
+	username string
+	password string
+	from     string
+	to       []string
+}
+
+func (e *EmailNotifier) Name() string {
+	return "email"
+}
+
+func (e *EmailNotifier) Notify(ctx context.Context, message ScanMessage) error {
+	host, _, err := net.SplitHostPort(e.server)
+	if err != nil {
+		return fmt.Errorf("invalid smtp server %s: %v", e.server, err)
+	}
+	var auth smtp.Auth
+	if e.username != "" {
+		auth = smtp.PlainAuth("", e.username, e.password, host)
+	}
+
+	body := &strings.Builder{}
+	fmt.Fprintf(body, "From: %s\r\n", e.from)
+	fmt.Fprintf(body, "To: %s\r\n", strings.Join(e.to, ", "))
+	fmt.Fprintf(body, "Subject: Badge scanned: %s\r\n", firstLine(message.DecodedCode))
+	fmt.Fprintf(body, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	body.WriteString(message.Text)
+	if message.QRCodeUrl != "" {
+		fmt.Fprintf(body, "\r\n\r\n%s", message.QRCodeUrl)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, notifierTimeout)
+	defer cancel()
+	return sendMail(ctx, e.server, host, auth, e.from, e.to, []byte(body.String()))
+}
+
+// sendMail works like smtp.SendMail, but the whole conversation with the server is bound to ctx. A server which
+// accepts the connection but does not answer can not block the outbox.
+func sendMail(ctx context.Context, addr string, host string, auth smtp.Auth, from string, to []string, msg []byte) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	// a context which is cancelled before its deadline aborts the conversation as well
+	stop := context.AfterFunc(ctx, func() {
+		conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if auth != nil {
+		if ok, _ := client.Extension("AUTH"); !ok {
+			return fmt.Errorf("smtp server %s does not support authentication", addr)
+		}
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(from); err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		if err := client.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// firstLine returns the first line of the text, header values must not contain line breaks. A bare carriage return
+// ends the line as well, it would allow to inject headers.
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	line, _, _ = strings.Cut(line, "\r")
+	return strings.TrimSpace(line)
+}
@@ -0,0 +1,175 @@
+package scoreservice
+
+import (
+	"context"
+	"text/template"
+	"time"
+
+	"github.com/golang/glog"
+)
+
+const (
+	outboxInterval    = 15 * time.Second
+	minRetryBackoff   = 10 * time.Second
+	maxRetryBackoff   = time.Hour
+	deliveryErrLength = 512
+)
+
+// Outbox delivers the notifications about first scans of badge codes. Notifications are persisted in the store
+// before they are delivered, so they are retried with exponential backoff while a channel is down and are not lost
+// on restart. Deliveries are at least once, replicas sharing a store may deliver a notification twice.
+type Outbox struct {
+	store   Store
+	config  ConfigSource
+	trigger chan struct{}
+}
+
+func NewOutbox(store Store, config ConfigSource) *Outbox {
+	return &Outbox{
+		store:   store,
+		config:  config,
+		trigger: make(chan struct{}, 1),
+	}
+}
+
+// Enqueue puts a notification about the first scan of the code into the outbox and triggers its delivery.
+func (o *Outbox) Enqueue(ctx context.Context, code string) error {
+	err := o.store.AddNotification(ctx, Notification{
+		Code:       code,
+		Timestamp:  time.Now(),
+		Deliveries: map[string]Delivery{},
+	})
+	if err != nil {
+		return err
+	}
+
+	select {
+	case o.trigger <- struct{}{}:
+	default:
+		// a delivery is already triggered
+	}
+	return nil
+}
+
+// Run delivers pending notifications until the context is done.
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(outboxInterval)
+	defer ticker.Stop()
+
+	for {
+		o.dispatch(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-o.trigger:
+		}
+	}
+}
+
+// dispatch delivers all pending notifications to the channels which are due.
+func (o *Outbox) dispatch(ctx context.Context) {
+	notifications, err := o.store.PendingNotifications(ctx)
+	if err != nil {
+		glog.Errorf("error listing pending notifications: %v", err)
+		return
+	}
+	if len(notifications) == 0 {
+		return
+	}
+
+	// notifications stay pending until they can be delivered, nothing is dropped because of the config
+	config, err := o.config.NotificationConfig(ctx)
+	if err != nil {
+		glog.Errorf("error reading notification config, keeping %d notifications pending: %v", len(notifications), err)
+		return
+	}
+	if _, err := template.New("notification").Parse(config.Template); err != nil {
+		glog.Errorf("invalid notification template, keeping %d notifications pending: %v", len(notifications), err)
+		return
+	}
+	notifiers := buildNotifiers(config)
+	if len(notifiers) == 0 {
+		glog.V(4).Infof("no notification channel configured, keeping %d notifications pending", len(notifications))
+		return
+	}
+
+	now := time.Now()
+	for _, notification := range notifications {
+		notification = o.deliver(ctx, config, notifiers, notification, now)
+		err := o.store.UpdateNotification(ctx, notification)
+		if err != nil {
+			glog.Errorf("error updating notification for code %s: %v", notification.Code, err)
+		}
+	}
+}
+
+// deliver sends the notification to every channel which has neither delivered it nor given up and is due.
+// The notification is done once no channel is left.
+func (o *Outbox) deliver(ctx context.Context, config NotificationConfig, notifiers []Notifier, notification Notification, now time.Time) Notification {
+	if notification.Deliveries == nil {
+		notification.Deliveries = map[string]Delivery{}
+	}
+
+	message, err := buildScanMessage(config, notification)
+	if err != nil {
+		// the message can not be built for any channel, e.g. the code can not be decoded. Retrying does not help
+		glog.Errorf("error building notification for code %s: %v", notification.Code, err)
+		notification.Done = true
+		return notification
+	}
+
+	done := true
+	for _, notifier := range notifiers {
+		delivery := notification.Deliveries[notifier.Name()]
+		if delivery.Delivered || delivery.Failed {
+			continue
+		}
+		if delivery.NextAttempt.After(now) {
+			done = false
+			continue
+		}
+
+		delivery.Attempts++
+		err := notifier.Notify(ctx, message)
+		switch {
+		case err == nil:
+			glog.Infof("delivered notification for code %s via %s", notification.Code, notifier.Name())
+			delivery.Delivered = true
+			delivery.LastError = ""
+		case delivery.Attempts >= config.MaxAttempts:
+			glog.Errorf("giving up notification for code %s via %s after %d attempts: %v", notification.Code, notifier.Name(), delivery.Attempts, err)
+			delivery.Failed = true
+			delivery.LastError = truncate(err.Error(), deliveryErrLength)
+		default:
+			glog.Errorf("error delivering notification for code %s via %s, retrying: %v", notification.Code, notifier.Name(), err)
+			delivery.NextAttempt = now.Add(retryBackoff(delivery.Attempts))
+			delivery.LastError = truncate(err.Error(), deliveryErrLength)
+			done = false
+		}
+		notification.Deliveries[notifier.Name()] = delivery
+	}
+
+	notification.Done = done
+	return notification
+}
+
+// retryBackoff returns the delay before the next attempt, doubling with every attempt.
+func retryBackoff(attempts int) time.Duration {
+	backoff := minRetryBackoff
+	for i := 1; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		return maxRetryBackoff
+	}
+	return backoff
+}
+
+func truncate(text string, length int) string {
+	if len(text) <= length {
+		return text
+	}
+	return text[:length]
+}
@@ -0,0 +1,229 @@
+package scoreservice
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeConfigSource struct {
+	config NotificationConfig
+	err    error
+}
+
+func (f *fakeConfigSource) NotificationConfig(_ context.Context) (NotificationConfig, error) {
+	return f.config, f.err
+}
+
+// fakeNotifier fails the first failures attempts.
+type fakeNotifier struct {
+	failures int
+	attempts int
+}
+
+func (f *fakeNotifier) Name() string {
+	return "fake"
+}
+
+func (f *fakeNotifier) Notify(_ context.Context, _ ScanMessage) error {
+	f.attempts++
+	if f.attempts <= f.failures {
+		return errors.New("channel down")
+	}
+	return nil
+}
+
+func enqueue(t *testing.T, outbox *Outbox, code string) {
+	t.Helper()
+	if err := outbox.Enqueue(context.Background(), base64.StdEncoding.EncodeToString([]byte(code))); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func pending(t *testing.T, store Store) []Notification {
+	t.Helper()
+	notifications, err := store.PendingNotifications(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notifications
+}
+
+func Test_dispatchKeepsNotificationsPending(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *fakeConfigSource
+	}{
+		{"config can not be read", &fakeConfigSource{err: errors.New("settingservice unavailable")}},
+		{"no channel configured", &fakeConfigSource{config: NotificationConfig{Template: DEFAULT_NOTIFICATION_TEMPLATE}}},
+		{"invalid template", &fakeConfigSource{config: NotificationConfig{Template: "{{ .DecodedCode", WebhookUrl: "http://localhost"}}},
+	}
+
+	for _, tt := range tests {
+		store := NewMemoryStore()
+		outbox := NewOutbox(store, tt.config)
+		enqueue(t, outbox, "badge")
+
+		outbox.dispatch(context.Background())
+
+		notifications := pending(t, store)
+		if len(notifications) != 1 {
+			t.Errorf("%s: expected notification to stay pending, got %d pending", tt.name, len(notifications))
+			continue
+		}
+		if len(notifications[0].Deliveries) != 0 {
+			t.Errorf("%s: expected no delivery attempt, got %v", tt.name, notifications[0].Deliveries)
+		}
+	}
+}
+
+func Test_dispatchDelivers(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received++
+	}))
+	defer server.Close()
+
+	store := NewMemoryStore()
+	outbox := NewOutbox(store, &fakeConfigSource{config: NotificationConfig{
+		Template:    DEFAULT_NOTIFICATION_TEMPLATE,
+		MaxAttempts: 3,
+		WebhookUrl:  server.URL,
+	}})
+	enqueue(t, outbox, "badge")
+
+	outbox.dispatch(context.Background())
+
+	if received != 1 {
+		t.Errorf("expected webhook to be called once, got %d", received)
+	}
+	if notifications := pending(t, store); len(notifications) != 0 {
+		t.Errorf("expected notification to be done, got %d pending", len(notifications))
+	}
+}
+
+func Test_deliverRetries(t *testing.T) {
+	outbox := NewOutbox(NewMemoryStore(), &fakeConfigSource{})
+	config := NotificationConfig{Template: DEFAULT_NOTIFICATION_TEMPLATE, MaxAttempts: 3}
+	notifier := &fakeNotifier{failures: 1}
+	notification := Notification{Code: base64.StdEncoding.EncodeToString([]byte("badge"))}
+	now := time.Now()
+
+	notification = outbox.deliver(context.Background(), config, []Notifier{notifier}, notification, now)
+	delivery := notification.Deliveries[notifier.Name()]
+	if notification.Done || delivery.Delivered || delivery.Attempts != 1 || delivery.LastError == "" {
+		t.Fatalf("expected failed attempt to be retried, got %+v", notification)
+	}
+	if !delivery.NextAttempt.Equal(now.Add(minRetryBackoff)) {
+		t.Errorf("expected next attempt after %s, got %s", minRetryBackoff, delivery.NextAttempt.Sub(now))
+	}
+
+	// not yet due
+	notification = outbox.deliver(context.Background(), config, []Notifier{notifier}, notification, now.Add(time.Second))
+	if notification.Done || notifier.attempts != 1 {
+		t.Fatalf("expected no attempt before backoff, got %d attempts", notifier.attempts)
+	}
+
+	notification = outbox.deliver(context.Background(), config, []Notifier{notifier}, notification, now.Add(minRetryBackoff))
+	delivery = notification.Deliveries[notifier.Name()]
+	if !notification.Done || !delivery.Delivered || delivery.Attempts != 2 || delivery.LastError != "" {
+		t.Errorf("expected notification to be delivered on retry, got %+v", notification)
+	}
+}
+
+func Test_deliverGivesUp(t *testing.T) {
+	outbox := NewOutbox(NewMemoryStore(), &fakeConfigSource{})
+	config := NotificationConfig{Template: DEFAULT_NOTIFICATION_TEMPLATE, MaxAttempts: 2}
+	notifier := &fakeNotifier{failures: 5}
+	notification := Notification{Code: base64.StdEncoding.EncodeToString([]byte("badge"))}
+	now := time.Now()
+
+	notification = outbox.deliver(context.Background(), config, []Notifier{notifier}, notification, now)
+	notification = outbox.deliver(context.Background(), config, []Notifier{notifier}, notification, now.Add(maxRetryBackoff))
+
+	delivery := notification.Deliveries[notifier.Name()]
+	if !notification.Done || !delivery.Failed || delivery.Delivered || delivery.Attempts != 2 {
+		t.Errorf("expected channel to give up after 2 attempts, got %+v", notification)
+	}
+}
+
+func Test_deliverDropsUndecodableCode(t *testing.T) {
+	outbox := NewOutbox(NewMemoryStore(), &fakeConfigSource{})
+	config := NotificationConfig{Template: DEFAULT_NOTIFICATION_TEMPLATE, MaxAttempts: 2}
+	notifier := &fakeNotifier{}
+
+	notification := outbox.deliver(context.Background(), config, []Notifier{notifier}, Notification{Code: "not base64!"}, time.Now())
+	if !notification.Done || notifier.attempts != 0 {
+		t.Errorf("expected undecodable notification to be done without attempt, got %+v", notification)
+	}
+}
+
+func Test_retryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, minRetryBackoff},
+		{2, 2 * minRetryBackoff},
+		{4, 8 * minRetryBackoff},
+		{100, maxRetryBackoff},
+	}
+
+	for _, tt := range tests {
+		if got := retryBackoff(tt.attempts); got != tt.want {
+			t.Errorf("attempt %d: expected backoff %s, got %s", tt.attempts, tt.want, got)
+		}
+	}
+}
+
+func Test_firstLine(t *testing.T) {
+	tests := map[string]string{
+		"badge":                        "badge",
+		" badge \nsecond":              "badge",
+		"badge\r\nBcc: evil@example":   "badge",
+		"badge\rBcc: evil@example.com": "badge",
+	}
+
+	for text, want := range tests {
+		if got := firstLine(text); got != want {
+			t.Errorf("first line of %q: expected %q, got %q", text, want, got)
+		}
+	}
+}
+
+func Test_EmailNotifierTimeout(t *testing.T) {
+	// a server which accepts connections but never answers
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	notifier := &EmailNotifier{server: listener.Addr().String(), from: "arcade@example.com", to: []string{"booth@example.com"}}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = notifier.Notify(ctx, ScanMessage{DecodedCode: "badge", Text: "Scanned: badge"})
+	if err == nil {
+		t.Fatal("expected error from unresponsive smtp server")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second || !strings.Contains(err.Error(), "timeout") {
+		t.Errorf("expected delivery to time out with the context, got %v after %s", err, elapsed)
+	}
+}
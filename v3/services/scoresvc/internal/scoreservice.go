@@ -4,10 +4,10 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/json"
-	"io"
 	"net/http"
 	"os"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/golang/glog"
@@ -18,6 +18,8 @@ import (
 
 const (
 	DEFAULT_COOLDOWN_DURATION = time.Hour * 1
+	DEFAULT_LIMIT             = 10
+	MAX_LIMIT                 = 100
 )
 
 type Cooldown struct {
@@ -25,14 +27,20 @@ type Cooldown struct {
 }
 
 type Score struct {
-	Name  string `json:"name"`
-	Score int    `json:"score"`
-	Code  string `json:"code"`
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Score     int       `json:"score"`
+	Code      string    `json:"code"`
+	Timestamp time.Time `json:"timestamp"`
 }
 
 type LanguageLeaderboard struct {
-	Language string  `json:"language"`
-	Scores   []Score `json:"scores"`
+	Language       string  `json:"language"`
+	ScheduledEvent string  `json:"scheduled_event,omitempty"`
+	Board          string  `json:"board"`
+	Window         Window  `json:"window"`
+	Total          int     `json:"total"` // number of scores within the window
+	Scores         []Score `json:"scores"`
 }
 
 type LanguageLeaderboardWithLocalScores struct {
@@ -42,30 +50,66 @@ type LanguageLeaderboardWithLocalScores struct {
 	Placement   int     `json:"placement"`
 }
 
+// boardKey returns the key of the board selected by the language and the scheduledevent and board query parameters.
+func boardKey(r *http.Request) BoardKey {
+	board := r.URL.Query().Get("board")
+	if board == "" {
+		board = DEFAULT_BOARD
+	}
+	return BoardKey{
+		Language:       mux.Vars(r)["language"],
+		ScheduledEvent: r.URL.Query().Get("scheduledevent"),
+		Board:          board,
+	}
+}
+
+// GetFunc returns a page of the leaderboard, ranked by score. The page is selected by the offset and limit query
+// parameters and defaults to the top 10. The window query parameter limits the leaderboard to daily scores.
 func (s *ScoreServer) GetFunc(w http.ResponseWriter, r *http.Request) {
-	language := mux.Vars(r)["language"] // Get language from URL parameter
+	key := boardKey(r)
 
-	// If not found return empty leaderboard
-	leaderboard := LanguageLeaderboard{
-		Language: language,
-		Scores:   []Score{},
+	window := Window(r.URL.Query().Get("window"))
+	if window == "" {
+		window = WindowEvent
+	}
+	if window != WindowEvent && window != WindowDaily {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid window")
+		return
 	}
 
-	leaderboardInterface, found := s.Cache.Get(language)
-	if found {
-		glog.Infof("Leaderboard not found: %s", language)
-		// Type assertion
-		lbType, ok := leaderboardInterface.(LanguageLeaderboard)
-		if ok {
-			leaderboard = s.rangeScores(lbType, 0, 10)
-		}
+	offset, err := queryInt(r, "offset", 0)
+	if err != nil || offset < 0 {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid offset")
+		return
+	}
+	limit, err := queryInt(r, "limit", DEFAULT_LIMIT)
+	if err != nil || limit < 1 || limit > MAX_LIMIT {
+		util.ReturnHTTPMessage(w, r, 400, "badrequest", "invalid limit")
+		return
+	}
+
+	scores, err := s.store.GetScores(r.Context(), key)
+	if err != nil {
+		glog.Errorf("Error retrieving leaderboard %v: %v", key, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+
+	leaderboard := LanguageLeaderboard{
+		Language:       key.Language,
+		ScheduledEvent: key.ScheduledEvent,
+		Board:          key.Board,
+		Window:         window,
+		Scores:         filterScores(scores, window.Since(time.Now())),
 	}
+	leaderboard.Total = len(leaderboard.Scores)
+	leaderboard = s.rangeScores(leaderboard, offset, limit)
 
 	buffer := &bytes.Buffer{}
 	encoder := json.NewEncoder(buffer)
 	encoder.SetEscapeHTML(false) // This disables the escaping
 
-	err := encoder.Encode(leaderboard)
+	err = encoder.Encode(leaderboard)
 
 	if err != nil {
 		glog.Infof("Error marshalling leaderboard: %v", err)
@@ -87,10 +131,14 @@ func (s *ScoreServer) AddScoreFunc(w http.ResponseWriter, r *http.Request) {
 
 	if newScore.Code != "" {
 		// Check if this score.code is on cooldown
-		codeCooldownCacheId := "scan_" + newScore.Code + "_cooldown"
-		_, exp, found := s.Cache.GetWithExpiration(codeCooldownCacheId)
+		exp, err := s.store.GetCooldown(r.Context(), newScore.Code)
+		if err != nil {
+			glog.Errorf("Error retrieving cooldown: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
 
-		if found {
+		if !exp.IsZero() {
 			cooldown := Cooldown{
 				Cooldown: exp,
 			}
@@ -107,28 +155,21 @@ func (s *ScoreServer) AddScoreFunc(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	language := mux.Vars(r)["language"] // Get language from URL parameter
+	key := boardKey(r)
+	newScore.Id = util.RandStringRunes(16)
+	newScore.Timestamp = time.Now()
 
-	s.Mutex.Lock()
-	// Retrieve the existing leaderboard from cache
-	temp, found := s.Cache.Get(language)
-	if !found {
-		// If not found, initialize a new leaderboard
-		temp = LanguageLeaderboard{
-			Language: language,
-			Scores:   []Score{},
-		}
+	previousScores, err := s.store.AddScore(r.Context(), key, newScore)
+	if err != nil {
+		glog.Errorf("Error adding score to leaderboard %v: %v", key, err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
 	}
 
-	leaderboard := temp.(LanguageLeaderboard)
-	leaderboard.Scores = append(leaderboard.Scores, newScore)
-
-	// Update the cache with the new leaderboard
-	s.Cache.Set(language, leaderboard, -1)
-
-	s.Mutex.Unlock()
-
-	leaderboardWithLocalScores := s.findLocalScores(temp.(LanguageLeaderboard), newScore)
+	leaderboardWithLocalScores := s.findLocalScores(LanguageLeaderboard{
+		Language: key.Language,
+		Scores:   previousScores,
+	}, newScore)
 
 	buffer := &bytes.Buffer{}
 	encoder := json.NewEncoder(buffer)
@@ -153,20 +194,27 @@ func (s *ScoreServer) ScanFunc(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	codeCacheId := "scan_" + code
-	scanner_cooldown_id := codeCacheId + "_cooldown"
+	// Retrieve existing scan from the store
+	expiration, err := s.store.GetCooldown(r.Context(), code)
+	if err != nil {
+		glog.Errorf("Error retrieving cooldown: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
 
-	// Retrieve existing scan from cache
-	_, expiration, found := s.Cache.GetWithExpiration(scanner_cooldown_id)
-	if !found {
-		s.Cache.Set(scanner_cooldown_id, true, s.GetTimeout())
-
-		_, found := s.Cache.Get(codeCacheId)
-		if !found {
-			s.Cache.Set(codeCacheId, true, -1)
-			// TODO send code to ms teams
-			// First decode from base64
-			s.SendNotification(code)
+	if expiration.IsZero() {
+		firstScan, err := s.store.Scan(r.Context(), code, time.Now().Add(s.GetTimeout()))
+		if err != nil {
+			glog.Errorf("Error storing scan: %v", err)
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+
+		if firstScan {
+			err = s.outbox.Enqueue(r.Context(), code)
+			if err != nil {
+				glog.Errorf("Error enqueueing notification for code %s: %v", code, err)
+			}
 		}
 
 		cooldown := Cooldown{
@@ -256,91 +304,30 @@ func (s *ScoreServer) GetTimeout() time.Duration {
 	return timeoutDuration
 }
 
-func (s *ScoreServer) SendNotification(code string) {
-	glog.Infof("Trying to send notification for ", code)
-	teamsWebhookUrl, found := os.LookupEnv("WEBHOOK_URL")
-	if !found {
-		glog.Infof("WEBHOOK_URL not found")
-		return
-	}
-
-	baseUrl, found := os.LookupEnv("BASE_URL")
-	if !found {
-		glog.Infof("BASE_URL not found")
-		return
-	}
-
-	imageUrl := baseUrl + "/score/qrcode/" + code
-
-	decodedCode, err := base64.StdEncoding.DecodeString(code)
-	if err != nil {
-		glog.Infof("Error decoding code:", err)
-		return
+// queryInt parses the query parameter as integer, returns the default value if it is not set.
+func queryInt(r *http.Request, param string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(param)
+	if value == "" {
+		return defaultValue, nil
 	}
+	return strconv.Atoi(value)
+}
 
-	// Creating the JSON payload for the Teams message
-	message := map[string]interface{}{
-		"type": "message",
-		"attachments": []map[string]interface{}{
-			{
-				"contentType": "application/vnd.microsoft.card.adaptive",
-				"content": map[string]interface{}{
-					"$schema": "http://adaptivecards.io/schemas/adaptive-card.json",
-					"type":    "AdaptiveCard",
-					"version": "1.2",
-					"body": []map[string]string{
-						{
-							"type": "TextBlock",
-							"text": "Scanned: " + string(decodedCode),
-						}, {
-							"type": "Image",
-							"url":  imageUrl,
-						},
-					},
-				},
-			},
-		},
-	}
-
-	// Marshal the map into a JSON string.
-	jsonData, err := json.Marshal(message)
-	if err != nil {
-		glog.Errorf("Error creating JSON payload:", err)
-		return
-	}
-
-	// Create a new HTTP request with the JSON payload
-	req, err := http.NewRequest("POST", teamsWebhookUrl, bytes.NewBuffer(jsonData))
-	if err != nil {
-		glog.Errorf("Error creating request:", err)
-		return
-	}
-
-	// Set headers
-	req.Header.Set("Content-Type", "application/json")
-
-	// Send the request using the default HTTP client
-	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		glog.Errorf("Error sending request to Teams:", err)
-		return
+// filterScores returns the scores achieved since the given time.
+func filterScores(scores []Score, since time.Time) []Score {
+	if since.IsZero() {
+		return scores
 	}
-	defer resp.Body.Close()
-
-	// Check the HTTP response status, 202 = Accepted
-	if resp.StatusCode != 202 {
-		glog.Errorf("Failed to send message. Status: %d\n", resp.StatusCode)
-		bodyBytes, _ := io.ReadAll(resp.Body)
-		bodyString := string(bodyBytes)
-		glog.Errorf("message: %d\n", bodyString)
-		return
+	filtered := []Score{}
+	for _, score := range scores {
+		if !score.Timestamp.Before(since) {
+			filtered = append(filtered, score)
+		}
 	}
-
-	glog.Infof("Message sent successfully")
+	return filtered
 }
 
-// top10Scores returns a LanguageLeaderboard with only the top 10 scores, ranked by score in descending order.
+// rangeScores returns a LanguageLeaderboard with only the scores from offset to offset + limit, ranked by score in descending order.
 func (s *ScoreServer) rangeScores(leaderboard LanguageLeaderboard, offset int, limit int) LanguageLeaderboard {
 	// Sort the Scores slice based on the Score field, in descending order.
 	sort.Slice(leaderboard.Scores, func(i, j int) bool {
@@ -355,7 +342,7 @@ func (s *ScoreServer) rangeScores(leaderboard LanguageLeaderboard, offset int, l
 			end = len(leaderboard.Scores)
 		}
 
-		leaderboard.Scores = leaderboard.Scores[offset:end] // Select only the top 10 scores
+		leaderboard.Scores = leaderboard.Scores[offset:end] // Select only the requested page
 	}
 
 	return leaderboard
@@ -1,21 +1,28 @@
 package scoreservice
 
 import (
-	"sync"
-
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
-	cache "github.com/patrickmn/go-cache"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 )
 
 type ScoreServer struct {
-	Cache *cache.Cache
-	Mutex sync.Mutex
+	store       Store
+	outbox      *Outbox
+	authnClient authnpb.AuthNClient
+	authrClient authrpb.AuthRClient
 }
 
-func NewScoreServer() (*ScoreServer, error) {
-	s := ScoreServer{}
-	s.Cache = cache.New(cache.NoExpiration, cache.NoExpiration)
+// NewScoreServer creates the score server. The authn and authr clients are nil for standalone arcades, which disables
+// the admin API.
+func NewScoreServer(store Store, outbox *Outbox, authnClient authnpb.AuthNClient, authrClient authrpb.AuthRClient) (*ScoreServer, error) {
+	s := ScoreServer{
+		store:       store,
+		outbox:      outbox,
+		authnClient: authnClient,
+		authrClient: authrClient,
+	}
 	return &s, nil
 }
 
@@ -25,5 +32,8 @@ func (s *ScoreServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/score/scan/{code}", s.ScanFunc).Methods("POST")
 	r.HandleFunc("/score/qrcode/{code}", s.HandleGenerateQR).Methods("GET")
 	r.HandleFunc("/score/healthz", s.Healthz).Methods("GET")
+	r.HandleFunc("/score/admin/leaderboards", s.ListBoardsFunc).Methods("GET")
+	r.HandleFunc("/score/admin/leaderboard/{language}", s.ResetBoardFunc).Methods("DELETE")
+	r.HandleFunc("/score/admin/leaderboard/{language}/{id}", s.RemoveScoreFunc).Methods("DELETE")
 	glog.V(2).Infof("set up routes for Score server")
 }
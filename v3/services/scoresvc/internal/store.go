@@ -0,0 +1,81 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	DEFAULT_BOARD = "default"
+)
+
+var ErrScoreNotFound = errors.New("score not found")
+
+// Window limits a leaderboard to the scores achieved within a period of time.
+type Window string
+
+const (
+	// WindowEvent contains all scores of the board, which is the whole scheduled event for boards of an event.
+	WindowEvent Window = "event"
+	// WindowDaily contains the scores achieved since midnight.
+	WindowDaily Window = "daily"
+)
+
+// Since returns the earliest time of scores within the window, the zero time if the window is not limited.
+func (w Window) Since(now time.Time) time.Time {
+	if w == WindowDaily {
+		year, month, day := now.Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+	}
+	return time.Time{}
+}
+
+// BoardKey identifies a leaderboard. Every language has a default board, scheduled events can have further named
+// boards, e.g. per booth or per track.
+type BoardKey struct {
+	Language       string `json:"language"`
+	ScheduledEvent string `json:"scheduled_event,omitempty"`
+	Board          string `json:"board"`
+}
+
+// Delivery is the state of a notification on a single channel.
+type Delivery struct {
+	Attempts    int       `json:"attempts"`
+	Delivered   bool      `json:"delivered"`
+	Failed      bool      `json:"failed"` // the channel gave up after too many attempts
+	NextAttempt time.Time `json:"next_attempt"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// Notification is an entry of the outbox, it notifies about the first scan of a badge code.
+type Notification struct {
+	Code       string              `json:"code"`
+	Timestamp  time.Time           `json:"timestamp"`
+	Done       bool                `json:"done"`       // every channel delivered the notification or gave up
+	Deliveries map[string]Delivery `json:"deliveries"` // by name of the channel
+}
+
+// Store persists the leaderboards, the cooldowns of scanned badge codes and the notification outbox.
+type Store interface {
+	// AddScore adds the score to the board and returns the scores of the board before the score was added.
+	AddScore(ctx context.Context, key BoardKey, score Score) ([]Score, error)
+	// GetScores returns all scores of the board, unsorted. Boards without scores are empty.
+	GetScores(ctx context.Context, key BoardKey) ([]Score, error)
+	// ListBoards returns the keys of all boards with scores.
+	ListBoards(ctx context.Context) ([]BoardKey, error)
+	// ResetBoard removes all scores of the board.
+	ResetBoard(ctx context.Context, key BoardKey) error
+	// RemoveScore removes a single score from the board, returns ErrScoreNotFound if the board has no such score.
+	RemoveScore(ctx context.Context, key BoardKey, id string) error
+	// GetCooldown returns until when the code is on cooldown, the zero time if it is not.
+	GetCooldown(ctx context.Context, code string) (time.Time, error)
+	// Scan puts the code on cooldown until the given time and returns whether the code was scanned for the first time.
+	Scan(ctx context.Context, code string, cooldown time.Time) (bool, error)
+	// AddNotification puts the notification into the outbox.
+	AddNotification(ctx context.Context, notification Notification) error
+	// PendingNotifications returns all notifications of the outbox which are not done.
+	PendingNotifications(ctx context.Context) ([]Notification, error)
+	// UpdateNotification stores the delivery state of the notification.
+	UpdateNotification(ctx context.Context, notification Notification) error
+}
@@ -0,0 +1,286 @@
+package scoreservice
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/client/clientset/versioned/fake"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testNamespace = "hobbyfarm"
+
+var (
+	defaultBoard = BoardKey{Language: "bash", Board: DEFAULT_BOARD}
+	boothBoard   = BoardKey{Language: "bash", ScheduledEvent: "se-test", Board: "booth"}
+)
+
+// testStores returns every store implementation, so both behave the same.
+func testStores() map[string]Store {
+	return map[string]Store{
+		"memory":     NewMemoryStore(),
+		"kubernetes": NewKubernetesStore(fake.NewSimpleClientset(), testNamespace),
+	}
+}
+
+func testScore(id string, score int) Score {
+	return Score{Id: id, Name: "player " + id, Score: score, Timestamp: time.Now()}
+}
+
+// scoreIds returns the sorted ids of the scores.
+func scoreIds(scores []Score) []string {
+	ids := make([]string, 0, len(scores))
+	for _, score := range scores {
+		ids = append(ids, score.Id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func equalIds(got []Score, want ...string) bool {
+	ids := scoreIds(got)
+	if len(ids) != len(want) {
+		return false
+	}
+	for i := range ids {
+		if ids[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func addScores(t *testing.T, store Store, key BoardKey, scores ...Score) {
+	t.Helper()
+	for _, score := range scores {
+		if _, err := store.AddScore(context.Background(), key, score); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func Test_StoreAddScore(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores() {
+		previous, err := store.AddScore(ctx, defaultBoard, testScore("a", 10))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(previous) != 0 {
+			t.Errorf("%s: expected no previous scores on a new board, got %v", name, scoreIds(previous))
+		}
+
+		previous, err = store.AddScore(ctx, defaultBoard, testScore("b", 20))
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !equalIds(previous, "a") {
+			t.Errorf("%s: expected previous scores [a], got %v", name, scoreIds(previous))
+		}
+		addScores(t, store, boothBoard, testScore("c", 30))
+
+		scores, err := store.GetScores(ctx, defaultBoard)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if !equalIds(scores, "a", "b") {
+			t.Errorf("%s: expected scores [a b], got %v", name, scoreIds(scores))
+		}
+		for _, score := range scores {
+			if score.Id == "b" && (score.Name != "player b" || score.Score != 20 || score.Timestamp.IsZero()) {
+				t.Errorf("%s: expected score to be stored, got %+v", name, score)
+			}
+		}
+
+		scores, err = store.GetScores(ctx, BoardKey{Language: "python", Board: DEFAULT_BOARD})
+		if err != nil || len(scores) != 0 {
+			t.Errorf("%s: expected unknown board to be empty, got %v, %v", name, scoreIds(scores), err)
+		}
+
+		boards, err := store.ListBoards(ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if len(boards) != 2 {
+			t.Errorf("%s: expected 2 boards, got %v", name, boards)
+		}
+		for _, board := range boards {
+			if board != defaultBoard && board != boothBoard {
+				t.Errorf("%s: unexpected board %v", name, board)
+			}
+		}
+	}
+}
+
+func Test_StoreRemoveScore(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores() {
+		addScores(t, store, defaultBoard, testScore("a", 10), testScore("b", 20))
+		addScores(t, store, boothBoard, testScore("c", 30))
+
+		if err := store.RemoveScore(ctx, defaultBoard, "a"); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		scores, _ := store.GetScores(ctx, defaultBoard)
+		if !equalIds(scores, "b") {
+			t.Errorf("%s: expected scores [b] after removal, got %v", name, scoreIds(scores))
+		}
+
+		if err := store.RemoveScore(ctx, defaultBoard, "a"); !errors.Is(err, ErrScoreNotFound) {
+			t.Errorf("%s: expected removed score to be not found, got %v", name, err)
+		}
+		if err := store.RemoveScore(ctx, defaultBoard, "c"); !errors.Is(err, ErrScoreNotFound) {
+			t.Errorf("%s: expected score of another board to be not found, got %v", name, err)
+		}
+		if err := store.RemoveScore(ctx, BoardKey{Language: "python", Board: DEFAULT_BOARD}, "b"); !errors.Is(err, ErrScoreNotFound) {
+			t.Errorf("%s: expected score of unknown board to be not found, got %v", name, err)
+		}
+
+		scores, _ = store.GetScores(ctx, boothBoard)
+		if !equalIds(scores, "c") {
+			t.Errorf("%s: expected other board to be kept, got %v", name, scoreIds(scores))
+		}
+	}
+}
+
+func Test_StoreResetBoard(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores() {
+		addScores(t, store, defaultBoard, testScore("a", 10), testScore("b", 20))
+		addScores(t, store, boothBoard, testScore("c", 30))
+
+		if err := store.ResetBoard(ctx, defaultBoard); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		scores, _ := store.GetScores(ctx, defaultBoard)
+		if len(scores) != 0 {
+			t.Errorf("%s: expected reset board to be empty, got %v", name, scoreIds(scores))
+		}
+		boards, _ := store.ListBoards(ctx)
+		if len(boards) != 1 || boards[0] != boothBoard {
+			t.Errorf("%s: expected only the other board to be listed, got %v", name, boards)
+		}
+
+		if err := store.ResetBoard(ctx, BoardKey{Language: "python", Board: DEFAULT_BOARD}); err != nil {
+			t.Errorf("%s: expected reset of unknown board to succeed, got %v", name, err)
+		}
+
+		// the board can be used again after a reset
+		addScores(t, store, defaultBoard, testScore("d", 40))
+		scores, _ = store.GetScores(ctx, defaultBoard)
+		if !equalIds(scores, "d") {
+			t.Errorf("%s: expected scores [d] after reset, got %v", name, scoreIds(scores))
+		}
+	}
+}
+
+func Test_StoreScan(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores() {
+		cooldown, err := store.GetCooldown(ctx, "badge")
+		if err != nil || !cooldown.IsZero() {
+			t.Errorf("%s: expected unscanned code to not be on cooldown, got %s, %v", name, cooldown, err)
+		}
+
+		first, err := store.Scan(ctx, "badge", time.Now().Add(time.Hour))
+		if err != nil || !first {
+			t.Errorf("%s: expected first scan, got %t, %v", name, first, err)
+		}
+		cooldown, err = store.GetCooldown(ctx, "badge")
+		if err != nil || time.Until(cooldown) < 59*time.Minute {
+			t.Errorf("%s: expected code to be on cooldown for an hour, got %s, %v", name, cooldown, err)
+		}
+
+		first, err = store.Scan(ctx, "badge", time.Now().Add(time.Hour))
+		if err != nil || first {
+			t.Errorf("%s: expected repeated scan to not be the first, got %t, %v", name, first, err)
+		}
+	}
+}
+
+func Test_StoreNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	for name, store := range testStores() {
+		// notifications are enqueued for scanned codes
+		if _, err := store.Scan(ctx, "badge", time.Now().Add(time.Hour)); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		notification := Notification{Code: "badge", Timestamp: time.Now(), Deliveries: map[string]Delivery{}}
+		if err := store.AddNotification(ctx, notification); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+
+		notification.Deliveries["webhook"] = Delivery{Attempts: 1, LastError: "channel down", NextAttempt: time.Now().Add(time.Minute)}
+		if err := store.UpdateNotification(ctx, notification); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		pending, err := store.PendingNotifications(ctx)
+		if err != nil || len(pending) != 1 {
+			t.Fatalf("%s: expected one pending notification, got %v, %v", name, pending, err)
+		}
+		if delivery := pending[0].Deliveries["webhook"]; delivery.Attempts != 1 || delivery.LastError != "channel down" {
+			t.Errorf("%s: expected delivery state to be stored, got %+v", name, delivery)
+		}
+
+		notification.Done = true
+		if err := store.UpdateNotification(ctx, notification); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		pending, err = store.PendingNotifications(ctx)
+		if err != nil || len(pending) != 0 {
+			t.Errorf("%s: expected no pending notification, got %v, %v", name, pending, err)
+		}
+	}
+}
+
+func Test_KubernetesStoreScoreObjects(t *testing.T) {
+	ctx := context.Background()
+	hfClientSet := fake.NewSimpleClientset()
+	store := NewKubernetesStore(hfClientSet, testNamespace)
+
+	addScores(t, store, defaultBoard, testScore("a", 10), testScore("b", 20))
+	addScores(t, store, boothBoard, testScore("c", 30))
+
+	lb, err := hfClientSet.HobbyfarmV1().Leaderboards(testNamespace).Get(ctx, leaderboardName(boothBoard), metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lb.Labels[hflabels.ScheduledEventLabel] != boothBoard.ScheduledEvent {
+		t.Errorf("expected leaderboard to be labeled with its scheduled event, got %v", lb.Labels)
+	}
+
+	scoreList, err := hfClientSet.HobbyfarmV1().ArcadeScores(testNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scoreList.Items) != 3 {
+		t.Fatalf("expected every score to be stored as its own object, got %d", len(scoreList.Items))
+	}
+
+	var score *hfv1.ArcadeScore
+	for i := range scoreList.Items {
+		if scoreList.Items[i].Spec.Id == "c" {
+			score = &scoreList.Items[i]
+		}
+	}
+	if score == nil {
+		t.Fatal("expected score c to be stored")
+	}
+	if score.Name != scoreName("c") || score.Spec.Leaderboard != lb.Name || score.Labels[hflabels.LeaderboardLabel] != lb.Name {
+		t.Errorf("expected score to belong to leaderboard %s, got %+v", lb.Name, score.ObjectMeta)
+	}
+	if len(score.OwnerReferences) != 1 || score.OwnerReferences[0].Name != lb.Name {
+		t.Errorf("expected score to be owned by leaderboard %s, got %v", lb.Name, score.OwnerReferences)
+	}
+}
@@ -1,22 +1,76 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"os"
 	"sync"
 
 	scoreservice "github.com/hobbyfarm/gargantua/services/scoresvc/v3/internal"
+	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 
 	"github.com/golang/glog"
+	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	settingpb "github.com/hobbyfarm/gargantua/v3/protos/setting"
 )
 
 func main() {
-	var wg sync.WaitGroup
+	ctx := context.Background()
+
+	var store scoreservice.Store
+	var config scoreservice.ConfigSource
+	var authnClient authnpb.AuthNClient
+	var authrClient authrpb.AuthRClient
+
+	// STORAGE selects where scores are stored. Scores are kept in memory by default, which suits a standalone arcade
+	// configured by environment variables. Within hobbyfarm "kubernetes" persists the scores as custom resources and
+	// the notifications are configured by settings.
+	switch os.Getenv("STORAGE") {
+	case "kubernetes":
+		serviceConfig := microservices.BuildServiceConfig()
+		cfg, hfClient, _ := microservices.BuildClusterConfig(serviceConfig)
+
+		crd.InstallCrds(scoreservice.ScoreCRDInstaller{}, cfg, "score")
+		store = scoreservice.NewKubernetesStore(hfClient, util.GetReleaseNamespace())
+
+		services := []microservices.MicroService{
+			microservices.AuthN,
+			microservices.AuthR,
+			microservices.Setting,
+		}
+		connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
+		for _, conn := range connections {
+			defer conn.Close()
+		}
+		config = scoreservice.NewSettingsConfigSource(settingpb.NewSettingSvcClient(connections[microservices.Setting]))
+		authnClient = authnpb.NewAuthNClient(connections[microservices.AuthN])
+		authrClient = authrpb.NewAuthRClient(connections[microservices.AuthR])
+	case "", "memory":
+		flag.Parse()
+		store = scoreservice.NewMemoryStore()
+		config = scoreservice.NewEnvConfigSource()
+	default:
+		glog.Fatalf("Unknown storage %s, use memory or kubernetes", os.Getenv("STORAGE"))
+	}
 
+	outbox := scoreservice.NewOutbox(store, config)
+
+	var wg sync.WaitGroup
+	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
 	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		outbox.Run(ctx)
+	}()
+
 	go func() {
 		defer wg.Done()
 
-		scoreServer, err := scoreservice.NewScoreServer()
+		scoreServer, err := scoreservice.NewScoreServer(store, outbox, authnClient, authrClient)
 		if err != nil {
 			glog.Fatalf("Error creating scoreserver: %v", err)
 		}
@@ -3,6 +3,7 @@ package sessionservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	accesscodepb "github.com/hobbyfarm/gargantua/v3/protos/accesscode"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
@@ -23,6 +24,7 @@ type SessionServer struct {
 	scheduledEventClient  scheduledeventpb.ScheduledEventSvcClient
 	vmclaimClient         vmclaimpb.VMClaimSvcClient
 	internalSessionServer *GrpcSessionServer
+	sessionFeed           *changefeed.Feed
 }
 
 func NewSessionServer(
@@ -35,6 +37,7 @@ func NewSessionServer(
 	scheduledEventClient scheduledeventpb.ScheduledEventSvcClient,
 	vmclaimClient vmclaimpb.VMClaimSvcClient,
 	internalSessionServer *GrpcSessionServer,
+	sessionFeed *changefeed.Feed,
 ) SessionServer {
 	return SessionServer{
 		authnClient:           authnClient,
@@ -46,6 +49,7 @@ func NewSessionServer(
 		scheduledEventClient:  scheduledEventClient,
 		vmclaimClient:         vmclaimClient,
 		internalSessionServer: internalSessionServer,
+		sessionFeed:           sessionFeed,
 	}
 }
 
@@ -56,5 +60,6 @@ func (sss SessionServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/session/{session_id}/keepalive", sss.KeepAliveSessionFunc).Methods("PUT")
 	r.HandleFunc("/session/{session_id}/pause", sss.PauseSessionFunc).Methods("PUT")
 	r.HandleFunc("/session/{session_id}/resume", sss.ResumeSessionFunc).Methods("PUT")
+	r.HandleFunc("/a/session/watch", sss.WatchFunc).Methods("GET")
 	glog.V(2).Infof("set up routes for session server")
 }
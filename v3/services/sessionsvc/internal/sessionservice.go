@@ -232,8 +232,9 @@ func (sss SessionServer) NewSessionFunc(w http.ResponseWriter, r *http.Request)
 		VmClaim:      sessionVmClaimSet,
 		AccessCode:   accessCodeId,
 		Labels: map[string]string{
-			hflabels.AccessCodeLabel: accessCodeObj.GetId(),
-			hflabels.UserLabel:       user.GetId(),
+			hflabels.AccessCodeLabel:     accessCodeObj.GetId(),
+			hflabels.ScheduledEventLabel: scheduledEventId,
+			hflabels.UserLabel:           user.GetId(),
 		},
 	})
 	if err != nil {
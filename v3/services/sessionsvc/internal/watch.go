@@ -0,0 +1,60 @@
+package sessionservice
+
+import (
+	"net/http"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// watchedSession is a session as it is sent on the change feed
+type watchedSession struct {
+	Id     string            `json:"id"`
+	Labels map[string]string `json:"labels"`
+	hfv1.SessionSpec
+	hfv1.SessionStatus
+}
+
+// PrepareSessionEvent converts a session of the informer for the change feed.
+func PrepareSessionEvent(obj metav1.Object) interface{} {
+	session, ok := obj.(*hfv1.Session)
+	if !ok {
+		return nil
+	}
+	return watchedSession{
+		Id:            session.Name,
+		Labels:        session.Labels,
+		SessionSpec:   session.Spec,
+		SessionStatus: session.Status,
+	}
+}
+
+/*
+Stream changes of sessions as server-sent events
+
+	Query:
+	- scheduledevent : Only changes of sessions in the scheduled event
+	- snapshot : Unless "false", the stream starts with every session followed by a "synced" event
+*/
+func (sss SessionServer) WatchFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, sss.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	impersonatedUserId := user.GetId()
+	authrResponse, err := rbac.Authorize(r, sss.authrClient, impersonatedUserId, []*authrpb.Permission{
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbList),
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbWatch),
+	}, rbac.OperatorAND)
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to watch sessions")
+		return
+	}
+
+	sss.sessionFeed.Stream(w, r, r.URL.Query().Get("scheduledevent"))
+}
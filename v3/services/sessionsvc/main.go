@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 
@@ -87,6 +89,11 @@ func main() {
 		glog.Fatalf("failed creating scheduled event controller: %s", err.Error())
 	}
 
+	sessionFeed, err := changefeed.NewFeed(rbac.ResourcePluralSession, hfInformerFactory.Hobbyfarm().V1().Sessions().Informer(), sessionservice.PrepareSessionEvent)
+	if err != nil {
+		glog.Fatalf("failed creating session change feed: %s", err.Error())
+	}
+
 	var wg sync.WaitGroup
 	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
 	wg.Add(1)
@@ -109,6 +116,7 @@ func main() {
 			scheduledEventClient,
 			vmClaimClient,
 			ss,
+			sessionFeed,
 		)
 		microservices.StartAPIServer(sessionServer)
 	}()
@@ -254,5 +254,213 @@ func predefinedSettings() []*settingpb.CreateSettingRequest {
 				DisplayName: "User Token Expiration (hours)",
 			},
 		},
+		{
+			Name:      string(settingUtil.TaskVerificationMaxConcurrentCommands),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "3",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_INTEGER,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Task Verification Max Concurrent Commands per VM",
+			},
+		},
+		{
+			Name:      string(settingUtil.TaskVerificationMaxCommandAttempts),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "5",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_INTEGER,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Task Verification Max Command Attempts",
+			},
+		},
+		{
+			Name:      string(settingUtil.TaskVerificationTimeout),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "30",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_INTEGER,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Task Verification Timeout (seconds)",
+			},
+		},
+		{
+			Name:      string(settingUtil.ScheduledEventEnvironmentFailureTimeout),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "30",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_INTEGER,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "ScheduledEvent environment failure timeout (min)",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeBaseUrl),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Base URL",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeNotificationTemplate),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "Scanned: {{ .DecodedCode }}",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Notification Template",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeNotificationMaxAttempts),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "10",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_INTEGER,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Notification Max Attempts",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeTeamsWebhookUrl),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Microsoft Teams Webhook URL",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeSlackWebhookUrl),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Slack Webhook URL",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeWebhookUrl),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Webhook URL",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeWebhookSecret),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Webhook HMAC Secret",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeSmtpServer),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade SMTP Server (host:port)",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeSmtpUsername),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade SMTP Username",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeSmtpPassword),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade SMTP Password",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeEmailFrom),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Email Sender",
+			},
+		},
+		{
+			Name:      string(settingUtil.ArcadeEmailTo),
+			Namespace: util.GetReleaseNamespace(),
+			Labels: map[string]string{
+				labels.SettingScope: "gargantua",
+			},
+			Value: "",
+			Property: &settingpb.Property{
+				DataType:    settingpb.DataType_DATA_TYPE_STRING,
+				ValueType:   settingpb.ValueType_VALUE_TYPE_SCALAR,
+				DisplayName: "Arcade Email Recipients (comma separated)",
+			},
+		},
 	}
 }
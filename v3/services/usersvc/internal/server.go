@@ -3,6 +3,7 @@ package userservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/revocation"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	rbacpb "github.com/hobbyfarm/gargantua/v3/protos/rbac"
@@ -13,14 +14,16 @@ type UserServer struct {
 	authrClient        authrpb.AuthRClient
 	rbacClient         rbacpb.RbacSvcClient
 	internalUserServer *GrpcUserServer
+	revocations        *revocation.TokenRevocations
 }
 
-func NewUserServer(authnClient authnpb.AuthNClient, authrClient authrpb.AuthRClient, rbacClient rbacpb.RbacSvcClient, internalUserServer *GrpcUserServer) UserServer {
+func NewUserServer(authnClient authnpb.AuthNClient, authrClient authrpb.AuthRClient, rbacClient rbacpb.RbacSvcClient, internalUserServer *GrpcUserServer, revocations *revocation.TokenRevocations) UserServer {
 	return UserServer{
 		authnClient:        authnClient,
 		authrClient:        authrClient,
 		rbacClient:         rbacClient,
 		internalUserServer: internalUserServer,
+		revocations:        revocations,
 	}
 }
 
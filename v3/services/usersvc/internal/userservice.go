@@ -180,6 +180,16 @@ func (u UserServer) UpdateFunc(w http.ResponseWriter, r *http.Request) {
 		}
 		glog.Errorf("error while updating user %s: %s", details.Id, s.Message())
 		util.ReturnHTTPMessage(w, r, 500, "error", "error attempting to update")
+		return
+	}
+
+	// tokens issued with the old password must not outlive it
+	if password != "" {
+		if err := u.revocations.Revoke(r.Context(), id); err != nil {
+			glog.Errorf("error revoking tokens of user %s: %v", id, err)
+			util.ReturnHTTPMessage(w, r, 500, "error", "password updated, but revoking the tokens of the user failed")
+			return
+		}
 	}
 
 	util.ReturnHTTPMessage(w, r, 200, "updated", "")
@@ -6,8 +6,10 @@ import (
 
 	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/revocation"
 	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	"k8s.io/client-go/informers"
 
 	"github.com/golang/glog"
 	userservice "github.com/hobbyfarm/gargantua/services/usersvc/v3/internal"
@@ -29,10 +31,14 @@ func init() {
 }
 
 func main() {
-	cfg, hfClient, _ := microservices.BuildClusterConfig(serviceConfig)
+	cfg, hfClient, kubeClient := microservices.BuildClusterConfig(serviceConfig)
 
 	namespace := util.GetReleaseNamespace()
 	hfInformerFactory := hfInformers.NewSharedInformerFactoryWithOptions(hfClient, time.Second*30, hfInformers.WithNamespace(namespace))
+	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, time.Second*30, informers.WithNamespace(namespace))
+
+	// tokens of a user are revoked when an admin changes the password of the user
+	revocations := revocation.NewTokenRevocations(kubeClient, kubeInformerFactory, namespace)
 
 	crd.InstallCrdsWithServiceReference(userservice.UserCRDInstaller{}, cfg, "user", serviceConfig.WebhookTLSCA)
 
@@ -73,12 +79,13 @@ func main() {
 	go func() {
 		defer wg.Done()
 
-		userServer := userservice.NewUserServer(authnClient, authrClient, rbacClient, us)
+		userServer := userservice.NewUserServer(authnClient, authrClient, rbacClient, us, revocations)
 		microservices.StartAPIServer(userServer)
 	}()
 
 	stopInformerFactoryCh := signals.SetupSignalHandler()
 	hfInformerFactory.Start(stopInformerFactoryCh)
+	kubeInformerFactory.Start(stopInformerFactoryCh)
 
 	wg.Wait()
 }
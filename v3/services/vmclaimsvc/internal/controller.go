@@ -20,6 +20,7 @@ import (
 	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
 	vmclaimpb "github.com/hobbyfarm/gargantua/v3/protos/vmclaim"
+	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -45,6 +46,7 @@ type VMClaimController struct {
 	environmentClient     environmentpb.EnvironmentSvcClient
 	dbConfigClient        dbconfigpb.DynamicBindConfigSvcClient
 	vmClient              vmpb.VMSvcClient
+	vmSetClient           vmsetpb.VMSetSvcClient
 	vmTemplateClient      vmtemplatepb.VMTemplateSvcClient
 	eventClient           scheduledeventpb.ScheduledEventSvcClient
 }
@@ -60,6 +62,7 @@ func NewVMClaimController(
 	progressClient progresspb.ProgressSvcClient,
 	sessionClient sessionpb.SessionSvcClient,
 	vmClient vmpb.VMSvcClient,
+	vmSetClient vmsetpb.VMSetSvcClient,
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient,
 	ctx context.Context,
 ) (*VMClaimController, error) {
@@ -83,6 +86,7 @@ func NewVMClaimController(
 		progressClient:              progressClient,
 		sessionClient:               sessionClient,
 		vmClient:                    vmClient,
+		vmSetClient:                 vmSetClient,
 		vmTemplateClient:            vmTemplateClient,
 	}
 	vmClaimController.SetReconciler(vmClaimController)
@@ -237,9 +241,28 @@ func (v *VMClaimController) submitVirtualMachines(vmc *vmclaimpb.VMClaim) (err e
 		return err
 	}
 
+	// Take vms from the warm pool of the scheduled event first, only the remaining vms are provisioned
+	vmMap := v.claimWarmPoolVMs(vmc, seName)
+	defer func() {
+		if err != nil {
+			// the claimed vms go back into the warm pool
+			for _, vm := range vmMap {
+				if _, unassignErr := v.unassignVM(vm.GetVmId()); unassignErr != nil {
+					glog.Errorf("error returning vm %s to the warm pool: %s", vm.GetVmId(), hferrors.GetErrorMessage(unassignErr))
+				}
+			}
+		}
+	}()
+	pendingVMs := make(map[string]*vmclaimpb.VMClaimVM)
+	for vmName, vmDetails := range vmc.GetVms() {
+		if _, claimed := vmMap[vmName]; !claimed {
+			pendingVMs[vmName] = vmDetails
+		}
+	}
+
 	// Calculate required VMs per template
 	requiredTemplateCount := make(map[string]int)
-	for _, vmDetails := range vmc.GetVms() {
+	for _, vmDetails := range pendingVMs {
 		if count, found := requiredTemplateCount[vmDetails.Template]; found {
 			requiredTemplateCount[vmDetails.Template] = count + 1
 		} else {
@@ -262,7 +285,7 @@ func (v *VMClaimController) submitVirtualMachines(vmc *vmclaimpb.VMClaim) (err e
 			}
 			reservedCapacity[environment.GetId()] = reserved
 		}
-		for vmName, vmDetails := range vmc.GetVms() {
+		for vmName, vmDetails := range pendingVMs {
 			env, dbc, err := v.findSuitableEnvironmentForVMTemplate(environments, dbcList, vmDetails.Template, reservedCapacity, vmc.Labels[hflabels.ScheduledEventLabel])
 			if err != nil {
 				glog.Errorf("no suitable environment for %s (%s): %v", vmName, vmDetails.GetTemplate(), err)
@@ -280,13 +303,12 @@ func (v *VMClaimController) submitVirtualMachines(vmc *vmclaimpb.VMClaim) (err e
 				break
 			}
 		}
-		for vmName := range vmc.GetVms() {
+		for vmName := range pendingVMs {
 			environmentMap[vmName] = VMEnvironment{enviroment, bestDBC}
 		}
 	}
 
-	vmMap := make(map[string]*vmclaimpb.VMClaimVM)
-	for vmName, vmDetails := range vmc.GetVms() {
+	for vmName, vmDetails := range pendingVMs {
 		genName := fmt.Sprintf("%s-%08x", vmc.GetBaseName(), rand.Uint32())
 		environment := environmentMap[vmName].Environment
 		dbc := environmentMap[vmName].DynamicBindConfiguration
@@ -463,7 +485,12 @@ func (v *VMClaimController) findBestDBCForVMs(dbcList []*dbconfigpb.DynamicBindC
 	return &dbconfigpb.DynamicBindConfig{}, fmt.Errorf("there is no best environment")
 }
 
+// Find the environment with the most free capacity for the template. The vms of scheduled events spreading a template
+// across environments are put into the least utilized environment.
 func (v *VMClaimController) findSuitableEnvironmentForVMTemplate(environments []*environmentpb.Environment, dbcList []*dbconfigpb.DynamicBindConfig, template string, reservedCapacity map[string]map[string]int, scheduledEvent string) (*environmentpb.Environment, *dbconfigpb.DynamicBindConfig, error) {
+	var suitableEnvironment *environmentpb.Environment
+	var suitableDBC *dbconfigpb.DynamicBindConfig
+	mostFree := 0
 	for _, environment := range environments {
 		countEnv, err := util.CountMachinesPerTemplateAndEnvironment(v.Context, v.vmClient, template, environment.GetId())
 		if err != nil {
@@ -488,9 +515,9 @@ func (v *VMClaimController) findSuitableEnvironmentForVMTemplate(environments []
 		for _, dbc := range dbcList {
 			if dbc.GetEnvironment() == environment.GetId() {
 				if capacity, found := dbc.GetBurstCountCapacity()[template]; found {
-					if countDBC < int(capacity) {
-						// Capacity also satisfied for environment + scheduledEvent via DBC
-						return environment, dbc, nil
+					// Capacity also satisfied for environment + scheduledEvent via DBC
+					if free := int(capacity) - countDBC; free > mostFree {
+						suitableEnvironment, suitableDBC, mostFree = environment, dbc, free
 					}
 				}
 				break
@@ -499,7 +526,10 @@ func (v *VMClaimController) findSuitableEnvironmentForVMTemplate(environments []
 
 	}
 
-	return &environmentpb.Environment{}, &dbconfigpb.DynamicBindConfig{}, fmt.Errorf("no suitable environment found. capacity reached")
+	if suitableEnvironment == nil {
+		return &environmentpb.Environment{}, &dbconfigpb.DynamicBindConfig{}, fmt.Errorf("no suitable environment found. capacity reached")
+	}
+	return suitableEnvironment, suitableDBC, nil
 }
 
 func (v *VMClaimController) checkVMStatus(vmc *vmclaimpb.VMClaim) (ready bool, err error) {
@@ -532,7 +562,7 @@ func (v *VMClaimController) findScheduledEvent(accessCode string) (schedEvent st
 	}
 
 	schedEvent = se.GetId()
-	environments = se.GetRequiredVms()
+	environments = util.RequiredVirtualMachines(se)
 	return schedEvent, environments, nil
 }
 
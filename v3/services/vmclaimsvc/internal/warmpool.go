@@ -0,0 +1,153 @@
+package vmclaimservice
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
+	vmclaimpb "github.com/hobbyfarm/gargantua/v3/protos/vmclaim"
+	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// claimWarmPoolVMs assigns unclaimed vms of the warm pool of an on demand scheduled event to the vms of the claim.
+// Every vm of the claim which has a warm pool for its template counts as a hit or miss of that warm pool.
+// Returns the claimed vms by their name within the claim, the remaining vms of the claim have to be provisioned.
+func (v *VMClaimController) claimWarmPoolVMs(vmc *vmclaimpb.VMClaim, seName string) map[string]*vmclaimpb.VMClaimVM {
+	claimed := make(map[string]*vmclaimpb.VMClaimVM)
+
+	vmSetList, err := v.vmSetClient.ListVMSet(v.Context, &generalpb.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=true", hflabels.ScheduledEventLabel, seName, hflabels.WarmPoolLabel),
+		LoadFromCache: true,
+	})
+	if err != nil {
+		glog.Errorf("error listing warm pools of scheduled event %s: %s", seName, hferrors.GetErrorMessage(err))
+		return claimed
+	}
+	if len(vmSetList.GetVmsets()) == 0 {
+		return claimed
+	}
+
+	taken := make(map[string]bool)
+	for vmName, vmDetails := range vmc.GetVms() {
+		var pools []*vmsetpb.VMSet
+		for _, vmSet := range vmSetList.GetVmsets() {
+			if vmSet.GetVmTemplate() == vmDetails.GetTemplate() {
+				pools = append(pools, vmSet)
+			}
+		}
+		if len(pools) == 0 {
+			// there is no warm pool for this template
+			continue
+		}
+
+		vmId, vmSetId := v.claimWarmPoolVM(vmc, seName, vmDetails.GetTemplate(), taken)
+		if vmId == "" {
+			glog.V(4).Infof("warm pool of scheduled event %s has no vm of template %s left", seName, vmDetails.GetTemplate())
+			v.recordWarmPoolClaim(pools[0].GetId(), false)
+			continue
+		}
+
+		taken[vmId] = true
+		claimed[vmName] = &vmclaimpb.VMClaimVM{
+			Template: vmDetails.GetTemplate(),
+			VmId:     vmId,
+		}
+		v.recordWarmPoolClaim(vmSetId, true)
+	}
+
+	return claimed
+}
+
+// claimWarmPoolVM assigns an unclaimed vm of the template from the warm pool of the scheduled event to the claim and
+// returns the ids of the vm and its vmset. Returns empty ids if the warm pool is empty.
+// Concurrent claims may pick the same vm. Each candidate is claimed with an update conditioned on the resource version
+// it was listed with, the claim which loses the race moves on to the next candidate.
+func (v *VMClaimController) claimWarmPoolVM(vmc *vmclaimpb.VMClaim, seName string, template string, taken map[string]bool) (string, string) {
+	for _, vm := range v.findWarmPoolVMs(seName, template, taken) {
+		err := v.assignWarmPoolVM(vmc, vm)
+		if hferrors.IsGrpcConflict(err) || hferrors.IsGrpcNotFound(err) {
+			glog.V(4).Infof("warm pool vm %s has been claimed concurrently, trying next vm", vm.GetId())
+			continue
+		}
+		if err != nil {
+			glog.Errorf("error assigning warm pool vm %s: %s", vm.GetId(), hferrors.GetErrorMessage(err))
+			continue
+		}
+		return vm.GetId(), vm.GetVmSetId()
+	}
+	return "", ""
+}
+
+// findWarmPoolVMs returns the unclaimed vms of the template from the warm pool of the scheduled event, running vms first.
+func (v *VMClaimController) findWarmPoolVMs(seName string, template string, taken map[string]bool) []*vmpb.VM {
+	vmLabels := labels.Set{
+		hflabels.ScheduledEventLabel:    seName,
+		hflabels.WarmPoolLabel:          "true",
+		hflabels.VirtualMachineTemplate: template,
+		"bound":                         "false",
+	}
+	vmList, err := v.vmClient.ListVM(v.Context, &generalpb.ListOptions{LabelSelector: vmLabels.AsSelector().String()})
+	if err != nil {
+		glog.Errorf("error listing warm pool vms of scheduled event %s: %s", seName, hferrors.GetErrorMessage(err))
+		return nil
+	}
+
+	var running, starting []*vmpb.VM
+	for _, vm := range vmList.GetVms() {
+		if taken[vm.GetId()] || vm.GetStatus().GetAllocated() || vm.GetStatus().GetTainted() || vm.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if vm.GetStatus().GetStatus() == string(hfv1.VmStatusRunning) {
+			running = append(running, vm)
+		} else {
+			starting = append(starting, vm)
+		}
+	}
+	return append(running, starting...)
+}
+
+// assignWarmPoolVM binds the vm to the claim, unless it has been modified since it was listed.
+func (v *VMClaimController) assignWarmPoolVM(vmc *vmclaimpb.VMClaim, vm *vmpb.VM) error {
+	_, err := v.vmClient.UpdateVM(v.Context, &vmpb.UpdateVMRequest{
+		Id:              vm.GetId(),
+		Bound:           "true",
+		VmClaimId:       wrapperspb.String(vmc.GetId()),
+		User:            wrapperspb.String(vmc.GetUserId()),
+		ResourceVersion: vm.GetResourceVersion(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = v.vmClient.UpdateVMStatus(v.Context, &vmpb.UpdateVMStatusRequest{
+		Id:        vm.GetId(),
+		Allocated: wrapperspb.Bool(true),
+	})
+	if err != nil {
+		// the vm is bound, but not allocated. It goes back into the warm pool
+		if _, unassignErr := v.unassignVM(vm.GetId()); unassignErr != nil {
+			glog.Errorf("error returning vm %s to the warm pool: %s", vm.GetId(), hferrors.GetErrorMessage(unassignErr))
+		}
+		return err
+	}
+	return nil
+}
+
+// recordWarmPoolClaim counts a claim of the warm pool vmset as hit or miss in the status of the vmset.
+func (v *VMClaimController) recordWarmPoolClaim(vmSetId string, hit bool) {
+	req := &vmsetpb.UpdateVMSetStatusRequest{Id: vmSetId}
+	if hit {
+		req.AddPoolHits = 1
+	} else {
+		req.AddPoolMisses = 1
+	}
+	_, err := v.vmSetClient.UpdateVMSetStatus(v.Context, req)
+	if err != nil {
+		glog.Errorf("error recording warm pool claim for vmset %s: %s", vmSetId, hferrors.GetErrorMessage(err))
+	}
+}
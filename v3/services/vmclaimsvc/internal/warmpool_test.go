@@ -0,0 +1,243 @@
+package vmclaimservice
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	hferrors "github.com/hobbyfarm/gargantua/v3/pkg/errors"
+	hflabels "github.com/hobbyfarm/gargantua/v3/pkg/labels"
+	generalpb "github.com/hobbyfarm/gargantua/v3/protos/general"
+	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
+	vmclaimpb "github.com/hobbyfarm/gargantua/v3/protos/vmclaim"
+	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// fakeVMClient keeps vms in memory. Updates conditioned on an outdated resource version fail with a conflict.
+type fakeVMClient struct {
+	vmpb.VMSvcClient
+	vms map[string]*vmpb.VM
+	// stale holds vms which are listed as they were before a concurrent claim bound them
+	stale map[string]*vmpb.VM
+}
+
+func (f *fakeVMClient) ListVM(_ context.Context, in *generalpb.ListOptions, _ ...grpc.CallOption) (*vmpb.ListVMsResponse, error) {
+	selector, err := labels.Parse(in.GetLabelSelector())
+	if err != nil {
+		return nil, err
+	}
+	var vms []*vmpb.VM
+	for id, vm := range f.vms {
+		if stale, ok := f.stale[id]; ok {
+			vm = stale
+		}
+		if selector.Matches(labels.Set(vm.GetLabels())) {
+			vms = append(vms, vm)
+		}
+	}
+	return &vmpb.ListVMsResponse{Vms: vms}, nil
+}
+
+func (f *fakeVMClient) UpdateVM(_ context.Context, in *vmpb.UpdateVMRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	vm, ok := f.vms[in.GetId()]
+	if !ok {
+		return &emptypb.Empty{}, hferrors.GrpcNotFoundError(&generalpb.ResourceId{Id: in.GetId()}, "virtual machine")
+	}
+	if in.GetResourceVersion() != "" && in.GetResourceVersion() != vm.GetResourceVersion() {
+		return &emptypb.Empty{}, hferrors.GrpcConflictError(in, "virtual machine")
+	}
+	if in.GetBound() != "" {
+		vm.Labels["bound"] = in.GetBound()
+	}
+	if in.GetVmClaimId() != nil {
+		vm.VmClaimId = in.GetVmClaimId().GetValue()
+	}
+	if in.GetUser() != nil {
+		vm.User = in.GetUser().GetValue()
+	}
+	f.bump(vm)
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeVMClient) UpdateVMStatus(_ context.Context, in *vmpb.UpdateVMStatusRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	vm := f.vms[in.GetId()]
+	if in.GetAllocated() != nil {
+		vm.Status.Allocated = in.GetAllocated().GetValue()
+	}
+	f.bump(vm)
+	return &emptypb.Empty{}, nil
+}
+
+func (f *fakeVMClient) bump(vm *vmpb.VM) {
+	rv, _ := strconv.Atoi(vm.GetResourceVersion())
+	vm.ResourceVersion = strconv.Itoa(rv + 1)
+}
+
+// fakeVMSetClient records the warm pool hits and misses added to the status of vmsets.
+type fakeVMSetClient struct {
+	vmsetpb.VMSetSvcClient
+	vmSets []*vmsetpb.VMSet
+	hits   map[string]uint32
+	misses map[string]uint32
+}
+
+func (f *fakeVMSetClient) ListVMSet(_ context.Context, _ *generalpb.ListOptions, _ ...grpc.CallOption) (*vmsetpb.ListVMSetsResponse, error) {
+	return &vmsetpb.ListVMSetsResponse{Vmsets: f.vmSets}, nil
+}
+
+func (f *fakeVMSetClient) UpdateVMSetStatus(_ context.Context, in *vmsetpb.UpdateVMSetStatusRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	f.hits[in.GetId()] += in.GetAddPoolHits()
+	f.misses[in.GetId()] += in.GetAddPoolMisses()
+	return &emptypb.Empty{}, nil
+}
+
+func warmPoolVM(id string, vmSet string, template string, status hfv1.VmStatus) *vmpb.VM {
+	return &vmpb.VM{
+		Id:              id,
+		VmSetId:         vmSet,
+		VmTemplateId:    template,
+		ResourceVersion: "1",
+		Labels: map[string]string{
+			hflabels.ScheduledEventLabel:    "se-test",
+			hflabels.WarmPoolLabel:          "true",
+			hflabels.VirtualMachineTemplate: template,
+			"bound":                         "false",
+		},
+		Status: &vmpb.VMStatus{Status: string(status)},
+	}
+}
+
+func newWarmPoolController(vms ...*vmpb.VM) (*VMClaimController, *fakeVMClient, *fakeVMSetClient) {
+	vmClient := &fakeVMClient{vms: map[string]*vmpb.VM{}, stale: map[string]*vmpb.VM{}}
+	for _, vm := range vms {
+		vmClient.vms[vm.GetId()] = vm
+	}
+	vmSetClient := &fakeVMSetClient{
+		vmSets: []*vmsetpb.VMSet{{Id: "vms-ubuntu", VmTemplate: "ubuntu"}},
+		hits:   map[string]uint32{},
+		misses: map[string]uint32{},
+	}
+
+	v := &VMClaimController{vmClient: vmClient, vmSetClient: vmSetClient}
+	v.Context = context.Background()
+	return v, vmClient, vmSetClient
+}
+
+func Test_claimWarmPoolVMs(t *testing.T) {
+	vmc := &vmclaimpb.VMClaim{
+		Id:     "vmc-test",
+		UserId: "u-test",
+		Vms: map[string]*vmclaimpb.VMClaimVM{
+			"node1": {Template: "ubuntu"},
+			"node2": {Template: "ubuntu"},
+			"other": {Template: "centos"},
+		},
+	}
+
+	v, vmClient, vmSetClient := newWarmPoolController(
+		warmPoolVM("vm-starting", "vms-ubuntu", "ubuntu", hfv1.VmStatusProvisioned),
+		warmPoolVM("vm-running", "vms-ubuntu", "ubuntu", hfv1.VmStatusRunning),
+	)
+
+	claimed := v.claimWarmPoolVMs(vmc, "se-test")
+
+	if len(claimed) != 2 {
+		t.Fatalf("expected both ubuntu vms to be claimed from the warm pool, got %d", len(claimed))
+	}
+	if _, ok := claimed["other"]; ok {
+		t.Error("expected vm without warm pool to be left for provisioning")
+	}
+	if claimed["node1"].GetVmId() == claimed["node2"].GetVmId() {
+		t.Errorf("expected distinct vms, got %s twice", claimed["node1"].GetVmId())
+	}
+	for _, vm := range vmClient.vms {
+		if vm.GetLabels()["bound"] != "true" || !vm.GetStatus().GetAllocated() || vm.GetVmClaimId() != vmc.GetId() {
+			t.Errorf("expected vm %s to be bound and allocated to the claim", vm.GetId())
+		}
+	}
+	if vmSetClient.hits["vms-ubuntu"] != 2 || vmSetClient.misses["vms-ubuntu"] != 0 {
+		t.Errorf("expected 2 hits and 0 misses, got %d hits and %d misses",
+			vmSetClient.hits["vms-ubuntu"], vmSetClient.misses["vms-ubuntu"])
+	}
+}
+
+func Test_claimWarmPoolVMsPrefersRunning(t *testing.T) {
+	vmc := &vmclaimpb.VMClaim{Id: "vmc-test", Vms: map[string]*vmclaimpb.VMClaimVM{"node1": {Template: "ubuntu"}}}
+
+	v, _, _ := newWarmPoolController(
+		warmPoolVM("vm-starting", "vms-ubuntu", "ubuntu", hfv1.VmStatusProvisioned),
+		warmPoolVM("vm-running", "vms-ubuntu", "ubuntu", hfv1.VmStatusRunning),
+	)
+
+	claimed := v.claimWarmPoolVMs(vmc, "se-test")
+	if claimed["node1"].GetVmId() != "vm-running" {
+		t.Errorf("expected running vm to be claimed, got %s", claimed["node1"].GetVmId())
+	}
+}
+
+func Test_claimWarmPoolVMsMiss(t *testing.T) {
+	vmc := &vmclaimpb.VMClaim{
+		Id: "vmc-test",
+		Vms: map[string]*vmclaimpb.VMClaimVM{
+			"node1": {Template: "ubuntu"},
+			"node2": {Template: "ubuntu"},
+		},
+	}
+
+	v, _, vmSetClient := newWarmPoolController(warmPoolVM("vm-running", "vms-ubuntu", "ubuntu", hfv1.VmStatusRunning))
+
+	claimed := v.claimWarmPoolVMs(vmc, "se-test")
+
+	if len(claimed) != 1 {
+		t.Fatalf("expected one vm to be claimed from the warm pool, got %d", len(claimed))
+	}
+	if vmSetClient.hits["vms-ubuntu"] != 1 || vmSetClient.misses["vms-ubuntu"] != 1 {
+		t.Errorf("expected 1 hit and 1 miss, got %d hits and %d misses",
+			vmSetClient.hits["vms-ubuntu"], vmSetClient.misses["vms-ubuntu"])
+	}
+}
+
+func Test_claimWarmPoolVMsConflict(t *testing.T) {
+	vmc := &vmclaimpb.VMClaim{Id: "vmc-test", Vms: map[string]*vmclaimpb.VMClaimVM{"node1": {Template: "ubuntu"}}}
+
+	taken := warmPoolVM("vm-taken", "vms-ubuntu", "ubuntu", hfv1.VmStatusRunning)
+	free := warmPoolVM("vm-free", "vms-ubuntu", "ubuntu", hfv1.VmStatusProvisioned)
+	v, vmClient, vmSetClient := newWarmPoolController(taken, free)
+
+	// another claim bound the running vm after it was listed
+	vmClient.stale[taken.GetId()] = warmPoolVM("vm-taken", "vms-ubuntu", "ubuntu", hfv1.VmStatusRunning)
+	taken.Labels["bound"] = "true"
+	taken.VmClaimId = "vmc-other"
+	taken.ResourceVersion = "2"
+
+	claimed := v.claimWarmPoolVMs(vmc, "se-test")
+
+	if claimed["node1"].GetVmId() != free.GetId() {
+		t.Fatalf("expected the next vm to be claimed after a conflict, got %s", claimed["node1"].GetVmId())
+	}
+	if taken.GetVmClaimId() != "vmc-other" {
+		t.Errorf("expected vm of the concurrent claim to be kept, got claim %s", taken.GetVmClaimId())
+	}
+	if vmSetClient.hits["vms-ubuntu"] != 1 || vmSetClient.misses["vms-ubuntu"] != 0 {
+		t.Errorf("expected 1 hit and 0 misses, got %d hits and %d misses",
+			vmSetClient.hits["vms-ubuntu"], vmSetClient.misses["vms-ubuntu"])
+	}
+}
+
+func Test_claimWarmPoolVMsNoPool(t *testing.T) {
+	vmc := &vmclaimpb.VMClaim{Id: "vmc-test", Vms: map[string]*vmclaimpb.VMClaimVM{"node1": {Template: "ubuntu"}}}
+
+	v, _, vmSetClient := newWarmPoolController()
+	vmSetClient.vmSets = nil
+
+	if claimed := v.claimWarmPoolVMs(vmc, "se-test"); len(claimed) != 0 {
+		t.Errorf("expected no vm to be claimed without warm pool, got %d", len(claimed))
+	}
+	if len(vmSetClient.hits) != 0 || len(vmSetClient.misses) != 0 {
+		t.Error("expected claims without warm pool to not be counted")
+	}
+}
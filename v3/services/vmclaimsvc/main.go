@@ -24,6 +24,7 @@ import (
 	sessionpb "github.com/hobbyfarm/gargantua/v3/protos/session"
 	vmpb "github.com/hobbyfarm/gargantua/v3/protos/vm"
 	vmclaimpb "github.com/hobbyfarm/gargantua/v3/protos/vmclaim"
+	vmsetpb "github.com/hobbyfarm/gargantua/v3/protos/vmset"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
 )
 
@@ -56,6 +57,7 @@ func main() {
 		microservices.ScheduledEvent,
 		microservices.Session,
 		microservices.VM,
+		microservices.VMSet,
 		microservices.VMTemplate,
 	}
 	connections := microservices.EstablishConnections(services, serviceConfig.ClientCert)
@@ -72,6 +74,7 @@ func main() {
 	progressClient := progresspb.NewProgressSvcClient(connections[microservices.Progress])
 	sessionClient := sessionpb.NewSessionSvcClient(connections[microservices.Session])
 	vmClient := vmpb.NewVMSvcClient(connections[microservices.VM])
+	vmSetClient := vmsetpb.NewVMSetSvcClient(connections[microservices.VMSet])
 	vmTemplateClient := vmtemplatepb.NewVMTemplateSvcClient(connections[microservices.VMTemplate])
 
 	vmClaimWorkqueue := workqueue.NewDelayingQueueWithConfig(workqueue.DelayingQueueConfig{Name: "vmclaim-controller"})
@@ -91,6 +94,7 @@ func main() {
 		progressClient,
 		sessionClient,
 		vmClient,
+		vmSetClient,
 		vmTemplateClient,
 		ctx,
 	)
@@ -114,6 +114,12 @@ func (v *VMSetController) reconcileVirtualMachineSet(vmset *vmsetpb.VMSet) error
 
 	currentVMs := currentVMList.GetVms()
 
+	// a warm pool only consists of the vms which are not claimed yet, claimed vms are replaced
+	warmPool := vmset.GetLabels()[hflabels.WarmPoolLabel] == "true"
+	if warmPool {
+		currentVMs = unclaimedVMs(currentVMs)
+	}
+
 	if len(currentVMs) < int(vmset.GetCount()) { // if desired count is greater than the current provisioned
 		// 1. let's check the environment to see if there is available capacity
 		// 2. if available capacity is available let's create new VM's
@@ -174,6 +180,9 @@ func (v *VMSetController) reconcileVirtualMachineSet(vmset *vmsetpb.VMSet) error
 			if restrictedBind {
 				vmLabels["restrictedbindvalue"] = vmset.GetRestrictedBindValue()
 			}
+			if warmPool {
+				vmLabels[hflabels.WarmPoolLabel] = "true"
+			}
 			if provisionMethod, ok := env.GetAnnotations()["hobbyfarm.io/provisioner"]; ok && provisionMethod != "" {
 				vmLabels["hobbyfarm.io/provisioner"] = provisionMethod
 			}
@@ -256,6 +265,9 @@ func (v *VMSetController) reconcileVirtualMachineSet(vmset *vmsetpb.VMSet) error
 	}
 
 	vms := vmList.GetVms()
+	if warmPool {
+		vms = unclaimedVMs(vms)
+	}
 
 	provisionedCount := 0
 	activeCount := 0
@@ -276,6 +288,17 @@ func (v *VMSetController) reconcileVirtualMachineSet(vmset *vmsetpb.VMSet) error
 	return err
 }
 
+// unclaimedVMs returns the vms which are neither allocated nor tainted.
+func unclaimedVMs(vms []*vmpb.VM) []*vmpb.VM {
+	var unclaimed []*vmpb.VM
+	for _, vm := range vms {
+		if !vm.GetStatus().GetAllocated() && !vm.GetStatus().GetTainted() {
+			unclaimed = append(unclaimed, vm)
+		}
+	}
+	return unclaimed
+}
+
 func (v *VMSetController) updateVMSetCount(vmSetName string, active int, prov int) error {
 	_, err := v.internalVmSetServer.UpdateVMSetStatus(v.Context, &vmsetpb.UpdateVMSetStatusRequest{
 		Id:          vmSetName,
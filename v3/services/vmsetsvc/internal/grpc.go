@@ -115,6 +115,8 @@ func (s *GrpcVMSetServer) GetVMSet(ctx context.Context, req *generalpb.GetReques
 		Machines:    vmSetVMs,
 		Available:   uint32(vms.Status.AvailableCount),
 		Provisioned: uint32(vms.Status.ProvisionedCount),
+		PoolHits:    uint32(vms.Status.PoolHits),
+		PoolMisses:  uint32(vms.Status.PoolMisses),
 	}
 
 	return &vmsetpb.VMSet{
@@ -199,6 +201,8 @@ func (s *GrpcVMSetServer) UpdateVMSetStatus(ctx context.Context, req *vmsetpb.Up
 	machines := req.GetMachines()
 	available := req.GetAvailable()
 	provisioned := req.GetProvisioned()
+	addPoolHits := req.GetAddPoolHits()
+	addPoolMisses := req.GetAddPoolMisses()
 
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		vms, err := s.vmSetClient.Get(ctx, id, metav1.GetOptions{})
@@ -220,6 +224,10 @@ func (s *GrpcVMSetServer) UpdateVMSetStatus(ctx context.Context, req *vmsetpb.Up
 			vms.Status.ProvisionedCount = int(provisioned.Value)
 		}
 
+		// added on the latest status, so concurrent claims are all counted
+		vms.Status.PoolHits += int(addPoolHits)
+		vms.Status.PoolMisses += int(addPoolMisses)
+
 		if len(machines) > 0 {
 			vmSetVMs := []hfv1.VirtualMachineProvision{}
 			for key, vm := range machines {
@@ -297,6 +305,8 @@ func (s *GrpcVMSetServer) ListVMSet(ctx context.Context, listOptions *generalpb.
 			Machines:    vmSetVMs,
 			Available:   uint32(vms.Status.AvailableCount),
 			Provisioned: uint32(vms.Status.ProvisionedCount),
+			PoolHits:    uint32(vms.Status.PoolHits),
+			PoolMisses:  uint32(vms.Status.PoolMisses),
 		}
 
 		preparedVmSets = append(preparedVmSets, &vmsetpb.VMSet{
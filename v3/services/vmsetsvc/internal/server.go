@@ -3,6 +3,7 @@ package vmsetservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 )
@@ -11,21 +12,25 @@ type VMSetServer struct {
 	authnClient         authnpb.AuthNClient
 	authrClient         authrpb.AuthRClient
 	internalVMSetServer *GrpcVMSetServer
+	vmSetFeed           *changefeed.Feed
 }
 
 func NewVMSetServer(
 	authnClient authnpb.AuthNClient,
 	authrClient authrpb.AuthRClient,
 	internalVMSetServer *GrpcVMSetServer,
+	vmSetFeed *changefeed.Feed,
 ) VMSetServer {
 	return VMSetServer{
 		authnClient:         authnClient,
 		authrClient:         authrClient,
 		internalVMSetServer: internalVMSetServer,
+		vmSetFeed:           vmSetFeed,
 	}
 }
 
 func (vms VMSetServer) SetupRoutes(r *mux.Router) {
+	r.HandleFunc("/a/vmset/watch", vms.WatchFunc).Methods("GET")
 	r.HandleFunc("/a/vmset/{se_id}", vms.GetVMSetListByScheduledEventFunc).Methods("GET")
 	r.HandleFunc("/a/vmset", vms.GetAllVMSetListFunc).Methods("GET")
 	glog.V(2).Infof("set up routes")
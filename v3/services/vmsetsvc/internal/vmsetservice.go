@@ -31,6 +31,8 @@ type PreparedVirtualMachineSet struct {
 	Machines            []*vmsetpb.VMProvision `json:"machines"`
 	AvailableCount      uint32                 `json:"available"`
 	ProvisionedCount    uint32                 `json:"provisioned"`
+	PoolHits            uint32                 `json:"pool_hits"`
+	PoolMisses          uint32                 `json:"pool_misses"`
 }
 
 func (vms VMSetServer) GetVMSetListByScheduledEventFunc(w http.ResponseWriter, r *http.Request) {
@@ -87,6 +89,8 @@ func (vms VMSetServer) GetVMSetListFunc(w http.ResponseWriter, r *http.Request,
 			Machines:            vmSet.GetStatus().GetMachines(),
 			AvailableCount:      vmSet.GetStatus().GetAvailable(),
 			ProvisionedCount:    vmSet.GetStatus().GetProvisioned(),
+			PoolHits:            vmSet.GetStatus().GetPoolHits(),
+			PoolMisses:          vmSet.GetStatus().GetPoolMisses(),
 		}
 		preparedVMSets = append(preparedVMSets, pVMSet)
 	}
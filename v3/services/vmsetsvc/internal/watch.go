@@ -0,0 +1,60 @@
+package vmsetservice
+
+import (
+	"net/http"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// watchedVMSet is a virtual machine set as it is sent on the change feed
+type watchedVMSet struct {
+	Id     string            `json:"id"`
+	Labels map[string]string `json:"labels"`
+	hfv1.VirtualMachineSetSpec
+	hfv1.VirtualMachineSetStatus
+}
+
+// PrepareVMSetEvent converts a virtual machine set of the informer for the change feed.
+func PrepareVMSetEvent(obj metav1.Object) interface{} {
+	vmSet, ok := obj.(*hfv1.VirtualMachineSet)
+	if !ok {
+		return nil
+	}
+	return watchedVMSet{
+		Id:                      vmSet.Name,
+		Labels:                  vmSet.Labels,
+		VirtualMachineSetSpec:   vmSet.Spec,
+		VirtualMachineSetStatus: vmSet.Status,
+	}
+}
+
+/*
+Stream changes of virtualmachinesets as server-sent events
+
+	Query:
+	- scheduledevent : Only changes of virtualmachinesets in the scheduled event
+	- snapshot : Unless "false", the stream starts with every virtual machine set followed by a "synced" event
+*/
+func (vms VMSetServer) WatchFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, vms.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	impersonatedUserId := user.GetId()
+	authrResponse, err := rbac.Authorize(r, vms.authrClient, impersonatedUserId, []*authrpb.Permission{
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbList),
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbWatch),
+	}, rbac.OperatorAND)
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to watch virtualmachinesets")
+		return
+	}
+
+	vms.vmSetFeed.Stream(w, r, r.URL.Query().Get("scheduledevent"))
+}
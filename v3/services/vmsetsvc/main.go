@@ -6,8 +6,10 @@ import (
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 	"k8s.io/client-go/util/workqueue"
@@ -78,6 +80,11 @@ func main() {
 		glog.Fatalf("failed creating vm set controller: %s", err.Error())
 	}
 
+	vmSetFeed, err := changefeed.NewFeed(rbac.ResourcePluralVMSet, hfInformerFactory.Hobbyfarm().V1().VirtualMachineSets().Informer(), vmsetservice.PrepareVMSetEvent)
+	if err != nil {
+		glog.Fatalf("failed creating vm set change feed: %s", err.Error())
+	}
+
 	var wg sync.WaitGroup
 	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
 	wg.Add(1)
@@ -94,6 +101,7 @@ func main() {
 			authnClient,
 			authrClient,
 			vs,
+			vmSetFeed,
 		)
 		microservices.StartAPIServer(vmSetServer)
 	}()
@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
@@ -172,6 +173,7 @@ func (s *GrpcVMServer) GetVM(ctx context.Context, req *generalpb.GetRequest) (*v
 		Annotations:       vm.Annotations,
 		DeletionTimestamp: deletionTimeStamp,
 		CreationTimestamp: creationTimestamp,
+		ResourceVersion:   vm.ResourceVersion,
 	}, nil
 }
 
@@ -186,8 +188,9 @@ func (s *GrpcVMServer) UpdateVM(ctx context.Context, req *vmpb.UpdateVMRequest)
 	user := req.GetUser()
 	secretName := req.GetSecretName()
 	finalizers := req.GetFinalizers()
+	resourceVersion := req.GetResourceVersion()
 
-	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+	update := func() error {
 		vm, err := s.vmClient.Get(ctx, id, metav1.GetOptions{})
 		if err != nil {
 			glog.Error(err)
@@ -219,9 +222,24 @@ func (s *GrpcVMServer) UpdateVM(ctx context.Context, req *vmpb.UpdateVMRequest)
 			vm.SetFinalizers(finalizers.GetValues())
 		}
 
+		if resourceVersion != "" {
+			// the update is rejected with a conflict if the vm was modified in the meantime
+			vm.SetResourceVersion(resourceVersion)
+		}
+
 		_, updateErr := s.vmClient.Update(ctx, vm, metav1.UpdateOptions{})
 		return updateErr
-	})
+	}
+
+	var retryErr error
+	if resourceVersion != "" {
+		retryErr = update()
+		if apierrors.IsConflict(retryErr) {
+			return &emptypb.Empty{}, hferrors.GrpcConflictError(req, "virtual machine")
+		}
+	} else {
+		retryErr = retry.RetryOnConflict(retry.DefaultRetry, update)
+	}
 
 	if retryErr != nil {
 		return &emptypb.Empty{}, hferrors.GrpcError(
@@ -385,6 +403,7 @@ func (s *GrpcVMServer) ListVM(ctx context.Context, listOptions *generalpb.ListOp
 			Annotations:       vm.Annotations,
 			DeletionTimestamp: deletionTimeStamp,
 			CreationTimestamp: creationTimestamp,
+			ResourceVersion:   vm.ResourceVersion,
 		})
 	}
 
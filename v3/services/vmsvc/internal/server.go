@@ -3,6 +3,7 @@ package vmservice
 import (
 	"github.com/golang/glog"
 	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	authnpb "github.com/hobbyfarm/gargantua/v3/protos/authn"
 	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
 	vmtemplatepb "github.com/hobbyfarm/gargantua/v3/protos/vmtemplate"
@@ -13,6 +14,7 @@ type VMServer struct {
 	authrClient      authrpb.AuthRClient
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient
 	internalVMServer *GrpcVMServer
+	vmFeed           *changefeed.Feed
 }
 
 func NewVMServer(
@@ -20,12 +22,14 @@ func NewVMServer(
 	authrClient authrpb.AuthRClient,
 	vmTemplateClient vmtemplatepb.VMTemplateSvcClient,
 	internalVMServer *GrpcVMServer,
+	vmFeed *changefeed.Feed,
 ) VMServer {
 	return VMServer{
 		authnClient:      authnClient,
 		authrClient:      authrClient,
 		vmTemplateClient: vmTemplateClient,
 		internalVMServer: internalVMServer,
+		vmFeed:           vmFeed,
 	}
 }
 
@@ -33,6 +37,7 @@ func (vms VMServer) SetupRoutes(r *mux.Router) {
 	r.HandleFunc("/vm/{vm_id}", vms.GetVMFunc).Methods("GET")
 	r.HandleFunc("/vm/{vm_id}", vms.DeleteVMFunc).Methods("DELETE")
 	r.HandleFunc("/vm/getwebinterfaces/{vm_id}", vms.getWebinterfaces).Methods("GET")
+	r.HandleFunc("/a/vm/watch", vms.WatchFunc).Methods("GET")
 	r.HandleFunc("/a/vm/list", vms.GetAllVMListFunc).Methods("GET")
 	r.HandleFunc("/a/vm/scheduledevent/{se_id}", vms.GetVMListByScheduledEventFunc).Methods("GET")
 	r.HandleFunc("/a/vm/count", vms.CountByScheduledEvent).Methods("GET")
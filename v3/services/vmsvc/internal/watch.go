@@ -0,0 +1,60 @@
+package vmservice
+
+import (
+	"net/http"
+
+	hfv1 "github.com/hobbyfarm/gargantua/v3/pkg/apis/hobbyfarm.io/v1"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
+	"github.com/hobbyfarm/gargantua/v3/pkg/util"
+	authrpb "github.com/hobbyfarm/gargantua/v3/protos/authr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// watchedVM is a virtual machine as it is sent on the change feed
+type watchedVM struct {
+	Id     string            `json:"id"`
+	Labels map[string]string `json:"labels"`
+	hfv1.VirtualMachineSpec
+	hfv1.VirtualMachineStatus
+}
+
+// PrepareVMEvent converts a virtual machine of the informer for the change feed.
+func PrepareVMEvent(obj metav1.Object) interface{} {
+	vm, ok := obj.(*hfv1.VirtualMachine)
+	if !ok {
+		return nil
+	}
+	return watchedVM{
+		Id:                   vm.Name,
+		Labels:               vm.Labels,
+		VirtualMachineSpec:   vm.Spec,
+		VirtualMachineStatus: vm.Status,
+	}
+}
+
+/*
+Stream changes of virtualmachines as server-sent events
+
+	Query:
+	- scheduledevent : Only changes of virtualmachines in the scheduled event
+	- snapshot : Unless "false", the stream starts with every virtual machine followed by a "synced" event
+*/
+func (vms VMServer) WatchFunc(w http.ResponseWriter, r *http.Request) {
+	user, err := rbac.AuthenticateRequest(r, vms.authnClient)
+	if err != nil {
+		util.ReturnHTTPMessage(w, r, 401, "unauthorized", "authentication failed")
+		return
+	}
+
+	impersonatedUserId := user.GetId()
+	authrResponse, err := rbac.Authorize(r, vms.authrClient, impersonatedUserId, []*authrpb.Permission{
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbList),
+		rbac.HobbyfarmPermission(resourcePlural, rbac.VerbWatch),
+	}, rbac.OperatorAND)
+	if err != nil || !authrResponse.Success {
+		util.ReturnHTTPMessage(w, r, 403, "forbidden", "no access to watch virtualmachines")
+		return
+	}
+
+	vms.vmFeed.Stream(w, r, r.URL.Query().Get("scheduledevent"))
+}
@@ -4,8 +4,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/glog"
+	"github.com/hobbyfarm/gargantua/v3/pkg/changefeed"
 	"github.com/hobbyfarm/gargantua/v3/pkg/crd"
 	"github.com/hobbyfarm/gargantua/v3/pkg/microservices"
+	"github.com/hobbyfarm/gargantua/v3/pkg/rbac"
 	"github.com/hobbyfarm/gargantua/v3/pkg/signals"
 	"github.com/hobbyfarm/gargantua/v3/pkg/util"
 
@@ -53,6 +56,11 @@ func main() {
 	vs := vmservice.NewGrpcVMServer(hfClient, hfInformerFactory)
 	vmpb.RegisterVMSvcServer(gs, vs)
 
+	vmFeed, err := changefeed.NewFeed(rbac.ResourcePluralVM, hfInformerFactory.Hobbyfarm().V1().VirtualMachines().Informer(), vmservice.PrepareVMEvent)
+	if err != nil {
+		glog.Fatalf("failed creating virtual machine change feed: %s", err.Error())
+	}
+
 	var wg sync.WaitGroup
 	// only add 1 to our wait group since our service should stop (and restart) as soon as one of the go routines terminates
 	wg.Add(1)
@@ -70,6 +78,7 @@ func main() {
 			authrClient,
 			vmTemplateClient,
 			vs,
+			vmFeed,
 		)
 		microservices.StartAPIServer(vmServer)
 	}()
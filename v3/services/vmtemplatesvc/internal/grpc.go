@@ -46,6 +46,7 @@ func (s *GrpcVMTemplateServer) CreateVMTemplate(ctx context.Context, req *vmtemp
 	configMapRaw := req.GetConfigMapRaw()
 	costBasePrice := req.GetCostBasePrice()
 	costTimeUnit := req.GetCostTimeUnit()
+	resourcesRaw := req.GetResourcesRaw()
 
 	requiredStringParams := map[string]string{
 		"name":  name,
@@ -79,6 +80,14 @@ func (s *GrpcVMTemplateServer) CreateVMTemplate(ctx context.Context, req *vmtemp
 		vmTemplate.Spec.ConfigMap = configMap
 	}
 
+	if resourcesRaw != "" {
+		resources, err := util.ParseResources(resourcesRaw, "resources")
+		if err != nil {
+			return &generalpb.ResourceId{}, hferrors.GrpcParsingError(req, "resources")
+		}
+		vmTemplate.Spec.Resources = resources
+	}
+
 	if costBasePrice != "" && costTimeUnit != "" {
 		vmTemplate.ObjectMeta.Labels = map[string]string{
 			labels.CostBasePrice: req.GetCostBasePrice(),
@@ -111,6 +120,7 @@ func (s *GrpcVMTemplateServer) GetVMTemplate(ctx context.Context, req *generalpb
 		ConfigMap:     vmTemplate.Spec.ConfigMap,
 		CostBasePrice: util.RefOrNil(vmTemplate.ObjectMeta.Labels[labels.CostBasePrice]),
 		CostTimeUnit:  util.RefOrNil(vmTemplate.ObjectMeta.Labels[labels.CostTimeUnit]),
+		Resources:     util.ConvertToResources(vmTemplate.Spec.Resources),
 	}, nil
 }
 
@@ -125,6 +135,7 @@ func (s *GrpcVMTemplateServer) UpdateVMTemplate(ctx context.Context, req *vmtemp
 	configMapRaw := req.GetConfigMapRaw()
 	costBasePrice := req.GetCostBasePrice()
 	costTimeUnit := req.GetCostTimeUnit()
+	resourcesRaw := req.GetResourcesRaw()
 
 	retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		vmTemplate, err := s.vmTemplateClient.Get(ctx, id, metav1.GetOptions{})
@@ -154,6 +165,14 @@ func (s *GrpcVMTemplateServer) UpdateVMTemplate(ctx context.Context, req *vmtemp
 			vmTemplate.Spec.ConfigMap = configMap
 		}
 
+		if resourcesRaw != "" {
+			resources, err := util.ParseResources(resourcesRaw, "resources")
+			if err != nil {
+				return err
+			}
+			vmTemplate.Spec.Resources = resources
+		}
+
 		if costBasePrice == "" && costTimeUnit == "" {
 			if vmTemplate.ObjectMeta.Labels != nil {
 				delete(vmTemplate.ObjectMeta.Labels, labels.CostBasePrice)
@@ -221,6 +240,7 @@ func (s *GrpcVMTemplateServer) ListVMTemplate(ctx context.Context, listOptions *
 			ConfigMap:     vmTemplate.Spec.ConfigMap,
 			CostBasePrice: util.RefOrNil(vmTemplate.ObjectMeta.Labels[labels.CostBasePrice]),
 			CostTimeUnit:  util.RefOrNil(vmTemplate.ObjectMeta.Labels[labels.CostTimeUnit]),
+			Resources:     util.ConvertToResources(vmTemplate.Spec.Resources),
 		})
 	}
 
@@ -26,11 +26,12 @@ const (
 
 // Prepared struct for API endpoints which only need to provide vmt id, name and image
 type PreparedVMTemplate struct {
-	Id            string `json:"id"`
-	Name          string `json:"name"`
-	Image         string `json:"image"`
-	CostBasePrice string `json:"cost_base_price,omitempty"`
-	CostTimeUnit  string `json:"cost_time_unit,omitempty"`
+	Id            string               `json:"id"`
+	Name          string               `json:"name"`
+	Image         string               `json:"image"`
+	CostBasePrice string               `json:"cost_base_price,omitempty"`
+	CostTimeUnit  string               `json:"cost_time_unit,omitempty"`
+	Resources     *generalpb.Resources `json:"resources,omitempty"`
 }
 
 // Prepared struct for API endpoints which additionally to the PreparedVMTemplate struct also need to provide config details
@@ -82,6 +83,7 @@ func (v VirtualMachineTemplateServer) GetFunc(w http.ResponseWriter, r *http.Req
 			Image:         vmt.GetImage(),
 			CostBasePrice: vmt.GetCostBasePrice(),
 			CostTimeUnit:  vmt.GetCostTimeUnit(),
+			Resources:     vmt.GetResources(),
 		},
 		ConfigMap: vmt.GetConfigMap(),
 	}
@@ -125,6 +127,7 @@ func (v VirtualMachineTemplateServer) ListFunc(w http.ResponseWriter, r *http.Re
 			Image:         vmt.GetImage(),
 			CostBasePrice: vmt.GetCostBasePrice(),
 			CostTimeUnit:  vmt.GetCostTimeUnit(),
+			Resources:     vmt.GetResources(),
 		})
 	}
 
@@ -164,6 +167,7 @@ func (v VirtualMachineTemplateServer) CreateFunc(w http.ResponseWriter, r *http.
 	}
 
 	configMapRaw := r.PostFormValue("config_map") // no validation, config_map not required
+	resourcesRaw := r.PostFormValue("resources")  // cpu cores, memory in MiB and disk in GiB, not required
 
 	costBasePrice, costTimeUnit, err := normalizeCost(
 		r.PostFormValue("cost_base_price"),
@@ -180,6 +184,7 @@ func (v VirtualMachineTemplateServer) CreateFunc(w http.ResponseWriter, r *http.
 		Name:          name,
 		Image:         image,
 		ConfigMapRaw:  configMapRaw,
+		ResourcesRaw:  resourcesRaw,
 		CostBasePrice: costBasePrice,
 		CostTimeUnit:  costTimeUnit,
 	})
@@ -249,6 +254,7 @@ func (v VirtualMachineTemplateServer) UpdateFunc(w http.ResponseWriter, r *http.
 	name := r.PostFormValue("name")
 	image := r.PostFormValue("image")
 	configMapRaw := r.PostFormValue("config_map")
+	resourcesRaw := r.PostFormValue("resources") // cpu cores, memory in MiB and disk in GiB, not required
 
 	costBasePrice, costTimeUnit, err := normalizeCost(
 		r.PostFormValue("cost_base_price"),
@@ -264,6 +270,7 @@ func (v VirtualMachineTemplateServer) UpdateFunc(w http.ResponseWriter, r *http.
 		Name:          name,
 		Image:         image,
 		ConfigMapRaw:  configMapRaw,
+		ResourcesRaw:  resourcesRaw,
 		CostBasePrice: costBasePrice,
 		CostTimeUnit:  costTimeUnit,
 	})
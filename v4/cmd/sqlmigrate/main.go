@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/kubernetes"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/sql"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+	"log"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sort"
+)
+
+var (
+	kubeconfig string
+	namespace  string
+	sqlDialect string
+	sqlDSN     string
+)
+
+func init() {
+	rootCmd.Flags().StringVar(&kubeconfig, "kubeconfig", "", "path to kubeconfig file, uses in-cluster if not set")
+	rootCmd.Flags().StringVar(&namespace, "namespace", "hobbyfarm", "namespace in which objects are stored in remote cluster")
+	rootCmd.Flags().StringVar(&sqlDialect, "sql-dialect", "postgres", "sql dialect, postgres or sqlite")
+	rootCmd.Flags().StringVar(&sqlDSN, "sql-dsn", "", "data source name of the database")
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "sqlmigrate",
+	Short: "copy hobbyfarm resources from a kubernetes cluster into a sql database",
+	RunE:  app,
+}
+
+func app(cmd *cobra.Command, args []string) error {
+	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return fmt.Errorf("could not connect to kubernetes cluster: %v", err.Error())
+	}
+
+	kClient, err := client.NewWithWatch(cfg, client.Options{
+		Scheme: scheme.Scheme,
+	})
+	if err != nil {
+		return fmt.Errorf("could not build client: %v", err.Error())
+	}
+
+	dialect, err := sql.DialectFor(sqlDialect)
+	if err != nil {
+		return err
+	}
+
+	store, err := sql.Open(dialect, sqlDSN, scheme.Scheme)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+
+	if err := store.Migrate(cmd.Context()); err != nil {
+		return err
+	}
+
+	target, err := sql.V4Alpha1Storages(store)
+	if err != nil {
+		return err
+	}
+
+	counts, err := sql.Migrate(cmd.Context(), kubernetes.V4Alpha1Storages(kClient, namespace), target)
+	if err != nil {
+		return err
+	}
+
+	resources := make([]string, 0, len(counts))
+	for resource := range counts {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		fmt.Printf("%-24s %d\n", resource, counts[resource])
+	}
+
+	return nil
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
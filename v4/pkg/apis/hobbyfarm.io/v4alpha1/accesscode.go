@@ -62,5 +62,5 @@ type AccessCodeStatus struct {
 }
 
 func (a AccessCode) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -0,0 +1,108 @@
+package v4alpha1
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ConditionDiscoverySuccessful = "DiscoverySuccessful"
+
+	DefaultOIDCDisplayNameClaim = "name"
+	DefaultOIDCGroupsClaim      = "groups"
+)
+
+// DefaultOIDCScopes are requested when an OIDCConfig does not list any scopes.
+var DefaultOIDCScopes = []string{"openid", "profile", "email"}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OIDCConfig stores the configuration for OpenID Connect authentication against a specific identity provider.
+type OIDCConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OIDCConfigSpec   `json:"spec"`
+	Status OIDCConfigStatus `json:"status,omitempty"`
+}
+
+type OIDCConfigSpec struct {
+	ServerDisplayName string `json:"serverDisplayName"`
+
+	// IssuerURL is the issuer of the identity provider. The provider is discovered
+	// from IssuerURL + "/.well-known/openid-configuration".
+	IssuerURL string `json:"issuerURL"`
+
+	ClientID string `json:"clientID"`
+
+	// ClientSecretSecret is the name of the Secret holding the client secret in key 'clientSecret'.
+	// It may be left empty for public clients, which rely on PKCE alone.
+	ClientSecretSecret string `json:"clientSecretSecret,omitempty"`
+
+	// RedirectURL is the externally reachable URL of the callback endpoint,
+	// i.e. https://<apiserver>/auth/oidc/<name>/callback
+	RedirectURL string `json:"redirectURL"`
+
+	// Scopes requested from the identity provider. Defaults to openid, profile and email.
+	Scopes []string `json:"scopes,omitempty"`
+
+	// DisplayNameClaim is the ID token claim used as the display name of the user. Defaults to 'name'.
+	DisplayNameClaim string `json:"displayNameClaim,omitempty"`
+
+	// GroupsClaim is the ID token claim holding the identity provider groups of the user. Defaults to 'groups'.
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+
+	// PostLoginRedirectURL, if set, is where the browser is sent after a successful login.
+	// The issued token is passed in the URL fragment as 'token'.
+	PostLoginRedirectURL string `json:"postLoginRedirectURL,omitempty"`
+}
+
+type OIDCConfigStatus struct {
+	Conditions []genericcondition.GenericCondition `json:"conditions"`
+
+	// Issuer, AuthorizationEndpoint, TokenEndpoint and JWKSURI are populated from the discovery document.
+	Issuer                string `json:"issuer,omitempty"`
+	AuthorizationEndpoint string `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         string `json:"tokenEndpoint,omitempty"`
+	JWKSURI               string `json:"jwksURI,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type OIDCConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []OIDCConfig `json:"items"`
+}
+
+func (oc OIDCConfig) NamespaceScoped() bool {
+	return false
+}
+
+// RequestedScopes returns the scopes to request, falling back to DefaultOIDCScopes.
+func (oc OIDCConfig) RequestedScopes() []string {
+	if len(oc.Spec.Scopes) == 0 {
+		return DefaultOIDCScopes
+	}
+
+	return oc.Spec.Scopes
+}
+
+// DisplayNameClaim returns the claim to use as display name, falling back to DefaultOIDCDisplayNameClaim.
+func (oc OIDCConfig) DisplayNameClaim() string {
+	if oc.Spec.DisplayNameClaim == "" {
+		return DefaultOIDCDisplayNameClaim
+	}
+
+	return oc.Spec.DisplayNameClaim
+}
+
+// GroupsClaim returns the claim holding groups, falling back to DefaultOIDCGroupsClaim.
+func (oc OIDCConfig) GroupsClaim() string {
+	if oc.Spec.GroupsClaim == "" {
+		return DefaultOIDCGroupsClaim
+	}
+
+	return oc.Spec.GroupsClaim
+}
@@ -84,5 +84,5 @@ type CourseSpec struct {
 }
 
 func (c Course) NamespaceScoped() bool {
-	return false
+	return true
 }
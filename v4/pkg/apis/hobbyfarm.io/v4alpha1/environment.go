@@ -93,5 +93,5 @@ type EnvironmentStatus struct {
 }
 
 func (c Environment) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -59,6 +59,9 @@ type ObjectReference struct {
 	// Kind is the kind of the referenced object, e.g. Machine or AccessCode
 	Kind string `json:"kind"`
 
+	// Namespace is the tenant of the referenced object, empty for cluster-scoped objects
+	Namespace string `json:"namespace,omitempty"`
+
 	// Name is the name of the referenced object, e.g. m-djfks or ac-j913s
 	Name string `json:"name"`
 }
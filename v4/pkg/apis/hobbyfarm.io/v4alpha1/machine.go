@@ -5,6 +5,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	ConditionMachineProvisioned = "Provisioned"
+	ConditionMachineReady       = "Ready"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Machine is the record of an instance of a MachineTemplate as provisioned via a Provider into an Environment.
@@ -68,5 +73,5 @@ type MachineStatus struct {
 }
 
 func (c Machine) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -5,6 +5,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	ConditionMachineBound = "MachineBound"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // MachineClaim is an object representing a User's desire to claim a Machine for their exclusive use.
@@ -78,5 +82,5 @@ type MachineClaimStatus struct {
 }
 
 func (c MachineClaim) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -5,6 +5,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	ConditionScaleUpSuccessful   = "ScaleUpSuccessful"
+	ConditionScaleDownSuccessful = "ScaleDownSuccessful"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // MachineSet defines a collection of machines that are scheduled together.
@@ -83,5 +88,5 @@ type MachineSetStatus struct {
 }
 
 func (c MachineSet) NamespaceScoped() bool {
-	return false
+	return true
 }
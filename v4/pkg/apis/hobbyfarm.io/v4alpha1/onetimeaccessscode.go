@@ -110,9 +110,9 @@ type OneTimeAccessCodeSetList struct {
 }
 
 func (c OneTimeAccessCode) NamespaceScoped() bool {
-	return false
+	return true
 }
 
 func (c OneTimeAccessCodeSet) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -69,5 +69,5 @@ type PredefinedServiceSpec struct {
 }
 
 func (c PredefinedService) NamespaceScoped() bool {
-	return false
+	return true
 }
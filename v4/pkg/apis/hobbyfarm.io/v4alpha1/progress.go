@@ -70,5 +70,5 @@ type ProgressList struct {
 }
 
 func (c Progress) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -20,6 +20,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&GroupList{},
 		&LdapConfig{},
 		&LdapConfigList{},
+		&OIDCConfig{},
+		&OIDCConfigList{},
 		&Provider{},
 		&ProviderList{},
 		&MachineTemplate{},
@@ -68,6 +70,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&RoleBindingList{},
 		&Event{},
 		&EventList{},
+		&Tenant{},
+		&TenantList{},
 	)
 
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -14,6 +14,10 @@ type RoleBinding struct {
 	Role   string   `json:"role"`
 	Users  []string `json:"users"`
 	Groups []string `json:"groups"`
+
+	// Tenant restricts the rules of the role to the resources of a Tenant, and to reading the resources
+	// that all tenants share. Rolebindings without Tenant apply everywhere.
+	Tenant string `json:"tenant,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -78,5 +78,5 @@ type ScenarioSpec struct {
 }
 
 func (c Scenario) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -39,5 +39,5 @@ type ScenarioStepStatus struct {
 }
 
 func (c ScenarioStep) NamespaceScoped() bool {
-	return false
+	return true
 }
@@ -5,6 +5,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	ConditionMachineSetsCreated = "MachineSetsCreated"
+	ConditionExpired            = "Expired"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ScheduledEvent is the representation of a period of time during which content is available to users.
@@ -113,5 +118,5 @@ type ScheduledEventStatus struct {
 }
 
 func (c ScheduledEvent) NamespaceScoped() bool {
-	return false
+	return true
 }
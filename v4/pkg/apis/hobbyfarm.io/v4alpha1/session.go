@@ -3,6 +3,17 @@ package v4alpha1
 import (
 	genericcondition2 "github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"time"
+)
+
+const (
+	// DefaultSessionKeepaliveDuration is used when neither the course nor the scenario of a
+	// session define a KeepaliveDuration.
+	DefaultSessionKeepaliveDuration = 5 * time.Minute
+
+	// DefaultSessionPauseDuration is used when neither the course nor the scenario of a
+	// session define a PauseDuration.
+	DefaultSessionPauseDuration = 2 * time.Hour
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -54,15 +65,87 @@ type SessionStatus struct {
 	// Conditions is a slice of conditions that may impact this session.
 	Conditions []genericcondition2.GenericCondition `json:"conditions"`
 
-	// MachineClaim is the object name of the MachineClaim generated from this
-	// session.
-	MachineClaim string `json:"machineClaim"`
+	// MachineClaims is a slice of object names of the MachineClaims generated from this
+	// session. One MachineClaim is generated for every Machine required by the session.
+	MachineClaims []string `json:"machineClaims"`
+
+	// ClaimedScenario is the object name of the scenario for which MachineClaims were generated.
+	// When the user moves on to another scenario of a course with a MachinePersistenceStrategy of
+	// NewPerScenario, the claims are terminated and new claims are generated.
+	ClaimedScenario string `json:"claimedScenario,omitempty"`
+
+	// ExpirationTime is the time after which the machines of this session are reclaimed,
+	// unless it is extended by a keepalive.
+	ExpirationTime metav1.Time `json:"expirationTime,omitempty"`
+
+	// Paused is true when the user has paused this session.
+	Paused bool `json:"paused"`
+
+	// PauseExpirationTime is the time until which a paused session is protected from being reclaimed.
+	PauseExpirationTime metav1.Time `json:"pauseExpirationTime,omitempty"`
+
+	// Finished is true once the machines of this session have been reclaimed.
+	Finished bool `json:"finished"`
 
 	// Progress is a slice of Progress structs that detail, for a given scenario, what
 	// position the user is in.
 	Progress []Progress `json:"progress"`
 }
 
+// SessionKeepaliveDuration returns the period of time a session may go without a keepalive before its machines
+// are reclaimed. The KeepaliveDuration of the course takes precedence over that of the scenario.
+// Either course or scenario may be nil.
+func SessionKeepaliveDuration(course *Course, scenario *Scenario) (time.Duration, error) {
+	var courseDuration, scenarioDuration string
+	if course != nil {
+		courseDuration = course.Spec.KeepaliveDuration
+	}
+	if scenario != nil {
+		scenarioDuration = scenario.Spec.KeepaliveDuration
+	}
+
+	return sessionDuration(DefaultSessionKeepaliveDuration, courseDuration, scenarioDuration)
+}
+
+// SessionPauseDuration returns the period of time a session may be paused. The PauseDuration of the course
+// takes precedence over that of the scenario. Either course or scenario may be nil.
+func SessionPauseDuration(course *Course, scenario *Scenario) (time.Duration, error) {
+	var courseDuration, scenarioDuration string
+	if course != nil {
+		courseDuration = course.Spec.PauseDuration
+	}
+	if scenario != nil {
+		scenarioDuration = scenario.Spec.PauseDuration
+	}
+
+	return sessionDuration(DefaultSessionPauseDuration, courseDuration, scenarioDuration)
+}
+
+// SessionPauseBehavior returns the PauseBehavior of the course if set, otherwise that of the scenario.
+// Sessions cannot be paused unless the behavior is explicitly CanPause.
+func SessionPauseBehavior(course *Course, scenario *Scenario) PauseBehavior {
+	if course != nil && course.Spec.PauseBehavior != "" {
+		return course.Spec.PauseBehavior
+	}
+
+	if scenario != nil && scenario.Spec.PauseBehavior != "" {
+		return scenario.Spec.PauseBehavior
+	}
+
+	return CannotPause
+}
+
+func sessionDuration(defaultDuration time.Duration, courseDuration string, scenarioDuration string) (time.Duration, error) {
+	switch {
+	case courseDuration != "":
+		return time.ParseDuration(courseDuration)
+	case scenarioDuration != "":
+		return time.ParseDuration(scenarioDuration)
+	default:
+		return defaultDuration, nil
+	}
+}
+
 func (c Session) NamespaceScoped() bool {
-	return false
+	return true
 }
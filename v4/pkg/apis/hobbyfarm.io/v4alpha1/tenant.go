@@ -0,0 +1,39 @@
+package v4alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Tenant separates the HobbyFarm resources of one business unit from those of other tenants.
+// The name of a Tenant is the namespace in which its tenant-scoped resources (Environments, ScheduledEvents,
+// Scenarios, Sessions and the like) live. Cluster-scoped resources such as Providers and MachineTemplates
+// are shared by all tenants.
+type Tenant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec TenantSpec `json:"spec"`
+}
+
+type TenantSpec struct {
+	// DisplayName is the pretty name of the Tenant
+	DisplayName string `json:"displayName"`
+
+	// StorageNamespace is the namespace of the backing kubernetes cluster in which the resources of the
+	// Tenant are stored. If empty, the resources are stored in a namespace named after the namespace of
+	// HobbyFarm and the Tenant, i.e. <namespace>-<tenant>. It is not used by the sql and memory storages.
+	StorageNamespace string `json:"storageNamespace,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type TenantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Tenant `json:"items"`
+}
+
+func (t Tenant) NamespaceScoped() bool {
+	return false
+}
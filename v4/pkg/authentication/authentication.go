@@ -8,6 +8,7 @@ import (
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/group"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/providers/ldap"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/providers/local"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/providers/oidc"
 	"github.com/hobbyfarm/gargantua/v4/pkg/gvkr"
 	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -42,6 +43,7 @@ func SetupAuthentication(ctx context.Context, cfg *rest.Config, mux *kmux.PathRe
 
 	local.New(kclient, userCache, genericTokenGV, authRouter.PathPrefix("/local/").Subrouter())
 	ldap.New(kclient, userCache, genericTokenGV, authRouter.PathPrefix("/ldap/").Subrouter())
+	oidc.New(kclient, userCache, genericTokenGV, authRouter.PathPrefix("/oidc/").Subrouter())
 
 	return []cache.Cache{userCache}, nil
 }
@@ -58,6 +60,7 @@ func setupUserCache(ctx context.Context, cfg *rest.Config) (cache.Cache, error)
 	indexers := []map[string]client.IndexerFunc{
 		ldap.Indexers(),
 		local.Indexers(),
+		oidc.Indexers(),
 	}
 
 	for _, v := range indexers {
@@ -76,6 +79,10 @@ func setupUserCache(ctx context.Context, cfg *rest.Config) (cache.Cache, error)
 		return nil, err
 	}
 
+	if err := userGroupCache.IndexField(ctx, &v4alpha1.Group{}, oidc.GroupProviderMembersIndex, group.GroupProviderIndexer("oidc")); err != nil {
+		return nil, err
+	}
+
 	return userGroupCache, nil
 }
 
@@ -49,6 +49,18 @@ func NewGenericGeneratorValidator(kclient client.Client) GenericGeneratorValidat
 	}
 }
 
+// NewGenericValidator returns a GenericGeneratorValidator for the given signing secret that is used to validate
+// tokens only. Tokens it generates use the default expiration time, as it cannot look up the expiration setting.
+func NewGenericValidator(signingSecret string) GenericGeneratorValidator {
+	if signingSecret == "" {
+		signingSecret = defaultSigningSecret
+	}
+
+	return GenericGeneratorValidator{
+		signingSecret: signingSecret,
+	}
+}
+
 func (gv GenericGeneratorValidator) GenerateToken(user *user.User, principal string) (string, error) {
 	claims := Claims{
 		StandardClaims: jwt.StandardClaims{
@@ -109,6 +121,9 @@ func (gv GenericGeneratorValidator) AuthenticateRequest(req *http.Request) (*aut
 
 func (gv GenericGeneratorValidator) getExpirationTime() *time.Time {
 	var timeout = defaultExpirationTime
+	if gv.kclient == nil {
+		return &timeout
+	}
 
 	set := &v4alpha1.Setting{}
 	if err := gv.kclient.Get(context.TODO(), client.ObjectKey{
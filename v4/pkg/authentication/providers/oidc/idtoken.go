@@ -0,0 +1,250 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"slices"
+	"strings"
+	"time"
+)
+
+// clockSkew is the leeway allowed between the clocks of hobbyfarm and the identity provider.
+const clockSkew = time.Minute
+
+type tokenResponse struct {
+	IDToken string `json:"id_token"`
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// idTokenClaims are the claims of a verified ID token.
+type idTokenClaims jwt.MapClaims
+
+func (c idTokenClaims) subject() string {
+	sub, _ := c["sub"].(string)
+	return sub
+}
+
+// stringClaim returns the claim as string, or an empty string if it is not set or not a string.
+func (c idTokenClaims) stringClaim(name string) string {
+	s, _ := c[name].(string)
+	return s
+}
+
+// stringsClaim returns the claim as a list of strings. A single string is treated as a list of one.
+func (c idTokenClaims) stringsClaim(name string) []string {
+	switch v := c[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var out = make([]string, 0, len(v))
+		for _, vv := range v {
+			if s, ok := vv.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+
+	return nil
+}
+
+// exchangeCode redeems the authorization code at the token endpoint and returns the raw ID token.
+func (p *Provider) exchangeCode(ctx context.Context, oc *v4alpha1.OIDCConfig, code string, verifier string) (string, error) {
+	if code == "" {
+		return "", fmt.Errorf("no authorization code in callback")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", oc.Spec.RedirectURL)
+	form.Set("client_id", oc.Spec.ClientID)
+	form.Set("code_verifier", verifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oc.Status.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	if oc.Spec.ClientSecretSecret != "" {
+		secret, err := p.clientSecret(ctx, oc)
+		if err != nil {
+			return "", err
+		}
+
+		// client_secret_basic, see RFC 6749 section 2.3.1
+		req.SetBasicAuth(url.QueryEscape(oc.Spec.ClientID), url.QueryEscape(secret))
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token endpoint returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	var tr = &tokenResponse{}
+	if err := json.Unmarshal(body, tr); err != nil {
+		return "", err
+	}
+
+	if tr.IDToken == "" {
+		return "", fmt.Errorf("token response contains no id_token")
+	}
+
+	return tr.IDToken, nil
+}
+
+func (p *Provider) clientSecret(ctx context.Context, oc *v4alpha1.OIDCConfig) (string, error) {
+	secret := &v4alpha1.Secret{}
+	if err := p.kclient.Get(ctx, client.ObjectKey{Name: oc.Spec.ClientSecretSecret}, secret); err != nil {
+		return "", err
+	}
+
+	data, ok := secret.Data["clientSecret"]
+	if !ok {
+		return "", fmt.Errorf("key 'clientSecret' not found in secret data")
+	}
+
+	return string(data), nil
+}
+
+// verifyIDToken checks the signature of the ID token against the keys of the identity provider, and
+// validates its claims as described in OpenID Connect Core 1.0 section 3.1.3.7.
+func (p *Provider) verifyIDToken(ctx context.Context, oc *v4alpha1.OIDCConfig, raw string, nonce string) (idTokenClaims, error) {
+	keys, err := p.fetchKeys(ctx, oc.Status.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	// exp and iat are checked below, allowing for clock skew
+	parser := &jwt.Parser{
+		ValidMethods:         []string{"RS256", "RS384", "RS512"},
+		SkipClaimsValidation: true,
+	}
+
+	mc := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(raw, mc, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return keys.find(kid)
+	}); err != nil {
+		return nil, err
+	}
+
+	claims := idTokenClaims(mc)
+	now := time.Now()
+
+	if iss := claims.stringClaim("iss"); iss != oc.Status.Issuer {
+		return nil, fmt.Errorf("unexpected issuer %s", iss)
+	}
+
+	if !slices.Contains(claims.stringsClaim("aud"), oc.Spec.ClientID) {
+		return nil, fmt.Errorf("token audience does not contain client id %s", oc.Spec.ClientID)
+	}
+
+	if !mc.VerifyExpiresAt(now.Add(-clockSkew).Unix(), true) {
+		return nil, fmt.Errorf("token has expired")
+	}
+
+	if !mc.VerifyIssuedAt(now.Add(clockSkew).Unix(), false) {
+		return nil, fmt.Errorf("token issued in the future")
+	}
+
+	if claims.stringClaim("nonce") != nonce {
+		return nil, fmt.Errorf("nonce mismatch")
+	}
+
+	if claims.subject() == "" {
+		return nil, fmt.Errorf("token has no subject")
+	}
+
+	return claims, nil
+}
+
+func (p *Provider) fetchKeys(ctx context.Context, jwksURI string) (*jsonWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", jwksURI, resp.StatusCode)
+	}
+
+	var set = &jsonWebKeySet{}
+	if err := json.NewDecoder(resp.Body).Decode(set); err != nil {
+		return nil, err
+	}
+
+	return set, nil
+}
+
+// find returns the RSA public key with the given kid. A token without kid may only be
+// verified by a key set holding a single key.
+func (s *jsonWebKeySet) find(kid string) (*rsa.PublicKey, error) {
+	var candidates []jsonWebKey
+	for _, k := range s.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+
+		if kid == "" || k.Kid == kid {
+			candidates = append(candidates, k)
+		}
+	}
+
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("no unique signing key found for kid '%s'", kid)
+	}
+
+	n, err := base64.RawURLEncoding.DecodeString(candidates[0].N)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(candidates[0].E)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
@@ -0,0 +1,182 @@
+package oidc
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/authenticators/token"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/providers"
+	user2 "github.com/hobbyfarm/gargantua/v4/pkg/authentication/user"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v4/pkg/statuswriter"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strings"
+	"time"
+)
+
+type Provider struct {
+	kclient    client.Client
+	userCache  cache.Cache
+	httpClient *http.Client
+	token.TokenGeneratorValidator
+	*mux.Router
+}
+
+func New(kclient client.Client, userCache cache.Cache, tok token.TokenGeneratorValidator, router *mux.Router) *Provider {
+	p := &Provider{
+		kclient:                 kclient,
+		userCache:               userCache,
+		httpClient:              &http.Client{Timeout: 10 * time.Second},
+		TokenGeneratorValidator: tok,
+		Router:                  router,
+	}
+
+	p.HandleFunc("/{config}/login", p.HandleLogin).Methods(http.MethodGet)
+	p.HandleFunc("/{config}/callback", p.HandleCallback).Methods(http.MethodGet)
+
+	return p
+}
+
+func Indexers() map[string]client.IndexerFunc {
+	return map[string]client.IndexerFunc{
+		labels2.OIDCPrincipalKey: oidcPrincipalIndexer,
+	}
+}
+
+// HandleLogin starts the authorization code flow by redirecting the browser to the identity provider.
+func (p *Provider) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	oc, err := p.getConfig(r.Context(), mux.Vars(r)["config"])
+	if err != nil {
+		slog.Error("error retrieving oidc config", "oidcConfig", mux.Vars(r)["config"], "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	ls, err := newLoginState()
+	if err != nil {
+		slog.Error("error generating oidc login state", "error", err.Error())
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	authURL, err := url.Parse(oc.Status.AuthorizationEndpoint)
+	if err != nil {
+		slog.Error("invalid oidc authorization endpoint", "oidcConfig", oc.Name, "error", err.Error())
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	q := authURL.Query()
+	q.Set("response_type", "code")
+	q.Set("client_id", oc.Spec.ClientID)
+	q.Set("redirect_uri", oc.Spec.RedirectURL)
+	q.Set("scope", strings.Join(oc.RequestedScopes(), " "))
+	q.Set("state", ls.State)
+	q.Set("nonce", ls.Nonce)
+	q.Set("code_challenge", codeChallenge(ls.Verifier))
+	q.Set("code_challenge_method", "S256")
+	authURL.RawQuery = q.Encode()
+
+	setLoginCookie(w, r, oc.Name, ls)
+	http.Redirect(w, r, authURL.String(), http.StatusFound)
+}
+
+// HandleCallback completes the authorization code flow. The code is exchanged for an ID token
+// which is verified, mapped to a hobbyfarm user, and swapped for a hobbyfarm token.
+func (p *Provider) HandleCallback(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["config"]
+
+	ls, err := readLoginCookie(r, name)
+	clearLoginCookie(w, r, name)
+	if err != nil {
+		slog.Info("error reading oidc login state", "oidcConfig", name, "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		slog.Info("oidc authorization failed", "oidcConfig", name, "error", e,
+			"description", query.Get("error_description"))
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(query.Get("state")), []byte(ls.State)) != 1 {
+		slog.Info("oidc state mismatch", "oidcConfig", name)
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	oc, err := p.getConfig(r.Context(), name)
+	if err != nil {
+		slog.Error("error retrieving oidc config", "oidcConfig", name, "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	rawIDToken, err := p.exchangeCode(r.Context(), oc, query.Get("code"), ls.Verifier)
+	if err != nil {
+		slog.Info("error exchanging oidc authorization code", "oidcConfig", name, "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	claims, err := p.verifyIDToken(r.Context(), oc, rawIDToken, ls.Nonce)
+	if err != nil {
+		slog.Info("invalid oidc id token", "oidcConfig", name, "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	principal := principalFor(oc.Status.Issuer, claims.subject())
+	user, err := p.findOrCreateHfUser(r.Context(), oc, principal, claims)
+	if err != nil {
+		slog.Error("error looking up or creating user", "oidcConfig", name, "error", err.Error())
+		statuswriter.WriteError(errors.NewUnauthorized(providers.Unauthorized), w)
+		return
+	}
+
+	tok, err := p.GenerateToken(user2.FromV4Alpha1User(user), principal)
+	if err != nil {
+		slog.Error("error generating token", "error", err.Error(), "user", user.Name)
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	if oc.Spec.PostLoginRedirectURL != "" {
+		http.Redirect(w, r, oc.Spec.PostLoginRedirectURL+"#token="+url.QueryEscape(tok), http.StatusFound)
+		return
+	}
+
+	statuswriter.WriteSuccess(tok, w)
+}
+
+func (p *Provider) getConfig(ctx context.Context, name string) (*v4alpha1.OIDCConfig, error) {
+	oc := &v4alpha1.OIDCConfig{}
+	if err := p.kclient.Get(ctx, client.ObjectKey{Name: name}, oc); err != nil {
+		return nil, err
+	}
+
+	if !genericcondition.IsTrue(oc.Status.Conditions, v4alpha1.ConditionDiscoverySuccessful) {
+		return nil, fmt.Errorf("identity provider of oidc config %s has not been discovered", name)
+	}
+
+	return oc, nil
+}
+
+// principalFor builds the principal of a user from the issuer and subject of an ID token.
+// The pair is the only stable and unique identifier of an end user, see OpenID Connect Core 1.0 section 5.7.
+func principalFor(issuer string, subject string) string {
+	host := strings.TrimPrefix(strings.TrimPrefix(issuer, "https://"), "http://")
+
+	return "oidc://" + strings.TrimSuffix(host, "/") + "/" + subject
+}
@@ -0,0 +1,347 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/gorilla/mux"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/group"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/user"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testClientID    = "hobbyfarm"
+	testRedirectURL = "https://hobbyfarm.example.com/auth/oidc/corp/callback"
+)
+
+// mockIdP is a minimal OpenID provider supporting the authorization code flow with PKCE.
+type mockIdP struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	subject string
+	claims  jwt.MapClaims
+
+	lock  sync.Mutex
+	codes map[string]url.Values
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	idp := &mockIdP{key: key, codes: map[string]url.Values{}}
+
+	m := http.NewServeMux()
+	m.HandleFunc("/authorize", idp.authorize)
+	m.HandleFunc("/token", idp.token)
+	m.HandleFunc("/jwks", idp.jwks)
+	idp.Server = httptest.NewServer(m)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+func (idp *mockIdP) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != testClientID || q.Get("response_type") != "code" ||
+		q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	code, _ := randomString()
+
+	idp.lock.Lock()
+	idp.codes[code] = q
+	idp.lock.Unlock()
+
+	http.Redirect(w, r, q.Get("redirect_uri")+"?code="+code+"&state="+url.QueryEscape(q.Get("state")), http.StatusFound)
+}
+
+func (idp *mockIdP) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+
+	idp.lock.Lock()
+	auth, ok := idp.codes[r.PostForm.Get("code")]
+	delete(idp.codes, r.PostForm.Get("code"))
+	idp.lock.Unlock()
+
+	if !ok || r.PostForm.Get("redirect_uri") != auth.Get("redirect_uri") ||
+		codeChallenge(r.PostForm.Get("code_verifier")) != auth.Get("code_challenge") {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+
+	claims := jwt.MapClaims{
+		"iss":   idp.URL,
+		"sub":   idp.subject,
+		"aud":   []string{testClientID},
+		"nonce": auth.Get("nonce"),
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range idp.claims {
+		claims[k] = v
+	}
+
+	tok := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tok.Header["kid"] = "test-key"
+	signed, err := tok.SignedString(idp.key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"access_token": "access",
+		"token_type":   "Bearer",
+		"id_token":     signed,
+	})
+}
+
+func (idp *mockIdP) jwks(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(jsonWebKeySet{Keys: []jsonWebKey{{
+		Kty: "RSA",
+		Kid: "test-key",
+		N:   base64.RawURLEncoding.EncodeToString(idp.key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(idp.key.E)).Bytes()),
+	}}})
+}
+
+// indexedCache serves the cache reads of the provider from a fake client.
+type indexedCache struct {
+	cache.Cache
+	kclient client.Client
+}
+
+func (c indexedCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return c.kclient.Get(ctx, key, obj, opts...)
+}
+
+func (c indexedCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	return c.kclient.List(ctx, list, opts...)
+}
+
+type stubTokens struct{}
+
+func (stubTokens) GenerateToken(u *user.User, principal string) (string, error) {
+	return u.Name + "|" + principal, nil
+}
+
+func (stubTokens) ValidateToken(string) (*user.User, bool) {
+	return nil, false
+}
+
+func setup(t *testing.T, idp *mockIdP) (*Provider, client.Client) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &v4alpha1.OIDCConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp"},
+		Spec: v4alpha1.OIDCConfigSpec{
+			IssuerURL:   idp.URL,
+			ClientID:    testClientID,
+			RedirectURL: testRedirectURL,
+		},
+		Status: v4alpha1.OIDCConfigStatus{
+			Issuer:                idp.URL,
+			AuthorizationEndpoint: idp.URL + "/authorize",
+			TokenEndpoint:         idp.URL + "/token",
+			JWKSURI:               idp.URL + "/jwks",
+		},
+	}
+	config.Status.Conditions = genericcondition.SetCondition(nil, v4alpha1.ConditionDiscoverySuccessful,
+		corev1.ConditionTrue, "", "")
+
+	trainers := &v4alpha1.Group{
+		ObjectMeta: metav1.ObjectMeta{Name: "trainers"},
+		Spec: v4alpha1.GroupSpec{
+			ProviderMembers: map[string][]string{"oidc": {"corp-trainers"}},
+		},
+	}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).
+		WithObjects(config, trainers).
+		WithStatusSubresource(&v4alpha1.User{}, &v4alpha1.OIDCConfig{}).
+		WithIndex(&v4alpha1.User{}, labels2.OIDCPrincipalKey, oidcPrincipalIndexer).
+		WithIndex(&v4alpha1.Group{}, GroupProviderMembersIndex, group.GroupProviderIndexer("oidc")).
+		Build()
+
+	router := mux.NewRouter().PathPrefix("/auth/").Subrouter().PathPrefix("/oidc/").Subrouter()
+	p := New(kclient, indexedCache{kclient: kclient}, stubTokens{}, router)
+
+	return p, kclient
+}
+
+// login runs the browser side of the flow and returns the response of the callback.
+func login(t *testing.T, p *Provider, tamper func(callback *http.Request)) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/corp/login", nil))
+	if rec.Code != http.StatusFound {
+		t.Fatalf("expected login to redirect, got %d: %s", rec.Code, rec.Body.String())
+	}
+	cookies := rec.Result().Cookies()
+
+	noFollow := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := noFollow.Get(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("expected idp to redirect, got %d", resp.StatusCode)
+	}
+
+	callback := httptest.NewRequest(http.MethodGet, resp.Header.Get("Location"), nil)
+	for _, c := range cookies {
+		callback.AddCookie(c)
+	}
+	if tamper != nil {
+		tamper(callback)
+	}
+
+	rec = httptest.NewRecorder()
+	p.ServeHTTP(rec, callback)
+
+	return rec
+}
+
+func Test_LoginCreatesUser(t *testing.T) {
+	idp := newMockIdP(t)
+	idp.subject = "248289761001"
+	idp.claims = jwt.MapClaims{"name": "Jane Doe", "groups": []string{"corp-trainers", "corp-other"}}
+
+	p, kclient := setup(t, idp)
+
+	rec := login(t, p, nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected login to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	users := &v4alpha1.UserList{}
+	if err := kclient.List(context.Background(), users); err != nil {
+		t.Fatal(err)
+	}
+	if len(users.Items) != 1 {
+		t.Fatalf("expected one user to be created, got %d", len(users.Items))
+	}
+
+	u := users.Items[0]
+	principal := principalFor(idp.URL, idp.subject)
+	if u.Spec.DisplayName != "Jane Doe" {
+		t.Errorf("expected display name Jane Doe, got %s", u.Spec.DisplayName)
+	}
+	if u.Spec.Principals["oidc"] != principal {
+		t.Errorf("expected principal %s, got %s", principal, u.Spec.Principals["oidc"])
+	}
+	if len(u.Status.GroupMemberships) != 1 || u.Status.GroupMemberships[0] != "trainers" {
+		t.Errorf("expected membership of group trainers, got %v", u.Status.GroupMemberships)
+	}
+	if !strings.Contains(rec.Body.String(), u.Name+"|"+principal) {
+		t.Errorf("expected token for %s in response, got %s", u.Name, rec.Body.String())
+	}
+
+	// logging in again maps to the same user, with the latest claims
+	idp.claims = jwt.MapClaims{"name": "Jane Smith"}
+	if rec := login(t, p, nil); rec.Code != http.StatusOK {
+		t.Fatalf("expected second login to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	if err := kclient.List(context.Background(), users); err != nil {
+		t.Fatal(err)
+	}
+	if len(users.Items) != 1 {
+		t.Fatalf("expected existing user to be reused, got %d users", len(users.Items))
+	}
+	if users.Items[0].Spec.DisplayName != "Jane Smith" || len(users.Items[0].Status.GroupMemberships) != 0 {
+		t.Errorf("expected user to be updated from claims, got %s %v", users.Items[0].Spec.DisplayName,
+			users.Items[0].Status.GroupMemberships)
+	}
+}
+
+func Test_LoginRejectsTamperedCallback(t *testing.T) {
+	idp := newMockIdP(t)
+	idp.subject = "248289761001"
+
+	tests := map[string]func(r *http.Request){
+		"state mismatch": func(r *http.Request) {
+			q := r.URL.Query()
+			q.Set("state", "forged")
+			r.URL.RawQuery = q.Encode()
+		},
+		"missing login cookie": func(r *http.Request) {
+			r.Header.Del("Cookie")
+		},
+		"pkce verifier mismatch": func(r *http.Request) {
+			ls, _ := newLoginState()
+			ls.State = r.URL.Query().Get("state")
+			data, _ := json.Marshal(ls)
+			r.Header.Del("Cookie")
+			r.AddCookie(&http.Cookie{Name: loginCookiePrefix + "corp", Value: base64.RawURLEncoding.EncodeToString(data)})
+		},
+	}
+
+	for name, tamper := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, kclient := setup(t, idp)
+
+			if rec := login(t, p, tamper); rec.Code != http.StatusUnauthorized {
+				t.Errorf("expected login to be rejected, got %d: %s", rec.Code, rec.Body.String())
+			}
+
+			users := &v4alpha1.UserList{}
+			if err := kclient.List(context.Background(), users); err != nil {
+				t.Fatal(err)
+			}
+			if len(users.Items) != 0 {
+				t.Errorf("expected no user to be created, got %d", len(users.Items))
+			}
+		})
+	}
+}
+
+func Test_CodeChallenge(t *testing.T) {
+	// example from RFC 7636 appendix B
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	if got := codeChallenge(verifier); got != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("unexpected code challenge %s", got)
+	}
+}
@@ -0,0 +1,106 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	loginCookiePrefix = "hf-oidc-"
+	loginCookiePath   = "/auth/oidc/"
+
+	// loginCookieMaxAge bounds how long a user may take to authenticate at the identity provider.
+	loginCookieMaxAge = 600
+)
+
+// loginState is kept in a cookie between the login redirect and the callback, so that
+// any apiserver replica can complete a login started by another one.
+type loginState struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+}
+
+func newLoginState() (*loginState, error) {
+	var ls = &loginState{}
+	var err error
+
+	if ls.State, err = randomString(); err != nil {
+		return nil, err
+	}
+
+	if ls.Nonce, err = randomString(); err != nil {
+		return nil, err
+	}
+
+	// 32 random bytes encode to a 43 character verifier, the minimum length allowed by RFC 7636
+	if ls.Verifier, err = randomString(); err != nil {
+		return nil, err
+	}
+
+	return ls, nil
+}
+
+// codeChallenge derives the S256 PKCE code challenge from the verifier. See RFC 7636 section 4.2.
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func randomString() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func setLoginCookie(w http.ResponseWriter, r *http.Request, config string, ls *loginState) {
+	data, _ := json.Marshal(ls)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     loginCookiePrefix + config,
+		Value:    base64.RawURLEncoding.EncodeToString(data),
+		Path:     loginCookiePath,
+		MaxAge:   loginCookieMaxAge,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+func readLoginCookie(r *http.Request, config string) (*loginState, error) {
+	cookie, err := r.Cookie(loginCookiePrefix + config)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cookie.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var ls = &loginState{}
+	if err := json.Unmarshal(data, ls); err != nil {
+		return nil, err
+	}
+
+	return ls, nil
+}
+
+func clearLoginCookie(w http.ResponseWriter, r *http.Request, config string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     loginCookiePrefix + config,
+		Value:    "",
+		Path:     loginCookiePath,
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
@@ -0,0 +1,131 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"slices"
+	"time"
+)
+
+const (
+	providerName = "oidc"
+
+	GroupProviderMembersIndex = "group-provider-members-oidc"
+)
+
+// oidcPrincipalIndexer is an indexing function for user objects that have an OIDC
+// principal annotation. On success it returns the principal of the user, nil slice otherwise.
+func oidcPrincipalIndexer(obj client.Object) []string {
+	anno, ok := obj.GetAnnotations()[labels2.OIDCPrincipalKey]
+	if !ok {
+		return nil
+	}
+
+	return []string{anno}
+}
+
+func (p *Provider) findOrCreateHfUser(ctx context.Context, oc *v4alpha1.OIDCConfig, principal string,
+	claims idTokenClaims) (*v4alpha1.User, error) {
+	user, err := p.findHfUser(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	if user == nil {
+		user = &v4alpha1.User{
+			ObjectMeta: v1.ObjectMeta{
+				GenerateName: "u-",
+			},
+		}
+	}
+
+	user.Spec.DisplayName = displayName(oc, claims)
+
+	if len(user.Spec.Principals) == 0 {
+		user.Spec.Principals = make(map[string]string, 1)
+	}
+	user.Spec.Principals[providerName] = principal
+
+	if user.Annotations == nil {
+		user.Annotations = make(map[string]string, 1)
+	}
+	user.Annotations[labels2.OIDCPrincipalKey] = principal
+
+	if user.Name == "" {
+		err = p.kclient.Create(ctx, user)
+	} else {
+		err = p.kclient.Update(ctx, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user.Status.GroupMemberships = p.hfGroupsFromOIDCGroups(ctx, claims.stringsClaim(oc.GroupsClaim()))
+	user.Status.LastLoginTimestamp = v1.Time{Time: time.Now()}
+
+	if err := p.kclient.Status().Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (p *Provider) findHfUser(ctx context.Context, principal string) (*v4alpha1.User, error) {
+	var users = &v4alpha1.UserList{}
+	if err := p.userCache.List(ctx, users, client.MatchingFields{
+		labels2.OIDCPrincipalKey: principal,
+	}); err != nil {
+		return nil, err
+	}
+
+	if len(users.Items) == 0 {
+		return nil, nil
+	}
+
+	if len(users.Items) > 1 {
+		return nil, fmt.Errorf("more than one user found with oidc principal: %s", principal)
+	}
+
+	return &users.Items[0], nil
+}
+
+// hfGroupsFromOIDCGroups returns the hobbyfarm groups that list any of the identity provider groups
+// as provider members.
+func (p *Provider) hfGroupsFromOIDCGroups(ctx context.Context, oidcGroups []string) []string {
+	var groups = []string{}
+	for _, og := range oidcGroups {
+		var groupList = &v4alpha1.GroupList{}
+		if err := p.userCache.List(ctx, groupList, client.MatchingFields{
+			GroupProviderMembersIndex: og,
+		}); err != nil {
+			slog.Error("listing groups using cache and index "+GroupProviderMembersIndex,
+				"error", err.Error())
+			return nil
+		}
+
+		for _, g := range groupList.Items {
+			if !slices.Contains(groups, g.Name) {
+				groups = append(groups, g.Name)
+			}
+		}
+	}
+
+	return groups
+}
+
+// displayName returns the configured display name claim, falling back to claims
+// that identity providers commonly populate.
+func displayName(oc *v4alpha1.OIDCConfig, claims idTokenClaims) string {
+	for _, c := range []string{oc.DisplayNameClaim(), "preferred_username", "email"} {
+		if v := claims.stringClaim(c); v != "" {
+			return v
+		}
+	}
+
+	return claims.subject()
+}
@@ -2,9 +2,12 @@ package authorization
 
 import (
 	"context"
+	"fmt"
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	user2 "github.com/hobbyfarm/gargantua/v4/pkg/authentication/user"
 	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	"k8s.io/apiserver/pkg/storage"
@@ -16,29 +19,38 @@ var _ authorizer.Authorizer = (*Authorizer)(nil)
 type Authorizer struct {
 	roleBindingLister strategy.Lister
 	roleGetter        strategy.Getter
+	tenantGetter      strategy.Getter
 	unprotectedPaths  []string
 }
 
-func NewAuthorizer(roleBindingLister strategy.Lister, roleGetter strategy.Getter, unprotectedPaths ...string) Authorizer {
+func NewAuthorizer(roleBindingLister strategy.Lister, roleGetter strategy.Getter, tenantGetter strategy.Getter,
+	unprotectedPaths ...string) Authorizer {
 	return Authorizer{
 		roleBindingLister: roleBindingLister,
 		roleGetter:        roleGetter,
+		tenantGetter:      tenantGetter,
 		unprotectedPaths:  unprotectedPaths,
 	}
 }
 
 func (az Authorizer) Authorize(ctx context.Context, a authorizer.Attributes) (authorized authorizer.Decision, reason string, err error) {
-	// regex match against unprotected paths
-	for _, v := range az.unprotectedPaths {
-		if ok, err := regexp.MatchString(v, a.GetPath()); ok && err == nil {
-			return authorizer.DecisionAllow, "", nil
-		}
+	if az.Unprotected(a.GetPath()) {
+		return authorizer.DecisionAllow, "", nil
 	}
 
 	if az.CheckSuperuser(a.GetUser()) {
 		return authorizer.DecisionAllow, "", nil
 	}
 
+	// requests to namespaces are requests to the resources of a tenant, which has to exist
+	if ns := a.GetNamespace(); ns != "" {
+		if _, err := az.tenantGetter.Get(ctx, "", ns); errors.IsNotFound(err) {
+			return authorizer.DecisionDeny, fmt.Sprintf("tenant %s not found", ns), nil
+		} else if err != nil {
+			return authorizer.DecisionDeny, "error looking up tenant", nil
+		}
+	}
+
 	// first, get bindings for the user and its groups
 	bindings, err := az.GetBindings(ctx, a.GetUser())
 	if err != nil {
@@ -46,6 +58,10 @@ func (az Authorizer) Authorize(ctx context.Context, a authorizer.Attributes) (au
 	}
 
 	for _, b := range bindings {
+		if !bindingApplies(b, a) {
+			continue
+		}
+
 		// get rules for each binding
 		r, err := az.roleGetter.Get(ctx, "", b.Role)
 		if err != nil {
@@ -65,6 +81,46 @@ func (az Authorizer) Authorize(ctx context.Context, a authorizer.Attributes) (au
 	return authorizer.DecisionDeny, "denied", nil
 }
 
+// AllowedTenants returns whether a cluster-scoped request is allowed by a rolebinding without tenant, or
+// else the tenants whose rolebindings allow it. The storages of tenant-filtered resources use it to only
+// return the objects of those tenants.
+func (az Authorizer) AllowedTenants(ctx context.Context, a authorizer.Attributes) (bool, sets.Set[string], error) {
+	tenants := sets.New[string]()
+	if az.CheckSuperuser(a.GetUser()) {
+		return true, tenants, nil
+	}
+
+	bindings, err := az.GetBindings(ctx, a.GetUser())
+	if err != nil {
+		return false, nil, err
+	}
+
+	for _, b := range bindings {
+		if !bindingApplies(b, a) {
+			continue
+		}
+
+		r, err := az.roleGetter.Get(ctx, "", b.Role)
+		if err != nil {
+			continue
+		}
+
+		if !compile(r.(*v4alpha1.Role)).allows(a) {
+			continue
+		}
+
+		if b.Tenant == "" {
+			return true, tenants, nil
+		}
+
+		if _, err := az.tenantGetter.Get(ctx, "", b.Tenant); err == nil {
+			tenants.Insert(b.Tenant)
+		}
+	}
+
+	return false, tenants, nil
+}
+
 func (az Authorizer) GetBindings(ctx context.Context, u user.Info) (result []v4alpha1.RoleBinding, err error) {
 	bindings, err := az.roleBindingLister.List(ctx, "", storage.ListOptions{})
 	if err != nil {
@@ -81,6 +137,18 @@ func (az Authorizer) GetBindings(ctx context.Context, u user.Info) (result []v4a
 	return
 }
 
+// Unprotected returns whether the path matches one of the unprotected paths, which everyone may access.
+func (az Authorizer) Unprotected(path string) bool {
+	// regex match against unprotected paths
+	for _, v := range az.unprotectedPaths {
+		if ok, err := regexp.MatchString(v, path); ok && err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (az Authorizer) CheckSuperuser(u user.Info) bool {
 	for _, group := range u.GetGroups() {
 		if group == user.SystemPrivilegedGroup {
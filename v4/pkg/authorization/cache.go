@@ -0,0 +1,448 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/storage"
+	"log/slog"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultDecisionTTL = 5 * time.Second
+
+	// maxDecisions bounds the decision cache, it is cleared once it is full
+	maxDecisions = 10000
+
+	// resyncDelay is the delay before a failed or closed watch is started again
+	resyncDelay = time.Second
+)
+
+var _ authorizer.Authorizer = (*CachedAuthorizer)(nil)
+
+type decision struct {
+	allowed bool
+	expires time.Time
+}
+
+// CachedAuthorizer authorizes requests like Authorizer does, but from rolebindings, roles and tenants it keeps
+// in memory. Rolebindings are indexed by their users and groups, and the rules of roles are compiled
+// once. Decisions are cached for the DecisionTTL, every change to a rolebinding, role or tenant clears the
+// cache. Until Run has synced them, requests are passed to the Authorizer.
+type CachedAuthorizer struct {
+	Authorizer
+
+	bindingStorage strategy.CompleteStrategy
+	roleStorage    strategy.CompleteStrategy
+	tenantStorage  strategy.CompleteStrategy
+
+	lock           sync.RWMutex
+	bindingsSynced bool
+	rolesSynced    bool
+	tenantsSynced  bool
+	bindings       map[string]v4alpha1.RoleBinding
+	byUser         map[string]sets.Set[string]
+	byGroup        map[string]sets.Set[string]
+	roles          map[string]ruleSet
+	tenants        sets.Set[string]
+	decisions      map[string]decision
+	generation     uint64
+
+	// DecisionTTL is the time for which decisions are cached
+	DecisionTTL time.Duration
+}
+
+func NewCachedAuthorizer(roleBindings strategy.CompleteStrategy, roles strategy.CompleteStrategy,
+	tenants strategy.CompleteStrategy, unprotectedPaths ...string) *CachedAuthorizer {
+	return &CachedAuthorizer{
+		Authorizer:     NewAuthorizer(roleBindings, roles, tenants, unprotectedPaths...),
+		bindingStorage: roleBindings,
+		roleStorage:    roles,
+		tenantStorage:  tenants,
+		bindings:       map[string]v4alpha1.RoleBinding{},
+		byUser:         map[string]sets.Set[string]{},
+		byGroup:        map[string]sets.Set[string]{},
+		roles:          map[string]ruleSet{},
+		tenants:        sets.New[string](),
+		decisions:      map[string]decision{},
+		DecisionTTL:    defaultDecisionTTL,
+	}
+}
+
+// Run keeps the rolebindings, roles and tenants in sync with their storages until the context is done.
+func (c *CachedAuthorizer) Run(ctx context.Context) {
+	go c.follow(ctx, "rolebindings", c.bindingStorage, c.replaceBindings, c.applyBinding)
+	go c.follow(ctx, "roles", c.roleStorage, c.replaceRoles, c.applyRole)
+	go c.follow(ctx, "tenants", c.tenantStorage, c.replaceTenants, c.applyTenant)
+}
+
+// HasSynced returns whether the rolebindings, roles and tenants have been listed.
+func (c *CachedAuthorizer) HasSynced() bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.bindingsSynced && c.rolesSynced && c.tenantsSynced
+}
+
+func (c *CachedAuthorizer) Authorize(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if c.Unprotected(a.GetPath()) || c.CheckSuperuser(a.GetUser()) {
+		return authorizer.DecisionAllow, "", nil
+	}
+
+	if !c.HasSynced() {
+		return c.Authorizer.Authorize(ctx, a)
+	}
+
+	if c.Allowed(a) {
+		return authorizer.DecisionAllow, "", nil
+	}
+
+	return authorizer.DecisionDeny, "denied", nil
+}
+
+// Allowed returns whether the roles bound to the user of the attributes allow the request. Requests to the
+// namespace of a tenant that does not exist are never allowed. Unprotected paths and superusers are not
+// considered.
+func (c *CachedAuthorizer) Allowed(a authorizer.Attributes) bool {
+	key := decisionKey(a)
+	now := time.Now()
+
+	c.lock.RLock()
+	d, ok := c.decisions[key]
+	if ok && now.Before(d.expires) {
+		c.lock.RUnlock()
+		return d.allowed
+	}
+
+	generation := c.generation
+	allowed := false
+	if ns := a.GetNamespace(); ns == "" || c.tenants.Has(ns) {
+		for _, name := range c.bindingsFor(a.GetUser()) {
+			rb := c.bindings[name]
+			if bindingApplies(rb, a) && c.roles[rb.Role].allows(a) {
+				allowed = true
+				break
+			}
+		}
+	}
+	c.lock.RUnlock()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// the decision is stale if rolebindings or roles changed in the meantime
+	if c.generation != generation {
+		return allowed
+	}
+	if len(c.decisions) >= maxDecisions {
+		c.decisions = map[string]decision{}
+	}
+	c.decisions[key] = decision{allowed: allowed, expires: now.Add(c.DecisionTTL)}
+
+	return allowed
+}
+
+// AllowedTenants returns whether a cluster-scoped request is allowed by a rolebinding without tenant, or
+// else the tenants whose rolebindings allow it, see Authorizer.AllowedTenants.
+func (c *CachedAuthorizer) AllowedTenants(ctx context.Context, a authorizer.Attributes) (bool, sets.Set[string], error) {
+	if c.CheckSuperuser(a.GetUser()) {
+		return true, sets.New[string](), nil
+	}
+
+	if !c.HasSynced() {
+		return c.Authorizer.AllowedTenants(ctx, a)
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	tenants := sets.New[string]()
+	for _, name := range c.bindingsFor(a.GetUser()) {
+		rb := c.bindings[name]
+		if !bindingApplies(rb, a) || !c.roles[rb.Role].allows(a) {
+			continue
+		}
+
+		if rb.Tenant == "" {
+			return true, tenants, nil
+		}
+
+		if c.tenants.Has(rb.Tenant) {
+			tenants.Insert(rb.Tenant)
+		}
+	}
+
+	return false, tenants, nil
+}
+
+// AccessRules returns the rules of the roles bound to the user or its groups.
+func (c *CachedAuthorizer) AccessRules(ctx context.Context, u user.Info) (*AccessRules, error) {
+	out := &AccessRules{
+		User:      u.GetName(),
+		Groups:    u.GetGroups(),
+		Superuser: c.CheckSuperuser(u),
+		Rules:     []v4alpha1.Rule{},
+	}
+
+	if !c.HasSynced() {
+		bindings, err := c.GetBindings(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, b := range bindings {
+			obj, err := c.roleGetter.Get(ctx, "", b.Role)
+			if err != nil {
+				continue
+			}
+			if role, ok := obj.(*v4alpha1.Role); ok {
+				out.add(b.Tenant, role.Rules)
+			}
+		}
+
+		return out, nil
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	for _, name := range c.bindingsFor(u) {
+		rb := c.bindings[name]
+		out.add(rb.Tenant, c.roles[rb.Role].rules)
+	}
+
+	return out, nil
+}
+
+// bindingsFor returns the names of the rolebindings of the user and its groups, ordered by name.
+// The caller must hold the lock.
+func (c *CachedAuthorizer) bindingsFor(u user.Info) []string {
+	names := sets.New[string]()
+	names = names.Union(c.byUser[u.GetName()])
+	for _, group := range u.GetGroups() {
+		names = names.Union(c.byGroup[group])
+	}
+
+	return sets.List(names)
+}
+
+// decisionKey identifies the request of a user. Authorization only depends on the name and groups of the user.
+func decisionKey(a authorizer.Attributes) string {
+	groups := append([]string(nil), a.GetUser().GetGroups()...)
+	sort.Strings(groups)
+
+	return strings.Join([]string{
+		a.GetUser().GetName(), strings.Join(groups, ","),
+		fmt.Sprint(a.IsResourceRequest()), a.GetPath(), a.GetVerb(), a.GetAPIGroup(),
+		a.GetResource(), a.GetSubresource(), a.GetNamespace(), a.GetName(),
+	}, "\x00")
+}
+
+// follow lists the objects of a storage and watches them for changes, until the context is done.
+func (c *CachedAuthorizer) follow(ctx context.Context, resource string, store strategy.CompleteStrategy,
+	replace func([]runtime.Object), apply func(watch.EventType, runtime.Object)) {
+	for {
+		if err := c.listWatch(ctx, store, replace, apply); err != nil {
+			slog.Error("error syncing authorization cache", "resource", resource, "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(resyncDelay):
+		}
+	}
+}
+
+func (c *CachedAuthorizer) listWatch(ctx context.Context, store strategy.CompleteStrategy,
+	replace func([]runtime.Object), apply func(watch.EventType, runtime.Object)) error {
+	list, err := store.List(ctx, "", storage.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	listMeta, err := meta.ListAccessor(list)
+	if err != nil {
+		return err
+	}
+
+	replace(items)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events, err := store.Watch(ctx, "", storage.ListOptions{ResourceVersion: listMeta.GetResourceVersion()})
+	if err != nil {
+		return err
+	}
+
+	for e := range events {
+		switch e.Type {
+		case watch.Added, watch.Modified, watch.Deleted:
+			apply(e.Type, e.Object)
+		case watch.Error:
+			return fmt.Errorf("watch failed: %v", e.Object)
+		}
+	}
+
+	return nil
+}
+
+func (c *CachedAuthorizer) replaceBindings(items []runtime.Object) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.bindings = map[string]v4alpha1.RoleBinding{}
+	c.byUser = map[string]sets.Set[string]{}
+	c.byGroup = map[string]sets.Set[string]{}
+	for _, item := range items {
+		if rb, ok := item.(*v4alpha1.RoleBinding); ok {
+			c.addBinding(*rb)
+		}
+	}
+
+	c.bindingsSynced = true
+	c.invalidate()
+}
+
+func (c *CachedAuthorizer) applyBinding(eventType watch.EventType, obj runtime.Object) {
+	rb, ok := obj.(*v4alpha1.RoleBinding)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.removeBinding(rb.Name)
+	if eventType != watch.Deleted {
+		c.addBinding(*rb)
+	}
+
+	c.invalidate()
+}
+
+// addBinding adds a rolebinding to the indexes. The caller must hold the lock.
+func (c *CachedAuthorizer) addBinding(rb v4alpha1.RoleBinding) {
+	c.bindings[rb.Name] = rb
+	for _, u := range rb.Users {
+		if c.byUser[u] == nil {
+			c.byUser[u] = sets.New[string]()
+		}
+		c.byUser[u].Insert(rb.Name)
+	}
+	for _, g := range rb.Groups {
+		if c.byGroup[g] == nil {
+			c.byGroup[g] = sets.New[string]()
+		}
+		c.byGroup[g].Insert(rb.Name)
+	}
+}
+
+// removeBinding removes a rolebinding from the indexes. The caller must hold the lock.
+func (c *CachedAuthorizer) removeBinding(name string) {
+	rb, ok := c.bindings[name]
+	if !ok {
+		return
+	}
+
+	delete(c.bindings, name)
+	for _, u := range rb.Users {
+		if c.byUser[u].Delete(name).Len() == 0 {
+			delete(c.byUser, u)
+		}
+	}
+	for _, g := range rb.Groups {
+		if c.byGroup[g].Delete(name).Len() == 0 {
+			delete(c.byGroup, g)
+		}
+	}
+}
+
+func (c *CachedAuthorizer) replaceRoles(items []runtime.Object) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.roles = map[string]ruleSet{}
+	for _, item := range items {
+		if role, ok := item.(*v4alpha1.Role); ok {
+			c.roles[role.Name] = compile(role)
+		}
+	}
+
+	c.rolesSynced = true
+	c.invalidate()
+}
+
+func (c *CachedAuthorizer) applyRole(eventType watch.EventType, obj runtime.Object) {
+	role, ok := obj.(*v4alpha1.Role)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if eventType == watch.Deleted {
+		delete(c.roles, role.Name)
+	} else {
+		c.roles[role.Name] = compile(role)
+	}
+
+	c.invalidate()
+}
+
+func (c *CachedAuthorizer) replaceTenants(items []runtime.Object) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.tenants = sets.New[string]()
+	for _, item := range items {
+		if tenant, ok := item.(*v4alpha1.Tenant); ok {
+			c.tenants.Insert(tenant.Name)
+		}
+	}
+
+	c.tenantsSynced = true
+	c.invalidate()
+}
+
+func (c *CachedAuthorizer) applyTenant(eventType watch.EventType, obj runtime.Object) {
+	tenant, ok := obj.(*v4alpha1.Tenant)
+	if !ok {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if eventType == watch.Deleted {
+		c.tenants.Delete(tenant.Name)
+	} else {
+		c.tenants.Insert(tenant.Name)
+	}
+
+	c.invalidate()
+}
+
+// invalidate clears the decision cache. The caller must hold the lock.
+func (c *CachedAuthorizer) invalidate() {
+	c.generation++
+	c.decisions = map[string]decision{}
+}
@@ -0,0 +1,184 @@
+package authorization
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	hfuser "github.com/hobbyfarm/gargantua/v4/pkg/authentication/user"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/memory"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"testing"
+	"time"
+)
+
+func testAuthorizer(t *testing.T) (*CachedAuthorizer, *memory.Strategy, *memory.Strategy) {
+	az, roles, bindings, _ := testTenantAuthorizer(t)
+	return az, roles, bindings
+}
+
+func testTenantAuthorizer(t *testing.T) (*CachedAuthorizer, *memory.Strategy, *memory.Strategy, *memory.Strategy) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	store := memory.NewStore(scheme)
+	roles, err := memory.NewStrategy(&v4alpha1.Role{}, store, "roles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bindings, err := memory.NewStrategy(&v4alpha1.RoleBinding{}, store, "rolebindings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tenants, err := memory.NewStrategy(&v4alpha1.Tenant{}, store, "tenants")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewCachedAuthorizer(bindings, roles, tenants, "^/public$"), roles, bindings, tenants
+}
+
+func getMachines(u user.Info) authorizer.AttributesRecord {
+	return authorizer.AttributesRecord{
+		User:            u,
+		Verb:            "get",
+		APIGroup:        v4alpha1.APIGroup,
+		Resource:        "machines",
+		ResourceRequest: true,
+	}
+}
+
+func waitFor(t *testing.T, what string, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func Test_CachedAuthorizer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	az, roles, bindings := testAuthorizer(t)
+	az.DecisionTTL = time.Hour
+
+	if _, err := roles.Create(ctx, &v4alpha1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine-reader"},
+		Rules: []v4alpha1.Rule{{
+			APIGroups: []string{v4alpha1.APIGroup},
+			Resources: []string{"machines"},
+			Verbs:     v4alpha1.DefaultReadVerbs,
+		}, {
+			Paths: []string{"/eventhistory"},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "readers"},
+		Role:       "machine-reader",
+		Groups:     []string{"readers"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	az.Run(ctx)
+	waitFor(t, "authorization cache to sync", az.HasSynced)
+
+	reader := &user.DefaultInfo{Name: "alice", Groups: []string{"readers"}}
+	other := &user.DefaultInfo{Name: "bob"}
+
+	for _, tc := range []struct {
+		name    string
+		attrs   authorizer.Attributes
+		allowed bool
+	}{
+		{"bound group", getMachines(reader), true},
+		{"unbound user", getMachines(other), false},
+		{"other verb", authorizer.AttributesRecord{User: reader, Verb: "delete", APIGroup: v4alpha1.APIGroup,
+			Resource: "machines", ResourceRequest: true}, false},
+		{"path rule", authorizer.AttributesRecord{User: reader, Path: "/eventhistory"}, true},
+		{"unprotected path", authorizer.AttributesRecord{User: other, Path: "/public"}, true},
+		{"superuser", getMachines(&user.DefaultInfo{Name: "admin", Groups: []string{hfuser.SuperuserGroup}}), true},
+	} {
+		decision, _, err := az.Authorize(ctx, tc.attrs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allowed := decision == authorizer.DecisionAllow; allowed != tc.allowed {
+			t.Errorf("%s: expected allowed %v, got %v", tc.name, tc.allowed, allowed)
+		}
+	}
+
+	// changes to bindings clear cached decisions
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "bob"},
+		Role:       "machine-reader",
+		Users:      []string{"bob"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "binding of bob", func() bool { return az.Allowed(getMachines(other)) })
+
+	// changes to roles clear cached decisions
+	obj, err := roles.Get(ctx, "", "machine-reader")
+	if err != nil {
+		t.Fatal(err)
+	}
+	role := obj.(*v4alpha1.Role)
+	role.Rules = role.Rules[1:]
+	if _, err := roles.Update(ctx, role); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "rules of machine-reader to be removed", func() bool { return !az.Allowed(getMachines(reader)) })
+
+	rules, err := az.AccessRules(ctx, reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules.Rules) != 1 || len(rules.Rules[0].Paths) != 1 || rules.Superuser {
+		t.Errorf("expected path rule of machine-reader, got %+v", rules)
+	}
+}
+
+func Test_CachedAuthorizerNotSynced(t *testing.T) {
+	ctx := context.Background()
+	az, roles, bindings := testAuthorizer(t)
+
+	if _, err := roles.Create(ctx, &v4alpha1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "all"},
+		Rules: []v4alpha1.Rule{{
+			APIGroups: v4alpha1.All,
+			Resources: v4alpha1.All,
+			Verbs:     v4alpha1.All,
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Role:       "all",
+		Users:      []string{"alice"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// without Run, requests are authorized from the storages
+	decision, _, err := az.Authorize(ctx, getMachines(&user.DefaultInfo{Name: "alice"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decision != authorizer.DecisionAllow {
+		t.Errorf("expected request to be allowed before sync")
+	}
+}
@@ -0,0 +1,163 @@
+package authorization
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/statuswriter"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+const (
+	// AccessReviewPath answers whether a user may perform a request, AccessReviewPath + "/rules" lists the
+	// rules of a user. Both are authorized by path rules.
+	AccessReviewPath = "/accessreview"
+
+	// SelfAccessReviewPath answers the same for the requesting user. It is meant to be unprotected, so
+	// that every user can look up what it may do.
+	SelfAccessReviewPath = AccessReviewPath + "/self"
+)
+
+// AccessReview asks whether a user may perform a request. Resource requests are described by the resource
+// attributes, requests to other paths by the path. Allowed holds the answer.
+type AccessReview struct {
+	User   string   `json:"user,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+
+	Verb        string `json:"verb,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	SubResource string `json:"subResource,omitempty"`
+	Namespace   string `json:"namespace,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Path        string `json:"path,omitempty"`
+
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+func (ar AccessReview) attributes(u user.Info) authorizer.AttributesRecord {
+	return authorizer.AttributesRecord{
+		User:            u,
+		Verb:            ar.Verb,
+		APIGroup:        ar.APIGroup,
+		Resource:        ar.Resource,
+		Subresource:     ar.SubResource,
+		Namespace:       ar.Namespace,
+		Name:            ar.Name,
+		ResourceRequest: ar.Resource != "",
+		Path:            ar.Path,
+	}
+}
+
+var accessReviewResource = schema.GroupResource{Resource: "accessreview"}
+
+type reviewHandler struct {
+	az *CachedAuthorizer
+}
+
+// NewReviewHandler returns the handler of the access review paths. Reviews are POSTed as AccessReview,
+// rules are listed with GET, for the user and groups given by the user and group query parameters.
+func NewReviewHandler(az *CachedAuthorizer) http.Handler {
+	return &reviewHandler{az: az}
+}
+
+func (h *reviewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	self := path == SelfAccessReviewPath || strings.HasPrefix(path, SelfAccessReviewPath+"/")
+
+	var u user.Info
+	if self {
+		requester, ok := request.UserFrom(r.Context())
+		if !ok {
+			statuswriter.WriteError(errors.NewUnauthorized("no user in request"), w)
+			return
+		}
+		u = requester
+		path = AccessReviewPath + strings.TrimPrefix(path, SelfAccessReviewPath)
+	}
+
+	switch {
+	case path == AccessReviewPath && r.Method == http.MethodPost:
+		h.review(w, r, u)
+	case path == AccessReviewPath+"/rules" && r.Method == http.MethodGet:
+		h.rules(w, r, u)
+	case path == AccessReviewPath || path == AccessReviewPath+"/rules":
+		statuswriter.WriteError(errors.NewMethodNotSupported(accessReviewResource, r.Method), w)
+	default:
+		statuswriter.WriteError(errors.NewNotFound(accessReviewResource, path), w)
+	}
+}
+
+// review answers an AccessReview, for the given user or the user of the review.
+func (h *reviewHandler) review(w http.ResponseWriter, r *http.Request, u user.Info) {
+	var review AccessReview
+	if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+		statuswriter.WriteError(errors.NewBadRequest(fmt.Sprintf("invalid access review: %v", err)), w)
+		return
+	}
+
+	if u == nil {
+		if review.User == "" {
+			statuswriter.WriteError(errors.NewBadRequest("user is required"), w)
+			return
+		}
+		u = &user.DefaultInfo{Name: review.User, Groups: review.Groups}
+	}
+	review.User = u.GetName()
+	review.Groups = u.GetGroups()
+
+	if review.Resource == "" && review.Path == "" {
+		statuswriter.WriteError(errors.NewBadRequest("resource or path is required"), w)
+		return
+	}
+
+	decision, reason, err := h.az.Authorize(r.Context(), review.attributes(u))
+	if err != nil {
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+	review.Allowed = decision == authorizer.DecisionAllow
+	review.Reason = reason
+
+	writeJSON(w, review)
+}
+
+// rules lists the rules of the given user or the user of the query parameters.
+func (h *reviewHandler) rules(w http.ResponseWriter, r *http.Request, u user.Info) {
+	if u == nil {
+		query := r.URL.Query()
+		if query.Get("user") == "" {
+			statuswriter.WriteError(errors.NewBadRequest("user is required"), w)
+			return
+		}
+		u = &user.DefaultInfo{Name: query.Get("user"), Groups: query["group"]}
+	}
+
+	rules, err := h.az.AccessRules(r.Context(), u)
+	if err != nil {
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	writeJSON(w, rules)
+}
+
+func writeJSON(w http.ResponseWriter, obj any) {
+	out, err := json.Marshal(obj)
+	if err != nil {
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(out); err != nil {
+		slog.Error("error writing http response", "error", err.Error())
+	}
+}
@@ -0,0 +1,84 @@
+package authorization
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func Test_ReviewHandler(t *testing.T) {
+	ctx := context.Background()
+	az, roles, bindings := testAuthorizer(t)
+
+	if _, err := roles.Create(ctx, &v4alpha1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "machine-reader"},
+		Rules: []v4alpha1.Rule{{
+			APIGroups: []string{v4alpha1.APIGroup},
+			Resources: []string{"machines"},
+			Verbs:     v4alpha1.DefaultReadVerbs,
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "alice"},
+		Role:       "machine-reader",
+		Users:      []string{"alice"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	handler := NewReviewHandler(az)
+
+	for _, tc := range []struct {
+		body    string
+		allowed bool
+	}{
+		{`{"user":"alice","verb":"list","apiGroup":"hobbyfarm.io","resource":"machines"}`, true},
+		{`{"user":"alice","verb":"delete","apiGroup":"hobbyfarm.io","resource":"machines"}`, false},
+		{`{"user":"bob","verb":"list","apiGroup":"hobbyfarm.io","resource":"machines"}`, false},
+	} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, AccessReviewPath, strings.NewReader(tc.body)))
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200 for review %s, got %d: %s", tc.body, rec.Code, rec.Body.String())
+		}
+
+		var review AccessReview
+		if err := json.Unmarshal(rec.Body.Bytes(), &review); err != nil {
+			t.Fatal(err)
+		}
+		if review.Allowed != tc.allowed {
+			t.Errorf("expected allowed %v for review %s, got %v", tc.allowed, tc.body, review.Allowed)
+		}
+	}
+
+	// the rules of the requesting user
+	req := httptest.NewRequest(http.MethodGet, SelfAccessReviewPath+"/rules", nil)
+	req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: "alice"}))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	var rules AccessRules
+	if err := json.Unmarshal(rec.Body.Bytes(), &rules); err != nil {
+		t.Fatal(err)
+	}
+	if rules.User != "alice" || len(rules.Rules) != 1 {
+		t.Errorf("expected the rule of alice, got %+v", rules)
+	}
+
+	// reviews need a user
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, AccessReviewPath,
+		strings.NewReader(`{"verb":"list","resource":"machines"}`)))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status 400 for review without user, got %d", rec.Code)
+	}
+}
@@ -0,0 +1,68 @@
+package authorization
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+// ruleSet holds the rules of a role, split by the kind of request they apply to.
+type ruleSet struct {
+	resources []v4alpha1.Rule
+	paths     []string
+	rules     []v4alpha1.Rule
+}
+
+func compile(role *v4alpha1.Role) ruleSet {
+	var rs = ruleSet{rules: role.Rules}
+	for _, rule := range role.Rules {
+		if len(rule.Paths) > 0 {
+			rs.paths = append(rs.paths, rule.Paths...)
+		}
+		if len(rule.Resources) > 0 {
+			rs.resources = append(rs.resources, rule)
+		}
+	}
+
+	return rs
+}
+
+func (rs ruleSet) allows(a authorizer.Attributes) bool {
+	if !a.IsResourceRequest() {
+		return v4alpha1.Matches(a.GetPath(), rs.paths)
+	}
+
+	for _, rule := range rs.resources {
+		if rule.Matches(a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccessRules are the rules of the roles bound to a user or its groups.
+type AccessRules struct {
+	User   string   `json:"user"`
+	Groups []string `json:"groups,omitempty"`
+
+	// Superuser is set if the user may do anything, regardless of rules
+	Superuser bool `json:"superuser"`
+
+	// Rules apply to all tenants and cluster-scoped resources
+	Rules []v4alpha1.Rule `json:"rules"`
+
+	// TenantRules apply to the resources of a tenant only, they are keyed by the tenant
+	TenantRules map[string][]v4alpha1.Rule `json:"tenantRules,omitempty"`
+}
+
+func (ar *AccessRules) add(tenant string, rules []v4alpha1.Rule) {
+	if tenant == "" {
+		ar.Rules = append(ar.Rules, rules...)
+		return
+	}
+
+	if ar.TenantRules == nil {
+		ar.TenantRules = map[string][]v4alpha1.Rule{}
+	}
+	ar.TenantRules[tenant] = append(ar.TenantRules[tenant], rules...)
+}
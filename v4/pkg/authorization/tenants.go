@@ -0,0 +1,45 @@
+package authorization
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+var (
+	// SharedResources are the cluster-scoped resources that all tenants use. Rolebindings of a tenant
+	// grant the read verbs of their rules on them, but never writes.
+	SharedResources = sets.New("providers", "machinetemplates")
+
+	// TenantFilteredResources are the cluster-scoped resources whose objects belong to tenants, i.e. the users
+	// and groups bound by the rolebindings of a tenant and the events of its objects. Rolebindings of a tenant
+	// grant the read verbs of their rules on them, their storages only return the objects of the tenant.
+	TenantFilteredResources = sets.New("users", "groups", "events")
+
+	readVerbs = sets.New(v4alpha1.DefaultReadVerbs...)
+)
+
+// bindingApplies returns whether the rules of a rolebinding apply to a request. Rolebindings without tenant
+// apply to every request. Rolebindings of a tenant apply to resource requests in the namespace of the tenant,
+// to reads of shared resources and to reads of tenant-filtered resources, but not to other cluster-scoped
+// resources, to requests across all namespaces or to paths.
+func bindingApplies(rb v4alpha1.RoleBinding, a authorizer.Attributes) bool {
+	if rb.Tenant == "" {
+		return true
+	}
+
+	if !a.IsResourceRequest() {
+		return false
+	}
+
+	if a.GetNamespace() != "" {
+		return a.GetNamespace() == rb.Tenant
+	}
+
+	if a.GetAPIGroup() != v4alpha1.APIGroup || !readVerbs.Has(a.GetVerb()) {
+		return false
+	}
+
+	return SharedResources.Has(a.GetResource()) ||
+		(TenantFilteredResources.Has(a.GetResource()) && a.GetSubresource() == "")
+}
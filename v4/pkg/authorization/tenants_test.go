@@ -0,0 +1,134 @@
+package authorization
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"testing"
+)
+
+func Test_TenantBindings(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	az, roles, bindings, tenants := testTenantAuthorizer(t)
+
+	for _, name := range []string{"a", "b"} {
+		if _, err := tenants.Create(ctx, &v4alpha1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := roles.Create(ctx, &v4alpha1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: "editor"},
+		Rules: []v4alpha1.Rule{{
+			APIGroups: []string{v4alpha1.APIGroup},
+			Resources: v4alpha1.All,
+			Verbs:     v4alpha1.DefaultWriteVerbs,
+		}, {
+			Paths: []string{"/eventhistory"},
+		}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-editors"},
+		Role:       "editor",
+		Groups:     []string{"a-staff"},
+		Tenant:     "a",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bindings.Create(ctx, &v4alpha1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "admins"},
+		Role:       "editor",
+		Groups:     []string{"admins"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	staff := &user.DefaultInfo{Name: "alice", Groups: []string{"a-staff"}}
+	admin := &user.DefaultInfo{Name: "bob", Groups: []string{"admins"}}
+
+	request := func(u user.Info, verb, resource, namespace string) authorizer.AttributesRecord {
+		return authorizer.AttributesRecord{User: u, Verb: verb, APIGroup: v4alpha1.APIGroup, Resource: resource,
+			Namespace: namespace, ResourceRequest: true}
+	}
+
+	cases := []struct {
+		name    string
+		attrs   authorizer.Attributes
+		allowed bool
+	}{
+		{"own tenant", request(staff, "create", "scenarios", "a"), true},
+		{"other tenant", request(staff, "get", "scenarios", "b"), false},
+		{"all tenants", request(staff, "list", "scenarios", ""), false},
+		{"read shared", request(staff, "list", "providers", ""), true},
+		{"write shared", request(staff, "update", "providers", ""), false},
+		{"cluster-scoped", request(staff, "list", "settings", ""), false},
+		{"read tenant-filtered", request(staff, "list", "users", ""), true},
+		{"write tenant-filtered", request(staff, "update", "users", ""), false},
+		{"path", authorizer.AttributesRecord{User: staff, Path: "/eventhistory"}, false},
+		{"unknown tenant", request(admin, "get", "scenarios", "c"), false},
+		{"cluster binding", request(admin, "get", "scenarios", "b"), true},
+		{"cluster binding across tenants", request(admin, "list", "scenarios", ""), true},
+	}
+
+	tenantCases := []struct {
+		name    string
+		attrs   authorizer.Attributes
+		all     bool
+		tenants []string
+	}{
+		{"tenant binding", request(staff, "list", "users", ""), false, []string{"a"}},
+		{"tenant binding write", request(staff, "update", "users", ""), false, nil},
+		{"cluster binding", request(admin, "list", "users", ""), true, nil},
+		{"no binding", request(&user.DefaultInfo{Name: "carol"}, "list", "users", ""), false, nil},
+	}
+
+	check := func(stage string) {
+		for _, tc := range cases {
+			decision, _, err := az.Authorize(ctx, tc.attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed := decision == authorizer.DecisionAllow; allowed != tc.allowed {
+				t.Errorf("%s, %s: expected allowed %v, got %v", stage, tc.name, tc.allowed, allowed)
+			}
+		}
+
+		for _, tc := range tenantCases {
+			all, tenants, err := az.AllowedTenants(ctx, tc.attrs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if all != tc.all || !tenants.Equal(sets.New(tc.tenants...)) {
+				t.Errorf("%s, %s: expected tenants %v (all %v), got %v (all %v)", stage, tc.name,
+					tc.tenants, tc.all, sets.List(tenants), all)
+			}
+		}
+	}
+
+	check("before sync")
+
+	az.Run(ctx)
+	waitFor(t, "authorization cache to sync", az.HasSynced)
+
+	check("after sync")
+
+	// removing a tenant denies requests to its namespace
+	if _, err := tenants.Delete(ctx, &v4alpha1.Tenant{ObjectMeta: metav1.ObjectMeta{Name: "a"}}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "removal of tenant a", func() bool { return !az.Allowed(request(staff, "create", "scenarios", "a")) })
+
+	rules, err := az.AccessRules(ctx, &user.DefaultInfo{Name: "carol", Groups: []string{"a-staff", "admins"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules.Rules) != 2 || len(rules.TenantRules["a"]) != 2 {
+		t.Errorf("expected cluster and tenant rules of editor, got %+v", rules)
+	}
+}
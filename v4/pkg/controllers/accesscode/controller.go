@@ -1,13 +1,25 @@
 package accesscode
 
 import (
+	"context"
 	"errors"
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/helpers"
+	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	client2 "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	// RoleFinalizer and RoleBindingFinalizer keep an access code until its role and rolebinding are deleted.
+	// Roles and rolebindings are cluster-scoped, they cannot be owned by the access codes of a tenant.
+	RoleFinalizer        = "hobbyfarm.io/accesscode-role"
+	RoleBindingFinalizer = "hobbyfarm.io/accesscode-rolebinding"
 )
 
 type accessCodeController struct {
@@ -25,7 +37,7 @@ func New(mgr manager.Manager) error {
 
 	if err := builder.
 		ControllerManagedBy(mgr).
-		Owns(&v4alpha1.Role{}, builder.MatchEveryOwner).
+		Watches(&v4alpha1.Role{}, handler.EnqueueRequestsFromMapFunc(accessCodeFor(labels2.CodeRoleLabel))).
 		Named("accesscode-role").
 		For(&v4alpha1.AccessCode{}).Complete(helpers.ReconcileFunc(acc.ReconcileRole)); err != nil {
 		errs = append(errs, err)
@@ -33,7 +45,7 @@ func New(mgr manager.Manager) error {
 
 	if err := builder.
 		ControllerManagedBy(mgr).
-		Owns(&v4alpha1.RoleBinding{}, builder.MatchEveryOwner).
+		Watches(&v4alpha1.RoleBinding{}, handler.EnqueueRequestsFromMapFunc(accessCodeFor(labels2.CodeRoleBindingLabel))).
 		Named("accesscode-rolebinding").
 		For(&v4alpha1.AccessCode{}).Complete(helpers.ReconcileFunc(acc.ReconcileRoleBinding)); err != nil {
 		errs = append(errs, err)
@@ -41,3 +53,19 @@ func New(mgr manager.Manager) error {
 
 	return errors.Join(errs...)
 }
+
+// accessCodeFor maps the role or rolebinding of an access code to the access code, which is named by the
+// given label. The tenant of the access code is named by the tenant label.
+func accessCodeFor(label string) handler.MapFunc {
+	return func(_ context.Context, obj client2.Object) []reconcile.Request {
+		name := obj.GetLabels()[label]
+		if name == "" {
+			return nil
+		}
+
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{
+			Namespace: obj.GetLabels()[labels2.TenantLabel],
+			Name:      name,
+		}}}
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
-	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
 	"log/slog"
 	client2 "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -16,6 +15,7 @@ func (acc *accessCodeController) ReconcileRole(ctx context.Context, request reco
 	roleList := &v4alpha1.RoleList{}
 	if err := acc.kclient.List(ctx, roleList, client2.MatchingLabels{
 		labels2.CodeRoleLabel: request.Name,
+		labels2.TenantLabel:   request.Namespace,
 	}); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -25,10 +25,26 @@ func (acc *accessCodeController) ReconcileRole(ctx context.Context, request reco
 		return reconcile.Result{}, client2.IgnoreNotFound(err)
 	}
 
-	// Because mink adds "-p" to end of UIDs for some reason
-	// We need to remove it because it breaks references to other objects
-	// Especially when using owner refs
-	ac.UID = uid.RemoveUIDPublic(ac.UID)
+	if ac.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(ac, RoleFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		for i := range roleList.Items {
+			if err := acc.kclient.Delete(ctx, &roleList.Items[i]); client2.IgnoreNotFound(err) != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		controllerutil.RemoveFinalizer(ac, RoleFinalizer)
+		return reconcile.Result{}, acc.kclient.Update(ctx, ac)
+	}
+
+	if controllerutil.AddFinalizer(ac, RoleFinalizer) {
+		if err := acc.kclient.Update(ctx, ac); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
 
 	var requeue bool
 
@@ -46,11 +62,6 @@ func (acc *accessCodeController) ReconcileRole(ctx context.Context, request reco
 
 		acc.setRules(ac, role)
 
-		if err := controllerutil.SetOwnerReference(ac, role, acc.scheme); err != nil {
-			slog.Error("error setting owner reference for role", "error", err.Error())
-			return reconcile.Result{}, err
-		}
-
 		if err := acc.kclient.Update(ctx, role); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -73,6 +84,7 @@ func (acc *accessCodeController) createRole(ctx context.Context, request reconci
 	role.GenerateName = "coderole-"
 	role.Labels = map[string]string{
 		labels2.CodeRoleLabel: request.Name,
+		labels2.TenantLabel:   accessCode.Namespace,
 	}
 
 	if err := acc.kclient.Create(ctx, role); err != nil {
@@ -4,7 +4,6 @@ import (
 	"context"
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	labels2 "github.com/hobbyfarm/gargantua/v4/pkg/labels"
-	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"log/slog"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -16,6 +15,7 @@ func (acc *accessCodeController) ReconcileRoleBinding(ctx context.Context, reque
 	roleBindingList := &v4alpha1.RoleBindingList{}
 	if err := acc.kclient.List(ctx, roleBindingList, client.MatchingLabels{
 		labels2.CodeRoleBindingLabel: request.Name,
+		labels2.TenantLabel:          request.Namespace,
 	}); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -25,8 +25,26 @@ func (acc *accessCodeController) ReconcileRoleBinding(ctx context.Context, reque
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
-	// Remove to avoid issues with ownership
-	ac.UID = uid.RemoveUIDPublic(ac.UID)
+	if ac.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(ac, RoleBindingFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		for i := range roleBindingList.Items {
+			if err := acc.kclient.Delete(ctx, &roleBindingList.Items[i]); client.IgnoreNotFound(err) != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		controllerutil.RemoveFinalizer(ac, RoleBindingFinalizer)
+		return reconcile.Result{}, acc.kclient.Update(ctx, ac)
+	}
+
+	if controllerutil.AddFinalizer(ac, RoleBindingFinalizer) {
+		if err := acc.kclient.Update(ctx, ac); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
 
 	var requeue = false
 	if len(roleBindingList.Items) == 0 {
@@ -40,13 +58,12 @@ func (acc *accessCodeController) ReconcileRoleBinding(ctx context.Context, reque
 	if len(roleBindingList.Items) == 1 {
 		var rolebinding = &roleBindingList.Items[0]
 
-		// set ownership, everything else (membership) is handled elsewhere
-		if err := controllerutil.SetControllerReference(ac, rolebinding, acc.scheme); err != nil {
-			return reconcile.Result{}, err
-		}
-
-		if err := acc.kclient.Update(ctx, rolebinding); err != nil {
-			return reconcile.Result{}, err
+		// restrict the binding to the tenant of the code, everything else (membership) is handled elsewhere
+		if rolebinding.Tenant != ac.Namespace {
+			rolebinding.Tenant = ac.Namespace
+			if err := acc.kclient.Update(ctx, rolebinding); err != nil {
+				return reconcile.Result{}, err
+			}
 		}
 	}
 
@@ -64,8 +81,10 @@ func (acc *accessCodeController) createRoleBinding(ctx context.Context, ac *v4al
 			GenerateName: "code-",
 			Labels: map[string]string{
 				labels2.CodeRoleBindingLabel: ac.Name,
+				labels2.TenantLabel:          ac.Namespace,
 			},
 		},
+		Tenant: ac.Namespace,
 	}
 
 	if err := acc.kclient.Create(ctx, rb); err != nil {
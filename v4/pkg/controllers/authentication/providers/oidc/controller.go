@@ -0,0 +1,33 @@
+package oidc
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"net/http"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"time"
+)
+
+const (
+	OIDCConfigControllerName = "oidc-config-controller"
+)
+
+type oidcConfigController struct {
+	kclient    client.Client
+	httpClient *http.Client
+}
+
+// New registers a controller that discovers the endpoints of the identity provider of each OIDCConfig
+// and records them in its status, where they are used by the oidc authentication provider.
+func New(mgr manager.Manager) error {
+	oc := &oidcConfigController{
+		kclient:    mgr.GetClient(),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.OIDCConfig{}).
+		Named(OIDCConfigControllerName).Complete(oc)
+}
@@ -0,0 +1,104 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"log/slog"
+	"net/http"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"strings"
+	"time"
+)
+
+const (
+	// rediscoveryInterval is how often the discovery document of a healthy identity provider is re-read.
+	rediscoveryInterval = time.Hour
+
+	// retryInterval is how often discovery is retried after it failed.
+	retryInterval = time.Minute
+)
+
+// discoveryDocument holds the fields of the OpenID Provider metadata that hobbyfarm relies on.
+type discoveryDocument struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+func (oc *oidcConfigController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	config := &v4alpha1.OIDCConfig{}
+	if err := oc.kclient.Get(ctx, request.NamespacedName, config); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if config.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	origStatus := config.Status.DeepCopy()
+	result := reconcile.Result{RequeueAfter: rediscoveryInterval}
+
+	doc, err := oc.discover(ctx, config.Spec.IssuerURL)
+	if err != nil {
+		slog.Info("oidc discovery failed", "oidcConfig", config.Name, "error", err.Error())
+		config.Status.Conditions = genericcondition.SetCondition(config.Status.Conditions,
+			v4alpha1.ConditionDiscoverySuccessful, corev1.ConditionFalse, "oidc discovery failed", err.Error())
+		result.RequeueAfter = retryInterval
+	} else {
+		config.Status.Issuer = doc.Issuer
+		config.Status.AuthorizationEndpoint = doc.AuthorizationEndpoint
+		config.Status.TokenEndpoint = doc.TokenEndpoint
+		config.Status.JWKSURI = doc.JWKSURI
+		config.Status.Conditions = genericcondition.SetCondition(config.Status.Conditions,
+			v4alpha1.ConditionDiscoverySuccessful, corev1.ConditionTrue, "oidc discovery succeeded", "success")
+	}
+
+	if equality.Semantic.DeepEqual(origStatus, &config.Status) {
+		return result, nil
+	}
+
+	return result, oc.kclient.Status().Update(ctx, config)
+}
+
+// discover retrieves and checks the discovery document of the issuer. See OpenID Connect Discovery 1.0, section 4.
+func (oc *oidcConfigController) discover(ctx context.Context, issuerURL string) (*discoveryDocument, error) {
+	wellKnown := strings.TrimSuffix(issuerURL, "/") + "/.well-known/openid-configuration"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, wellKnown, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := oc.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s returned status %d", wellKnown, resp.StatusCode)
+	}
+
+	doc := &discoveryDocument{}
+	if err := json.NewDecoder(resp.Body).Decode(doc); err != nil {
+		return nil, fmt.Errorf("error decoding discovery document: %s", err.Error())
+	}
+
+	// the issuer in the document must be identical to the one it was retrieved from
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(issuerURL, "/") {
+		return nil, fmt.Errorf("discovery document issuer %s does not match issuer url %s", doc.Issuer, issuerURL)
+	}
+
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, fmt.Errorf("discovery document is missing authorization_endpoint, token_endpoint or jwks_uri")
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,40 @@
+package event
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventarchive"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"time"
+)
+
+const (
+	EventGCControllerName = "event-gc-controller"
+
+	// DefaultTTL is the time events are kept after the object they reference is gone
+	DefaultTTL = 24 * time.Hour
+)
+
+type eventGCController struct {
+	kclient client.Client
+	archive eventarchive.Archive
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// New registers a controller that deletes events once the object they reference has been gone for the ttl.
+// Deleted events are written to the archive first, if one is given.
+func New(mgr manager.Manager, archive eventarchive.Archive, ttl time.Duration) error {
+	cx := &eventGCController{
+		kclient: mgr.GetClient(),
+		archive: archive,
+		ttl:     ttl,
+		now:     time.Now,
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.Event{}).
+		Named(EventGCControllerName).Complete(cx)
+}
@@ -0,0 +1,119 @@
+package event
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+// recheckInterval is the interval in which events of existing objects are checked again. Deletions of the
+// objects do not trigger the controller, as it only watches events.
+const recheckInterval = 10 * time.Minute
+
+func (cx *eventGCController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	ev := &v4alpha1.Event{}
+	if err := cx.kclient.Get(ctx, request.NamespacedName, ev); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	exists, err := cx.objectExists(ctx, ev.ObjectReference)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	goneSince, marked := goneTime(ev)
+
+	if exists {
+		// the object has been recreated since it was found to be gone
+		if marked {
+			delete(ev.Annotations, labels.EventObjectGoneAnnotation)
+			if err := cx.kclient.Update(ctx, ev); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		return reconcile.Result{RequeueAfter: recheckInterval}, nil
+	}
+
+	if !marked {
+		goneSince = cx.now()
+		if ev.Annotations == nil {
+			ev.Annotations = map[string]string{}
+		}
+		ev.Annotations[labels.EventObjectGoneAnnotation] = goneSince.UTC().Format(time.RFC3339)
+		if err := cx.kclient.Update(ctx, ev); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if remaining := goneSince.Add(cx.ttl).Sub(cx.now()); remaining > 0 {
+		return reconcile.Result{RequeueAfter: remaining}, nil
+	}
+
+	if cx.archive != nil {
+		if err := cx.archive.Write(ctx, *ev); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := cx.kclient.Delete(ctx, ev); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// goneTime returns the time at which the object of the event was found to be gone, and false if
+// the event has not been marked yet.
+func goneTime(ev *v4alpha1.Event) (time.Time, bool) {
+	value, ok := ev.Annotations[labels.EventObjectGoneAnnotation]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		// an invalid mark is replaced by a new one
+		return time.Time{}, false
+	}
+
+	return t, true
+}
+
+// objectExists returns whether the referenced object exists. References to kinds that are not known
+// to the scheme of the client, such as the "UNKNOWN" references of the event builder, are treated as gone.
+func (cx *eventGCController) objectExists(ctx context.Context, ref v4alpha1.ObjectReference) (bool, error) {
+	if ref.Kind == "" || ref.Name == "" {
+		return false, nil
+	}
+
+	scheme := cx.kclient.Scheme()
+	for _, version := range scheme.PrioritizedVersionsForGroup(ref.APIGroup) {
+		gvk := version.WithKind(ref.Kind)
+		if !scheme.Recognizes(gvk) {
+			continue
+		}
+
+		obj, err := scheme.New(gvk)
+		if err != nil {
+			return false, err
+		}
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return false, nil
+		}
+
+		err = cx.kclient.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}, clientObj)
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return err == nil, err
+	}
+
+	return false, nil
+}
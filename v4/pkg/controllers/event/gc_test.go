@@ -0,0 +1,115 @@
+package event
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"testing"
+	"time"
+)
+
+type memoryArchive struct {
+	events []v4alpha1.Event
+}
+
+func (m *memoryArchive) Write(_ context.Context, events ...v4alpha1.Event) error {
+	m.events = append(m.events, events...)
+	return nil
+}
+
+func (m *memoryArchive) List(_ context.Context, _ v4alpha1.ObjectReference) ([]v4alpha1.Event, error) {
+	return m.events, nil
+}
+
+func Test_EventGC(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	machine := &v4alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "m-test"}}
+	live := &v4alpha1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "ev-live"},
+		ObjectReference: v4alpha1.ObjectReference{
+			APIGroup: v4alpha1.APIGroup, Kind: "Machine", Name: machine.Name,
+		},
+	}
+	gone := &v4alpha1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: "ev-gone"},
+		ObjectReference: v4alpha1.ObjectReference{
+			APIGroup: v4alpha1.APIGroup, Kind: "Machine", Name: "m-deleted",
+		},
+	}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(machine, live, gone).Build()
+	archive := &memoryArchive{}
+	cx := &eventGCController{kclient: kclient, archive: archive, ttl: time.Hour, now: func() time.Time { return now }}
+
+	reconcileEvent := func(name string) reconcile.Result {
+		t.Helper()
+		result, err := cx.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	// events of existing objects are kept and checked again later
+	if result := reconcileEvent(live.Name); result.RequeueAfter != recheckInterval {
+		t.Errorf("expected requeue after %s for event of existing object, got %s", recheckInterval, result.RequeueAfter)
+	}
+
+	// events of gone objects are marked and kept for the ttl
+	if result := reconcileEvent(gone.Name); result.RequeueAfter != time.Hour {
+		t.Errorf("expected requeue after ttl for event of gone object, got %s", result.RequeueAfter)
+	}
+	ev := &v4alpha1.Event{}
+	if err := kclient.Get(ctx, client.ObjectKey{Name: gone.Name}, ev); err != nil {
+		t.Fatal(err)
+	}
+	if ev.Annotations[labels.EventObjectGoneAnnotation] != now.Format(time.RFC3339) {
+		t.Errorf("expected event to be marked as gone at %s, got %q", now.Format(time.RFC3339),
+			ev.Annotations[labels.EventObjectGoneAnnotation])
+	}
+
+	now = now.Add(30 * time.Minute)
+	if result := reconcileEvent(gone.Name); result.RequeueAfter != 30*time.Minute {
+		t.Errorf("expected requeue after remaining ttl, got %s", result.RequeueAfter)
+	}
+
+	// once the ttl has passed the event is archived and deleted
+	now = now.Add(30 * time.Minute)
+	reconcileEvent(gone.Name)
+	if err := kclient.Get(ctx, client.ObjectKey{Name: gone.Name}, ev); !apierrors.IsNotFound(err) {
+		t.Errorf("expected expired event to be deleted, got %v", err)
+	}
+	if len(archive.events) != 1 || archive.events[0].Name != gone.Name {
+		t.Errorf("expected expired event to be archived, got %v", archive.events)
+	}
+
+	// objects that come back unmark their events
+	if err := kclient.Delete(ctx, machine); err != nil {
+		t.Fatal(err)
+	}
+	reconcileEvent(live.Name)
+	if err := kclient.Create(ctx, &v4alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: machine.Name}}); err != nil {
+		t.Fatal(err)
+	}
+	reconcileEvent(live.Name)
+	if err := kclient.Get(ctx, client.ObjectKey{Name: live.Name}, ev); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ev.Annotations[labels.EventObjectGoneAnnotation]; ok {
+		t.Errorf("expected mark to be removed from event of recreated object")
+	}
+}
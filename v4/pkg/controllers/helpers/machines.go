@@ -0,0 +1,92 @@
+package helpers
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"slices"
+	"sort"
+)
+
+// MachineSetMatchesClaim returns true if machines from the MachineSet may be used to fulfill the MachineClaim.
+// This takes into account the MachineTemplate, the availability configuration of the set, and
+// (when the claim uses BindStrategyRequireMachineSets) the list of required sets on the claim.
+func MachineSetMatchesClaim(set *v4alpha1.MachineSet, claim *v4alpha1.MachineClaim) bool {
+	if set.Spec.MachineTemplate != claim.Spec.MachineTemplate {
+		return false
+	}
+
+	if claim.Spec.BindStrategy == v4alpha1.BindStrategyRequireMachineSets &&
+		!slices.Contains(claim.Spec.PreferRequireMachineSets, set.Name) {
+		return false
+	}
+
+	switch set.Spec.AvailabilityConfiguration.Availability {
+	case v4alpha1.MachineSetAvailabilityScheduledEvent:
+		return claim.Spec.ScheduledEvent != "" && claim.Spec.ScheduledEvent == set.Spec.AvailabilityConfiguration.Value
+	case v4alpha1.MachineSetAvailabilityAccessCode:
+		return claim.Spec.AccessCode != "" && claim.Spec.AccessCode == set.Spec.AvailabilityConfiguration.Value
+	default:
+		return true
+	}
+}
+
+// OrderMachineSetsForClaim filters sets down to those that match the claim, and orders them
+// in the order in which they should be tried. Sets preferred (or required) by the claim come first,
+// in the order listed on the claim. All other matching sets follow, sorted by name.
+func OrderMachineSetsForClaim(claim *v4alpha1.MachineClaim, sets []v4alpha1.MachineSet) []*v4alpha1.MachineSet {
+	var preferred = make([]*v4alpha1.MachineSet, 0)
+	var rest = make([]*v4alpha1.MachineSet, 0)
+
+	for i := range sets {
+		set := &sets[i]
+		if !MachineSetMatchesClaim(set, claim) {
+			continue
+		}
+
+		if slices.Contains(claim.Spec.PreferRequireMachineSets, set.Name) {
+			preferred = append(preferred, set)
+		} else {
+			rest = append(rest, set)
+		}
+	}
+
+	sort.SliceStable(preferred, func(i, j int) bool {
+		return slices.Index(claim.Spec.PreferRequireMachineSets, preferred[i].Name) <
+			slices.Index(claim.Spec.PreferRequireMachineSets, preferred[j].Name)
+	})
+
+	sort.SliceStable(rest, func(i, j int) bool {
+		return rest[i].Name < rest[j].Name
+	})
+
+	return append(preferred, rest...)
+}
+
+// SelectOnDemandMachineSet returns the MachineSet that is responsible for provisioning a machine for the claim,
+// or nil if no OnDemand MachineSet with remaining capacity matches. Because several OnDemand sets may match
+// a single claim, only the first set (in the order given by OrderMachineSetsForClaim) provisions for it.
+func SelectOnDemandMachineSet(claim *v4alpha1.MachineClaim, sets []v4alpha1.MachineSet) *v4alpha1.MachineSet {
+	for _, set := range OrderMachineSetsForClaim(claim, sets) {
+		if set.Spec.ProvisioningStrategy != v4alpha1.ProvisioningStrategyDynamic {
+			continue
+		}
+
+		if set.DeletionTimestamp != nil {
+			continue
+		}
+
+		if set.Status.Provisioned < set.Spec.MaxProvisioned || set.Status.Available > 0 {
+			return set
+		}
+	}
+
+	return nil
+}
+
+// ClaimIsPending returns true if the claim is still waiting to be bound to a machine.
+func ClaimIsPending(claim *v4alpha1.MachineClaim) bool {
+	if claim.DeletionTimestamp != nil || claim.Status.Machine != "" {
+		return false
+	}
+
+	return claim.Status.Phase == "" || claim.Status.Phase == v4alpha1.MachineClaimPhaseRequested
+}
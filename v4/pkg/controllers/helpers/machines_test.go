@@ -0,0 +1,102 @@
+package helpers
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+func testSets() []v4alpha1.MachineSet {
+	mk := func(name string, template string, availability v4alpha1.MachineSetAvailability, value string) v4alpha1.MachineSet {
+		return v4alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v4alpha1.MachineSetSpec{
+				MachineTemplate: template,
+				AvailabilityConfiguration: v4alpha1.AvailabilityConfiguration{
+					Availability: availability,
+					Value:        value,
+				},
+			},
+		}
+	}
+
+	return []v4alpha1.MachineSet{
+		mk("pool-b", "ubuntu", v4alpha1.MachineSetAvailabilityPool, ""),
+		mk("pool-a", "ubuntu", v4alpha1.MachineSetAvailabilityPool, ""),
+		mk("event", "ubuntu", v4alpha1.MachineSetAvailabilityScheduledEvent, "se-1"),
+		mk("code", "ubuntu", v4alpha1.MachineSetAvailabilityAccessCode, "my-code"),
+		mk("windows", "windows", v4alpha1.MachineSetAvailabilityPool, ""),
+	}
+}
+
+func names(sets []*v4alpha1.MachineSet) []string {
+	var out = make([]string, len(sets))
+	for i, s := range sets {
+		out[i] = s.Name
+	}
+
+	return out
+}
+
+func Test_OrderMachineSetsForClaim(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     v4alpha1.MachineClaimSpec
+		expected []string
+	}{
+		{
+			name: "any",
+			spec: v4alpha1.MachineClaimSpec{
+				MachineTemplate: "ubuntu",
+				BindStrategy:    v4alpha1.BindStrategyAnyAvailable,
+			},
+			expected: []string{"pool-a", "pool-b"},
+		},
+		{
+			name: "any with scheduled event",
+			spec: v4alpha1.MachineClaimSpec{
+				MachineTemplate: "ubuntu",
+				ScheduledEvent:  "se-1",
+				BindStrategy:    v4alpha1.BindStrategyAnyAvailable,
+			},
+			expected: []string{"event", "pool-a", "pool-b"},
+		},
+		{
+			name: "prefer",
+			spec: v4alpha1.MachineClaimSpec{
+				MachineTemplate:          "ubuntu",
+				AccessCode:               "my-code",
+				BindStrategy:             v4alpha1.BindStrategyPreferMachineSets,
+				PreferRequireMachineSets: []string{"pool-b", "code"},
+			},
+			expected: []string{"pool-b", "code", "pool-a"},
+		},
+		{
+			name: "require",
+			spec: v4alpha1.MachineClaimSpec{
+				MachineTemplate:          "ubuntu",
+				ScheduledEvent:           "se-1",
+				BindStrategy:             v4alpha1.BindStrategyRequireMachineSets,
+				PreferRequireMachineSets: []string{"event", "windows"},
+			},
+			expected: []string{"event"},
+		},
+	}
+
+	for _, tt := range tests {
+		claim := &v4alpha1.MachineClaim{Spec: tt.spec}
+
+		got := names(OrderMachineSetsForClaim(claim, testSets()))
+		if len(got) != len(tt.expected) {
+			t.Errorf("%s: wrong sets, expected %v got %v", tt.name, tt.expected, got)
+			continue
+		}
+
+		for i := range got {
+			if got[i] != tt.expected[i] {
+				t.Errorf("%s: wrong order, expected %v got %v", tt.name, tt.expected, got)
+				break
+			}
+		}
+	}
+}
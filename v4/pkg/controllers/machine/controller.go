@@ -0,0 +1,36 @@
+package machine
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	MachineProviderControllerName = "machine-provider-controller"
+
+	// ProviderDriverFinalizer is added to machines of providers that have a registered driver,
+	// so that the driver can remove the infrastructure of the machine before it is deleted.
+	ProviderDriverFinalizer = "hobbyfarm.io/provider-driver"
+)
+
+type machineController struct {
+	kclient client.Client
+	drivers *providers.Registry
+}
+
+// New registers a controller that provisions machines through the drivers in the registry.
+// Machines of providers without a registered driver are left to external operators.
+func New(mgr manager.Manager, drivers *providers.Registry) error {
+	mc := &machineController{
+		kclient: mgr.GetClient(),
+		drivers: drivers,
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.Machine{}).
+		Named(MachineProviderControllerName).Complete(mc)
+}
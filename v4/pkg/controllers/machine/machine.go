@@ -0,0 +1,130 @@
+package machine
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	// readyPollInterval is how often a provisioned machine that is not yet ready is checked.
+	readyPollInterval = 10 * time.Second
+)
+
+func (mc *machineController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	machine := &v4alpha1.Machine{}
+	if err := mc.kclient.Get(ctx, request.NamespacedName, machine); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	driver, ok := mc.drivers.Get(machine.Spec.Provider)
+	if !ok {
+		// provisioned by an external operator
+		return reconcile.Result{}, nil
+	}
+
+	if machine.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(machine, ProviderDriverFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		if err := driver.Delete(ctx, machine); err != nil {
+			eventbuilder.Error().For(machine).By(MachineProviderControllerName, "").
+				Reason("Machine failed to delete").Note(err.Error()).WriteOrLog(mc.kclient)
+			return reconcile.Result{}, err
+		}
+
+		slog.Debug("deleted machine through provider driver", "machine", machine.Name, "provider", machine.Spec.Provider)
+
+		controllerutil.RemoveFinalizer(machine, ProviderDriverFinalizer)
+		return reconcile.Result{}, mc.kclient.Update(ctx, machine)
+	}
+
+	if controllerutil.AddFinalizer(machine, ProviderDriverFinalizer) {
+		if err := mc.kclient.Update(ctx, machine); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if !genericcondition.IsTrue(machine.Status.Conditions, v4alpha1.ConditionMachineProvisioned) {
+		if err := driver.Create(ctx, machine); err != nil {
+			eventbuilder.Error().For(machine).By(MachineProviderControllerName, "").
+				Reason("Machine failed to provision").Note(err.Error()).WriteOrLog(mc.kclient)
+
+			return reconcile.Result{}, mc.setFailed(ctx, machine, v4alpha1.ConditionMachineProvisioned,
+				"error provisioning machine", err)
+		}
+
+		slog.Debug("provisioned machine through provider driver", "machine", machine.Name, "provider", machine.Spec.Provider)
+	}
+
+	state, err := driver.Status(ctx, machine)
+	if err != nil {
+		return reconcile.Result{}, mc.setFailed(ctx, machine, v4alpha1.ConditionMachineReady,
+			"error retrieving machine status", err)
+	}
+
+	// endpoints live on the spec, and must be written before any change is made to the status
+	if state.ConnectEndpoints != nil && !equality.Semantic.DeepEqual(state.ConnectEndpoints, machine.Spec.ConnectEndpoints) {
+		machine.Spec.ConnectEndpoints = state.ConnectEndpoints
+		if err := mc.kclient.Update(ctx, machine); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	origStatus := machine.Status.DeepCopy()
+
+	machine.Status.Conditions = genericcondition.SetCondition(machine.Status.Conditions, v4alpha1.ConditionMachineProvisioned,
+		corev1.ConditionTrue, "machine provisioned", "")
+
+	if state.MachineInformation != nil {
+		machine.Status.MachineInformation = state.MachineInformation
+	}
+
+	if state.Ready {
+		machine.Status.Conditions = genericcondition.SetCondition(machine.Status.Conditions, v4alpha1.ConditionMachineReady,
+			corev1.ConditionTrue, "machine ready", state.Message)
+	} else {
+		machine.Status.Conditions = genericcondition.SetCondition(machine.Status.Conditions, v4alpha1.ConditionMachineReady,
+			corev1.ConditionFalse, "machine not ready", state.Message)
+	}
+
+	if !equality.Semantic.DeepEqual(origStatus, &machine.Status) {
+		if err := mc.kclient.Status().Update(ctx, machine); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if !state.Ready {
+		return reconcile.Result{RequeueAfter: readyPollInterval}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// setFailed records err on the condition of type condType and returns err,
+// or the error encountered while writing the status.
+func (mc *machineController) setFailed(ctx context.Context, machine *v4alpha1.Machine, condType string,
+	reason string, err error) error {
+	slog.Error(reason, "machine", machine.Name, "provider", machine.Spec.Provider, "error", err.Error())
+
+	origStatus := machine.Status.DeepCopy()
+	machine.Status.Conditions = genericcondition.SetCondition(machine.Status.Conditions, condType,
+		corev1.ConditionFalse, reason, err.Error())
+
+	if !equality.Semantic.DeepEqual(origStatus, &machine.Status) {
+		if updateErr := mc.kclient.Status().Update(ctx, machine); updateErr != nil {
+			return updateErr
+		}
+	}
+
+	return err
+}
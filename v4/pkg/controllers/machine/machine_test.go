@@ -0,0 +1,141 @@
+package machine
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers/fake"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"testing"
+	"time"
+)
+
+func Test_FakeProviderLifecycle(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	machine := &v4alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "m-test"},
+		Spec: v4alpha1.MachineSpec{
+			Provider: "fake",
+			ConnectEndpoints: map[v4alpha1.ConnectProtocol]string{
+				v4alpha1.ConnectProtocolSSH: "placeholder",
+			},
+		},
+	}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(machine).
+		WithStatusSubresource(&v4alpha1.Machine{}).Build()
+
+	driver := fake.New(fake.WithReadyAfter(time.Minute), fake.WithClock(func() time.Time { return now }))
+	registry := providers.NewRegistry()
+	if err := registry.Register("fake", driver); err != nil {
+		t.Fatal(err)
+	}
+
+	mc := &machineController{kclient: kclient, drivers: registry}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Name: machine.Name}}
+
+	// provisioned, but not yet ready
+	result, err := mc.Reconcile(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != readyPollInterval {
+		t.Errorf("expected requeue after %s for starting machine, got %s", readyPollInterval, result.RequeueAfter)
+	}
+
+	got := getMachine(t, kclient, machine.Name)
+	if !controllerutil.ContainsFinalizer(got, ProviderDriverFinalizer) {
+		t.Error("expected finalizer to be added")
+	}
+	if !genericcondition.IsTrue(got.Status.Conditions, v4alpha1.ConditionMachineProvisioned) {
+		t.Error("expected machine to be provisioned")
+	}
+	if genericcondition.IsTrue(got.Status.Conditions, v4alpha1.ConditionMachineReady) {
+		t.Error("expected machine to not be ready")
+	}
+
+	// ready
+	now = now.Add(time.Minute)
+	if _, err := mc.Reconcile(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	got = getMachine(t, kclient, machine.Name)
+	if !genericcondition.IsTrue(got.Status.Conditions, v4alpha1.ConditionMachineReady) {
+		t.Error("expected machine to be ready")
+	}
+	if got.Status.MachineInformation["private_ip"] != "10.0.0.1" {
+		t.Errorf("expected private_ip 10.0.0.1, got %s", got.Status.MachineInformation["private_ip"])
+	}
+	if got.Spec.ConnectEndpoints[v4alpha1.ConnectProtocolSSH] != "10.0.0.1:22" {
+		t.Errorf("expected ssh endpoint 10.0.0.1:22, got %s", got.Spec.ConnectEndpoints[v4alpha1.ConnectProtocolSSH])
+	}
+
+	// deleted
+	if err := kclient.Delete(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mc.Reconcile(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	if driver.Exists(machine.Name) {
+		t.Error("expected driver to have deleted machine")
+	}
+	if err := kclient.Get(ctx, client.ObjectKey{Name: machine.Name}, &v4alpha1.Machine{}); !errors.IsNotFound(err) {
+		t.Errorf("expected machine to be removed, got %v", err)
+	}
+}
+
+func Test_ExternalProviderIgnored(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	machine := &v4alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "m-external"},
+		Spec:       v4alpha1.MachineSpec{Provider: "external"},
+	}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(machine).
+		WithStatusSubresource(&v4alpha1.Machine{}).Build()
+
+	mc := &machineController{kclient: kclient, drivers: providers.NewRegistry()}
+	if _, err := mc.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Name: machine.Name},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	got := getMachine(t, kclient, machine.Name)
+	if len(got.Finalizers) > 0 || len(got.Status.Conditions) > 0 {
+		t.Error("expected machine of external provider to be left untouched")
+	}
+}
+
+func getMachine(t *testing.T, kclient client.Client, name string) *v4alpha1.Machine {
+	t.Helper()
+
+	m := &v4alpha1.Machine{}
+	if err := kclient.Get(context.Background(), client.ObjectKey{Name: name}, m); err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
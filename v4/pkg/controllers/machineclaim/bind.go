@@ -0,0 +1,111 @@
+package machineclaim
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/helpers"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// bind attempts to bind the claim to a free machine. This replaces the v3 vmclaimsvc assignNextFreeVM logic.
+//
+// MachineSets are tried in the order given by helpers.OrderMachineSetsForClaim, which implements the
+// semantics of the claim's BindStrategy: preferred (or required) sets first, followed by all other matching
+// sets unless the strategy is BindStrategyRequireMachineSets.
+//
+// Binding is done by updating the labels of the Machine. Because updates are checked against the
+// resourceVersion of the object, two claims racing for the same machine cannot both succeed - the loser
+// receives a conflict and moves on to the next candidate.
+//
+// If no machine could be bound, a nil machine and nil error are returned.
+func (mcc *machineClaimController) bind(ctx context.Context, claim *v4alpha1.MachineClaim) (*v4alpha1.Machine, error) {
+	// we may have bound a machine previously but failed to record it in the claim status
+	if machine, err := mcc.findBoundMachine(ctx, claim); err != nil || machine != nil {
+		return machine, err
+	}
+
+	setList := &v4alpha1.MachineSetList{}
+	if err := mcc.kclient.List(ctx, setList, client.InNamespace(claim.Namespace)); err != nil {
+		return nil, err
+	}
+
+	for _, set := range helpers.OrderMachineSetsForClaim(claim, setList.Items) {
+		machineList := &v4alpha1.MachineList{}
+		if err := mcc.kclient.List(ctx, machineList, client.InNamespace(claim.Namespace), client.MatchingLabels{
+			labels.MachineSetLabel:   set.Name,
+			labels.MachineBoundLabel: "false",
+		}); err != nil {
+			return nil, err
+		}
+
+		for i := range machineList.Items {
+			machine := &machineList.Items[i]
+			if machine.DeletionTimestamp != nil {
+				continue
+			}
+
+			ok, err := mcc.tryBind(ctx, claim, machine)
+			if err != nil {
+				return nil, err
+			}
+
+			if ok {
+				return machine, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// tryBind attempts to mark the machine as bound to the claim. It returns false without error
+// if another claim won the race for this machine.
+func (mcc *machineClaimController) tryBind(ctx context.Context, claim *v4alpha1.MachineClaim, machine *v4alpha1.Machine) (bool, error) {
+	if machine.Labels == nil {
+		machine.Labels = map[string]string{}
+	}
+
+	machine.Labels[labels.MachineBoundLabel] = "true"
+	machine.Labels[labels.MachineClaimLabel] = claim.Name
+
+	if err := controllerutil.SetOwnerReference(claim, machine, mcc.scheme); err != nil {
+		return false, err
+	}
+
+	if err := mcc.kclient.Update(ctx, machine); err != nil {
+		if errors.IsConflict(err) || errors.IsNotFound(err) {
+			slog.Debug("lost race binding machine", "machineclaim", claim.Name, "machine", machine.Name)
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (mcc *machineClaimController) findBoundMachine(ctx context.Context, claim *v4alpha1.MachineClaim) (*v4alpha1.Machine, error) {
+	machineList := &v4alpha1.MachineList{}
+	if err := mcc.kclient.List(ctx, machineList, client.InNamespace(claim.Namespace), client.MatchingLabels{
+		labels.MachineClaimLabel: claim.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+		if boundTo(machine, claim) && machine.DeletionTimestamp == nil {
+			return machine, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func boundTo(machine *v4alpha1.Machine, claim *v4alpha1.MachineClaim) bool {
+	return machine.Labels[labels.MachineBoundLabel] == "true" && machine.Labels[labels.MachineClaimLabel] == claim.Name
+}
@@ -0,0 +1,73 @@
+package machineclaim
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/helpers"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	MachineClaimBindControllerName = "machineclaim-bind-controller"
+
+	// MachineClaimFinalizer keeps a claim until the machine bound to it has been deleted. The bound machine
+	// is still owned by its MachineSet, so it is not garbage collected with the claim.
+	MachineClaimFinalizer = "hobbyfarm.io/machineclaim-release"
+)
+
+type machineClaimController struct {
+	kclient client.Client
+	scheme  *runtime.Scheme
+}
+
+func New(mgr manager.Manager) error {
+	mcc := &machineClaimController{
+		kclient: mgr.GetClient(),
+		scheme:  mgr.GetScheme(),
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.MachineClaim{}).
+		Watches(&v4alpha1.Machine{}, handler.EnqueueRequestsFromMapFunc(mcc.claimsForMachine)).
+		Named(MachineClaimBindControllerName).Complete(mcc)
+}
+
+// claimsForMachine maps a Machine to the claims that care about it. A bound machine maps to the claim
+// that holds it. An unbound machine maps to every pending claim for the same MachineTemplate, since any
+// of them may now be able to bind.
+func (mcc *machineClaimController) claimsForMachine(ctx context.Context, obj client.Object) []reconcile.Request {
+	if claim, ok := obj.GetLabels()[labels.MachineClaimLabel]; ok && claim != "" {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: claim}}}
+	}
+
+	claimList := &v4alpha1.MachineClaimList{}
+	if err := mcc.kclient.List(ctx, claimList, client.InNamespace(obj.GetNamespace())); err != nil {
+		slog.Error("error listing machineclaims for machine", "machine", obj.GetName(), "error", err.Error())
+		return nil
+	}
+
+	var out = make([]reconcile.Request, 0)
+	for i := range claimList.Items {
+		claim := &claimList.Items[i]
+		if !helpers.ClaimIsPending(claim) {
+			continue
+		}
+
+		if claim.Spec.MachineTemplate != obj.GetLabels()[labels.MachineTemplateLabel] {
+			continue
+		}
+
+		out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: claim.Namespace, Name: claim.Name}})
+	}
+
+	return out
+}
@@ -0,0 +1,155 @@
+package machineclaim
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	// bindTimeout is the period of time after which a MachineClaim that could not be bound
+	// is moved to MachineClaimPhaseFailed.
+	bindTimeout = 10 * time.Minute
+
+	// bindRetryInterval is how often an unbound claim is retried while waiting for a machine.
+	bindRetryInterval = 10 * time.Second
+)
+
+func (mcc *machineClaimController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	claim := &v4alpha1.MachineClaim{}
+	if err := mcc.kclient.Get(ctx, request.NamespacedName, claim); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if claim.DeletionTimestamp != nil {
+		if !controllerutil.ContainsFinalizer(claim, MachineClaimFinalizer) {
+			return reconcile.Result{}, nil
+		}
+
+		if err := mcc.release(ctx, claim); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		controllerutil.RemoveFinalizer(claim, MachineClaimFinalizer)
+		return reconcile.Result{}, mcc.kclient.Update(ctx, claim)
+	}
+
+	if controllerutil.AddFinalizer(claim, MachineClaimFinalizer) {
+		if err := mcc.kclient.Update(ctx, claim); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Because mink adds "-p" to end of UIDs
+	// remove it so that owner references on machines are valid
+	claim.UID = uid.RemoveUIDPublic(claim.UID)
+
+	switch claim.Status.Phase {
+	case "":
+		claim.Status.Phase = v4alpha1.MachineClaimPhaseRequested
+		if err := mcc.kclient.Status().Update(ctx, claim); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		return reconcile.Result{Requeue: true}, nil
+	case v4alpha1.MachineClaimPhaseRequested:
+		return mcc.reconcileRequested(ctx, claim)
+	case v4alpha1.MachineClaimPhaseBound:
+		return mcc.reconcileBound(ctx, claim)
+	case v4alpha1.MachineClaimPhaseTerminated:
+		return reconcile.Result{}, mcc.release(ctx, claim)
+	default:
+		// failed claims are left for the user (or a session) to clean up
+		return reconcile.Result{}, nil
+	}
+}
+
+func (mcc *machineClaimController) reconcileRequested(ctx context.Context, claim *v4alpha1.MachineClaim) (reconcile.Result, error) {
+	machine, err := mcc.bind(ctx, claim)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if machine != nil {
+		slog.Debug("bound machineclaim to machine", "machineclaim", claim.Name, "machine", machine.Name)
+
+		claim.Status.Machine = machine.Name
+		claim.Status.Phase = v4alpha1.MachineClaimPhaseBound
+		claim.Status.Conditions = genericcondition.SetCondition(claim.Status.Conditions, v4alpha1.ConditionMachineBound,
+			corev1.ConditionTrue, "machine bound", fmt.Sprintf("bound to machine %s", machine.Name))
+
+		eventbuilder.Info().For(claim).By(MachineClaimBindControllerName, "").
+			Reason("MachineClaim bound").Note(fmt.Sprintf("bound to machine %s from machineset %s",
+			machine.Name, machine.Spec.MachineSet)).WriteOrLog(mcc.kclient)
+
+		return reconcile.Result{}, mcc.kclient.Status().Update(ctx, claim)
+	}
+
+	if time.Since(claim.CreationTimestamp.Time) > bindTimeout {
+		claim.Status.Phase = v4alpha1.MachineClaimPhaseFailed
+		claim.Status.Conditions = genericcondition.SetCondition(claim.Status.Conditions, v4alpha1.ConditionMachineBound,
+			corev1.ConditionFalse, "no machine available",
+			fmt.Sprintf("no machine could be bound within %s", bindTimeout.String()))
+
+		eventbuilder.Warning().For(claim).By(MachineClaimBindControllerName, "").
+			Reason("MachineClaim failed to bind").
+			Note(fmt.Sprintf("no machine of template %s became available within %s. check the capacity "+
+				"of matching machinesets", claim.Spec.MachineTemplate, bindTimeout.String())).WriteOrLog(mcc.kclient)
+
+		return reconcile.Result{}, mcc.kclient.Status().Update(ctx, claim)
+	}
+
+	origStatus := claim.Status.DeepCopy()
+	claim.Status.Conditions = genericcondition.SetCondition(claim.Status.Conditions, v4alpha1.ConditionMachineBound,
+		corev1.ConditionFalse, "waiting for machine", "no matching machine is available yet")
+	if !equality.Semantic.DeepEqual(origStatus, &claim.Status) {
+		if err := mcc.kclient.Status().Update(ctx, claim); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: bindRetryInterval}, nil
+}
+
+func (mcc *machineClaimController) reconcileBound(ctx context.Context, claim *v4alpha1.MachineClaim) (reconcile.Result, error) {
+	machine := &v4alpha1.Machine{}
+	err := mcc.kclient.Get(ctx, client.ObjectKey{Namespace: claim.Namespace, Name: claim.Status.Machine}, machine)
+	if err != nil && !errors.IsNotFound(err) {
+		return reconcile.Result{}, err
+	}
+
+	if errors.IsNotFound(err) || machine.DeletionTimestamp != nil {
+		claim.Status.Phase = v4alpha1.MachineClaimPhaseTerminated
+		claim.Status.Conditions = genericcondition.SetCondition(claim.Status.Conditions, v4alpha1.ConditionMachineBound,
+			corev1.ConditionFalse, "machine unavailable", fmt.Sprintf("machine %s is no longer available", claim.Status.Machine))
+
+		return reconcile.Result{}, mcc.kclient.Status().Update(ctx, claim)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// release deletes the machine bound to a terminated or deleted claim. Machines are not recycled between
+// claims, the owning MachineSet provisions a fresh machine in its place. The machine is looked up by its
+// labels, it may have been bound without being recorded in the status of the claim.
+func (mcc *machineClaimController) release(ctx context.Context, claim *v4alpha1.MachineClaim) error {
+	machine, err := mcc.findBoundMachine(ctx, claim)
+	if err != nil || machine == nil {
+		return err
+	}
+
+	slog.Debug("releasing machine for terminated machineclaim", "machineclaim", claim.Name, "machine", machine.Name)
+
+	return client.IgnoreNotFound(mcc.kclient.Delete(ctx, machine))
+}
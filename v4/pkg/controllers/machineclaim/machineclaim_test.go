@@ -0,0 +1,83 @@
+package machineclaim
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"testing"
+)
+
+func Test_DeletedClaimReleasesMachine(t *testing.T) {
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	claim := &v4alpha1.MachineClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mc-test"},
+		Spec:       v4alpha1.MachineClaimSpec{MachineTemplate: "ubuntu"},
+		Status: v4alpha1.MachineClaimStatus{
+			Phase:   v4alpha1.MachineClaimPhaseBound,
+			Machine: "m-bound",
+		},
+	}
+	bound := &v4alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "m-bound",
+		Labels: map[string]string{
+			labels.MachineSetLabel:   "ms-test",
+			labels.MachineBoundLabel: "true",
+			labels.MachineClaimLabel: claim.Name,
+		},
+	}}
+	free := &v4alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "m-free",
+		Labels: map[string]string{
+			labels.MachineSetLabel:   "ms-test",
+			labels.MachineBoundLabel: "false",
+		},
+	}}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(claim, bound, free).
+		WithStatusSubresource(&v4alpha1.MachineClaim{}).Build()
+
+	mcc := &machineClaimController{kclient: kclient, scheme: scheme}
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: claim.Namespace, Name: claim.Name}}
+
+	if _, err := mcc.Reconcile(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &v4alpha1.MachineClaim{}
+	if err := kclient.Get(ctx, request.NamespacedName, got); err != nil {
+		t.Fatal(err)
+	}
+	if !controllerutil.ContainsFinalizer(got, MachineClaimFinalizer) {
+		t.Fatal("expected finalizer to be added")
+	}
+
+	// deleted while bound
+	if err := kclient.Delete(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := mcc.Reconcile(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := kclient.Get(ctx, client.ObjectKeyFromObject(bound), &v4alpha1.Machine{}); !errors.IsNotFound(err) {
+		t.Errorf("expected bound machine to be deleted, got %v", err)
+	}
+	if err := kclient.Get(ctx, client.ObjectKeyFromObject(free), &v4alpha1.Machine{}); err != nil {
+		t.Errorf("expected unbound machine to be kept, got %v", err)
+	}
+	if err := kclient.Get(ctx, request.NamespacedName, &v4alpha1.MachineClaim{}); !errors.IsNotFound(err) {
+		t.Errorf("expected claim to be removed, got %v", err)
+	}
+}
@@ -0,0 +1,64 @@
+package machineset
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/helpers"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+const (
+	MachineSetScaleControllerName = "machineset-scale-controller"
+)
+
+type machineSetController struct {
+	kclient client.Client
+	scheme  *runtime.Scheme
+}
+
+func New(mgr manager.Manager) error {
+	msc := &machineSetController{
+		kclient: mgr.GetClient(),
+		scheme:  mgr.GetScheme(),
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.MachineSet{}).
+		Owns(&v4alpha1.Machine{}).
+		Watches(&v4alpha1.MachineClaim{}, handler.EnqueueRequestsFromMapFunc(msc.machineSetsForClaim)).
+		Named(MachineSetScaleControllerName).Complete(msc)
+}
+
+// machineSetsForClaim enqueues every OnDemand MachineSet that could provision a machine for the claim.
+// AutoScale sets do not care about claims directly, they are requeued when their machines change.
+func (msc *machineSetController) machineSetsForClaim(ctx context.Context, obj client.Object) []reconcile.Request {
+	claim, ok := obj.(*v4alpha1.MachineClaim)
+	if !ok {
+		return nil
+	}
+
+	setList := &v4alpha1.MachineSetList{}
+	if err := msc.kclient.List(ctx, setList, client.InNamespace(claim.Namespace)); err != nil {
+		slog.Error("error listing machinesets for machineclaim", "machineclaim", claim.Name, "error", err.Error())
+		return nil
+	}
+
+	var out = make([]reconcile.Request, 0)
+	for _, set := range helpers.OrderMachineSetsForClaim(claim, setList.Items) {
+		if set.Spec.ProvisioningStrategy != v4alpha1.ProvisioningStrategyDynamic {
+			continue
+		}
+
+		out = append(out, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: set.Namespace, Name: set.Name}})
+	}
+
+	return out
+}
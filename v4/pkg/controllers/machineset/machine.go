@@ -0,0 +1,89 @@
+package machineset
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	defaultMachineNamePrefix = "m-"
+)
+
+func (msc *machineSetController) newMachine(set *v4alpha1.MachineSet, template *v4alpha1.MachineTemplate,
+	env *v4alpha1.Environment) (*v4alpha1.Machine, error) {
+	machine := &v4alpha1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: machineNamePrefix(set, template, env),
+			Namespace:    set.Namespace,
+			Labels: map[string]string{
+				labels.MachineSetLabel:      set.Name,
+				labels.MachineTemplateLabel: template.Name,
+				labels.EnvironmentLabel:     env.Name,
+				labels.ProviderLabel:        set.Spec.Provider,
+				labels.MachineBoundLabel:    "false",
+			},
+		},
+		Spec: v4alpha1.MachineSpec{
+			MachineType:           template.Spec.MachineType,
+			Provider:              set.Spec.Provider,
+			ProviderConfiguration: providerConfiguration(set, template, env),
+			MachineSet:            set.Name,
+			MachineTemplate:       template.Name,
+			Environment:           env.Name,
+			ConnectEndpoints:      connectEndpoints(template, env),
+		},
+	}
+
+	if err := controllerutil.SetControllerReference(set, machine, msc.scheme); err != nil {
+		return nil, err
+	}
+
+	return machine, nil
+}
+
+// machineNamePrefix determines the GenerateName of a new machine.
+// In decreasing order of precedence: MachineSet, Environment, MachineTemplate.
+func machineNamePrefix(set *v4alpha1.MachineSet, template *v4alpha1.MachineTemplate, env *v4alpha1.Environment) string {
+	for _, prefix := range []string{set.Spec.MachineNamePrefix, env.Spec.MachineNamePrefix, template.Spec.MachineNamePrefix} {
+		if prefix != "" {
+			return prefix
+		}
+	}
+
+	return defaultMachineNamePrefix
+}
+
+// providerConfiguration merges the configuration for the provider of the set.
+// In increasing order of precedence: MachineTemplate, Environment(ProviderConfiguration),
+// Environment(TemplateConfiguration), MachineSet.
+func providerConfiguration(set *v4alpha1.MachineSet, template *v4alpha1.MachineTemplate, env *v4alpha1.Environment) map[string]string {
+	var out = make(map[string]string)
+
+	for _, conf := range []map[string]string{
+		template.Spec.ProviderConfiguration[set.Spec.Provider],
+		env.Spec.ProviderConfiguration,
+		env.Spec.TemplateConfiguration[template.Name],
+		set.Spec.ProviderConfiguration,
+	} {
+		for k, v := range conf {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+// connectEndpoints returns the endpoints of the environment for every protocol supported by the template.
+func connectEndpoints(template *v4alpha1.MachineTemplate, env *v4alpha1.Environment) map[v4alpha1.ConnectProtocol]string {
+	var out = make(map[v4alpha1.ConnectProtocol]string)
+
+	for _, proto := range template.Spec.ConnectProtocols {
+		if endpoint, ok := env.Spec.Endpoints[proto]; ok {
+			out[proto] = endpoint
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,221 @@
+package machineset
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/helpers"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func (msc *machineSetController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	set := &v4alpha1.MachineSet{}
+	if err := msc.kclient.Get(ctx, request.NamespacedName, set); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if set.DeletionTimestamp != nil {
+		// machines are owned by the set and will be garbage collected with it
+		return reconcile.Result{}, nil
+	}
+
+	// Because mink adds "-p" to end of UIDs
+	// remove it so that owner references on machines are valid
+	set.UID = uid.RemoveUIDPublic(set.UID)
+
+	machineList := &v4alpha1.MachineList{}
+	if err := msc.kclient.List(ctx, machineList, client.InNamespace(set.Namespace), client.MatchingLabels{
+		labels.MachineSetLabel: set.Name,
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	provisioned, unbound := countMachines(machineList.Items)
+	origStatus := set.Status.DeepCopy()
+
+	var pending = 0
+	if set.Spec.ProvisioningStrategy == v4alpha1.ProvisioningStrategyDynamic {
+		var err error
+		if pending, err = msc.pendingClaims(ctx, set); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	set.Status.Provisioned = provisioned
+	set.Status.Available = len(unbound)
+
+	delta := scaleDelta(set, provisioned, len(unbound), pending)
+
+	switch {
+	case delta > 0:
+		msc.scaleUp(ctx, set, delta)
+	case delta < 0:
+		msc.scaleDown(ctx, set, unbound[:-delta])
+	}
+
+	if !equality.Semantic.DeepEqual(origStatus, &set.Status) {
+		if err := msc.kclient.Status().Update(ctx, set); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{Requeue: delta != 0}, nil
+}
+
+// scaleDelta calculates how many machines should be created (positive) or deleted (negative) given the
+// current state of the MachineSet.
+// For AutoScale sets the target is MinAvailable unbound machines. For OnDemand sets the target is one unbound
+// machine per pending MachineClaim that this set is responsible for. In both cases the number of provisioned
+// machines is never allowed to exceed MaxProvisioned, and only unbound machines are ever removed.
+func scaleDelta(set *v4alpha1.MachineSet, provisioned int, available int, pending int) int {
+	var delta int
+
+	switch set.Spec.ProvisioningStrategy {
+	case v4alpha1.ProvisioningStrategyDynamic:
+		delta = pending - available
+	default:
+		delta = max(set.Spec.MinAvailable-available, 0)
+	}
+
+	if headroom := set.Spec.MaxProvisioned - provisioned; delta > headroom {
+		delta = headroom
+	}
+
+	if delta < -available {
+		delta = -available
+	}
+
+	return delta
+}
+
+// countMachines returns the number of provisioned machines as well as the slice
+// of machines that are not bound to a MachineClaim.
+// Machines that are being deleted are not counted.
+func countMachines(machines []v4alpha1.Machine) (int, []v4alpha1.Machine) {
+	var provisioned = 0
+	var unbound = make([]v4alpha1.Machine, 0)
+
+	for _, m := range machines {
+		if m.DeletionTimestamp != nil {
+			continue
+		}
+
+		provisioned++
+
+		if m.Labels[labels.MachineBoundLabel] != "true" {
+			unbound = append(unbound, m)
+		}
+	}
+
+	return provisioned, unbound
+}
+
+// pendingClaims counts the MachineClaims waiting on a machine for which this set is the
+// selected OnDemand provisioner.
+func (msc *machineSetController) pendingClaims(ctx context.Context, set *v4alpha1.MachineSet) (int, error) {
+	claimList := &v4alpha1.MachineClaimList{}
+	if err := msc.kclient.List(ctx, claimList, client.InNamespace(set.Namespace)); err != nil {
+		return 0, err
+	}
+
+	setList := &v4alpha1.MachineSetList{}
+	if err := msc.kclient.List(ctx, setList, client.InNamespace(set.Namespace)); err != nil {
+		return 0, err
+	}
+
+	var pending = 0
+	for i := range claimList.Items {
+		claim := &claimList.Items[i]
+		if !helpers.ClaimIsPending(claim) {
+			continue
+		}
+
+		if selected := helpers.SelectOnDemandMachineSet(claim, setList.Items); selected != nil && selected.Name == set.Name {
+			pending++
+		}
+	}
+
+	return pending, nil
+}
+
+func (msc *machineSetController) scaleUp(ctx context.Context, set *v4alpha1.MachineSet, count int) {
+	slog.Debug("scaling up machineset", "machineset", set.Name, "count", count)
+
+	template := &v4alpha1.MachineTemplate{}
+	if err := msc.kclient.Get(ctx, client.ObjectKey{Name: set.Spec.MachineTemplate}, template); err != nil {
+		msc.scaleUpFailed(set, "error retrieving machinetemplate", err)
+		return
+	}
+
+	env := &v4alpha1.Environment{}
+	if err := msc.kclient.Get(ctx, client.ObjectKey{Namespace: set.Namespace, Name: set.Spec.Environment}, env); err != nil {
+		msc.scaleUpFailed(set, "error retrieving environment", err)
+		return
+	}
+
+	var created = 0
+	for range count {
+		machine, err := msc.newMachine(set, template, env)
+		if err != nil {
+			msc.scaleUpFailed(set, "error building machine", err)
+			return
+		}
+
+		if err := msc.kclient.Create(ctx, machine); err != nil {
+			msc.scaleUpFailed(set, "error creating machine", err)
+			return
+		}
+
+		created++
+	}
+
+	set.Status.Provisioned += created
+	set.Status.Available += created
+	set.Status.Conditions = genericcondition.SetCondition(set.Status.Conditions, v4alpha1.ConditionScaleUpSuccessful,
+		corev1.ConditionTrue, "machines created", fmt.Sprintf("created %d machine(s)", created))
+}
+
+func (msc *machineSetController) scaleUpFailed(set *v4alpha1.MachineSet, reason string, err error) {
+	slog.Error(reason, "machineset", set.Name, "error", err.Error())
+
+	eventbuilder.Error().For(set).By(MachineSetScaleControllerName, "").
+		Reason("MachineSet failed scale-up").Note(fmt.Sprintf("%s: %s", reason, err.Error())).WriteOrLog(msc.kclient)
+
+	set.Status.Conditions = genericcondition.SetCondition(set.Status.Conditions, v4alpha1.ConditionScaleUpSuccessful,
+		corev1.ConditionFalse, reason, err.Error())
+}
+
+func (msc *machineSetController) scaleDown(ctx context.Context, set *v4alpha1.MachineSet, machines []v4alpha1.Machine) {
+	slog.Debug("scaling down machineset", "machineset", set.Name, "count", len(machines))
+
+	var deleted = 0
+	for _, m := range machines {
+		// the machine may have been bound since we listed it, in which case deletion fails on conflict
+		if err := msc.kclient.Delete(ctx, &m, client.Preconditions{ResourceVersion: &m.ResourceVersion}); err != nil {
+			eventbuilder.Error().For(set).By(MachineSetScaleControllerName, "").
+				Reason("MachineSet failed scale-down").Note(err.Error()).WriteOrLog(msc.kclient)
+
+			set.Status.Conditions = genericcondition.SetCondition(set.Status.Conditions, v4alpha1.ConditionScaleDownSuccessful,
+				corev1.ConditionFalse, "error deleting machine", err.Error())
+			continue
+		}
+
+		deleted++
+	}
+
+	set.Status.Provisioned -= deleted
+	set.Status.Available -= deleted
+
+	if deleted == len(machines) {
+		set.Status.Conditions = genericcondition.SetCondition(set.Status.Conditions, v4alpha1.ConditionScaleDownSuccessful,
+			corev1.ConditionTrue, "machines deleted", fmt.Sprintf("deleted %d machine(s)", deleted))
+	}
+}
@@ -0,0 +1,83 @@
+package machineset
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"testing"
+)
+
+func Test_ScaleDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		strategy    v4alpha1.ProvisioningStrategy
+		min         int
+		max         int
+		provisioned int
+		available   int
+		pending     int
+		want        int
+	}{
+		{"autoscale from empty", v4alpha1.ProvisioningStrategyAutoScale, 10, 20, 0, 0, 0, 10},
+		{"autoscale partially claimed", v4alpha1.ProvisioningStrategyAutoScale, 10, 20, 10, 4, 0, 6},
+		{"autoscale capped at max", v4alpha1.ProvisioningStrategyAutoScale, 10, 20, 18, 2, 0, 2},
+		{"autoscale satisfied", v4alpha1.ProvisioningStrategyAutoScale, 10, 20, 15, 12, 0, 0},
+		{"autoscale over max", v4alpha1.ProvisioningStrategyAutoScale, 2, 5, 8, 2, 0, -2},
+		{"ondemand pending claims", v4alpha1.ProvisioningStrategyDynamic, 10, 20, 3, 0, 2, 2},
+		{"ondemand ignores min", v4alpha1.ProvisioningStrategyDynamic, 10, 20, 0, 0, 0, 0},
+		{"ondemand surplus", v4alpha1.ProvisioningStrategyDynamic, 0, 20, 5, 3, 1, -2},
+		{"ondemand capped at max", v4alpha1.ProvisioningStrategyDynamic, 0, 4, 3, 0, 5, 1},
+		{"ondemand drained", v4alpha1.ProvisioningStrategyDynamic, 0, 0, 3, 1, 5, -1},
+	}
+
+	for _, tt := range tests {
+		set := &v4alpha1.MachineSet{
+			Spec: v4alpha1.MachineSetSpec{
+				ProvisioningStrategy: tt.strategy,
+				MinAvailable:         tt.min,
+				MaxProvisioned:       tt.max,
+			},
+		}
+
+		if got := scaleDelta(set, tt.provisioned, tt.available, tt.pending); got != tt.want {
+			t.Errorf("%s: wrong delta, expected %d got %d", tt.name, tt.want, got)
+		}
+	}
+}
+
+func Test_ProviderConfigurationPrecedence(t *testing.T) {
+	set := &v4alpha1.MachineSet{Spec: v4alpha1.MachineSetSpec{
+		Provider:              "aws",
+		ProviderConfiguration: map[string]string{"size": "set"},
+	}}
+	template := &v4alpha1.MachineTemplate{Spec: v4alpha1.MachineTemplateSpec{
+		ProviderConfiguration: map[string]map[string]string{
+			"aws": {"ami": "template", "size": "template", "user": "template"},
+			"gcp": {"image": "template"},
+		},
+	}}
+	template.Name = "ubuntu"
+	env := &v4alpha1.Environment{Spec: v4alpha1.EnvironmentSpec{
+		ProviderConfiguration: map[string]string{"ami": "env", "region": "env"},
+		TemplateConfiguration: map[string]map[string]string{
+			"ubuntu": {"ami": "env-template"},
+		},
+	}}
+
+	conf := providerConfiguration(set, template, env)
+
+	expected := map[string]string{
+		"ami":    "env-template",
+		"size":   "set",
+		"user":   "template",
+		"region": "env",
+	}
+
+	if len(conf) != len(expected) {
+		t.Errorf("wrong configuration, expected %v got %v", expected, conf)
+	}
+
+	for k, v := range expected {
+		if conf[k] != v {
+			t.Errorf("wrong value for %s, expected %s got %s", k, v, conf[k])
+		}
+	}
+}
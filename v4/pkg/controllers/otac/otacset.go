@@ -27,7 +27,7 @@ func (cx otacSetScaleController) Reconcile(ctx context.Context, request reconcil
 	}
 
 	otacList := &v4alpha1.OneTimeAccessCodeList{}
-	if err := cx.kclient.List(ctx, otacList); err != nil {
+	if err := cx.kclient.List(ctx, otacList, client.InNamespace(set.Namespace)); err != nil {
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
@@ -54,6 +54,7 @@ func (cx otacSetScaleController) Reconcile(ctx context.Context, request reconcil
 			newOtac := &v4alpha1.OneTimeAccessCode{
 				ObjectMeta: metav1.ObjectMeta{
 					GenerateName: "otacset-",
+					Namespace:    set.Namespace,
 					Labels: map[string]string{
 						labels.OneTimeAccessCodeSetLabel:      set.GetName(),
 						labels.OneTimeAccessCodeRedeemedLabel: "false",
@@ -84,7 +85,8 @@ func (cx otacSetScaleController) Reconcile(ctx context.Context, request reconcil
 		// list the number of objects that meet the requirement
 		list := &v4alpha1.OneTimeAccessCodeList{}
 		if err := cx.kclient.List(ctx, list, &client.ListOptions{
-			Limit: int64(set.Status.Created - set.Spec.Count),
+			Namespace: set.Namespace,
+			Limit:     int64(set.Status.Created - set.Spec.Count),
 			LabelSelector: labels2.SelectorFromSet(map[string]string{
 				labels.OneTimeAccessCodeRedeemedLabel: "false",
 			}),
@@ -0,0 +1,31 @@
+package scheduledevent
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	ScheduledEventControllerName = "scheduledevent-lifecycle-controller"
+)
+
+type scheduledEventController struct {
+	kclient client.Client
+	scheme  *runtime.Scheme
+}
+
+func New(mgr manager.Manager) error {
+	sec := &scheduledEventController{
+		kclient: mgr.GetClient(),
+		scheme:  mgr.GetScheme(),
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.ScheduledEvent{}).
+		Owns(&v4alpha1.MachineSet{}).
+		Named(ScheduledEventControllerName).Complete(sec)
+}
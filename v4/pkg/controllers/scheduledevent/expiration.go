@@ -0,0 +1,191 @@
+package scheduledevent
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+const (
+	// softExpirationInterval is how often an event expired with ExpirationStrategySoft is
+	// checked for machinesets that have drained.
+	softExpirationInterval = time.Minute
+)
+
+// expire applies the ExpirationStrategy of an event that has passed its EndTime.
+//
+// With ExpirationStrategyCutOff, the MachineClaims of all sessions in the event are terminated and the
+// machinesets created for the event are removed immediately.
+//
+// With ExpirationStrategySoft, users keep the machines they are currently using. Created machinesets are
+// switched to OnDemand with no minimum and no capacity so that no new machines are provisioned, not even for
+// pending claims, and each set is removed once its last machine has been released.
+func (sec *scheduledEventController) expire(ctx context.Context, se *v4alpha1.ScheduledEvent,
+	origStatus *v4alpha1.ScheduledEventStatus) (reconcile.Result, error) {
+	var requeue time.Duration
+
+	switch se.Spec.ExpirationStrategy {
+	case v4alpha1.ExpirationStrategySoft:
+		remaining, err := sec.drainMachineSets(ctx, se)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if remaining > 0 {
+			requeue = softExpirationInterval
+		}
+	default:
+		if err := sec.terminateSessions(ctx, se); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := sec.deleteMachineSets(ctx, se); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	se.Status.Conditions = genericcondition.SetCondition(se.Status.Conditions, v4alpha1.ConditionExpired,
+		corev1.ConditionTrue, fmt.Sprintf("expired using %s strategy", expirationStrategy(se)),
+		fmt.Sprintf("event ended at %s", se.Spec.EndTime.String()))
+
+	if !equality.Semantic.DeepEqual(origStatus, &se.Status) {
+		if err := sec.kclient.Status().Update(ctx, se); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: requeue}, nil
+}
+
+func expirationStrategy(se *v4alpha1.ScheduledEvent) v4alpha1.ExpirationStrategy {
+	if se.Spec.ExpirationStrategy == v4alpha1.ExpirationStrategySoft {
+		return v4alpha1.ExpirationStrategySoft
+	}
+
+	return v4alpha1.ExpirationStrategyCutOff
+}
+
+// terminateSessions moves the MachineClaims of every session of the event into the Terminated phase
+// and marks the session inactive.
+func (sec *scheduledEventController) terminateSessions(ctx context.Context, se *v4alpha1.ScheduledEvent) error {
+	sessionList := &v4alpha1.SessionList{}
+	if err := sec.kclient.List(ctx, sessionList, client.InNamespace(se.Namespace)); err != nil {
+		return err
+	}
+
+	for i := range sessionList.Items {
+		session := &sessionList.Items[i]
+		if session.Spec.ScheduledEvent != se.Name {
+			continue
+		}
+
+		for _, name := range session.Status.MachineClaims {
+			claim := &v4alpha1.MachineClaim{}
+			if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: se.Namespace, Name: name}, claim); client.IgnoreNotFound(err) != nil {
+				return err
+			} else if err == nil && claim.Status.Phase != v4alpha1.MachineClaimPhaseTerminated {
+				claim.Status.Phase = v4alpha1.MachineClaimPhaseTerminated
+				if err := sec.kclient.Status().Update(ctx, claim); err != nil {
+					return err
+				}
+			}
+		}
+
+		origSessionStatus := session.Status.DeepCopy()
+		session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionActive),
+			corev1.ConditionFalse, "scheduled event ended", fmt.Sprintf("scheduled event %s has ended", se.Name))
+		if !equality.Semantic.DeepEqual(origSessionStatus, &session.Status) {
+			if err := sec.kclient.Status().Update(ctx, session); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (sec *scheduledEventController) deleteMachineSets(ctx context.Context, se *v4alpha1.ScheduledEvent) error {
+	for _, name := range se.Status.CreatedMachineSets {
+		set := &v4alpha1.MachineSet{}
+		if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: se.Namespace, Name: name}, set); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return err
+		}
+
+		if set.DeletionTimestamp != nil {
+			continue
+		}
+
+		slog.Debug("deleting machineset of expired scheduledevent", "scheduledevent", se.Name, "machineset", name)
+		if err := sec.kclient.Delete(ctx, set); client.IgnoreNotFound(err) != nil {
+			eventbuilder.Error().For(se).By(ScheduledEventControllerName, "").
+				Reason("ScheduledEvent failed to delete MachineSet").Note(err.Error()).WriteOrLog(sec.kclient)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// drainMachineSets stops the created machinesets of the event from provisioning and deletes those that no
+// longer have any bound machines. It returns the number of machinesets that are still draining.
+// OnDemand sets provision for pending claims up to MaxProvisioned, so MaxProvisioned is set to zero. This also
+// removes the unbound machines of the set.
+func (sec *scheduledEventController) drainMachineSets(ctx context.Context, se *v4alpha1.ScheduledEvent) (int, error) {
+	var remaining = 0
+
+	for _, name := range se.Status.CreatedMachineSets {
+		set := &v4alpha1.MachineSet{}
+		if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: se.Namespace, Name: name}, set); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return remaining, err
+		}
+
+		if set.DeletionTimestamp != nil {
+			continue
+		}
+
+		if set.Spec.ProvisioningStrategy != v4alpha1.ProvisioningStrategyDynamic || set.Spec.MinAvailable != 0 ||
+			set.Spec.MaxProvisioned != 0 {
+			set.Spec.ProvisioningStrategy = v4alpha1.ProvisioningStrategyDynamic
+			set.Spec.MinAvailable = 0
+			set.Spec.MaxProvisioned = 0
+			if err := sec.kclient.Update(ctx, set); err != nil {
+				return remaining, err
+			}
+		}
+
+		bound := &v4alpha1.MachineList{}
+		if err := sec.kclient.List(ctx, bound, client.InNamespace(se.Namespace), client.MatchingLabels{
+			labels.MachineSetLabel:   set.Name,
+			labels.MachineBoundLabel: "true",
+		}); err != nil {
+			return remaining, err
+		}
+
+		if len(bound.Items) > 0 {
+			remaining++
+			continue
+		}
+
+		slog.Debug("deleting drained machineset of expired scheduledevent", "scheduledevent", se.Name, "machineset", name)
+		if err := sec.kclient.Delete(ctx, set); client.IgnoreNotFound(err) != nil {
+			return remaining, err
+		}
+	}
+
+	return remaining, nil
+}
@@ -0,0 +1,227 @@
+package scheduledevent
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"testing"
+	"time"
+)
+
+func newTestController(t *testing.T, objs ...client.Object) *scheduledEventController {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	kclient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+		WithStatusSubresource(&v4alpha1.ScheduledEvent{}, &v4alpha1.MachineClaim{}, &v4alpha1.Session{}).Build()
+
+	return &scheduledEventController{kclient: kclient, scheme: scheme}
+}
+
+func expiredEvent(strategy v4alpha1.ExpirationStrategy, sets ...string) *v4alpha1.ScheduledEvent {
+	now := time.Now()
+
+	return &v4alpha1.ScheduledEvent{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "se-test"},
+		Spec: v4alpha1.ScheduledEventSpec{
+			StartTime:          metav1.NewTime(now.Add(-2 * time.Hour)),
+			EndTime:            metav1.NewTime(now.Add(-time.Minute)),
+			ExpirationStrategy: strategy,
+		},
+		Status: v4alpha1.ScheduledEventStatus{CreatedMachineSets: sets},
+	}
+}
+
+func eventMachineSet(name string, strategy v4alpha1.ProvisioningStrategy) *v4alpha1.MachineSet {
+	return &v4alpha1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name,
+			Labels: map[string]string{labels.ScheduledEventLabel: "se-test"}},
+		Spec: v4alpha1.MachineSetSpec{
+			ProvisioningStrategy: strategy,
+			MinAvailable:         5,
+			MaxProvisioned:       10,
+		},
+	}
+}
+
+func eventMachine(name string, set string, bound bool) *v4alpha1.Machine {
+	var boundLabel = "false"
+	if bound {
+		boundLabel = "true"
+	}
+
+	return &v4alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name,
+		Labels: map[string]string{
+			labels.MachineSetLabel:   set,
+			labels.MachineBoundLabel: boundLabel,
+		},
+	}}
+}
+
+func Test_SoftExpiration(t *testing.T) {
+	ctx := context.Background()
+
+	se := expiredEvent(v4alpha1.ExpirationStrategySoft, "ms-used", "ms-unused", "ms-ondemand")
+	used := eventMachineSet("ms-used", v4alpha1.ProvisioningStrategyAutoScale)
+	unused := eventMachineSet("ms-unused", v4alpha1.ProvisioningStrategyAutoScale)
+	onDemand := eventMachineSet("ms-ondemand", v4alpha1.ProvisioningStrategyDynamic)
+	machine := eventMachine("m-used", used.Name, true)
+
+	sec := newTestController(t, se, used, unused, onDemand, machine,
+		eventMachine("m-ondemand", onDemand.Name, true))
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: se.Namespace, Name: se.Name}}
+
+	result, err := sec.Reconcile(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != softExpirationInterval {
+		t.Errorf("expected draining event to be requeued after %s, got %s", softExpirationInterval, result.RequeueAfter)
+	}
+
+	for _, name := range []string{used.Name, onDemand.Name} {
+		set := &v4alpha1.MachineSet{}
+		if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, set); err != nil {
+			t.Fatalf("expected machineset %s with bound machines to be kept, got %v", name, err)
+		}
+		if set.Spec.ProvisioningStrategy != v4alpha1.ProvisioningStrategyDynamic ||
+			set.Spec.MinAvailable != 0 || set.Spec.MaxProvisioned != 0 {
+			t.Errorf("expected machineset %s to stop provisioning, got %+v", name, set.Spec)
+		}
+	}
+
+	if err := sec.kclient.Get(ctx, client.ObjectKeyFromObject(unused), &v4alpha1.MachineSet{}); !errors.IsNotFound(err) {
+		t.Errorf("expected machineset without bound machines to be deleted, got %v", err)
+	}
+
+	got := &v4alpha1.ScheduledEvent{}
+	if err := sec.kclient.Get(ctx, request.NamespacedName, got); err != nil {
+		t.Fatal(err)
+	}
+	if !genericcondition.IsTrue(got.Status.Conditions, v4alpha1.ConditionExpired) {
+		t.Error("expected event to be expired")
+	}
+
+	// the last machines are released
+	for _, name := range []string{"m-used", "m-ondemand"} {
+		m := &v4alpha1.Machine{}
+		if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, m); err != nil {
+			t.Fatal(err)
+		}
+		m.Labels[labels.MachineBoundLabel] = "false"
+		if err := sec.kclient.Update(ctx, m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err = sec.Reconcile(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("expected drained event to not be requeued, got %s", result.RequeueAfter)
+	}
+	for _, name := range []string{used.Name, onDemand.Name} {
+		if err := sec.kclient.Get(ctx, client.ObjectKey{Namespace: "default", Name: name}, &v4alpha1.MachineSet{}); !errors.IsNotFound(err) {
+			t.Errorf("expected drained machineset %s to be deleted, got %v", name, err)
+		}
+	}
+}
+
+func Test_CutOffExpiration(t *testing.T) {
+	ctx := context.Background()
+
+	se := expiredEvent(v4alpha1.ExpirationStrategyCutOff, "ms-used")
+	set := eventMachineSet("ms-used", v4alpha1.ProvisioningStrategyAutoScale)
+	claim := &v4alpha1.MachineClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "mc-test"},
+		Status:     v4alpha1.MachineClaimStatus{Phase: v4alpha1.MachineClaimPhaseBound, Machine: "m-used"},
+	}
+	session := &v4alpha1.Session{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "s-test"},
+		Spec:       v4alpha1.SessionSpec{ScheduledEvent: se.Name},
+		Status:     v4alpha1.SessionStatus{MachineClaims: []string{claim.Name}},
+	}
+	other := &v4alpha1.Session{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "s-other"},
+		Spec:       v4alpha1.SessionSpec{ScheduledEvent: "se-other"},
+	}
+
+	sec := newTestController(t, se, set, eventMachine("m-used", set.Name, true), claim, session, other)
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: se.Namespace, Name: se.Name}}
+
+	result, err := sec.Reconcile(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("expected cut off event to not be requeued, got %s", result.RequeueAfter)
+	}
+
+	if err := sec.kclient.Get(ctx, client.ObjectKeyFromObject(set), &v4alpha1.MachineSet{}); !errors.IsNotFound(err) {
+		t.Errorf("expected machineset to be deleted right away, got %v", err)
+	}
+
+	gotClaim := &v4alpha1.MachineClaim{}
+	if err := sec.kclient.Get(ctx, client.ObjectKeyFromObject(claim), gotClaim); err != nil {
+		t.Fatal(err)
+	}
+	if gotClaim.Status.Phase != v4alpha1.MachineClaimPhaseTerminated {
+		t.Errorf("expected claim to be terminated, got %s", gotClaim.Status.Phase)
+	}
+
+	gotSession := &v4alpha1.Session{}
+	if err := sec.kclient.Get(ctx, client.ObjectKeyFromObject(session), gotSession); err != nil {
+		t.Fatal(err)
+	}
+	if genericcondition.IsTrue(gotSession.Status.Conditions, string(v4alpha1.ConditionActive)) ||
+		len(gotSession.Status.Conditions) == 0 {
+		t.Error("expected session of the event to be inactive")
+	}
+
+	gotOther := &v4alpha1.Session{}
+	if err := sec.kclient.Get(ctx, client.ObjectKeyFromObject(other), gotOther); err != nil {
+		t.Fatal(err)
+	}
+	if len(gotOther.Status.Conditions) != 0 {
+		t.Error("expected session of another event to be kept")
+	}
+}
+
+func Test_NotExpiredBeforeEndTime(t *testing.T) {
+	ctx := context.Background()
+
+	se := expiredEvent(v4alpha1.ExpirationStrategySoft)
+	se.Spec.EndTime = metav1.NewTime(time.Now().Add(time.Hour))
+
+	sec := newTestController(t, se)
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: se.Namespace, Name: se.Name}}
+
+	result, err := sec.Reconcile(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > time.Hour {
+		t.Errorf("expected event to be requeued at its end time, got %s", result.RequeueAfter)
+	}
+
+	got := &v4alpha1.ScheduledEvent{}
+	if err := sec.kclient.Get(ctx, request.NamespacedName, got); err != nil {
+		t.Fatal(err)
+	}
+	if genericcondition.IsTrue(got.Status.Conditions, v4alpha1.ConditionExpired) {
+		t.Error("expected event to not be expired before its end time")
+	}
+}
@@ -0,0 +1,224 @@
+package scheduledevent
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultProvisioningLeadTime is used when ProvisioningStartTime is not set on a ScheduledEvent.
+	defaultProvisioningLeadTime = 30 * time.Minute
+)
+
+func (sec *scheduledEventController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	se := &v4alpha1.ScheduledEvent{}
+	if err := sec.kclient.Get(ctx, request.NamespacedName, se); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if se.DeletionTimestamp != nil {
+		// created machinesets are owned by the event and will be garbage collected with it
+		return reconcile.Result{}, nil
+	}
+
+	now := time.Now()
+
+	if sec.setLabels(se, now) {
+		if err := sec.kclient.Update(ctx, se); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// Because mink adds "-p" to end of UIDs
+	// remove it so that owner references on machinesets are valid
+	se.UID = uid.RemoveUIDPublic(se.UID)
+
+	provisioningStart := ProvisioningStartTime(se)
+	origStatus := se.Status.DeepCopy()
+
+	switch {
+	case now.Before(provisioningStart):
+		return reconcile.Result{RequeueAfter: provisioningStart.Sub(now)}, nil
+	case now.Before(se.Spec.EndTime.Time):
+		sec.createMachineSets(ctx, se)
+
+		if !equality.Semantic.DeepEqual(origStatus, &se.Status) {
+			if err := sec.kclient.Status().Update(ctx, se); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		return reconcile.Result{RequeueAfter: se.Spec.EndTime.Sub(now)}, nil
+	default:
+		return sec.expire(ctx, se, origStatus)
+	}
+}
+
+// ProvisioningStartTime returns the time at which machines for the event should be provisioned.
+// If not set, this defaults to 30 minutes prior to the StartTime of the event.
+func ProvisioningStartTime(se *v4alpha1.ScheduledEvent) time.Time {
+	if !se.Spec.ProvisioningStartTime.IsZero() {
+		return se.Spec.ProvisioningStartTime.Time
+	}
+
+	return se.Spec.StartTime.Add(-defaultProvisioningLeadTime)
+}
+
+// IsComplete returns true if the event has passed its EndTime.
+func IsComplete(se *v4alpha1.ScheduledEvent, now time.Time) bool {
+	return !now.Before(se.Spec.EndTime.Time)
+}
+
+// setLabels sets the labels used to look up events by environment and completion state.
+// Returns true if any label was changed.
+func (sec *scheduledEventController) setLabels(se *v4alpha1.ScheduledEvent, now time.Time) bool {
+	var desired = map[string]string{
+		labels.ScheduledEventCompleteLabel: "False",
+	}
+
+	if IsComplete(se, now) {
+		desired[labels.ScheduledEventCompleteLabel] = "True"
+	}
+
+	for _, req := range se.Spec.RequiredMachines {
+		if req.CreateMachineSet != nil && req.CreateMachineSet.Environment != "" {
+			desired[labels.ScheduledEventEnvironmentLabelPrefix+req.CreateMachineSet.Environment] = "true"
+		}
+	}
+
+	if se.Labels == nil {
+		se.Labels = map[string]string{}
+	}
+
+	var changed = false
+
+	// remove environment labels that no longer apply
+	for k := range se.Labels {
+		if _, ok := desired[k]; !ok && strings.HasPrefix(k, labels.ScheduledEventEnvironmentLabelPrefix) {
+			delete(se.Labels, k)
+			changed = true
+		}
+	}
+
+	for k, v := range desired {
+		if se.Labels[k] != v {
+			se.Labels[k] = v
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// createMachineSets creates a MachineSet for every MachineProvisioningRequirement that requests one,
+// unless that MachineSet has already been created. Created sets are recorded in Status.CreatedMachineSets.
+func (sec *scheduledEventController) createMachineSets(ctx context.Context, se *v4alpha1.ScheduledEvent) {
+	var failed = false
+
+	for i, req := range se.Spec.RequiredMachines {
+		if req.CreateMachineSet == nil {
+			continue
+		}
+
+		existing := &v4alpha1.MachineSetList{}
+		if err := sec.kclient.List(ctx, existing, client.InNamespace(se.Namespace), client.MatchingLabels{
+			labels.ScheduledEventLabel:     se.Name,
+			labels.MachineRequirementLabel: strconv.Itoa(i),
+		}); err != nil {
+			sec.createFailed(se, err)
+			failed = true
+			continue
+		}
+
+		if len(existing.Items) > 0 {
+			for _, set := range existing.Items {
+				recordCreated(se, set.Name)
+			}
+			continue
+		}
+
+		set := newMachineSet(se, i, req)
+		if err := controllerutil.SetControllerReference(se, set, sec.scheme); err != nil {
+			sec.createFailed(se, err)
+			failed = true
+			continue
+		}
+
+		if err := sec.kclient.Create(ctx, set); err != nil {
+			sec.createFailed(se, err)
+			failed = true
+			continue
+		}
+
+		slog.Debug("created machineset for scheduledevent", "scheduledevent", se.Name, "machineset", set.Name)
+		recordCreated(se, set.Name)
+	}
+
+	if !failed {
+		se.Status.Conditions = genericcondition.SetCondition(se.Status.Conditions, v4alpha1.ConditionMachineSetsCreated,
+			corev1.ConditionTrue, "machinesets created",
+			fmt.Sprintf("%d machineset(s) created for this event", len(se.Status.CreatedMachineSets)))
+	}
+}
+
+func (sec *scheduledEventController) createFailed(se *v4alpha1.ScheduledEvent, err error) {
+	slog.Error("error creating machineset for scheduledevent", "scheduledevent", se.Name, "error", err.Error())
+
+	eventbuilder.Error().For(se).By(ScheduledEventControllerName, "").
+		Reason("ScheduledEvent failed to create MachineSet").Note(err.Error()).WriteOrLog(sec.kclient)
+
+	se.Status.Conditions = genericcondition.SetCondition(se.Status.Conditions, v4alpha1.ConditionMachineSetsCreated,
+		corev1.ConditionFalse, "error creating machineset", err.Error())
+}
+
+func newMachineSet(se *v4alpha1.ScheduledEvent, index int, req v4alpha1.MachineProvisioningRequirement) *v4alpha1.MachineSet {
+	spec := req.CreateMachineSet.DeepCopy()
+
+	if spec.MachineTemplate == "" {
+		spec.MachineTemplate = req.MachineTemplate
+	}
+
+	// machinesets created for an event are for that event, unless explicitly configured otherwise
+	if spec.AvailabilityConfiguration.Availability == "" {
+		spec.AvailabilityConfiguration = v4alpha1.AvailabilityConfiguration{
+			Availability: v4alpha1.MachineSetAvailabilityScheduledEvent,
+			Value:        se.Name,
+		}
+	}
+
+	return &v4alpha1.MachineSet{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "ms-",
+			Namespace:    se.Namespace,
+			Labels: map[string]string{
+				labels.ScheduledEventLabel:     se.Name,
+				labels.MachineRequirementLabel: strconv.Itoa(index),
+				labels.EnvironmentLabel:        spec.Environment,
+				labels.ProviderLabel:           spec.Provider,
+			},
+		},
+		Spec: *spec,
+	}
+}
+
+func recordCreated(se *v4alpha1.ScheduledEvent, name string) {
+	if !slices.Contains(se.Status.CreatedMachineSets, name) {
+		se.Status.CreatedMachineSets = append(se.Status.CreatedMachineSets, name)
+	}
+}
@@ -0,0 +1,60 @@
+package serviceaccount
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
+	"github.com/hobbyfarm/gargantua/v4/pkg/testserver"
+	"github.com/rancher/lasso/pkg/controller"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"testing"
+	"time"
+)
+
+func Test_EnsureToken(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := testserver.Start(t)
+	c := s.Client(t)
+
+	factory, err := controller.NewSharedControllerFactoryFromConfig(s.Config, scheme.Scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := RegisterHandlers(factory); err != nil {
+		t.Fatal(err)
+	}
+	if err := factory.Start(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.Create(ctx, &v4alpha1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "robot"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		sa := &v4alpha1.ServiceAccount{}
+		if err := c.Get(ctx, client.ObjectKey{Name: "robot"}, sa); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(sa.Secrets) == 1 {
+			secret := &v4alpha1.Secret{}
+			if err := c.Get(ctx, client.ObjectKey{Name: sa.Secrets[0]}, secret); err != nil {
+				t.Fatal(err)
+			}
+			if len(secret.Data["password"]) == 0 {
+				t.Error("expected the token secret to hold a password")
+			}
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the serviceaccount token")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
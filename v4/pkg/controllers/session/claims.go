@@ -0,0 +1,259 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/scheduledevent"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/labels"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"strconv"
+	"time"
+)
+
+// reconcileClaims ensures the session holds a MachineClaim for every machine it requires.
+//
+// With the NewPerScenario persistence strategy, claims are generated for the scenario the user is on. When
+// the user moves on to another scenario, the claims of the previous scenario are terminated and new claims
+// are generated. With PersistThroughCourse, the claims generated for the first scenario are kept for the
+// remainder of the course.
+func (sc *sessionController) reconcileClaims(ctx context.Context, session *v4alpha1.Session,
+	course *v4alpha1.Course, scenario *v4alpha1.Scenario) error {
+	strategy := PersistenceStrategy(session, course)
+
+	if len(session.Status.MachineClaims) > 0 {
+		if strategy == v4alpha1.PersistThroughCourse || session.Status.ClaimedScenario == session.Spec.Scenario {
+			return nil
+		}
+
+		slog.Debug("scenario changed, terminating machineclaims of previous scenario", "session", session.Name,
+			"previous", session.Status.ClaimedScenario, "scenario", session.Spec.Scenario)
+
+		if err := sc.terminateClaims(ctx, session.Namespace, session.Status.MachineClaims); err != nil {
+			return err
+		}
+
+		session.Status.MachineClaims = nil
+		session.Status.ClaimedScenario = ""
+	}
+
+	var se *v4alpha1.ScheduledEvent
+	if session.Spec.ScheduledEvent != "" {
+		se = &v4alpha1.ScheduledEvent{}
+		if err := sc.kclient.Get(ctx, client.ObjectKey{Namespace: session.Namespace, Name: session.Spec.ScheduledEvent}, se); err != nil {
+			return err
+		}
+
+		if scheduledevent.IsComplete(se, time.Now()) {
+			// users of an ended event keep the machines they have, but are not given new ones
+			return nil
+		}
+	}
+
+	// claims may already exist if a previous status update failed after they were created
+	existing, err := sc.activeClaims(ctx, session, strategy)
+	if err != nil {
+		return err
+	}
+
+	if len(existing) > 0 {
+		session.Status.MachineClaims = existing
+		session.Status.ClaimedScenario = session.Spec.Scenario
+		return nil
+	}
+
+	for _, req := range Requirements(strategy, course, scenario) {
+		if req.MachineType == v4alpha1.MachineTypeShared {
+			// shared machines are provisioned per event and are not claimed by individual users
+			continue
+		}
+
+		bindStrategy, sets, err := sc.bindStrategy(ctx, se, req.MachineTemplate)
+		if err != nil {
+			return err
+		}
+
+		for range req.Count {
+			claim := newMachineClaim(session, req.MachineTemplate, bindStrategy, sets)
+			if err := controllerutil.SetControllerReference(session, claim, sc.scheme); err != nil {
+				return err
+			}
+
+			if err := sc.kclient.Create(ctx, claim); err != nil {
+				eventbuilder.Error().For(session).By(SessionControllerName, "").
+					Reason("Session failed to create MachineClaim").Note(err.Error()).WriteOrLog(sc.kclient)
+				return err
+			}
+
+			session.Status.MachineClaims = append(session.Status.MachineClaims, claim.Name)
+		}
+	}
+
+	session.Status.ClaimedScenario = session.Spec.Scenario
+
+	return nil
+}
+
+// PersistenceStrategy returns the MachinePersistenceStrategy of the session, falling back to that of
+// the course. Defaults to NewPerScenario.
+func PersistenceStrategy(session *v4alpha1.Session, course *v4alpha1.Course) v4alpha1.MachinePersistenceStrategy {
+	if session.Spec.PersistenceStrategy != "" {
+		return session.Spec.PersistenceStrategy
+	}
+
+	if course != nil && course.Spec.MachinePersistenceStrategy != "" {
+		return course.Spec.MachinePersistenceStrategy
+	}
+
+	return v4alpha1.NewPerScenario
+}
+
+// Requirements returns the machines required by a session. Machines that persist through a course are
+// sized by the requirements of the course, while machines generated per scenario are sized by the
+// requirements of that scenario. Either falls back to the other if it does not define any requirements.
+func Requirements(strategy v4alpha1.MachinePersistenceStrategy, course *v4alpha1.Course,
+	scenario *v4alpha1.Scenario) []v4alpha1.MachineRequirement {
+	var courseReqs, scenarioReqs []v4alpha1.MachineRequirement
+	if course != nil {
+		courseReqs = course.Spec.MachineRequirements
+	}
+	if scenario != nil {
+		scenarioReqs = scenario.Spec.MachineRequirements
+	}
+
+	if strategy == v4alpha1.PersistThroughCourse && len(courseReqs) > 0 {
+		return courseReqs
+	}
+
+	if len(scenarioReqs) > 0 {
+		return scenarioReqs
+	}
+
+	return courseReqs
+}
+
+// bindStrategy determines how a claim for the given template should be bound. Sessions of a scheduled event
+// use the bind strategy of the matching requirement of the event, and prefer or require the machinesets
+// listed there along with any machinesets the event created. Other sessions may bind any available machine.
+func (sc *sessionController) bindStrategy(ctx context.Context, se *v4alpha1.ScheduledEvent,
+	template string) (v4alpha1.BindStrategy, []string, error) {
+	if se == nil {
+		return v4alpha1.BindStrategyAnyAvailable, nil, nil
+	}
+
+	for i, req := range se.Spec.RequiredMachines {
+		if req.MachineTemplate != template {
+			continue
+		}
+
+		sets := append([]string{}, req.PreferRequireMachineSets...)
+		bindStrategy := req.BindStrategy
+
+		if req.CreateMachineSet != nil {
+			created := &v4alpha1.MachineSetList{}
+			if err := sc.kclient.List(ctx, created, client.InNamespace(se.Namespace), client.MatchingLabels{
+				labels.ScheduledEventLabel:     se.Name,
+				labels.MachineRequirementLabel: strconv.Itoa(i),
+			}); err != nil {
+				return "", nil, err
+			}
+
+			for _, set := range created.Items {
+				sets = append(sets, set.Name)
+			}
+
+			if bindStrategy == "" || bindStrategy == v4alpha1.BindStrategyAnyAvailable {
+				bindStrategy = v4alpha1.BindStrategyPreferMachineSets
+			}
+		}
+
+		if bindStrategy == "" {
+			bindStrategy = v4alpha1.BindStrategyAnyAvailable
+		}
+
+		return bindStrategy, sets, nil
+	}
+
+	return v4alpha1.BindStrategyAnyAvailable, nil, nil
+}
+
+// activeClaims returns the names of the claims generated for this session that have not been terminated.
+// With NewPerScenario, only claims generated for the current scenario are returned.
+func (sc *sessionController) activeClaims(ctx context.Context, session *v4alpha1.Session,
+	strategy v4alpha1.MachinePersistenceStrategy) ([]string, error) {
+	selector := client.MatchingLabels{
+		labels.SessionLabel: session.Name,
+	}
+
+	if strategy == v4alpha1.NewPerScenario {
+		selector[labels.ScenarioLabel] = session.Spec.Scenario
+	}
+
+	claimList := &v4alpha1.MachineClaimList{}
+	if err := sc.kclient.List(ctx, claimList, client.InNamespace(session.Namespace), selector); err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for _, claim := range claimList.Items {
+		if claim.DeletionTimestamp != nil || claim.Status.Phase == v4alpha1.MachineClaimPhaseTerminated {
+			continue
+		}
+
+		out = append(out, claim.Name)
+	}
+
+	return out, nil
+}
+
+// terminateClaims moves the given claims of a namespace into the Terminated phase, after which the
+// machineclaim controller releases their machines.
+func (sc *sessionController) terminateClaims(ctx context.Context, namespace string, names []string) error {
+	for _, name := range names {
+		claim := &v4alpha1.MachineClaim{}
+		if err := sc.kclient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, claim); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return err
+		}
+
+		if claim.Status.Phase == v4alpha1.MachineClaimPhaseTerminated {
+			continue
+		}
+
+		claim.Status.Phase = v4alpha1.MachineClaimPhaseTerminated
+		if err := sc.kclient.Status().Update(ctx, claim); err != nil {
+			return fmt.Errorf("error terminating machineclaim %s: %s", name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func newMachineClaim(session *v4alpha1.Session, template string, bindStrategy v4alpha1.BindStrategy,
+	sets []string) *v4alpha1.MachineClaim {
+	return &v4alpha1.MachineClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "mc-",
+			Namespace:    session.Namespace,
+			Labels: map[string]string{
+				labels.SessionLabel:         session.Name,
+				labels.ScenarioLabel:        session.Spec.Scenario,
+				labels.MachineTemplateLabel: template,
+			},
+		},
+		Spec: v4alpha1.MachineClaimSpec{
+			MachineTemplate:          template,
+			User:                     session.Spec.User,
+			AccessCode:               session.Spec.AccessCode,
+			ScheduledEvent:           session.Spec.ScheduledEvent,
+			BindStrategy:             bindStrategy,
+			PreferRequireMachineSets: sets,
+		},
+	}
+}
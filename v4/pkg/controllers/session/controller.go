@@ -0,0 +1,31 @@
+package session
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	SessionControllerName = "session-controller"
+)
+
+type sessionController struct {
+	kclient client.Client
+	scheme  *runtime.Scheme
+}
+
+func New(mgr manager.Manager) error {
+	sc := &sessionController{
+		kclient: mgr.GetClient(),
+		scheme:  mgr.GetScheme(),
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		For(&v4alpha1.Session{}).
+		Owns(&v4alpha1.MachineClaim{}).
+		Named(SessionControllerName).Complete(sc)
+}
@@ -0,0 +1,147 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventbuilder"
+	"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition"
+	"github.com/hobbyfarm/gargantua/v4/pkg/uid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"log/slog"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"time"
+)
+
+func (sc *sessionController) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	session := &v4alpha1.Session{}
+	if err := sc.kclient.Get(ctx, request.NamespacedName, session); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if session.DeletionTimestamp != nil {
+		// machineclaims are owned by the session and will be garbage collected with it
+		return reconcile.Result{}, nil
+	}
+
+	if session.Status.Finished {
+		return reconcile.Result{}, nil
+	}
+
+	// Because mink adds "-p" to end of UIDs
+	// remove it so that owner references on machineclaims are valid
+	session.UID = uid.RemoveUIDPublic(session.UID)
+
+	origStatus := session.Status.DeepCopy()
+	now := time.Now()
+
+	course, scenario, err := sc.getCourseAndScenario(ctx, session)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if session.Status.ExpirationTime.IsZero() {
+		keepalive, err := v4alpha1.SessionKeepaliveDuration(course, scenario)
+		if err != nil {
+			eventbuilder.Warning().For(session).By(SessionControllerName, "").
+				Reason("Session has invalid keepalive duration").Note(err.Error()).WriteOrLog(sc.kclient)
+			keepalive = v4alpha1.DefaultSessionKeepaliveDuration
+		}
+
+		session.Status.ExpirationTime = metav1.NewTime(now.Add(keepalive))
+	}
+
+	if IsExpired(session, now) {
+		return reconcile.Result{}, sc.finish(ctx, session)
+	}
+
+	if err := sc.reconcileClaims(ctx, session, course, scenario); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionActive),
+		corev1.ConditionTrue, "session active", "")
+
+	if session.Status.Paused {
+		session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionPaused),
+			corev1.ConditionTrue, "session paused",
+			fmt.Sprintf("session is paused until %s", session.Status.PauseExpirationTime.String()))
+	} else {
+		session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionPaused),
+			corev1.ConditionFalse, "session not paused", "")
+	}
+
+	if !equality.Semantic.DeepEqual(origStatus, &session.Status) {
+		if err := sc.kclient.Status().Update(ctx, session); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: ExpiresAt(session).Sub(now)}, nil
+}
+
+// ExpiresAt returns the time at which the machines of the session will be reclaimed, unless the session
+// is kept alive. For a paused session this is the later of the pause expiration and the keepalive expiration.
+func ExpiresAt(session *v4alpha1.Session) time.Time {
+	expires := session.Status.ExpirationTime.Time
+
+	if session.Status.Paused && session.Status.PauseExpirationTime.After(expires) {
+		expires = session.Status.PauseExpirationTime.Time
+	}
+
+	return expires
+}
+
+// IsExpired returns true if the session has not received a keepalive within its keepalive duration,
+// and is not protected by an unexpired pause.
+func IsExpired(session *v4alpha1.Session, now time.Time) bool {
+	return !now.Before(ExpiresAt(session))
+}
+
+// finish terminates the MachineClaims of an expired session so that its machines are reclaimed,
+// and marks the session as finished.
+func (sc *sessionController) finish(ctx context.Context, session *v4alpha1.Session) error {
+	slog.Debug("session expired, reclaiming machines", "session", session.Name)
+
+	if err := sc.terminateClaims(ctx, session.Namespace, session.Status.MachineClaims); err != nil {
+		return err
+	}
+
+	session.Status.Finished = true
+	session.Status.Paused = false
+	session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionActive),
+		corev1.ConditionFalse, "session expired",
+		fmt.Sprintf("session expired at %s", ExpiresAt(session).Format(time.RFC3339)))
+	session.Status.Conditions = genericcondition.SetCondition(session.Status.Conditions, string(v4alpha1.ConditionPaused),
+		corev1.ConditionFalse, "session finished", "")
+
+	eventbuilder.Info().For(session).By(SessionControllerName, "").
+		Reason("Session expired").Note(fmt.Sprintf("reclaimed %d machineclaim(s)", len(session.Status.MachineClaims))).
+		WriteOrLog(sc.kclient)
+
+	return sc.kclient.Status().Update(ctx, session)
+}
+
+func (sc *sessionController) getCourseAndScenario(ctx context.Context, session *v4alpha1.Session) (*v4alpha1.Course, *v4alpha1.Scenario, error) {
+	var course *v4alpha1.Course
+	var scenario *v4alpha1.Scenario
+
+	if session.Spec.Course != "" {
+		course = &v4alpha1.Course{}
+		if err := sc.kclient.Get(ctx, client.ObjectKey{Namespace: session.Namespace, Name: session.Spec.Course}, course); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if session.Spec.Scenario != "" {
+		scenario = &v4alpha1.Scenario{}
+		if err := sc.kclient.Get(ctx, client.ObjectKey{Namespace: session.Namespace, Name: session.Spec.Scenario}, scenario); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return course, scenario, nil
+}
@@ -0,0 +1,69 @@
+package session
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+	"time"
+)
+
+func Test_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name            string
+		expiration      time.Time
+		paused          bool
+		pauseExpiration time.Time
+		want            bool
+	}{
+		{"not expired", now.Add(time.Minute), false, time.Time{}, false},
+		{"expired", now.Add(-time.Minute), false, time.Time{}, true},
+		{"expired while paused", now.Add(-time.Minute), true, now.Add(time.Hour), false},
+		{"pause expired", now.Add(-time.Hour), true, now.Add(-time.Minute), true},
+		{"pause expiration ignored when resumed", now.Add(-time.Minute), false, now.Add(time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		session := &v4alpha1.Session{
+			Status: v4alpha1.SessionStatus{
+				ExpirationTime:      metav1.NewTime(tt.expiration),
+				Paused:              tt.paused,
+				PauseExpirationTime: metav1.NewTime(tt.pauseExpiration),
+			},
+		}
+
+		if got := IsExpired(session, now); got != tt.want {
+			t.Errorf("%s: expected %t got %t", tt.name, tt.want, got)
+		}
+	}
+}
+
+func Test_Requirements(t *testing.T) {
+	courseReqs := []v4alpha1.MachineRequirement{{MachineTemplate: "course-template", Count: 1}}
+	scenarioReqs := []v4alpha1.MachineRequirement{{MachineTemplate: "scenario-template", Count: 1}}
+
+	course := &v4alpha1.Course{Spec: v4alpha1.CourseSpec{MachineRequirements: courseReqs}}
+	scenario := &v4alpha1.Scenario{Spec: v4alpha1.ScenarioSpec{MachineRequirements: scenarioReqs}}
+
+	tests := []struct {
+		name     string
+		strategy v4alpha1.MachinePersistenceStrategy
+		course   *v4alpha1.Course
+		scenario *v4alpha1.Scenario
+		want     string
+	}{
+		{"persist through course", v4alpha1.PersistThroughCourse, course, scenario, "course-template"},
+		{"new per scenario", v4alpha1.NewPerScenario, course, scenario, "scenario-template"},
+		{"persist without course requirements", v4alpha1.PersistThroughCourse, &v4alpha1.Course{}, scenario, "scenario-template"},
+		{"new per scenario without scenario requirements", v4alpha1.NewPerScenario, course, &v4alpha1.Scenario{}, "course-template"},
+		{"scenario only", v4alpha1.NewPerScenario, nil, scenario, "scenario-template"},
+	}
+
+	for _, tt := range tests {
+		got := Requirements(tt.strategy, tt.course, tt.scenario)
+		if len(got) != 1 || got[0].MachineTemplate != tt.want {
+			t.Errorf("%s: expected template %s got %v", tt.name, tt.want, got)
+		}
+	}
+}
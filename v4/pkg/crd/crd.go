@@ -26,6 +26,16 @@ func GenerateCRDs() []crder.CRD {
 					cv.WithStatus()
 				})
 		}),
+		hobbyfarmCRD(&v4alpha1.OIDCConfig{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v4alpha1", &v4alpha1.OIDCConfig{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("DisplayName", ".spec.serverDisplayName").
+						WithColumn("Issuer", ".spec.issuerURL").
+						WithStatus()
+				})
+		}),
 		hobbyfarmCRD(&v4alpha1.Provider{}, func(c *crder.CRD) {
 			c.
 				IsNamespaced(true).
@@ -134,8 +144,10 @@ func GenerateCRDs() []crder.CRD {
 						WithColumn("User", ".spec.user").
 						WithColumn("AccessCode", ".spec.accessCode").
 						WithColumn("ScheduledEvent", ".spec.scheduledEvent").
-						WithColumn("MachineClaim", ".status.machineClaim").
+						WithColumn("Scenario", ".spec.scenario").
 						WithColumn("PersistenceStrategy", ".spec.persistenceStrategy").
+						WithColumn("Expires", ".status.expirationTime").
+						WithColumn("Paused", ".status.paused").
 						WithStatus().
 						IsServed(true).
 						IsStored(true)
@@ -272,6 +284,16 @@ func GenerateCRDs() []crder.CRD {
 						WithColumn("Reason", ".reason")
 				})
 		}),
+		hobbyfarmCRD(&v4alpha1.Tenant{}, func(c *crder.CRD) {
+			c.
+				IsNamespaced(true).
+				AddVersion("v4alpha1", &v4alpha1.Tenant{}, func(cv *crder.Version) {
+					cv.
+						WithColumn("DisplayName", ".spec.displayName").
+						WithColumn("StorageNamespace", ".spec.storageNamespace").
+						IsServed(true).IsStored(true)
+				})
+		}),
 	}
 }
 
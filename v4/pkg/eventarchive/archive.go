@@ -0,0 +1,119 @@
+package eventarchive
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/sql"
+	"sort"
+	"time"
+)
+
+// Archive keeps the events that have been removed from the apiserver.
+type Archive interface {
+	// Write archives events. Archiving an event that has been archived before must not fail.
+	Write(ctx context.Context, events ...v4alpha1.Event) error
+
+	// List returns the archived events of the referenced object, ordered by their time.
+	List(ctx context.Context, ref v4alpha1.ObjectReference) ([]v4alpha1.Event, error)
+}
+
+// Options select the archive events are written to. Files take precedence over sql.
+type Options struct {
+	// Dir is the directory of the file archive
+	Dir string
+
+	// MaxFileSize is the size in bytes at which the file archive starts a new file
+	MaxFileSize int64
+
+	// MaxFiles is the number of files the file archive keeps, 0 to keep all files
+	MaxFiles int
+
+	// SQLDialect is the dialect of the sql archive, postgres or sqlite
+	SQLDialect string
+
+	// SQLDSN is the data source name of the sql archive
+	SQLDSN string
+}
+
+// Open opens the archive selected by the options. It returns nil if no archive is configured.
+func Open(ctx context.Context, opts Options) (Archive, error) {
+	switch {
+	case opts.Dir != "":
+		archive, err := NewFileArchive(opts.Dir, opts.MaxFileSize, opts.MaxFiles)
+		if err != nil {
+			return nil, err
+		}
+
+		return archive, nil
+	case opts.SQLDSN != "":
+		dialect, err := sql.DialectFor(opts.SQLDialect)
+		if err != nil {
+			return nil, err
+		}
+
+		db, err := sql.OpenDB(dialect, opts.SQLDSN)
+		if err != nil {
+			return nil, err
+		}
+
+		archive := NewSQLArchive(db, dialect)
+		if err := archive.Migrate(ctx); err != nil {
+			return nil, err
+		}
+
+		return archive, nil
+	case opts.SQLDialect != "":
+		return nil, fmt.Errorf("sql event archive requires a data source name")
+	}
+
+	return nil, nil
+}
+
+// Merge merges live and archived events. Events in both lists are returned once, the live copy wins.
+// The result is ordered by the time of the events.
+func Merge(live []v4alpha1.Event, archived []v4alpha1.Event) []v4alpha1.Event {
+	seen := make(map[string]bool, len(live))
+	merged := make([]v4alpha1.Event, 0, len(live)+len(archived))
+	for _, events := range [][]v4alpha1.Event{live, archived} {
+		for _, e := range events {
+			key := eventKey(e)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			merged = append(merged, e)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return eventTime(merged[i]).Before(eventTime(merged[j]))
+	})
+
+	return merged
+}
+
+// Matches returns whether the event references the object.
+func Matches(e v4alpha1.Event, ref v4alpha1.ObjectReference) bool {
+	return e.ObjectReference.APIGroup == ref.APIGroup &&
+		e.ObjectReference.Kind == ref.Kind &&
+		e.ObjectReference.Namespace == ref.Namespace &&
+		e.ObjectReference.Name == ref.Name
+}
+
+func eventKey(e v4alpha1.Event) string {
+	if e.UID != "" {
+		return string(e.UID)
+	}
+
+	return e.Name
+}
+
+// eventTime returns the time the event occurred, or the time it was created if it has no event time.
+func eventTime(e v4alpha1.Event) time.Time {
+	if e.EventTime != nil {
+		return e.EventTime.Time
+	}
+
+	return e.CreationTimestamp.Time
+}
@@ -0,0 +1,51 @@
+package eventarchive
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"testing"
+	"time"
+)
+
+var testRef = v4alpha1.ObjectReference{APIGroup: v4alpha1.APIGroup, Kind: "Machine", Name: "m-test"}
+
+func testEvent(uid string, ref v4alpha1.ObjectReference, at time.Time) v4alpha1.Event {
+	eventTime := metav1.NewMicroTime(at)
+	return v4alpha1.Event{
+		ObjectMeta:      metav1.ObjectMeta{Name: "ev-" + uid, UID: types.UID(uid)},
+		ObjectReference: ref,
+		EventTime:       &eventTime,
+		Reason:          "reason " + uid,
+	}
+}
+
+func eventNames(events []v4alpha1.Event) []string {
+	out := make([]string, len(events))
+	for i, e := range events {
+		out[i] = e.Name
+	}
+	return out
+}
+
+func Test_Merge(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	archived := []v4alpha1.Event{
+		testEvent("a", testRef, start),
+		testEvent("c", testRef, start.Add(2*time.Minute)),
+	}
+	live := []v4alpha1.Event{
+		testEvent("b", testRef, start.Add(time.Minute)),
+		testEvent("c", testRef, start.Add(2*time.Minute)),
+	}
+	live[1].Note = "live"
+
+	merged := Merge(live, archived)
+	if got := eventNames(merged); len(got) != 3 || got[0] != "ev-a" || got[1] != "ev-b" || got[2] != "ev-c" {
+		t.Fatalf("expected events ev-a, ev-b and ev-c in order, got %v", got)
+	}
+	if merged[2].Note != "live" {
+		t.Errorf("expected live copy of event to win, got note %q", merged[2].Note)
+	}
+}
@@ -0,0 +1,185 @@
+package eventarchive
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	defaultMaxFileSize = 64 << 20
+
+	currentFile  = "events.jsonl"
+	rotatedGlob  = "events-*.jsonl"
+	rotateFormat = "20060102T150405.000000000"
+
+	// maxLineSize is the size of the largest event that can be read back
+	maxLineSize = 4 << 20
+)
+
+// FileArchive writes events as JSON lines to files in a directory. Once the current file would grow beyond
+// the maximum file size it is rotated to a file named after the time of the rotation, and the oldest files
+// beyond the maximum number of files are removed.
+type FileArchive struct {
+	dir         string
+	maxFileSize int64
+	maxFiles    int
+
+	lock sync.Mutex
+}
+
+func NewFileArchive(dir string, maxFileSize int64, maxFiles int) (*FileArchive, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("error creating event archive directory: %v", err)
+	}
+
+	if maxFileSize <= 0 {
+		maxFileSize = defaultMaxFileSize
+	}
+
+	return &FileArchive{
+		dir:         dir,
+		maxFileSize: maxFileSize,
+		maxFiles:    maxFiles,
+	}, nil
+}
+
+func (a *FileArchive) Write(_ context.Context, events ...v4alpha1.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var data bytes.Buffer
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		data.Write(line)
+		data.WriteByte('\n')
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	path := filepath.Join(a.dir, currentFile)
+	if info, err := os.Stat(path); err == nil && info.Size() > 0 && info.Size()+int64(data.Len()) > a.maxFileSize {
+		if err := a.rotate(); err != nil {
+			return err
+		}
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Write(data.Bytes()); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// rotate renames the current file and removes the files beyond the maximum. The caller must hold the lock.
+func (a *FileArchive) rotate() error {
+	rotated := filepath.Join(a.dir, "events-"+time.Now().UTC().Format(rotateFormat)+".jsonl")
+	if err := os.Rename(filepath.Join(a.dir, currentFile), rotated); err != nil {
+		return err
+	}
+
+	if a.maxFiles <= 0 {
+		return nil
+	}
+
+	files, err := a.rotatedFiles()
+	if err != nil {
+		return err
+	}
+
+	// the current file counts towards the maximum
+	for len(files) > 0 && len(files)+1 > a.maxFiles {
+		if err := os.Remove(files[0]); err != nil {
+			return err
+		}
+		files = files[1:]
+	}
+
+	return nil
+}
+
+// rotatedFiles returns the rotated files from the oldest to the newest.
+func (a *FileArchive) rotatedFiles() ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(a.dir, rotatedGlob))
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func (a *FileArchive) List(_ context.Context, ref v4alpha1.ObjectReference) ([]v4alpha1.Event, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	files, err := a.rotatedFiles()
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, filepath.Join(a.dir, currentFile))
+
+	events := make([]v4alpha1.Event, 0)
+	for _, file := range files {
+		found, err := readEvents(file, ref)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, found...)
+	}
+
+	return Merge(nil, events), nil
+}
+
+// readEvents returns the events of a file that reference the object.
+func readEvents(file string, ref v4alpha1.ObjectReference) ([]v4alpha1.Event, error) {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	events := make([]v4alpha1.Event, 0)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		// cheap check before decoding, the name is part of every matching line
+		if !bytes.Contains(line, []byte(ref.Name)) {
+			continue
+		}
+
+		var e v4alpha1.Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			return nil, fmt.Errorf("error reading event archive %s: %v", file, err)
+		}
+		if Matches(e, ref) {
+			events = append(events, e)
+		}
+	}
+
+	return events, scanner.Err()
+}
@@ -0,0 +1,79 @@
+package eventarchive
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_FileArchive(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	archive, err := NewFileArchive(dir, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other := v4alpha1.ObjectReference{APIGroup: v4alpha1.APIGroup, Kind: "Machine", Name: "m-other"}
+
+	// every write rotates the file, as the files are at most 1 byte
+	for i, e := range []v4alpha1.Event{
+		testEvent("a", testRef, start),
+		testEvent("b", other, start.Add(time.Minute)),
+		testEvent("c", testRef, start.Add(2*time.Minute)),
+		testEvent("d", testRef, start.Add(3*time.Minute)),
+	} {
+		if err := archive.Write(ctx, e); err != nil {
+			t.Fatalf("error writing event %d: %v", i, err)
+		}
+		// rotated files are named after the time of the rotation
+		time.Sleep(time.Millisecond)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Errorf("expected archive to keep 2 files, got %v", files)
+	}
+
+	events, err := archive.List(ctx, testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := eventNames(events); len(got) != 2 || got[0] != "ev-c" || got[1] != "ev-d" {
+		t.Errorf("expected events ev-c and ev-d of kept files, got %v", got)
+	}
+
+	// archiving an event again does not duplicate it
+	if err := archive.Write(ctx, events[1]); err != nil {
+		t.Fatal(err)
+	}
+	events, err = archive.List(ctx, testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := eventNames(events); len(got) != 1 || got[0] != "ev-d" {
+		t.Errorf("expected event ev-d once after rotation, got %v", got)
+	}
+}
+
+func Test_FileArchiveEmpty(t *testing.T) {
+	archive, err := NewFileArchive(filepath.Join(t.TempDir(), "archive"), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := archive.List(context.Background(), testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected no events in empty archive, got %v", eventNames(events))
+	}
+}
@@ -0,0 +1,104 @@
+package eventarchive
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/statuswriter"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"log/slog"
+	"net/http"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HistoryPath is the path of the event history of an object
+const HistoryPath = "/eventhistory"
+
+// Handler serves the event history of an object, which merges the events in the apiserver with the events
+// in the archive. The object is selected by the apiGroup, kind, namespace and name query parameters. Access
+// to the history is granted by rules for the HistoryPath, not by rules for events.
+type Handler struct {
+	kclient client.Client
+	archive Archive
+}
+
+// NewHandler returns a handler that lists the live events with the client and the archived events from the
+// archive. Without archive, the history holds the live events only.
+func NewHandler(kclient client.Client, archive Archive) *Handler {
+	return &Handler{
+		kclient: kclient,
+		archive: archive,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		statuswriter.WriteError(errors.NewMethodNotSupported(schema.GroupResource{
+			Group:    v4alpha1.APIGroup,
+			Resource: "eventhistory",
+		}, r.Method), w)
+		return
+	}
+
+	query := r.URL.Query()
+	ref := v4alpha1.ObjectReference{
+		APIGroup:  query.Get("apiGroup"),
+		Kind:      query.Get("kind"),
+		Namespace: query.Get("namespace"),
+		Name:      query.Get("name"),
+	}
+	if ref.Kind == "" || ref.Name == "" {
+		statuswriter.WriteError(errors.NewBadRequest("kind and name are required"), w)
+		return
+	}
+
+	live, err := h.live(r, ref)
+	if err != nil {
+		slog.Error("error listing events", "kind", ref.Kind, "name", ref.Name, "error", err.Error())
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	var archived []v4alpha1.Event
+	if h.archive != nil {
+		if archived, err = h.archive.List(r.Context(), ref); err != nil {
+			slog.Error("error listing archived events", "kind", ref.Kind, "name", ref.Name, "error", err.Error())
+			statuswriter.WriteError(errors.NewInternalError(err), w)
+			return
+		}
+	}
+
+	list := &v4alpha1.EventList{Items: Merge(live, archived)}
+	list.APIVersion = v4alpha1.SchemeGroupVersion.String()
+	list.Kind = "EventList"
+
+	out, err := json.Marshal(list)
+	if err != nil {
+		statuswriter.WriteError(errors.NewInternalError(err), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(out); err != nil {
+		slog.Error("error writing http response", "error", err.Error())
+	}
+}
+
+// live lists the events of the object in the apiserver. Events are not labeled with the object they
+// reference, so all events are listed and filtered by their reference.
+func (h *Handler) live(r *http.Request, ref v4alpha1.ObjectReference) ([]v4alpha1.Event, error) {
+	list := &v4alpha1.EventList{}
+	if err := h.kclient.List(r.Context(), list); err != nil {
+		return nil, fmt.Errorf("error listing events: %v", err)
+	}
+
+	events := make([]v4alpha1.Event, 0)
+	for _, e := range list.Items {
+		if Matches(e, ref) {
+			events = append(events, e)
+		}
+	}
+
+	return events, nil
+}
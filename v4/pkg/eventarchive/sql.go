@@ -0,0 +1,109 @@
+package eventarchive
+
+import (
+	"context"
+	gosql "database/sql"
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/sql"
+)
+
+// SQLArchive writes events to a table of a database of one of the dialects of the sql store. It can share
+// the database of the sql store, its table does not clash with the tables of the store.
+type SQLArchive struct {
+	db      *gosql.DB
+	dialect sql.Dialect
+}
+
+func NewSQLArchive(db *gosql.DB, dialect sql.Dialect) *SQLArchive {
+	return &SQLArchive{
+		db:      db,
+		dialect: dialect,
+	}
+}
+
+// Migrate creates the table of the archive if it does not exist yet.
+func (a *SQLArchive) Migrate(ctx context.Context) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS hobbyfarm_archived_events (
+			uid TEXT PRIMARY KEY,
+			api_group TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			event_time BIGINT NOT NULL,
+			object TEXT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS hobbyfarm_archived_events_object
+			ON hobbyfarm_archived_events (api_group, kind, namespace, name, event_time)`,
+	}
+
+	for _, statement := range statements {
+		if _, err := a.db.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("error migrating %s event archive: %v", a.dialect.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *SQLArchive) Close() error {
+	return a.db.Close()
+}
+
+func (a *SQLArchive) Write(ctx context.Context, events ...v4alpha1.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, e := range events {
+		object, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, a.dialect.Rebind(`INSERT INTO hobbyfarm_archived_events
+			(uid, api_group, kind, namespace, name, event_time, object) VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (uid) DO NOTHING`),
+			eventKey(e), e.ObjectReference.APIGroup, e.ObjectReference.Kind, e.ObjectReference.Namespace,
+			e.ObjectReference.Name, eventTime(e).UnixNano(), string(object))
+		if err != nil {
+			return fmt.Errorf("error archiving event %s: %v", e.Name, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (a *SQLArchive) List(ctx context.Context, ref v4alpha1.ObjectReference) ([]v4alpha1.Event, error) {
+	rows, err := a.db.QueryContext(ctx, a.dialect.Rebind(`SELECT object FROM hobbyfarm_archived_events
+		WHERE api_group = ? AND kind = ? AND namespace = ? AND name = ? ORDER BY event_time, uid`),
+		ref.APIGroup, ref.Kind, ref.Namespace, ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	events := make([]v4alpha1.Event, 0)
+	for rows.Next() {
+		var object string
+		if err := rows.Scan(&object); err != nil {
+			return nil, err
+		}
+
+		var e v4alpha1.Event
+		if err := json.Unmarshal([]byte(object), &e); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,58 @@
+package eventarchive
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func Test_SQLArchive(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	archive, err := Open(ctx, Options{
+		SQLDialect: "sqlite",
+		SQLDSN:     "file:" + filepath.Join(t.TempDir(), "events.db"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = archive.(*SQLArchive).Close() })
+
+	other := v4alpha1.ObjectReference{APIGroup: v4alpha1.APIGroup, Kind: "Machine", Name: "m-other"}
+	if err := archive.Write(ctx,
+		testEvent("b", testRef, start.Add(time.Minute)),
+		testEvent("a", testRef, start),
+		testEvent("c", other, start),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// archiving an event again does not fail or duplicate it
+	if err := archive.Write(ctx, testEvent("a", testRef, start)); err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := archive.List(ctx, testRef)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := eventNames(events); len(got) != 2 || got[0] != "ev-a" || got[1] != "ev-b" {
+		t.Fatalf("expected events ev-a and ev-b in order, got %v", got)
+	}
+	if events[1].Reason != "reason b" {
+		t.Errorf("expected archived event to keep its reason, got %q", events[1].Reason)
+	}
+}
+
+func Test_OpenNothing(t *testing.T) {
+	archive, err := Open(context.Background(), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if archive != nil {
+		t.Errorf("expected no archive without options, got %T", archive)
+	}
+}
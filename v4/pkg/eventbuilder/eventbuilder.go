@@ -68,17 +68,19 @@ func (e *EventBuilder) Type(eventType v4alpha1.EventType) *EventBuilder {
 
 // For sets the v4alpha1.ObjectReference of the Event
 func (e *EventBuilder) For(obj runtime.Object) *EventBuilder {
-	var name string
+	var name, namespace string
 	if thing, ok := obj.(client.Object); !ok {
 		name = "UNKNOWN"
 	} else {
 		name = thing.GetName()
+		namespace = thing.GetNamespace()
 	}
 
 	e.e.ObjectReference = v4alpha1.ObjectReference{
-		Name:     name,
-		APIGroup: obj.GetObjectKind().GroupVersionKind().Group,
-		Kind:     obj.GetObjectKind().GroupVersionKind().Kind,
+		Name:      name,
+		Namespace: namespace,
+		APIGroup:  obj.GetObjectKind().GroupVersionKind().Group,
+		Kind:      obj.GetObjectKind().GroupVersionKind().Kind,
 	}
 
 	return e
@@ -41,6 +41,38 @@ func (g *GenericCondition) ChangeCondition(status v1.ConditionStatus, reason str
 	g.Reason = reason
 }
 
+// SetCondition changes the condition of type condType within conditions, creating it
+// if it does not exist. The (possibly grown) slice is returned and should be assigned
+// back to the status that owns it.
+// A condition whose status, reason and message are unchanged is left untouched so that
+// controllers can skip writing a status that did not change.
+func SetCondition(conditions []GenericCondition, condType string, status v1.ConditionStatus, reason string, message string) []GenericCondition {
+	for i := range conditions {
+		if conditions[i].Type == condType {
+			if conditions[i].Status != status || conditions[i].Reason != reason || conditions[i].Message != message {
+				conditions[i].ChangeCondition(status, reason, message)
+			}
+			return conditions
+		}
+	}
+
+	cond := GenericCondition{Type: condType}
+	cond.ChangeCondition(status, reason, message)
+
+	return append(conditions, cond)
+}
+
+// IsTrue returns true if the condition of type condType exists within conditions and has a status of True.
+func IsTrue(conditions []GenericCondition, condType string) bool {
+	for _, c := range conditions {
+		if c.Type == condType {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
 func Update(obj runtime.Object, name string, status v1.ConditionStatus, reason string, message string) {
 	if k := get(obj, name); k != nil {
 		k.ChangeCondition(status, reason, message)
@@ -1,18 +1,47 @@
 package labels
 
 const (
+	TenantLabel                 = "hobbyfarm.io/tenant"
 	ProviderLabel               = "hobbyfarm.io/provider"
 	EnvironmentLabel            = "hobbyfarm.io/environment"
 	ScheduledEventCompleteLabel = "hobbyfarm.io/scheduled-event-complete"
 	UsernameLabel               = "hobbyfarm.io/username"
 )
 
+// machine related
+
+const (
+	MachineSetLabel      = "hobbyfarm.io/machineset"
+	MachineTemplateLabel = "hobbyfarm.io/machinetemplate"
+	MachineBoundLabel    = "hobbyfarm.io/machine-bound"
+	MachineClaimLabel    = "hobbyfarm.io/machineclaim"
+)
+
+// scheduledevent related
+
+const (
+	ScheduledEventLabel     = "hobbyfarm.io/scheduledevent"
+	MachineRequirementLabel = "hobbyfarm.io/machine-requirement"
+
+	// ScheduledEventEnvironmentLabelPrefix is joined with an environment name to mark a ScheduledEvent
+	// as using that environment, e.g. environment.hobbyfarm.io/my-env=true
+	ScheduledEventEnvironmentLabelPrefix = "environment.hobbyfarm.io/"
+)
+
+// session related
+
+const (
+	SessionLabel  = "hobbyfarm.io/session"
+	ScenarioLabel = "hobbyfarm.io/scenario"
+)
+
 // auth-related
 
 const (
 	LdapPrincipalKey  = "auth.hobbyfarm.io/ldap-principal"
 	LocalPrincipalKey = "auth.hobbyfarm.io/local-principal"
 	LocalUsernameKey  = "auth.hobbyfarm.io/local-username"
+	OIDCPrincipalKey  = "auth.hobbyfarm.io/oidc-principal"
 )
 
 // accesscode related
@@ -33,3 +62,11 @@ const (
 	RoleBindingByRole       = "hobbyfarm.io/role-binding-by-role"
 	RoleBindingByAccessCode = "hobbyfarm.io/role-binding-by-accesscode"
 )
+
+// event related
+
+const (
+	// EventObjectGoneAnnotation holds the time at which the event garbage collector found the object
+	// referenced by an event to be gone, in RFC 3339 format
+	EventObjectGoneAnnotation = "hobbyfarm.io/event-object-gone"
+)
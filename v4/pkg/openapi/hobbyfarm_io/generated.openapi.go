@@ -75,6 +75,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.MachineTemplateSpec":            schema_pkg_apis_hobbyfarmio_v4alpha1_MachineTemplateSpec(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Namespaced":                     schema_pkg_apis_hobbyfarmio_v4alpha1_Namespaced(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.NonNamespaced":                  schema_pkg_apis_hobbyfarmio_v4alpha1_NonNamespaced(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfig":                     schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfig(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigList":                 schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigList(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigSpec":                 schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigSpec(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigStatus":               schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigStatus(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.ObjectReference":                schema_pkg_apis_hobbyfarmio_v4alpha1_ObjectReference(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OneTimeAccessCode":              schema_pkg_apis_hobbyfarmio_v4alpha1_OneTimeAccessCode(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OneTimeAccessCodeList":          schema_pkg_apis_hobbyfarmio_v4alpha1_OneTimeAccessCodeList(ref),
@@ -123,6 +127,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Setting":                        schema_pkg_apis_hobbyfarmio_v4alpha1_Setting(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.SettingList":                    schema_pkg_apis_hobbyfarmio_v4alpha1_SettingList(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.StepTime":                       schema_pkg_apis_hobbyfarmio_v4alpha1_StepTime(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Tenant":                         schema_pkg_apis_hobbyfarmio_v4alpha1_Tenant(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.TenantList":                     schema_pkg_apis_hobbyfarmio_v4alpha1_TenantList(ref),
+		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.TenantSpec":                     schema_pkg_apis_hobbyfarmio_v4alpha1_TenantSpec(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.User":                           schema_pkg_apis_hobbyfarmio_v4alpha1_User(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.UserList":                       schema_pkg_apis_hobbyfarmio_v4alpha1_UserList(ref),
 		"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.UserSpec":                       schema_pkg_apis_hobbyfarmio_v4alpha1_UserSpec(ref),
@@ -2742,6 +2749,241 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_NonNamespaced(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OIDCConfig stores the configuration for OpenID Connect authentication against a specific identity provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigSpec", "github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfigStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.OIDCConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverDisplayName": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"issuerURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IssuerURL is the issuer of the identity provider. The provider is discovered from IssuerURL + \"/.well-known/openid-configuration\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientID": {
+						SchemaProps: spec.SchemaProps{
+							Default: "",
+							Type:    []string{"string"},
+							Format:  "",
+						},
+					},
+					"clientSecretSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientSecretSecret is the name of the Secret holding the client secret in key 'clientSecret'. It may be left empty for public clients, which rely on PKCE alone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"redirectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RedirectURL is the externally reachable URL of the callback endpoint, i.e. https://<apiserver>/auth/oidc/<name>/callback",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scopes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Scopes requested from the identity provider. Defaults to openid, profile and email.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"displayNameClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayNameClaim is the ID token claim used as the display name of the user. Defaults to 'name'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groupsClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupsClaim is the ID token claim holding the identity provider groups of the user. Defaults to 'groups'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"postLoginRedirectURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PostLoginRedirectURL, if set, is where the browser is sent after a successful login. The issued token is passed in the URL fragment as 'token'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"serverDisplayName", "issuerURL", "clientID", "redirectURL"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_hobbyfarmio_v4alpha1_OIDCConfigStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/genericcondition.GenericCondition"),
+									},
+								},
+							},
+						},
+					},
+					"issuer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Issuer, AuthorizationEndpoint, TokenEndpoint and JWKSURI are populated from the discovery document.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authorizationEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"tokenEndpoint": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"jwksURI": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"conditions"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/hobbyfarm/gargantua/v4/pkg/genericcondition.GenericCondition"},
+	}
+}
+
 func schema_pkg_apis_hobbyfarmio_v4alpha1_ObjectReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2772,6 +3014,13 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_ObjectReference(ref common.ReferenceCa
 							Format:      "",
 						},
 					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the tenant of the referenced object, empty for cluster-scoped objects",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"apiGroup", "kind", "name"},
 			},
@@ -3784,6 +4033,13 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_RoleBinding(ref common.ReferenceCallba
 							},
 						},
 					},
+					"tenant": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tenant restricts the rules of the role to the resources of a Tenant, and to reading the resources that all tenants share. Rolebindings without Tenant apply everywhere.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"role", "users", "groups"},
 			},
@@ -5066,14 +5322,56 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_SessionStatus(ref common.ReferenceCall
 							},
 						},
 					},
-					"machineClaim": {
+					"machineClaims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineClaim is the object name of the MachineClaim generated from this session.",
-							Default:     "",
+							Description: "MachineClaims is a slice of object names of the MachineClaims generated from this session. One MachineClaim is generated for every Machine required by the session.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"claimedScenario": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimedScenario is the object name of the scenario for which MachineClaims were generated. When the user moves on to another scenario of a course with a MachinePersistenceStrategy of NewPerScenario, the claims are terminated and new claims are generated.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"expirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTime is the time after which the machines of this session are reclaimed, unless it is extended by a keepalive.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused is true when the user has paused this session.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"pauseExpirationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PauseExpirationTime is the time until which a paused session is protected from being reclaimed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"finished": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finished is true once the machines of this session have been reclaimed.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"progress": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Progress is a slice of Progress structs that detail, for a given scenario, what position the user is in.",
@@ -5089,11 +5387,11 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_SessionStatus(ref common.ReferenceCall
 						},
 					},
 				},
-				Required: []string{"conditions", "machineClaim", "progress"},
+				Required: []string{"conditions", "machineClaims", "paused", "finished", "progress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Progress", "github.com/hobbyfarm/gargantua/v4/pkg/genericcondition.GenericCondition"},
+			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Progress", "github.com/hobbyfarm/gargantua/v4/pkg/genericcondition.GenericCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5307,6 +5605,124 @@ func schema_pkg_apis_hobbyfarmio_v4alpha1_StepTime(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_hobbyfarmio_v4alpha1_Tenant(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Tenant separates the HobbyFarm resources of one business unit from those of other tenants. The name of a Tenant is the namespace in which its tenant-scoped resources (Environments, ScheduledEvents, Scenarios, Sessions and the like) live. Cluster-scoped resources such as Providers and MachineTemplates are shared by all tenants.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.TenantSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.TenantSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_hobbyfarmio_v4alpha1_TenantList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Tenant"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1.Tenant", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_hobbyfarmio_v4alpha1_TenantSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisplayName is the pretty name of the Tenant",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"storageNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageNamespace is the namespace of the backing kubernetes cluster in which the resources of the Tenant are stored. If empty, the resources are stored in a namespace named after the namespace of HobbyFarm and the Tenant, i.e. <namespace>-<tenant>. It is not used by the sql and memory storages.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"displayName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_hobbyfarmio_v4alpha1_User(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -0,0 +1,42 @@
+package providers
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+)
+
+// Driver is the in-process implementation of a Provider. A Driver is responsible for creating, deleting,
+// and reporting the state of the infrastructure that backs a Machine, e.g. an EC2 instance or a DigitalOcean
+// droplet.
+//
+// Providers that are implemented as 3rd party operators do not need a Driver, they watch Machines through
+// the apiserver directly.
+type Driver interface {
+	// Create provisions the infrastructure for the Machine. Create is called on every reconcile of a Machine
+	// that has not yet been provisioned, and MUST be idempotent.
+	Create(ctx context.Context, machine *v4alpha1.Machine) error
+
+	// Delete removes the infrastructure for the Machine. Delete MUST return nil if the infrastructure
+	// does not exist.
+	Delete(ctx context.Context, machine *v4alpha1.Machine) error
+
+	// Status reports the current state of the infrastructure for the Machine.
+	Status(ctx context.Context, machine *v4alpha1.Machine) (MachineState, error)
+}
+
+// MachineState is the state of a Machine as reported by a Driver.
+type MachineState struct {
+	// Ready is true once the Machine can be used by a user.
+	Ready bool
+
+	// Message is an optional, human-readable description of the state of the Machine.
+	Message string
+
+	// MachineInformation is recorded as MachineStatus.MachineInformation. Keys SHOULD be those defined in
+	// the MachineInformation field of the Provider.
+	MachineInformation map[string]string
+
+	// ConnectEndpoints are the endpoints at which the Machine can be reached, by protocol.
+	// If set, these replace the endpoints on the MachineSpec.
+	ConnectEndpoints map[v4alpha1.ConnectProtocol]string
+}
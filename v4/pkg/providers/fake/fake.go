@@ -0,0 +1,138 @@
+package fake
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+var _ providers.Driver = (*Driver)(nil)
+
+// ports are the ports reported in ConnectEndpoints for each protocol
+var ports = map[v4alpha1.ConnectProtocol]int{
+	v4alpha1.ConnectProtocolSSH: 22,
+	v4alpha1.ConnectProtocolRDP: 3389,
+	v4alpha1.ConnectProtocolVNC: 5900,
+}
+
+// Driver is an in-process providers.Driver that does not provision any infrastructure. Machines are
+// assigned an address from 10.0.0.0/8 and become ready after a configurable delay. It is intended for
+// tests and local development.
+type Driver struct {
+	lock       sync.Mutex
+	machines   map[string]*machine
+	next       uint32
+	readyAfter time.Duration
+	now        func() time.Time
+}
+
+type machine struct {
+	created time.Time
+	address string
+}
+
+type Option func(d *Driver)
+
+// WithReadyAfter sets the period of time after creation at which machines become ready.
+func WithReadyAfter(readyAfter time.Duration) Option {
+	return func(d *Driver) {
+		d.readyAfter = readyAfter
+	}
+}
+
+// WithClock sets the func used to get the current time.
+func WithClock(now func() time.Time) Option {
+	return func(d *Driver) {
+		d.now = now
+	}
+}
+
+func New(opts ...Option) *Driver {
+	d := &Driver{
+		machines: map[string]*machine{},
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(d)
+	}
+
+	return d
+}
+
+func (d *Driver) Create(_ context.Context, m *v4alpha1.Machine) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if _, ok := d.machines[m.Name]; ok {
+		return nil
+	}
+
+	d.next++
+	d.machines[m.Name] = &machine{
+		created: d.now(),
+		address: net.IPv4(10, byte(d.next>>16), byte(d.next>>8), byte(d.next)).String(),
+	}
+
+	return nil
+}
+
+func (d *Driver) Delete(_ context.Context, m *v4alpha1.Machine) error {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	delete(d.machines, m.Name)
+
+	return nil
+}
+
+func (d *Driver) Status(_ context.Context, m *v4alpha1.Machine) (providers.MachineState, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	fm, ok := d.machines[m.Name]
+	if !ok {
+		return providers.MachineState{}, fmt.Errorf("machine %s has not been created", m.Name)
+	}
+
+	if d.now().Before(fm.created.Add(d.readyAfter)) {
+		return providers.MachineState{
+			Message: "machine is starting",
+		}, nil
+	}
+
+	endpoints := map[v4alpha1.ConnectProtocol]string{}
+	for protocol, endpoint := range m.Spec.ConnectEndpoints {
+		if port, ok := ports[protocol]; ok {
+			endpoints[protocol] = net.JoinHostPort(fm.address, strconv.Itoa(port))
+		} else {
+			endpoints[protocol] = endpoint
+		}
+	}
+
+	return providers.MachineState{
+		Ready:   true,
+		Message: "machine is running",
+		MachineInformation: map[string]string{
+			"hostname":   m.Name,
+			"private_ip": fm.address,
+			"public_ip":  fm.address,
+		},
+		ConnectEndpoints: endpoints,
+	}, nil
+}
+
+// Exists returns true if the named machine has been created and not deleted.
+func (d *Driver) Exists(name string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	_, ok := d.machines[name]
+
+	return ok
+}
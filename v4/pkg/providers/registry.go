@@ -0,0 +1,55 @@
+package providers
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Registry holds the Driver for each Provider, keyed by the object name of the Provider.
+type Registry struct {
+	lock    sync.RWMutex
+	drivers map[string]Driver
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		drivers: map[string]Driver{},
+	}
+}
+
+// Register adds the Driver for the named Provider. Registering a second Driver for a Provider is an error.
+func (r *Registry) Register(provider string, driver Driver) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.drivers[provider]; ok {
+		return fmt.Errorf("driver for provider %s already registered", provider)
+	}
+
+	r.drivers[provider] = driver
+
+	return nil
+}
+
+// Get returns the Driver for the named Provider, or false if no Driver is registered.
+func (r *Registry) Get(provider string) (Driver, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	driver, ok := r.drivers[provider]
+
+	return driver, ok
+}
+
+// Providers returns the names of all Providers for which a Driver is registered.
+func (r *Registry) Providers() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var out = make([]string, 0, len(r.drivers))
+	for k := range r.drivers {
+		out = append(out, k)
+	}
+
+	return out
+}
@@ -2,18 +2,26 @@ package server
 
 import (
 	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/authenticators"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/authenticators/cert"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/authenticators/token"
 	"github.com/hobbyfarm/gargantua/v4/pkg/authorization"
+	"github.com/hobbyfarm/gargantua/v4/pkg/config"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventarchive"
 	"github.com/hobbyfarm/gargantua/v4/pkg/openapi/hobbyfarm_io"
 	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
 	"github.com/hobbyfarm/gargantua/v4/pkg/stores/kubernetes"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/memory"
 	"github.com/hobbyfarm/gargantua/v4/pkg/stores/registry"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/sql"
 	"github.com/hobbyfarm/mink/pkg/serializer"
 	"github.com/hobbyfarm/mink/pkg/server"
 	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/spf13/viper"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
 	"k8s.io/apiserver/pkg/registry/rest"
 	apiserver "k8s.io/apiserver/pkg/server"
 	"k8s.io/apiserver/pkg/server/options"
@@ -25,6 +33,9 @@ type KubernetesServerConfig struct {
 	Client                client.WithWatch
 	ForceStorageNamespace string
 	CACertBundle          string
+
+	// EventArchive is merged into the event history, if it is set
+	EventArchive eventarchive.Archive
 }
 
 // NewKubernetesServer creates a new hobbyfarm-api server backed by a remote Kubernetes cluster.
@@ -35,7 +46,129 @@ type KubernetesServerConfig struct {
 func NewKubernetesServer(ctx context.Context, config *KubernetesServerConfig) (*server.Server, error) {
 	v4alpha1Storage := kubernetes.V4Alpha1Storages(config.Client, config.ForceStorageNamespace)
 
-	v4alpha1ApiGroups, err := V4Alpha1APIGroups(v4alpha1Storage)
+	return newServer(ctx, serverOptions{
+		storages:           v4alpha1Storage,
+		caCertBundle:       config.CACertBundle,
+		tokenAuthenticator: token.NewGenericGeneratorValidator(config.Client),
+		eventArchive:       config.EventArchive,
+	})
+}
+
+type SQLServerConfig struct {
+	Store        *sql.Store
+	CACertBundle string
+
+	// EventArchive is merged into the event history, if it is set
+	EventArchive eventarchive.Archive
+}
+
+// NewSQLServer creates a new hobbyfarm-api server backed by a SQL database.
+// Store is the store of the database, its tables have to be migrated already. The server compacts
+// the events of the store while it runs.
+func NewSQLServer(ctx context.Context, config *SQLServerConfig) (*server.Server, error) {
+	v4alpha1Storage, err := sql.V4Alpha1Storages(config.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	signingSecret, err := storedSigningSecret(ctx, v4alpha1Storage["secrets"])
+	if err != nil {
+		return nil, err
+	}
+
+	return newServer(ctx, serverOptions{
+		storages:           v4alpha1Storage,
+		caCertBundle:       config.CACertBundle,
+		tokenAuthenticator: token.NewGenericValidator(signingSecret),
+		eventArchive:       config.EventArchive,
+		postStart: func(ctx context.Context) error {
+			go config.Store.Run(ctx)
+			return nil
+		},
+	})
+}
+
+type MemoryServerConfig struct {
+	Store        *memory.Store
+	CACertBundle string
+
+	// HTTPListenPort and HTTPSListenPort default to 8080 and 8443
+	HTTPListenPort  int
+	HTTPSListenPort int
+
+	// EventArchive is merged into the event history, if it is set
+	EventArchive eventarchive.Archive
+}
+
+// NewMemoryServer creates a new hobbyfarm-api server that keeps all resources in memory. It does not
+// need a Kubernetes cluster or a database, which makes it suited for tests and development.
+func NewMemoryServer(ctx context.Context, config *MemoryServerConfig) (*server.Server, error) {
+	v4alpha1Storage, err := memory.V4Alpha1Storages(config.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	signingSecret, err := storedSigningSecret(ctx, v4alpha1Storage["secrets"])
+	if err != nil {
+		return nil, err
+	}
+
+	return newServer(ctx, serverOptions{
+		storages:           v4alpha1Storage,
+		caCertBundle:       config.CACertBundle,
+		tokenAuthenticator: token.NewGenericValidator(signingSecret),
+		eventArchive:       config.EventArchive,
+		httpListenPort:     config.HTTPListenPort,
+		httpsListenPort:    config.HTTPSListenPort,
+	})
+}
+
+// storedSigningSecret returns the JWT signing key from the secrets storage, or an empty string if it
+// has not been created yet.
+func storedSigningSecret(ctx context.Context, secrets strategy.CompleteStrategy) (string, error) {
+	obj, err := secrets.Get(ctx, "", viper.GetString(config.JWTSigningKeySecretName))
+	if errors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	secret, ok := obj.(*v4alpha1.Secret)
+	if !ok {
+		return "", nil
+	}
+
+	return string(secret.Data[viper.GetString(config.JWTSigningKeySecretKey)]), nil
+}
+
+type serverOptions struct {
+	storages           map[string]strategy.CompleteStrategy
+	caCertBundle       string
+	tokenAuthenticator authenticator.Request
+	eventArchive       eventarchive.Archive
+
+	// postStart is run once the server has started, if it is set
+	postStart func(ctx context.Context) error
+
+	httpListenPort  int
+	httpsListenPort int
+}
+
+// newServer creates the hobbyfarm-api server serving the given storages.
+func newServer(ctx context.Context, opts serverOptions) (*server.Server, error) {
+	if opts.httpListenPort == 0 {
+		opts.httpListenPort = 8080
+	}
+	if opts.httpsListenPort == 0 {
+		opts.httpsListenPort = 8443
+	}
+
+	authorizer := authorization.NewCachedAuthorizer(opts.storages["rolebindings"],
+		opts.storages["roles"], opts.storages["tenants"], "/auth/.*/login", "/auth/oidc/.*/callback",
+		"^"+authorization.SelfAccessReviewPath+"(/.*)?$")
+
+	v4alpha1ApiGroups, err := V4Alpha1APIGroups(opts.storages, authorizer)
 	if err != nil {
 		return nil, err
 	}
@@ -45,24 +178,20 @@ func NewKubernetesServer(ctx context.Context, config *KubernetesServerConfig) (*
 		return nil, err
 	}
 
-	certAuthenticatior, err := cert.NewCertAuthenticator(config.CACertBundle)
+	certAuthenticatior, err := cert.NewCertAuthenticator(opts.caCertBundle)
 	if err != nil {
 		return nil, err
 	}
 
-	// authenticator := token.NewGenericGeneratorValidator(Client)
 	authenticator := authenticators.NewChainAuthenticator(
 		certAuthenticatior,
-		token.NewGenericGeneratorValidator(config.Client))
-
-	authorizer := authorization.NewAuthorizer(v4alpha1Storage["rolebindings"],
-		v4alpha1Storage["roles"], "/auth/.*/login")
+		opts.tokenAuthenticator)
 
 	svr, err := server.New(&server.Config{
 		Name:                         "hobbyfarm-api",
 		Version:                      "v4alpha1",
-		HTTPListenPort:               8080,
-		HTTPSListenPort:              8443,
+		HTTPListenPort:               opts.httpListenPort,
+		HTTPSListenPort:              opts.httpsListenPort,
 		LongRunningVerbs:             []string{"watch"},
 		LongRunningResources:         nil,
 		Scheme:                       scheme.Scheme,
@@ -78,7 +207,7 @@ func NewKubernetesServer(ctx context.Context, config *KubernetesServerConfig) (*
 			SkipInClusterLookup:          true,
 			RemoteKubeConfigFileOptional: true,
 			ClientCert: options.ClientCertAuthenticationOptions{
-				ClientCA: config.CACertBundle,
+				ClientCA: opts.caCertBundle,
 			},
 		},
 		Authenticator:         authenticator,
@@ -99,6 +228,27 @@ func NewKubernetesServer(ctx context.Context, config *KubernetesServerConfig) (*
 		return nil, err
 	}
 
+	reviewHandler := authorization.NewReviewHandler(authorizer)
+	svr.GenericAPIServer.Handler.NonGoRestfulMux.Handle(authorization.AccessReviewPath, reviewHandler)
+	svr.GenericAPIServer.Handler.NonGoRestfulMux.HandlePrefix(authorization.AccessReviewPath+"/", reviewHandler)
+
+	if err := svr.GenericAPIServer.AddPostStartHook("authorization-cache", func(_ apiserver.PostStartHookContext) error {
+		authorizer.Run(ctx)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	eventClient, err := client.New(svr.GenericAPIServer.LoopbackClientConfig, client.Options{
+		Scheme: scheme.Scheme,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	svr.GenericAPIServer.Handler.NonGoRestfulMux.Handle(eventarchive.HistoryPath,
+		eventarchive.NewHandler(eventClient, opts.eventArchive))
+
 	if err := svr.GenericAPIServer.AddPostStartHook("auth-caches", func(_ apiserver.PostStartHookContext) error {
 		for _, cache := range caches {
 			if err := cache.Start(ctx); err != nil {
@@ -111,10 +261,20 @@ func NewKubernetesServer(ctx context.Context, config *KubernetesServerConfig) (*
 		return nil, err
 	}
 
+	if opts.postStart != nil {
+		if err := svr.GenericAPIServer.AddPostStartHook("storage", func(_ apiserver.PostStartHookContext) error {
+			return opts.postStart(ctx)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
 	return svr, nil
 }
 
-func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[string]rest.Storage, error) {
+// V4Alpha1APIGroups returns the storages of the v4alpha1 resources. Storages which authorize beyond the verb of the
+// request, e.g. by the owner of the object or by the tenants of the object, use the given authorizer.
+func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy, authorizer *authorization.CachedAuthorizer) (map[string]rest.Storage, error) {
 	providerStorage, err := registry.NewProviderStorage(storages["providers"],
 		storages["machinesets"], storages["machines"], storages["environments"])
 	if err != nil {
@@ -175,6 +335,13 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 
 	sessionStatusStorage := registry.NewSessionStatusStorage(storages["sessions"].Scheme(), storages["sessions"])
 
+	sessionKeepaliveStorage := registry.NewSessionActionStorage(storages["sessions"], storages["courses"],
+		storages["scenarios"], authorizer, registry.SessionActionKeepalive)
+	sessionPauseStorage := registry.NewSessionActionStorage(storages["sessions"], storages["courses"],
+		storages["scenarios"], authorizer, registry.SessionActionPause)
+	sessionResumeStorage := registry.NewSessionActionStorage(storages["sessions"], storages["courses"],
+		storages["scenarios"], authorizer, registry.SessionActionResume)
+
 	courseStorage, err := registry.NewCourseStorage(storages["courses"])
 	if err != nil {
 		return nil, err
@@ -221,7 +388,7 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 		return nil, err
 	}
 
-	userStorage, err := registry.NewUserStorage(storages["users"])
+	userStorage, err := registry.NewUserStorage(storages["users"], storages["rolebindings"], authorizer)
 	if err != nil {
 		return nil, err
 	}
@@ -263,7 +430,17 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 		return nil, err
 	}
 
-	groupStorage, err := registry.NewGroupStorage(storages["groups"])
+	oidcConfigStorage, err := registry.NewOIDCConfigStorage(storages["oidcconfigs"])
+	if err != nil {
+		return nil, err
+	}
+
+	oidcConfigStatusStorage, err := registry.NewOIDCConfigStatusStorage(storages["oidcconfigs"].Scheme(), storages["oidcconfigs"])
+	if err != nil {
+		return nil, err
+	}
+
+	groupStorage, err := registry.NewGroupStorage(storages["groups"], storages["rolebindings"], authorizer)
 	if err != nil {
 		return nil, err
 	}
@@ -278,7 +455,12 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 		return nil, err
 	}
 
-	eventStorage, err := registry.NewEventStorage(storages["events"])
+	eventStorage, err := registry.NewEventStorage(storages["events"], authorizer)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantStorage, err := registry.NewTenantStorage(storages["tenants"])
 	if err != nil {
 		return nil, err
 	}
@@ -300,6 +482,9 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 		"accesscodes/status":           accessCodeStatusStorage,
 		"sessions":                     sessionStorage,
 		"sessions/status":              sessionStatusStorage,
+		"sessions/keepalive":           sessionKeepaliveStorage,
+		"sessions/pause":               sessionPauseStorage,
+		"sessions/resume":              sessionResumeStorage,
 		"courses":                      courseStorage,
 		"onetimeaccesscodes":           otacStorage,
 		"onetimeaccesscodes/status":    otacStatusStorage,
@@ -320,10 +505,13 @@ func V4Alpha1APIGroups(storages map[string]strategy.CompleteStrategy) (map[strin
 		"rolebindings":                 roleBindingStorage,
 		"ldapconfigs":                  ldapConfigStorage,
 		"ldapconfigs/status":           ldapConfigStatusStorage,
+		"oidcconfigs":                  oidcConfigStorage,
+		"oidcconfigs/status":           oidcConfigStatusStorage,
 		"groups":                       groupStorage,
 		"onetimeaccesscodesets":        otacSetStorage,
 		"onetimeaccesscodesets/status": otacSetStatusStorage,
 		"events":                       eventStorage,
+		"tenants":                      tenantStorage,
 	}
 
 	return stores, nil
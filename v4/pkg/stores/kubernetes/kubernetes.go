@@ -10,22 +10,27 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// V4Alpha1Storages returns the remotes of the v4alpha1 resources. Cluster-scoped resources are stored in
+// the namespace, the resources of each tenant in the storage namespace of the tenant.
 func V4Alpha1Storages(client client.WithWatch, namespace string) map[string]strategy.CompleteStrategy {
+	tenantRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Tenant{}, client, namespace)
+	tenants := remote.NewTenantMapper(tenantRemote, namespace)
+
 	providerRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Provider{}, client, namespace)
 	machineTemplateRemote := remote.NewNamespaceScopedRemote(&v4alpha1.MachineTemplate{}, client, namespace)
-	environmentRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Environment{}, client, namespace)
-	machineSetRemote := remote.NewNamespaceScopedRemote(&v4alpha1.MachineSet{}, client, namespace)
-	machineRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Machine{}, client, namespace)
-	machineClaimRemote := remote.NewNamespaceScopedRemote(&v4alpha1.MachineClaim{}, client, namespace)
-	scheduledEventRemote := remote.NewNamespaceScopedRemote(&v4alpha1.ScheduledEvent{}, client, namespace)
-	accessCodeRemote := remote.NewNamespaceScopedRemote(&v4alpha1.AccessCode{}, client, namespace)
-	sessionRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Session{}, client, namespace)
-	courseRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Course{}, client, namespace)
-	otacRemote := remote.NewNamespaceScopedRemote(&v4alpha1.OneTimeAccessCode{}, client, namespace)
-	predefinedServiceRemote := remote.NewNamespaceScopedRemote(&v4alpha1.PredefinedService{}, client, namespace)
-	progressRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Progress{}, client, namespace)
-	scenarioRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Scenario{}, client, namespace)
-	scenarioStepRemote := remote.NewNamespaceScopedRemote(&v4alpha1.ScenarioStep{}, client, namespace)
+	environmentRemote := remote.NewTenantScopedRemote(&v4alpha1.Environment{}, client, tenants)
+	machineSetRemote := remote.NewTenantScopedRemote(&v4alpha1.MachineSet{}, client, tenants)
+	machineRemote := remote.NewTenantScopedRemote(&v4alpha1.Machine{}, client, tenants)
+	machineClaimRemote := remote.NewTenantScopedRemote(&v4alpha1.MachineClaim{}, client, tenants)
+	scheduledEventRemote := remote.NewTenantScopedRemote(&v4alpha1.ScheduledEvent{}, client, tenants)
+	accessCodeRemote := remote.NewTenantScopedRemote(&v4alpha1.AccessCode{}, client, tenants)
+	sessionRemote := remote.NewTenantScopedRemote(&v4alpha1.Session{}, client, tenants)
+	courseRemote := remote.NewTenantScopedRemote(&v4alpha1.Course{}, client, tenants)
+	otacRemote := remote.NewTenantScopedRemote(&v4alpha1.OneTimeAccessCode{}, client, tenants)
+	predefinedServiceRemote := remote.NewTenantScopedRemote(&v4alpha1.PredefinedService{}, client, tenants)
+	progressRemote := remote.NewTenantScopedRemote(&v4alpha1.Progress{}, client, tenants)
+	scenarioRemote := remote.NewTenantScopedRemote(&v4alpha1.Scenario{}, client, tenants)
+	scenarioStepRemote := remote.NewTenantScopedRemote(&v4alpha1.ScenarioStep{}, client, tenants)
 	scopeRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Scope{}, client, namespace)
 	settingRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Setting{}, client, namespace)
 	userRemote := remote.NewNamespaceScopedRemote(&v4alpha1.User{}, client, namespace)
@@ -33,8 +38,9 @@ func V4Alpha1Storages(client client.WithWatch, namespace string) map[string]stra
 	roleRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Role{}, client, namespace)
 	roleBindingRemote := remote.NewNamespaceScopedRemote(&v4alpha1.RoleBinding{}, client, namespace)
 	ldapConfigRemote := remote.NewNamespaceScopedRemote(&v4alpha1.LdapConfig{}, client, namespace)
+	oidcConfigRemote := remote.NewNamespaceScopedRemote(&v4alpha1.OIDCConfig{}, client, namespace)
 	groupRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Group{}, client, namespace)
-	otacSetRemote := remote.NewNamespaceScopedRemote(&v4alpha1.OneTimeAccessCodeSet{}, client, namespace)
+	otacSetRemote := remote.NewTenantScopedRemote(&v4alpha1.OneTimeAccessCodeSet{}, client, tenants)
 	eventRemote := remote.NewNamespaceScopedRemote(&v4alpha1.Event{}, client, namespace)
 
 	configMapTranslator := translators.ConfigMapTranslator{Namespace: namespace}
@@ -72,8 +78,10 @@ func V4Alpha1Storages(client client.WithWatch, namespace string) map[string]stra
 		"roles":                 roleRemote,
 		"rolebindings":          roleBindingRemote,
 		"ldapconfigs":           ldapConfigRemote,
+		"oidcconfigs":           oidcConfigRemote,
 		"groups":                groupRemote,
 		"onetimeaccesscodesets": otacSetRemote,
 		"events":                eventRemote,
+		"tenants":               tenantRemote,
 	}
 }
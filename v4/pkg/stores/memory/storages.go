@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/hobbyfarm/mink/pkg/types"
+)
+
+// V4Alpha1Storages returns the strategies of the v4alpha1 resources kept in the store, keyed like the
+// storages of the kubernetes backend. ConfigMaps and Secrets are stored as they are served, they do not
+// need to be translated.
+func V4Alpha1Storages(store *Store) (map[string]strategy.CompleteStrategy, error) {
+	resources := []struct {
+		name   string
+		obj    types.Object
+		status bool
+	}{
+		{"providers", &v4alpha1.Provider{}, false},
+		{"machinetemplates", &v4alpha1.MachineTemplate{}, false},
+		{"environments", &v4alpha1.Environment{}, true},
+		{"machinesets", &v4alpha1.MachineSet{}, true},
+		{"machines", &v4alpha1.Machine{}, true},
+		{"machineclaims", &v4alpha1.MachineClaim{}, true},
+		{"scheduledevents", &v4alpha1.ScheduledEvent{}, true},
+		{"accesscodes", &v4alpha1.AccessCode{}, true},
+		{"sessions", &v4alpha1.Session{}, true},
+		{"courses", &v4alpha1.Course{}, false},
+		{"onetimeaccesscodes", &v4alpha1.OneTimeAccessCode{}, true},
+		{"predefinedservices", &v4alpha1.PredefinedService{}, false},
+		{"progresses", &v4alpha1.Progress{}, false},
+		{"scenarios", &v4alpha1.Scenario{}, false},
+		{"scenariosteps", &v4alpha1.ScenarioStep{}, false},
+		{"scopes", &v4alpha1.Scope{}, false},
+		{"settings", &v4alpha1.Setting{}, false},
+		{"users", &v4alpha1.User{}, true},
+		{"serviceaccounts", &v4alpha1.ServiceAccount{}, false},
+		{"configmaps", &v4alpha1.ConfigMap{}, false},
+		{"secrets", &v4alpha1.Secret{}, false},
+		{"roles", &v4alpha1.Role{}, false},
+		{"rolebindings", &v4alpha1.RoleBinding{}, false},
+		{"ldapconfigs", &v4alpha1.LdapConfig{}, true},
+		{"oidcconfigs", &v4alpha1.OIDCConfig{}, true},
+		{"groups", &v4alpha1.Group{}, false},
+		{"onetimeaccesscodesets", &v4alpha1.OneTimeAccessCodeSet{}, true},
+		{"events", &v4alpha1.Event{}, false},
+		{"tenants", &v4alpha1.Tenant{}, false},
+	}
+
+	storages := make(map[string]strategy.CompleteStrategy, len(resources))
+	for _, resource := range resources {
+		s, err := NewStrategy(resource.obj, store, resource.name)
+		if err != nil {
+			return nil, err
+		}
+		if resource.status {
+			s.WithStatusSubresource()
+		}
+		storages[resource.name] = s
+	}
+
+	return storages, nil
+}
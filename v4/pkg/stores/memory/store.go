@@ -0,0 +1,238 @@
+package memory
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// defaultEventRetention is the number of events kept for watches to resume from
+const defaultEventRetention = 10000
+
+// Store keeps objects of any resource in memory. Every write increments the resource version of the store
+// and is recorded as event, like the sql store does. Nothing survives a restart, the store is meant for
+// tests and development.
+type Store struct {
+	scheme *runtime.Scheme
+
+	lock    sync.RWMutex
+	version int64
+	objects map[string]map[objectKey]*unstructured.Unstructured
+	events  []event
+	changed chan struct{}
+
+	// EventRetention is the number of events kept for watches to resume from
+	EventRetention int
+}
+
+func NewStore(scheme *runtime.Scheme) *Store {
+	return &Store{
+		scheme:         scheme,
+		objects:        make(map[string]map[objectKey]*unstructured.Unstructured),
+		changed:        make(chan struct{}),
+		EventRetention: defaultEventRetention,
+	}
+}
+
+// objectKey orders the objects of a resource.
+type objectKey struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+func keyOf(obj *unstructured.Unstructured) objectKey {
+	return objectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
+
+func (k objectKey) less(other objectKey) bool {
+	return k.Namespace < other.Namespace || (k.Namespace == other.Namespace && k.Name < other.Name)
+}
+
+type event struct {
+	id        int64
+	resource  string
+	eventType watch.EventType
+	object    *unstructured.Unstructured
+	previous  *unstructured.Unstructured
+}
+
+// write runs fn while holding the write lock and wakes the watches afterward.
+func (s *Store) write(fn func() error) error {
+	s.lock.Lock()
+	err := fn()
+	changed := s.changed
+	if err == nil {
+		s.changed = make(chan struct{})
+	}
+	s.lock.Unlock()
+
+	if err == nil {
+		close(changed)
+	}
+
+	return err
+}
+
+// get returns a copy of the stored object, or nil if it does not exist. The caller must hold the lock.
+func (s *Store) get(resource string, key objectKey) *unstructured.Unstructured {
+	obj, ok := s.objects[resource][key]
+	if !ok {
+		return nil
+	}
+
+	return obj.DeepCopy()
+}
+
+// list returns copies of the objects of a resource ordered by their key, starting after the given key.
+// An empty namespace returns the objects of all namespaces. The caller must hold the lock.
+func (s *Store) list(resource, namespace string, after objectKey) []*unstructured.Unstructured {
+	objects := make([]*unstructured.Unstructured, 0)
+	for key, obj := range s.objects[resource] {
+		if namespace != "" && key.Namespace != namespace {
+			continue
+		}
+		if !after.less(key) {
+			continue
+		}
+		objects = append(objects, obj.DeepCopy())
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return keyOf(objects[i]).less(keyOf(objects[j]))
+	})
+
+	return objects
+}
+
+// since returns the events of a resource after the given resource version, and false if events after
+// the version have been dropped already. The caller must hold the lock.
+func (s *Store) since(resource string, version int64) ([]event, bool) {
+	oldest := s.version + 1
+	if len(s.events) > 0 {
+		oldest = s.events[0].id
+	}
+	if version+1 < oldest {
+		return nil, false
+	}
+
+	// events are ordered by id
+	start := sort.Search(len(s.events), func(i int) bool {
+		return s.events[i].id > version
+	})
+
+	events := make([]event, 0)
+	for _, e := range s.events[start:] {
+		if e.resource == resource {
+			events = append(events, e)
+		}
+	}
+
+	return events, true
+}
+
+// record writes an object and records the write as event. The resource version of the object is set
+// to the new version of the store. Deleted objects are removed from the store. The caller must hold the lock.
+func (s *Store) record(resource string, eventType watch.EventType, obj *unstructured.Unstructured,
+	previous *unstructured.Unstructured) {
+	s.version++
+	obj.SetResourceVersion(strconv.FormatInt(s.version, 10))
+
+	if s.objects[resource] == nil {
+		s.objects[resource] = make(map[objectKey]*unstructured.Unstructured)
+	}
+	if eventType == watch.Deleted {
+		delete(s.objects[resource], keyOf(obj))
+	} else {
+		s.objects[resource][keyOf(obj)] = obj.DeepCopy()
+	}
+
+	e := event{id: s.version, resource: resource, eventType: eventType, object: obj.DeepCopy()}
+	if previous != nil {
+		e.previous = previous.DeepCopy()
+	}
+	s.events = append(s.events, e)
+	if drop := len(s.events) - s.EventRetention; drop > 0 {
+		s.events = append([]event(nil), s.events[drop:]...)
+	}
+}
+
+// update writes an updated object. Objects marked for deletion are deleted once their last finalizer
+// has been removed. The caller must hold the lock.
+func (s *Store) update(resource string, obj *unstructured.Unstructured, previous *unstructured.Unstructured) {
+	if obj.GetDeletionTimestamp() != nil && len(obj.GetFinalizers()) == 0 {
+		s.record(resource, watch.Deleted, obj, nil)
+		s.deleteDependents(obj.GetUID())
+		return
+	}
+
+	s.record(resource, watch.Modified, obj, previous)
+}
+
+// delete deletes an object. Objects with finalizers are only marked for deletion, they are deleted
+// once their finalizers have been removed. The caller must hold the lock.
+func (s *Store) delete(resource string, obj *unstructured.Unstructured) {
+	if len(obj.GetFinalizers()) > 0 {
+		if obj.GetDeletionTimestamp() != nil {
+			return
+		}
+
+		previous := obj.DeepCopy()
+		now := metav1.Now()
+		obj.SetDeletionTimestamp(&now)
+		s.record(resource, watch.Modified, obj, previous)
+		return
+	}
+
+	s.record(resource, watch.Deleted, obj, nil)
+	s.deleteDependents(obj.GetUID())
+}
+
+// deleteDependents deletes the objects owned by a deleted object that have no other existing owner,
+// like the garbage collector of kubernetes does with background propagation. The caller must hold the lock.
+func (s *Store) deleteDependents(owner types.UID) {
+	resources := make([]string, 0, len(s.objects))
+	for resource := range s.objects {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		for _, obj := range s.list(resource, "", objectKey{}) {
+			if !ownedBy(obj, owner) || s.hasOwner(obj) {
+				continue
+			}
+
+			s.delete(resource, obj)
+		}
+	}
+}
+
+func ownedBy(obj *unstructured.Unstructured, owner types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == owner {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasOwner returns whether any owner of the object still exists. The caller must hold the lock.
+func (s *Store) hasOwner(obj *unstructured.Unstructured) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		for _, objects := range s.objects {
+			for _, candidate := range objects {
+				if candidate.GetUID() == ref.UID {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"strconv"
+)
+
+// Watch sends the changes of the objects matching the predicate after the resource version of the options.
+// Without resource version, or with resource version "0", the watch starts with an ADDED event for every
+// matching object. Objects that stop or start matching the predicate are sent as DELETED or ADDED.
+func (s *Strategy) Watch(ctx context.Context, namespace string, opts storage.ListOptions) (<-chan watch.Event, error) {
+	matches := s.matcher(namespace, opts.Predicate)
+
+	var since int64
+	var initial []*unstructured.Unstructured
+	switch opts.ResourceVersion {
+	case "", "0":
+		s.store.lock.RLock()
+		since = s.store.version
+		initial = s.store.list(s.resource, namespace, objectKey{})
+		s.store.lock.RUnlock()
+	default:
+		var err error
+		if since, err = strconv.ParseInt(opts.ResourceVersion, 10, 64); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid resource version %q", opts.ResourceVersion))
+		}
+
+		s.store.lock.RLock()
+		_, ok := s.store.since(s.resource, since)
+		s.store.lock.RUnlock()
+		if !ok {
+			return nil, s.expired(since)
+		}
+	}
+
+	result := make(chan watch.Event)
+	go func() {
+		defer close(result)
+
+		for _, obj := range initial {
+			ok, err := matches(obj)
+			if err != nil {
+				s.fail(ctx, result, apierrors.NewInternalError(err))
+				return
+			}
+			if !ok {
+				continue
+			}
+
+			typed, err := s.fromUnstructured(obj)
+			if err != nil {
+				s.fail(ctx, result, apierrors.NewInternalError(err))
+				return
+			}
+			if !s.send(ctx, result, watch.Event{Type: watch.Added, Object: typed}) {
+				return
+			}
+		}
+
+		s.follow(ctx, result, since, matches)
+	}()
+
+	return result, nil
+}
+
+func (s *Strategy) expired(version int64) *apierrors.StatusError {
+	return apierrors.NewResourceExpired(fmt.Sprintf("resource version %d of %s is too old", version, s.resource))
+}
+
+func (s *Strategy) send(ctx context.Context, result chan<- watch.Event, e watch.Event) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case result <- e:
+		return true
+	}
+}
+
+// follow sends the events after the given resource version until the context is done.
+func (s *Strategy) follow(ctx context.Context, result chan<- watch.Event, since int64, matches func(*unstructured.Unstructured) (bool, error)) {
+	for {
+		s.store.lock.RLock()
+		events, ok := s.store.since(s.resource, since)
+		changed := s.store.changed
+		s.store.lock.RUnlock()
+
+		if !ok {
+			s.fail(ctx, result, s.expired(since))
+			return
+		}
+
+		for _, e := range events {
+			since = e.id
+
+			out, ok, err := s.translate(e, matches)
+			if err != nil {
+				s.fail(ctx, result, apierrors.NewInternalError(err))
+				return
+			}
+			if ok && !s.send(ctx, result, out) {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+// translate turns a stored event into the event of the watch, based on whether the object matched the
+// predicate before and after the write.
+func (s *Strategy) translate(e event, matches func(*unstructured.Unstructured) (bool, error)) (watch.Event, bool, error) {
+	now, err := matches(e.object)
+	if err != nil {
+		return watch.Event{}, false, err
+	}
+	before := false
+	if e.previous != nil {
+		if before, err = matches(e.previous); err != nil {
+			return watch.Event{}, false, err
+		}
+	}
+
+	var eventType watch.EventType
+	switch {
+	case e.eventType == watch.Deleted && now:
+		eventType = watch.Deleted
+	case e.eventType == watch.Deleted:
+		return watch.Event{}, false, nil
+	case now && before:
+		eventType = watch.Modified
+	case now:
+		eventType = watch.Added
+	case before:
+		// objects that stop matching are sent with their new state, as the kubernetes apiserver does
+		eventType = watch.Deleted
+	default:
+		return watch.Event{}, false, nil
+	}
+
+	typed, err := s.fromUnstructured(e.object)
+	if err != nil {
+		return watch.Event{}, false, err
+	}
+
+	return watch.Event{Type: eventType, Object: typed}, true, nil
+}
+
+func (s *Strategy) fail(ctx context.Context, result chan<- watch.Event, err apierrors.APIStatus) {
+	status := err.Status()
+	s.send(ctx, result, watch.Event{Type: watch.Error, Object: &status})
+}
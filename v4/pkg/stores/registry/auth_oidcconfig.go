@@ -0,0 +1,66 @@
+package registry
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/stores"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"net/url"
+	"slices"
+)
+
+type oidcConfigValidator struct{}
+
+func NewOIDCConfigStatusStorage(scheme *runtime.Scheme, statusStrategy strategy.StatusUpdater) (rest.Storage, error) {
+	return stores.NewStatus(scheme, statusStrategy), nil
+}
+
+func NewOIDCConfigStorage(oidcConfigStrategy strategy.CompleteStrategy) (rest.Storage, error) {
+	var ocv = &oidcConfigValidator{}
+
+	return stores.NewBuilder(oidcConfigStrategy.Scheme(), &v4alpha1.OIDCConfig{}).
+		WithCompleteCRUD(oidcConfigStrategy).
+		WithValidateCreate(ocv).
+		WithValidateUpdate(ocv).Build(), nil
+}
+
+func (ocv oidcConfigValidator) ValidateUpdate(ctx context.Context, new runtime.Object, old runtime.Object) field.ErrorList {
+	return ocv.doValidate(ctx, new)
+}
+
+func (ocv oidcConfigValidator) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ocv.doValidate(ctx, obj)
+}
+
+func (ocv oidcConfigValidator) doValidate(ctx context.Context, obj runtime.Object) (result field.ErrorList) {
+	config := obj.(*v4alpha1.OIDCConfig)
+
+	if u, err := url.Parse(config.Spec.IssuerURL); err != nil || u.Scheme == "" || u.Host == "" {
+		result = append(result, field.Invalid(field.NewPath("spec", "issuerURL"),
+			config.Spec.IssuerURL, "issuerURL must be an absolute url"))
+	}
+
+	if config.Spec.ClientID == "" {
+		result = append(result, field.Invalid(field.NewPath("spec", "clientID"),
+			config.Spec.ClientID, "clientID is required"))
+	}
+
+	if u, err := url.Parse(config.Spec.RedirectURL); err != nil || u.Scheme == "" || u.Host == "" {
+		result = append(result, field.Invalid(field.NewPath("spec", "redirectURL"),
+			config.Spec.RedirectURL, "redirectURL must be an absolute url"))
+	}
+
+	if len(config.Spec.Scopes) > 0 && !slices.Contains(config.Spec.Scopes, "openid") {
+		result = append(result, field.Invalid(field.NewPath("spec", "scopes"),
+			config.Spec.Scopes, "scopes must include openid"))
+	}
+
+	if len(result) > 0 {
+		return result
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	"github.com/hobbyfarm/mink/pkg/strategy"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -24,27 +25,32 @@ func HandleConflictList(ctx context.Context, namespace string, lister strategy.L
 		return errors.NewInternalError(err)
 	}
 
-	if poml, ok := list.(*metav1.PartialObjectMetadataList); ok {
-		if len(poml.Items) == 0 {
-			return nil
-		}
+	// the kubernetes storages list metadata only, the sql storages list typed objects
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return errors.NewInternalError(fmt.Errorf("error extracting items of %s for object %s: %v",
+			list.GetObjectKind().GroupVersionKind().String(), objName, err))
+	}
+	if len(items) == 0 {
+		return nil
+	}
 
-		var out = make([]string, len(poml.Items))
-		for i, v := range poml.Items {
-			out[i] = v.Name
+	var out = make([]string, len(items))
+	for i, v := range items {
+		accessor, err := meta.Accessor(v)
+		if err != nil {
+			return errors.NewInternalError(err)
 		}
+		out[i] = accessor.GetName()
+	}
 
-		return &errors.StatusError{
-			ErrStatus: metav1.Status{
-				Status: metav1.StatusFailure,
-				Code:   http.StatusConflict,
-				Message: fmt.Sprintf("cannot delete referenced object. reference list %s contains items %v",
-					list.GetObjectKind().GroupVersionKind().String(), out),
-			},
-		}
-	} else {
-		return errors.NewInternalError(fmt.Errorf("error converting %s to PartialObjectMetadataList for object %s",
-			list.GetObjectKind().GroupVersionKind().String(), objName))
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status: metav1.StatusFailure,
+			Code:   http.StatusConflict,
+			Message: fmt.Sprintf("cannot delete referenced object. reference list %s contains items %v",
+				list.GetObjectKind().GroupVersionKind().String(), out),
+		},
 	}
 }
 
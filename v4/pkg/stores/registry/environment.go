@@ -61,9 +61,13 @@ func (ev *environmentValidator) ValidateDelete(ctx context.Context, obj runtime.
 		return err
 	}
 
-	// TODO: Make sure that we add logic to the controllers to set the complete label to true
-	environmentSelector[labels2.ScheduledEventCompleteLabel] = "True"
-	if err := HandleConflictList(ctx, env.Namespace, ev.scheduledEventLister, environmentSelector, env.Name); err != nil {
+	// the scheduledevent controller labels events with every environment they provision into,
+	// and flips the complete label to "True" once the event has ended
+	scheduledEventSelector := map[string]string{
+		labels2.ScheduledEventEnvironmentLabelPrefix + env.Name: "true",
+		labels2.ScheduledEventCompleteLabel:                     "False",
+	}
+	if err := HandleConflictList(ctx, env.Namespace, ev.scheduledEventLister, scheduledEventSelector, env.Name); err != nil {
 		return err
 	}
 
@@ -106,8 +110,8 @@ func (ev *environmentValidator) doBasicValidations(ctx context.Context, obj runt
 }
 
 func (ev *environmentValidator) getProvider(ctx context.Context, env *v4alpha1.Environment) (*v4alpha1.Provider, field.ErrorList) {
-	// Ensure the provider exists
-	provObj, err := ev.providerGetter.Get(ctx, env.Namespace, env.Spec.Provider)
+	// Ensure the provider exists, providers are shared by all tenants
+	provObj, err := ev.providerGetter.Get(ctx, "", env.Spec.Provider)
 	if err != nil {
 		return nil, field.ErrorList{
 			field.Invalid(field.NewPath("spec", "provider"), env.Spec.Provider, err.Error()),
@@ -7,7 +7,9 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 )
 
-func NewEventStorage(eventStrategy strategy.CompleteStrategy) (rest.Storage, error) {
+// NewEventStorage returns the storage of events. Users that may read events by rolebindings of tenants only
+// read the events of objects in those tenants.
+func NewEventStorage(eventStrategy strategy.CompleteStrategy, authorizer TenantAuthorizer) (rest.Storage, error) {
 	return stores.NewBuilder(eventStrategy.Scheme(), &v4alpha1.Event{}).
-		WithCompleteCRUD(eventStrategy).Build(), nil
+		WithCompleteCRUD(newTenantFilter("events", eventStrategy, authorizer, nil, eventTenants)).Build(), nil
 }
@@ -12,11 +12,14 @@ import (
 
 type groupValidator struct{}
 
-func NewGroupStorage(groupStrategy strategy.CompleteStrategy) (rest.Storage, error) {
+// NewGroupStorage returns the storage of groups. Users that may read groups by rolebindings of tenants only
+// read the groups that rolebindings of those tenants bind.
+func NewGroupStorage(groupStrategy strategy.CompleteStrategy, roleBindingLister strategy.Lister,
+	authorizer TenantAuthorizer) (rest.Storage, error) {
 	var gv = &groupValidator{}
 
 	return stores.NewBuilder(groupStrategy.Scheme(), &v4alpha1.Group{}).
-		WithCompleteCRUD(groupStrategy).
+		WithCompleteCRUD(newTenantFilter("groups", groupStrategy, authorizer, roleBindingLister, groupTenants)).
 		WithValidateCreate(gv).
 		WithValidateUpdate(gv).Build(), nil
 }
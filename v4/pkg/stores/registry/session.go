@@ -1,13 +1,41 @@
 package registry
 
 import (
+	"context"
+	"fmt"
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	"github.com/hobbyfarm/mink/pkg/stores"
 	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"net/http"
+	"time"
 )
 
+// SessionAction is an action that a user can take on their session through a subresource,
+// e.g. POST /sessions/<name>/keepalive
+type SessionAction string
+
+const (
+	SessionActionKeepalive SessionAction = "keepalive"
+	SessionActionPause     SessionAction = "pause"
+	SessionActionResume    SessionAction = "resume"
+)
+
+var _ rest.Connecter = (*sessionActionStorage)(nil)
+
+type sessionActionStorage struct {
+	sessionStrategy strategy.CompleteStrategy
+	courseGetter    strategy.Getter
+	scenarioGetter  strategy.Getter
+	authorizer      authorizer.Authorizer
+	action          SessionAction
+}
+
 func NewSessionStatusStorage(scheme *runtime.Scheme, storage strategy.StatusUpdater) rest.Storage {
 	return stores.NewStatus(scheme, storage)
 }
@@ -16,3 +44,237 @@ func NewSessionStorage(sessionStrategy strategy.CompleteStrategy) (rest.Storage,
 	return stores.NewBuilder(sessionStrategy.Scheme(), &v4alpha1.Session{}).
 		WithCompleteCRUD(sessionStrategy).Build(), nil
 }
+
+// NewSessionActionStorage returns the storage for a subresource of sessions that applies the given action
+// when POSTed to. Keepalive and pause durations are looked up from the course and scenario of the session.
+// Users may apply the action to their own sessions, the sessions of other users require the permission
+// to update the status of sessions.
+func NewSessionActionStorage(
+	sessionStrategy strategy.CompleteStrategy,
+	courseGetter strategy.Getter,
+	scenarioGetter strategy.Getter,
+	authorizer authorizer.Authorizer,
+	action SessionAction,
+) rest.Storage {
+	return &sessionActionStorage{
+		sessionStrategy: sessionStrategy,
+		courseGetter:    courseGetter,
+		scenarioGetter:  scenarioGetter,
+		authorizer:      authorizer,
+		action:          action,
+	}
+}
+
+func (s *sessionActionStorage) New() runtime.Object {
+	return &v4alpha1.Session{}
+}
+
+func (s *sessionActionStorage) Destroy() {}
+
+func (s *sessionActionStorage) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+func (s *sessionActionStorage) ConnectMethods() []string {
+	return []string{http.MethodPost}
+}
+
+func (s *sessionActionStorage) Connect(ctx context.Context, id string, _ runtime.Object, r rest.Responder) (http.Handler, error) {
+	return http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		session, err := s.apply(ctx, id)
+		if err != nil {
+			r.Error(err)
+			return
+		}
+
+		r.Object(http.StatusOK, session)
+	}), nil
+}
+
+func (s *sessionActionStorage) apply(ctx context.Context, name string) (runtime.Object, error) {
+	// sessions belong to a tenant, the namespace of the request
+	obj, err := s.sessionStrategy.Get(ctx, request.NamespaceValue(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+
+	session := obj.(*v4alpha1.Session).DeepCopy()
+
+	if err := s.authorizeSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	course, scenario, err := s.getCourseAndScenario(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed bool
+	switch s.action {
+	case SessionActionKeepalive:
+		changed, err = KeepaliveSession(session, course, scenario, time.Now())
+	case SessionActionPause:
+		changed, err = PauseSession(session, course, scenario, time.Now())
+	case SessionActionResume:
+		changed, err = ResumeSession(session, course, scenario, time.Now())
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unknown session action %s", s.action))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !changed {
+		return session, nil
+	}
+
+	updated, err := s.sessionStrategy.UpdateStatus(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// authorizeSession checks whether the user of the request may apply the action to the session. The subresource
+// only requires permission to create sessions/<action>, which every user has for their own sessions. Other users'
+// sessions additionally require the permission to update sessions/status.
+func (s *sessionActionStorage) authorizeSession(ctx context.Context, session *v4alpha1.Session) error {
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return errors.NewUnauthorized("no user found in request")
+	}
+
+	if u.GetName() == session.Spec.User {
+		return nil
+	}
+
+	decision, _, err := s.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            u,
+		Verb:            "update",
+		Namespace:       session.Namespace,
+		APIGroup:        v4alpha1.SchemeGroupVersion.Group,
+		APIVersion:      v4alpha1.SchemeGroupVersion.Version,
+		Resource:        "sessions",
+		Subresource:     "status",
+		Name:            session.Name,
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	if decision != authorizer.DecisionAllow {
+		return errors.NewForbidden(v4alpha1.SchemeGroupVersion.WithResource("sessions").GroupResource(), session.Name,
+			fmt.Errorf("session belongs to another user"))
+	}
+
+	return nil
+}
+
+func (s *sessionActionStorage) getCourseAndScenario(ctx context.Context, session *v4alpha1.Session) (*v4alpha1.Course, *v4alpha1.Scenario, error) {
+	var course *v4alpha1.Course
+	var scenario *v4alpha1.Scenario
+
+	if session.Spec.Course != "" {
+		obj, err := s.courseGetter.Get(ctx, session.Namespace, session.Spec.Course)
+		if err != nil {
+			return nil, nil, errors.NewInternalError(fmt.Errorf("error retrieving course %s: %s", session.Spec.Course, err.Error()))
+		}
+		course = obj.(*v4alpha1.Course)
+	}
+
+	if session.Spec.Scenario != "" {
+		obj, err := s.scenarioGetter.Get(ctx, session.Namespace, session.Spec.Scenario)
+		if err != nil {
+			return nil, nil, errors.NewInternalError(fmt.Errorf("error retrieving scenario %s: %s", session.Spec.Scenario, err.Error()))
+		}
+		scenario = obj.(*v4alpha1.Scenario)
+	}
+
+	return course, scenario, nil
+}
+
+// KeepaliveSession extends the expiration time of a session by its keepalive duration.
+// Paused and finished sessions cannot be kept alive.
+// Returns true if the status of the session was changed.
+func KeepaliveSession(session *v4alpha1.Session, course *v4alpha1.Course, scenario *v4alpha1.Scenario, now time.Time) (bool, error) {
+	if session.Status.Finished {
+		return false, sessionConflict(session, "session has finished")
+	}
+
+	if session.Status.Paused {
+		return false, sessionConflict(session, "session is paused")
+	}
+
+	keepalive, err := v4alpha1.SessionKeepaliveDuration(course, scenario)
+	if err != nil {
+		return false, errors.NewInternalError(err)
+	}
+
+	session.Status.ExpirationTime = metav1.NewTime(now.Add(keepalive))
+
+	return true, nil
+}
+
+// PauseSession pauses a session for its pause duration, if the course or scenario of the
+// session allows pausing. Pausing an already paused session does not extend the pause.
+// Returns true if the status of the session was changed.
+func PauseSession(session *v4alpha1.Session, course *v4alpha1.Course, scenario *v4alpha1.Scenario, now time.Time) (bool, error) {
+	if session.Status.Finished {
+		return false, sessionConflict(session, "session has finished")
+	}
+
+	if v4alpha1.SessionPauseBehavior(course, scenario) != v4alpha1.CanPause {
+		return false, errors.NewBadRequest(fmt.Sprintf("session %s cannot be paused", session.Name))
+	}
+
+	if session.Status.Paused {
+		return false, nil
+	}
+
+	pause, err := v4alpha1.SessionPauseDuration(course, scenario)
+	if err != nil {
+		return false, errors.NewInternalError(err)
+	}
+
+	session.Status.Paused = true
+	session.Status.PauseExpirationTime = metav1.NewTime(now.Add(pause))
+
+	return true, nil
+}
+
+// ResumeSession resumes a paused session and resets its expiration time using the keepalive duration.
+// Returns true if the status of the session was changed.
+func ResumeSession(session *v4alpha1.Session, course *v4alpha1.Course, scenario *v4alpha1.Scenario, now time.Time) (bool, error) {
+	if session.Status.Finished {
+		return false, sessionConflict(session, "session has finished")
+	}
+
+	if !session.Status.Paused {
+		return false, nil
+	}
+
+	keepalive, err := v4alpha1.SessionKeepaliveDuration(course, scenario)
+	if err != nil {
+		return false, errors.NewInternalError(err)
+	}
+
+	session.Status.Paused = false
+	session.Status.PauseExpirationTime = metav1.Time{}
+	session.Status.ExpirationTime = metav1.NewTime(now.Add(keepalive))
+
+	return true, nil
+}
+
+func sessionConflict(session *v4alpha1.Session, message string) *errors.StatusError {
+	return &errors.StatusError{
+		ErrStatus: metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusConflict,
+			Reason:  metav1.StatusReasonConflict,
+			Message: fmt.Sprintf("session %s: %s", session.Name, message),
+		},
+	}
+}
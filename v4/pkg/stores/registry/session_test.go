@@ -0,0 +1,59 @@
+package registry
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"testing"
+)
+
+func Test_authorizeSession(t *testing.T) {
+	session := &v4alpha1.Session{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "tenant-a", Name: "s-test"},
+		Spec:       v4alpha1.SessionSpec{User: "u-owner"},
+	}
+
+	// admins may update the status of sessions
+	var checked []authorizer.Attributes
+	az := authorizer.AuthorizerFunc(func(_ context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		checked = append(checked, a)
+		if a.GetUser().GetName() == "u-admin" && a.GetVerb() == "update" && a.GetResource() == "sessions" &&
+			a.GetSubresource() == "status" && a.GetNamespace() == session.Namespace {
+			return authorizer.DecisionAllow, "", nil
+		}
+		return authorizer.DecisionDeny, "denied", nil
+	})
+	s := &sessionActionStorage{authorizer: az, action: SessionActionKeepalive}
+
+	tests := []struct {
+		name    string
+		user    string
+		check   func(error) bool
+		checked int
+	}{
+		{"owner", "u-owner", func(err error) bool { return err == nil }, 0},
+		{"other user", "u-other", errors.IsForbidden, 1},
+		{"admin", "u-admin", func(err error) bool { return err == nil }, 1},
+		{"anonymous", "", errors.IsUnauthorized, 0},
+	}
+
+	for _, tt := range tests {
+		checked = nil
+		ctx := context.Background()
+		if tt.user != "" {
+			ctx = request.WithUser(ctx, &user.DefaultInfo{Name: tt.user})
+		}
+
+		err := s.authorizeSession(ctx, session)
+		if !tt.check(err) {
+			t.Errorf("%s: unexpected result %v", tt.name, err)
+		}
+		if len(checked) != tt.checked {
+			t.Errorf("%s: expected %d authorization checks, got %d", tt.name, tt.checked, len(checked))
+		}
+	}
+}
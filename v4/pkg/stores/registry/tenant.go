@@ -0,0 +1,58 @@
+package registry
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/stores"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+type tenantValidator struct{}
+
+func NewTenantStorage(tenantStrategy strategy.CompleteStrategy) (rest.Storage, error) {
+	var tv = &tenantValidator{}
+
+	return stores.NewBuilder(tenantStrategy.Scheme(), &v4alpha1.Tenant{}).
+		WithCompleteCRUD(tenantStrategy).
+		WithValidateCreate(tv).
+		WithValidateUpdate(tv).Build(), nil
+}
+
+func (tv tenantValidator) ValidateUpdate(ctx context.Context, new runtime.Object, old runtime.Object) (result field.ErrorList) {
+	result = tv.doValidate(ctx, new)
+
+	// the resources of the tenant would be left behind in the old storage namespace
+	newTenant, oldTenant := new.(*v4alpha1.Tenant), old.(*v4alpha1.Tenant)
+	if newTenant.Spec.StorageNamespace != oldTenant.Spec.StorageNamespace {
+		result = append(result, field.Forbidden(field.NewPath("spec", "storageNamespace"),
+			"storageNamespace cannot be changed"))
+	}
+
+	return
+}
+
+func (tv tenantValidator) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return tv.doValidate(ctx, obj)
+}
+
+func (tv tenantValidator) doValidate(_ context.Context, obj runtime.Object) (result field.ErrorList) {
+	tenant := obj.(*v4alpha1.Tenant)
+
+	// the name of a tenant is the namespace of its resources
+	for _, msg := range validation.IsDNS1123Label(tenant.Name) {
+		result = append(result, field.Invalid(field.NewPath("metadata", "name"), tenant.Name, msg))
+	}
+
+	if tenant.Spec.StorageNamespace != "" {
+		for _, msg := range validation.IsDNS1123Label(tenant.Spec.StorageNamespace) {
+			result = append(result, field.Invalid(field.NewPath("spec", "storageNamespace"),
+				tenant.Spec.StorageNamespace, msg))
+		}
+	}
+
+	return
+}
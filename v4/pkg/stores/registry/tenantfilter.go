@@ -0,0 +1,228 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/hobbyfarm/mink/pkg/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage"
+)
+
+// TenantAuthorizer returns whether a cluster-scoped request is allowed in all tenants, or else the tenants
+// in which it is allowed. It is implemented by the authorizers of the authorization package.
+type TenantAuthorizer interface {
+	AllowedTenants(ctx context.Context, a authorizer.Attributes) (bool, sets.Set[string], error)
+}
+
+// tenantsOf returns the tenants to which an object belongs, given the rolebindings of all tenants.
+type tenantsOf func(obj runtime.Object, bindings []v4alpha1.RoleBinding) sets.Set[string]
+
+// tenantFilter serves a cluster-scoped resource whose objects belong to tenants. Users that may read the
+// resource by rolebindings of tenants only get, list and watch the objects of those tenants, users that
+// may read it by a rolebinding without tenant get all objects. Memberships of a watch are looked up once,
+// when the watch is started.
+type tenantFilter struct {
+	strategy.CompleteStrategy
+
+	resource      schema.GroupResource
+	authorizer    TenantAuthorizer
+	bindingLister strategy.Lister
+	tenantsOf     tenantsOf
+}
+
+func newTenantFilter(resource string, s strategy.CompleteStrategy, az TenantAuthorizer,
+	bindingLister strategy.Lister, tenantsOf tenantsOf) *tenantFilter {
+	return &tenantFilter{
+		CompleteStrategy: s,
+		resource:         schema.GroupResource{Group: v4alpha1.APIGroup, Resource: resource},
+		authorizer:       az,
+		bindingLister:    bindingLister,
+		tenantsOf:        tenantsOf,
+	}
+}
+
+// readable returns whether the requesting user may read an object. The request is authorized as the
+// authorizer saw it, so that e.g. the object of an update is only found by users that may update it.
+func (f tenantFilter) readable(ctx context.Context, verb string) (func(obj runtime.Object) bool, error) {
+	u, ok := request.UserFrom(ctx)
+	if !ok {
+		return nil, errors.NewUnauthorized("no user in request")
+	}
+
+	attrs := authorizer.AttributesRecord{
+		User:            u,
+		Verb:            verb,
+		APIGroup:        f.resource.Group,
+		Resource:        f.resource.Resource,
+		ResourceRequest: true,
+	}
+	if info, ok := request.RequestInfoFrom(ctx); ok && info.IsResourceRequest {
+		attrs.Verb, attrs.Subresource, attrs.Name = info.Verb, info.Subresource, info.Name
+	}
+
+	all, tenants, err := f.authorizer.AllowedTenants(ctx, attrs)
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("error looking up tenants of user %s: %v", u.GetName(), err))
+	}
+	if all {
+		return func(runtime.Object) bool { return true }, nil
+	}
+	if tenants.Len() == 0 {
+		return func(runtime.Object) bool { return false }, nil
+	}
+
+	var bindings []v4alpha1.RoleBinding
+	if f.bindingLister != nil {
+		list, err := f.bindingLister.List(ctx, "", storage.ListOptions{})
+		if err != nil {
+			return nil, errors.NewInternalError(fmt.Errorf("error listing rolebindings: %v", err))
+		}
+		bindings = list.(*v4alpha1.RoleBindingList).Items
+	}
+
+	return func(obj runtime.Object) bool {
+		return f.tenantsOf(obj, bindings).Intersection(tenants).Len() > 0
+	}, nil
+}
+
+func (f tenantFilter) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	readable, err := f.readable(ctx, "get")
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := f.CompleteStrategy.Get(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// objects of other tenants do not exist for the user
+	if !readable(obj) {
+		return nil, errors.NewNotFound(f.resource, name)
+	}
+
+	return obj, nil
+}
+
+func (f tenantFilter) List(ctx context.Context, namespace string, opts storage.ListOptions) (types.ObjectList, error) {
+	readable, err := f.readable(ctx, "list")
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := f.CompleteStrategy.List(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		if readable(item) {
+			out = append(out, item)
+		}
+	}
+
+	if err := meta.SetList(list, out); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (f tenantFilter) Watch(ctx context.Context, namespace string, opts storage.ListOptions) (<-chan watch.Event, error) {
+	readable, err := f.readable(ctx, "watch")
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := f.CompleteStrategy.Watch(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan watch.Event)
+	go func() {
+		defer close(out)
+
+		for e := range events {
+			if e.Type != watch.Error && e.Type != watch.Bookmark && !readable(e.Object) {
+				continue
+			}
+
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				// drain the watch until it is closed with the context
+				for range events {
+				}
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// userTenants returns the tenants whose rolebindings bind the user or one of its groups.
+func userTenants(obj runtime.Object, bindings []v4alpha1.RoleBinding) sets.Set[string] {
+	tenants := sets.New[string]()
+
+	u, ok := obj.(*v4alpha1.User)
+	if !ok {
+		return tenants
+	}
+
+	groups := sets.New(u.Status.GroupMemberships...)
+	for _, rb := range bindings {
+		if rb.Tenant != "" && (sets.New(rb.Users...).Has(u.Name) || groups.HasAny(rb.Groups...)) {
+			tenants.Insert(rb.Tenant)
+		}
+	}
+
+	return tenants
+}
+
+// groupTenants returns the tenants whose rolebindings bind the group.
+func groupTenants(obj runtime.Object, bindings []v4alpha1.RoleBinding) sets.Set[string] {
+	tenants := sets.New[string]()
+
+	g, ok := obj.(*v4alpha1.Group)
+	if !ok {
+		return tenants
+	}
+
+	for _, rb := range bindings {
+		if rb.Tenant != "" && sets.New(rb.Groups...).Has(g.Name) {
+			tenants.Insert(rb.Tenant)
+		}
+	}
+
+	return tenants
+}
+
+// eventTenants returns the tenant of the object the event refers to. Events of cluster-scoped objects
+// belong to no tenant.
+func eventTenants(obj runtime.Object, _ []v4alpha1.RoleBinding) sets.Set[string] {
+	tenants := sets.New[string]()
+
+	e, ok := obj.(*v4alpha1.Event)
+	if ok && e.ObjectReference.Namespace != "" {
+		tenants.Insert(e.ObjectReference.Namespace)
+	}
+
+	return tenants
+}
@@ -0,0 +1,244 @@
+package registry
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/memory"
+	"github.com/hobbyfarm/mink/pkg/types"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeTenantAuthorizer allows reads of the user admin in all tenants, and reads of other users in their tenants.
+type fakeTenantAuthorizer map[string][]string
+
+func (f fakeTenantAuthorizer) AllowedTenants(_ context.Context, a authorizer.Attributes) (bool, sets.Set[string], error) {
+	if a.GetVerb() != "get" && a.GetVerb() != "list" && a.GetVerb() != "watch" {
+		return false, sets.New[string](), nil
+	}
+
+	return a.GetUser().GetName() == "admin", sets.New(f[a.GetUser().GetName()]...), nil
+}
+
+func newMemoryStrategy(t *testing.T, obj types.Object, resource string) *memory.Strategy {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := memory.NewStrategy(obj, memory.NewStore(scheme), resource)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func listNames(t *testing.T, list runtime.Object) []string {
+	t.Helper()
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var names []string
+	for _, item := range items {
+		obj, _ := meta.Accessor(item)
+		names = append(names, obj.GetName())
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func equalNames(got []string, want ...string) bool {
+	return sets.New(got...).Equal(sets.New(want...)) && len(got) == len(want)
+}
+
+func Test_tenantFilterUsers(t *testing.T) {
+	ctx := context.Background()
+
+	users := newMemoryStrategy(t, &v4alpha1.User{}, "users")
+	bindings := newMemoryStrategy(t, &v4alpha1.RoleBinding{}, "rolebindings")
+
+	for _, u := range []*v4alpha1.User{
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-a-staff"}, Status: v4alpha1.UserStatus{GroupMemberships: []string{"a-staff"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "u-none"}},
+	} {
+		if _, err := users.Create(ctx, u); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, rb := range []*v4alpha1.RoleBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a-users"}, Users: []string{"u-a"}, Tenant: "a"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a-staff"}, Groups: []string{"a-staff"}, Tenant: "a"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b-users"}, Users: []string{"u-b"}, Tenant: "b"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "admins"}, Users: []string{"u-none"}},
+	} {
+		if _, err := bindings.Create(ctx, rb); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f := newTenantFilter("users", users, fakeTenantAuthorizer{"a-admin": {"a"}, "ab-admin": {"a", "b"}},
+		bindings, userTenants)
+
+	tests := []struct {
+		name  string
+		user  string
+		users []string
+	}{
+		{"tenant a", "a-admin", []string{"u-a", "u-a-staff"}},
+		{"tenants a and b", "ab-admin", []string{"u-a", "u-a-staff", "u-b"}},
+		{"all tenants", "admin", []string{"u-a", "u-a-staff", "u-b", "u-none"}},
+		{"no tenant", "player", nil},
+	}
+
+	for _, tt := range tests {
+		userCtx := request.WithUser(ctx, &user.DefaultInfo{Name: tt.user})
+
+		list, err := f.List(userCtx, "", storage.ListOptions{})
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if names := listNames(t, list); !equalNames(names, tt.users...) {
+			t.Errorf("%s: expected users %v, got %v", tt.name, tt.users, names)
+		}
+
+		for _, name := range []string{"u-a", "u-b", "u-none"} {
+			_, err := f.Get(userCtx, "", name)
+			visible := sets.New(tt.users...).Has(name)
+			if visible && err != nil {
+				t.Errorf("%s: expected user %s to be found, got %v", tt.name, name, err)
+			}
+			if !visible && !errors.IsNotFound(err) {
+				t.Errorf("%s: expected user %s to not be found, got %v", tt.name, name, err)
+			}
+		}
+	}
+
+	// the object of an update is only found by users that may update it
+	updateCtx := request.WithRequestInfo(request.WithUser(ctx, &user.DefaultInfo{Name: "a-admin"}),
+		&request.RequestInfo{IsResourceRequest: true, Verb: "update", Resource: "users", Name: "u-a"})
+	if _, err := f.Get(updateCtx, "", "u-a"); !errors.IsNotFound(err) {
+		t.Errorf("expected user to not be found for an update, got %v", err)
+	}
+
+	if _, err := f.List(ctx, "", storage.ListOptions{}); !errors.IsUnauthorized(err) {
+		t.Errorf("expected request without user to be unauthorized, got %v", err)
+	}
+}
+
+func Test_tenantFilterGroups(t *testing.T) {
+	ctx := context.Background()
+
+	groups := newMemoryStrategy(t, &v4alpha1.Group{}, "groups")
+	bindings := newMemoryStrategy(t, &v4alpha1.RoleBinding{}, "rolebindings")
+
+	for _, name := range []string{"a-staff", "b-staff"} {
+		if _, err := groups.Create(ctx, &v4alpha1.Group{ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v4alpha1.GroupSpec{DisplayName: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, rb := range []*v4alpha1.RoleBinding{
+		{ObjectMeta: metav1.ObjectMeta{Name: "a-staff"}, Groups: []string{"a-staff"}, Tenant: "a"},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b-staff"}, Groups: []string{"b-staff"}, Tenant: "b"},
+	} {
+		if _, err := bindings.Create(ctx, rb); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f := newTenantFilter("groups", groups, fakeTenantAuthorizer{"a-admin": {"a"}}, bindings, groupTenants)
+	userCtx := request.WithUser(ctx, &user.DefaultInfo{Name: "a-admin"})
+
+	list, err := f.List(userCtx, "", storage.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := listNames(t, list); !equalNames(names, "a-staff") {
+		t.Errorf("expected groups of tenant a, got %v", names)
+	}
+	if _, err := f.Get(userCtx, "", "b-staff"); !errors.IsNotFound(err) {
+		t.Errorf("expected group of tenant b to not be found, got %v", err)
+	}
+}
+
+func Test_tenantFilterEvents(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := newMemoryStrategy(t, &v4alpha1.Event{}, "events")
+	f := newTenantFilter("events", events, fakeTenantAuthorizer{"a-admin": {"a"}}, nil, eventTenants)
+	userCtx := request.WithUser(ctx, &user.DefaultInfo{Name: "a-admin"})
+
+	event := func(name, namespace string) *v4alpha1.Event {
+		return &v4alpha1.Event{ObjectMeta: metav1.ObjectMeta{Name: name},
+			ObjectReference: v4alpha1.ObjectReference{Kind: "Machine", Namespace: namespace, Name: "m-" + name}}
+	}
+
+	if _, err := events.Create(ctx, event("e-a", "a")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := events.Create(ctx, event("e-b", "b")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := events.Create(ctx, event("e-cluster", "")); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := f.List(userCtx, "", storage.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := listNames(t, list); !equalNames(names, "e-a") {
+		t.Errorf("expected events of objects in tenant a, got %v", names)
+	}
+
+	w, err := f.Watch(userCtx, "", storage.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := events.Create(ctx, event("e-b2", "b")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := events.Create(ctx, event("e-a2", "a")); err != nil {
+		t.Fatal(err)
+	}
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-w:
+			if e.Type != watch.Added {
+				continue
+			}
+			obj, _ := meta.Accessor(e.Object)
+			if obj.GetName() == "e-a2" {
+				return
+			}
+			if obj.GetName() != "e-a" {
+				t.Fatalf("expected only events of tenant a to be watched, got %s", obj.GetName())
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for event of tenant a")
+		}
+	}
+}
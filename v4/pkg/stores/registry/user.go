@@ -17,12 +17,15 @@ func NewUserStatusStorage(scheme *runtime.Scheme, storage strategy.StatusUpdater
 	return stores.NewStatus(scheme, storage)
 }
 
-func NewUserStorage(userStrategy strategy.CompleteStrategy) (rest.Storage, error) {
+// NewUserStorage returns the storage of users. Users that may read users by rolebindings of tenants only
+// read the users that rolebindings of those tenants bind, by name or by group.
+func NewUserStorage(userStrategy strategy.CompleteStrategy, roleBindingLister strategy.Lister,
+	authorizer TenantAuthorizer) (rest.Storage, error) {
 	var uv = userValidator{}
 
 	return stores.NewBuilder(userStrategy.Scheme(), &v4alpha1.User{}).
 		WithValidateUpdate(uv).
-		WithCompleteCRUD(userStrategy).Build(), nil
+		WithCompleteCRUD(newTenantFilter("users", userStrategy, authorizer, roleBindingLister, userTenants)).Build(), nil
 }
 
 func (uv userValidator) ValidateUpdate(ctx context.Context, obj runtime.Object, _ runtime.Object) (result field.ErrorList) {
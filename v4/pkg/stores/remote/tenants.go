@@ -0,0 +1,115 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage"
+	"sync"
+	"time"
+)
+
+const (
+	// refreshInterval is the time after which known tenants are listed again
+	refreshInterval = 30 * time.Second
+
+	// missRefreshInterval is the time after which tenants are listed again to look up an unknown tenant
+	missRefreshInterval = time.Second
+)
+
+var tenantResource = schema.GroupResource{Group: v4alpha1.APIGroup, Resource: "tenants"}
+
+// TenantMapper maps tenants to the namespaces of the backing cluster in which their resources are stored,
+// and those namespaces back to the tenants. Tenants are stored in the namespace of HobbyFarm, their resources
+// are stored in the StorageNamespace of the tenant or in <namespace>-<tenant>.
+type TenantMapper struct {
+	tenants   strategy.Lister
+	namespace string
+
+	lock        sync.Mutex
+	byTenant    map[string]string
+	byNamespace map[string]string
+	refreshed   time.Time
+}
+
+func NewTenantMapper(tenants strategy.Lister, namespace string) *TenantMapper {
+	return &TenantMapper{
+		tenants:     tenants,
+		namespace:   namespace,
+		byTenant:    map[string]string{},
+		byNamespace: map[string]string{},
+	}
+}
+
+// StorageNamespace returns the namespace in which the resources of a tenant are stored. It returns a
+// NotFound error if the tenant does not exist.
+func (m *TenantMapper) StorageNamespace(ctx context.Context, tenant string) (string, error) {
+	namespace, ok, err := m.lookup(ctx, m.byTenant, tenant)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", errors.NewNotFound(tenantResource, tenant)
+	}
+
+	return namespace, nil
+}
+
+// Tenant returns the tenant whose resources are stored in the namespace, if there is one.
+func (m *TenantMapper) Tenant(ctx context.Context, namespace string) (string, bool, error) {
+	return m.lookup(ctx, m.byNamespace, namespace)
+}
+
+// lookup returns the value of a key of one of the maps, which are refreshed if they are outdated or if the
+// key is missing.
+func (m *TenantMapper) lookup(ctx context.Context, index map[string]string, key string) (string, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	value, ok := index[key]
+	if ok && time.Since(m.refreshed) < refreshInterval {
+		return value, true, nil
+	}
+	if !ok && time.Since(m.refreshed) < missRefreshInterval {
+		return "", false, nil
+	}
+
+	if err := m.refresh(ctx); err != nil {
+		return "", false, err
+	}
+
+	value, ok = index[key]
+	return value, ok, nil
+}
+
+// refresh lists the tenants and fills the maps with them. The caller must hold the lock.
+func (m *TenantMapper) refresh(ctx context.Context) error {
+	list, err := m.tenants.List(ctx, "", storage.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing tenants: %v", err)
+	}
+
+	tenants, ok := list.(*v4alpha1.TenantList)
+	if !ok {
+		return fmt.Errorf("unexpected list of tenants %T", list)
+	}
+
+	// the maps are cleared rather than replaced, lookup holds on to one of them
+	clear(m.byTenant)
+	clear(m.byNamespace)
+	for _, tenant := range tenants.Items {
+		namespace := tenant.Spec.StorageNamespace
+		if namespace == "" {
+			namespace = m.namespace + "-" + tenant.Name
+		}
+
+		m.byTenant[tenant.Name] = namespace
+		m.byNamespace[namespace] = tenant.Name
+	}
+	m.refreshed = time.Now()
+
+	return nil
+}
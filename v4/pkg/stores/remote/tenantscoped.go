@@ -0,0 +1,226 @@
+package remote
+
+import (
+	"context"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/hobbyfarm/mink/pkg/strategy/remote"
+	"github.com/hobbyfarm/mink/pkg/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ strategy.CompleteStrategy = (*TenantScopedRemote)(nil)
+
+// TenantScopedRemote stores the resources of each tenant in the namespace that the TenantMapper maps the
+// tenant to. Objects are served with the name of their tenant as namespace. Listing and watching across all
+// namespaces only returns the objects of tenants, objects in other namespaces of the remote are skipped.
+type TenantScopedRemote struct {
+	root    strategy.CompleteStrategy
+	tenants *TenantMapper
+}
+
+func NewTenantScopedRemote(obj types.Object, client client.WithWatch, tenants *TenantMapper) *TenantScopedRemote {
+	return &TenantScopedRemote{
+		root:    remote.NewRemote(obj, client),
+		tenants: tenants,
+	}
+}
+
+// toStorage moves the object to the storage namespace of its tenant and returns the tenant.
+func (t TenantScopedRemote) toStorage(ctx context.Context, obj types.Object) (string, error) {
+	tenant := obj.GetNamespace()
+
+	namespace, err := t.tenants.StorageNamespace(ctx, tenant)
+	if err != nil {
+		return "", err
+	}
+	obj.SetNamespace(namespace)
+
+	return tenant, nil
+}
+
+func toPublic(obj types.Object, tenant string) types.Object {
+	obj.SetNamespace(tenant)
+
+	return obj
+}
+
+func (t TenantScopedRemote) Create(ctx context.Context, object types.Object) (types.Object, error) {
+	tenant, err := t.toStorage(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := t.root.Create(ctx, object)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPublic(created, tenant), nil
+}
+
+func (t TenantScopedRemote) New() types.Object {
+	return t.root.New()
+}
+
+func (t TenantScopedRemote) Get(ctx context.Context, tenant, name string) (types.Object, error) {
+	namespace, err := t.tenants.StorageNamespace(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := t.root.Get(ctx, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPublic(obj, tenant), nil
+}
+
+func (t TenantScopedRemote) Update(ctx context.Context, obj types.Object) (types.Object, error) {
+	tenant, err := t.toStorage(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := t.root.Update(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPublic(updated, tenant), nil
+}
+
+func (t TenantScopedRemote) UpdateStatus(ctx context.Context, obj types.Object) (types.Object, error) {
+	tenant, err := t.toStorage(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := t.root.UpdateStatus(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPublic(updated, tenant), nil
+}
+
+func (t TenantScopedRemote) List(ctx context.Context, tenant string, opts storage.ListOptions) (types.ObjectList, error) {
+	var namespace string
+	if tenant != "" {
+		var err error
+		if namespace, err = t.tenants.StorageNamespace(ctx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	list, err := t.root.List(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]runtime.Object, 0, len(items))
+	for _, item := range items {
+		obj, err := meta.Accessor(item)
+		if err != nil {
+			return nil, err
+		}
+
+		itemTenant, ok, err := t.tenants.Tenant(ctx, obj.GetNamespace())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+
+		obj.SetNamespace(itemTenant)
+		out = append(out, item)
+	}
+
+	if err := meta.SetList(list, out); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (t TenantScopedRemote) NewList() types.ObjectList {
+	return t.root.NewList()
+}
+
+func (t TenantScopedRemote) Delete(ctx context.Context, obj types.Object) (types.Object, error) {
+	tenant, err := t.toStorage(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted, err := t.root.Delete(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPublic(deleted, tenant), nil
+}
+
+func (t TenantScopedRemote) Watch(ctx context.Context, tenant string, opts storage.ListOptions) (<-chan watch.Event, error) {
+	var namespace string
+	if tenant != "" {
+		var err error
+		if namespace, err = t.tenants.StorageNamespace(ctx, tenant); err != nil {
+			return nil, err
+		}
+	}
+
+	events, err := t.root.Watch(ctx, namespace, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(chan watch.Event)
+	go func() {
+		defer close(out)
+
+		for e := range events {
+			if e.Type != watch.Error && e.Type != watch.Bookmark {
+				obj, err := meta.Accessor(e.Object)
+				if err != nil {
+					continue
+				}
+
+				itemTenant, ok, err := t.tenants.Tenant(ctx, obj.GetNamespace())
+				if err != nil || !ok {
+					continue
+				}
+				obj.SetNamespace(itemTenant)
+			}
+
+			select {
+			case out <- e:
+			case <-ctx.Done():
+				// drain the remote watch until it is closed with the context
+				for range events {
+				}
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+func (t TenantScopedRemote) Destroy() {
+	t.root.Destroy()
+}
+
+func (t TenantScopedRemote) Scheme() *runtime.Scheme {
+	return t.root.Scheme()
+}
@@ -0,0 +1,127 @@
+package sql
+
+import (
+	gosql "database/sql"
+	"fmt"
+	_ "github.com/jackc/pgx/v5/stdlib" // driver of the postgres dialect
+	_ "modernc.org/sqlite"             // driver of the sqlite dialect
+	"strconv"
+	"strings"
+)
+
+// Dialect holds the differences between the supported databases. Statements are written
+// with ? placeholders and rebound by the dialect.
+type Dialect struct {
+	// Name is the name users select the dialect with
+	Name string
+
+	// DriverName is the name of the database/sql driver
+	DriverName string
+
+	// serialID is the column definition of an auto incrementing primary key
+	serialID string
+
+	// lockWrites is executed at the start of every write transaction. Resource versions are
+	// taken from an auto incrementing key, so writes must commit in the order of their keys
+	// for watches to not miss any event.
+	lockWrites string
+
+	// numberedPlaceholders rebinds ? placeholders to $1, $2, ...
+	numberedPlaceholders bool
+
+	// maxOpenConns limits the connections of the pool, 0 for no limit
+	maxOpenConns int
+}
+
+var (
+	Postgres = Dialect{
+		Name:                 "postgres",
+		DriverName:           "pgx",
+		serialID:             "BIGSERIAL PRIMARY KEY",
+		lockWrites:           "SELECT pg_advisory_xact_lock(4242424242)",
+		numberedPlaceholders: true,
+	}
+
+	// SQLite serializes writes on its own. A single connection avoids busy errors between
+	// the connections of the pool.
+	SQLite = Dialect{
+		Name:         "sqlite",
+		DriverName:   "sqlite",
+		serialID:     "INTEGER PRIMARY KEY AUTOINCREMENT",
+		maxOpenConns: 1,
+	}
+)
+
+// DialectFor returns the dialect with the given name.
+func DialectFor(name string) (Dialect, error) {
+	for _, d := range []Dialect{Postgres, SQLite} {
+		if d.Name == name {
+			return d, nil
+		}
+	}
+
+	return Dialect{}, fmt.Errorf("unsupported sql dialect %s, supported are postgres and sqlite", name)
+}
+
+// Rebind replaces the ? placeholders of a statement with the placeholders of the dialect.
+func (d Dialect) Rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+
+	var out strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			out.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		out.WriteRune(r)
+	}
+
+	return out.String()
+}
+
+func (d Dialect) configure(db *gosql.DB) {
+	if d.maxOpenConns > 0 {
+		db.SetMaxOpenConns(d.maxOpenConns)
+	}
+}
+
+func (d Dialect) schema() []string {
+	return []string{
+		`CREATE TABLE IF NOT EXISTS hobbyfarm_objects (
+			resource TEXT NOT NULL,
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			uid TEXT NOT NULL,
+			resource_version BIGINT NOT NULL,
+			object TEXT NOT NULL,
+			PRIMARY KEY (resource, namespace, name)
+		)`,
+		`CREATE INDEX IF NOT EXISTS hobbyfarm_objects_uid ON hobbyfarm_objects (uid)`,
+		`CREATE TABLE IF NOT EXISTS hobbyfarm_owners (
+			owner_uid TEXT NOT NULL,
+			resource TEXT NOT NULL,
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			PRIMARY KEY (owner_uid, resource, namespace, name)
+		)`,
+		`CREATE TABLE IF NOT EXISTS hobbyfarm_events (
+			id ` + d.serialID + `,
+			resource TEXT NOT NULL,
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			type TEXT NOT NULL,
+			object TEXT NOT NULL,
+			previous TEXT,
+			created BIGINT NOT NULL
+		)`,
+		`CREATE INDEX IF NOT EXISTS hobbyfarm_events_resource ON hobbyfarm_events (resource, id)`,
+		`CREATE TABLE IF NOT EXISTS hobbyfarm_meta (
+			name TEXT PRIMARY KEY,
+			value BIGINT NOT NULL
+		)`,
+	}
+}
@@ -0,0 +1,62 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/hobbyfarm/mink/pkg/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apiserver/pkg/storage"
+	"log/slog"
+	"sort"
+)
+
+// importer writes objects as they are, see Strategy.Import.
+type importer interface {
+	Import(ctx context.Context, obj types.Object) (types.Object, error)
+}
+
+// Migrate copies the objects of every resource of source into the storage of the same resource in target,
+// e.g. from the storages of the kubernetes backend into the storages of a Store. Objects keep their uid,
+// timestamps, status and finalizers, objects already in target are overwritten. It returns the number of
+// objects copied per resource.
+func Migrate(ctx context.Context, source, target map[string]strategy.CompleteStrategy) (map[string]int, error) {
+	resources := make([]string, 0, len(source))
+	for resource := range source {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	counts := make(map[string]int, len(resources))
+	for _, resource := range resources {
+		to, ok := target[resource].(importer)
+		if !ok {
+			return counts, fmt.Errorf("target storage of %s does not support imports", resource)
+		}
+
+		list, err := source[resource].List(ctx, "", storage.ListOptions{})
+		if err != nil {
+			return counts, fmt.Errorf("error listing %s: %v", resource, err)
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return counts, fmt.Errorf("error extracting %s: %v", resource, err)
+		}
+
+		for _, item := range items {
+			obj, ok := item.(types.Object)
+			if !ok {
+				return counts, fmt.Errorf("item of %s is not an object", resource)
+			}
+			if _, err := to.Import(ctx, obj); err != nil {
+				return counts, fmt.Errorf("error importing %s %s: %v", resource, obj.GetName(), err)
+			}
+			counts[resource]++
+		}
+
+		slog.Info("migrated resource", "resource", resource, "count", counts[resource])
+	}
+
+	return counts, nil
+}
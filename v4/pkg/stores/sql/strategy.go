@@ -0,0 +1,419 @@
+package sql
+
+import (
+	"context"
+	gosql "database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/hobbyfarm/mink/pkg/strategy"
+	"github.com/hobbyfarm/mink/pkg/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"reflect"
+)
+
+var _ strategy.CompleteStrategy = (*Strategy)(nil)
+
+const optimisticLockErrorMsg = "the object has been modified; please apply your changes to the latest version and try again"
+
+// Strategy stores the objects of a single resource in a Store.
+type Strategy struct {
+	store    *Store
+	resource string
+	gvk      schema.GroupVersionKind
+	obj      types.Object
+	list     types.ObjectList
+
+	// statusSubresource keeps the status out of creates and updates, it is only written by UpdateStatus
+	statusSubresource bool
+}
+
+// NewStrategy creates a strategy for the resource of obj. The kind of obj and of its list must be
+// registered in the scheme of the store.
+func NewStrategy(obj types.Object, store *Store, resource string) (*Strategy, error) {
+	gvks, _, err := store.scheme.ObjectKinds(obj)
+	if err != nil {
+		return nil, err
+	}
+	gvk := gvks[0]
+
+	list, err := store.scheme.New(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err != nil {
+		return nil, err
+	}
+	objList, ok := list.(types.ObjectList)
+	if !ok {
+		return nil, fmt.Errorf("list of %s is not an object list", gvk.Kind)
+	}
+
+	return &Strategy{
+		store:    store,
+		resource: resource,
+		gvk:      gvk,
+		obj:      obj,
+		list:     objList,
+	}, nil
+}
+
+// WithStatusSubresource makes the status of the resource a subresource, as it is for CRDs with status.
+func (s *Strategy) WithStatusSubresource() *Strategy {
+	s.statusSubresource = true
+	return s
+}
+
+func (s *Strategy) groupResource() schema.GroupResource {
+	return schema.GroupResource{Group: s.gvk.Group, Resource: s.resource}
+}
+
+func (s *Strategy) toUnstructured(obj types.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(s.gvk)
+
+	return u, nil
+}
+
+func (s *Strategy) fromUnstructured(u *unstructured.Unstructured) (types.Object, error) {
+	obj := s.New()
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj); err != nil {
+		return nil, err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(s.gvk)
+
+	return obj, nil
+}
+
+func (s *Strategy) New() types.Object {
+	return s.obj.DeepCopyObject().(types.Object)
+}
+
+func (s *Strategy) NewList() types.ObjectList {
+	return s.list.DeepCopyObject().(types.ObjectList)
+}
+
+func (s *Strategy) Scheme() *runtime.Scheme {
+	return s.store.scheme
+}
+
+func (s *Strategy) Destroy() {}
+
+func (s *Strategy) Create(ctx context.Context, object types.Object) (types.Object, error) {
+	obj, err := s.toUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.GetName() == "" {
+		if obj.GetGenerateName() == "" {
+			return nil, apierrors.NewBadRequest("name or generateName is required")
+		}
+		obj.SetName(names.SimpleNameGenerator.GenerateName(obj.GetGenerateName()))
+	}
+
+	obj.SetUID(uuid.NewUUID())
+	obj.SetCreationTimestamp(metav1.Now())
+	obj.SetGeneration(1)
+	obj.SetDeletionTimestamp(nil)
+	if s.statusSubresource {
+		unstructured.RemoveNestedField(obj.Object, "status")
+	}
+
+	err = s.store.write(ctx, func(tx *gosql.Tx) error {
+		current, err := s.store.get(ctx, tx, s.resource, obj.GetNamespace(), obj.GetName())
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			return apierrors.NewAlreadyExists(s.groupResource(), obj.GetName())
+		}
+
+		return s.store.record(ctx, tx, s.resource, watch.Added, obj, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fromUnstructured(obj)
+}
+
+func (s *Strategy) Get(ctx context.Context, namespace, name string) (types.Object, error) {
+	obj, err := s.store.get(ctx, s.store.db, s.resource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, apierrors.NewNotFound(s.groupResource(), name)
+	}
+
+	return s.fromUnstructured(obj)
+}
+
+func (s *Strategy) Update(ctx context.Context, object types.Object) (types.Object, error) {
+	return s.update(ctx, object, false)
+}
+
+func (s *Strategy) UpdateStatus(ctx context.Context, object types.Object) (types.Object, error) {
+	return s.update(ctx, object, true)
+}
+
+// setStatus copies the status of from into obj.
+func setStatus(obj *unstructured.Unstructured, from *unstructured.Unstructured) {
+	if status, ok := from.Object["status"]; ok {
+		obj.Object["status"] = status
+	} else {
+		delete(obj.Object, "status")
+	}
+}
+
+// content returns the fields of an object that make up its generation, everything but its metadata and status.
+func content(obj *unstructured.Unstructured) map[string]interface{} {
+	out := make(map[string]interface{}, len(obj.Object))
+	for k, v := range obj.Object {
+		if k != "metadata" && k != "status" {
+			out[k] = v
+		}
+	}
+
+	return out
+}
+
+func (s *Strategy) update(ctx context.Context, object types.Object, status bool) (types.Object, error) {
+	obj, err := s.toUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *unstructured.Unstructured
+	err = s.store.write(ctx, func(tx *gosql.Tx) error {
+		current, err := s.store.get(ctx, tx, s.resource, obj.GetNamespace(), obj.GetName())
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return apierrors.NewNotFound(s.groupResource(), obj.GetName())
+		}
+		if rv := obj.GetResourceVersion(); rv != "" && rv != current.GetResourceVersion() {
+			return apierrors.NewConflict(s.groupResource(), obj.GetName(), fmt.Errorf(optimisticLockErrorMsg))
+		}
+
+		if status {
+			updated = current.DeepCopy()
+			setStatus(updated, obj)
+		} else {
+			updated = obj.DeepCopy()
+			updated.SetUID(current.GetUID())
+			updated.SetCreationTimestamp(current.GetCreationTimestamp())
+			updated.SetDeletionTimestamp(current.GetDeletionTimestamp())
+			updated.SetGeneration(current.GetGeneration())
+			if s.statusSubresource {
+				setStatus(updated, current)
+			}
+			if !reflect.DeepEqual(content(current), content(updated)) {
+				updated.SetGeneration(current.GetGeneration() + 1)
+			}
+		}
+
+		// updates without changes do not get a new resource version
+		updated.SetResourceVersion(current.GetResourceVersion())
+		if reflect.DeepEqual(current.Object, updated.Object) {
+			return nil
+		}
+
+		return s.store.update(ctx, tx, s.resource, updated, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fromUnstructured(updated)
+}
+
+// Delete deletes the object, or marks it for deletion while it has finalizers. Objects owned by
+// the deleted object are deleted as well.
+func (s *Strategy) Delete(ctx context.Context, object types.Object) (types.Object, error) {
+	var deleted *unstructured.Unstructured
+	err := s.store.write(ctx, func(tx *gosql.Tx) error {
+		current, err := s.store.get(ctx, tx, s.resource, object.GetNamespace(), object.GetName())
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return apierrors.NewNotFound(s.groupResource(), object.GetName())
+		}
+
+		deleted = current
+		return s.store.delete(ctx, tx, s.resource, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fromUnstructured(deleted)
+}
+
+// Import writes an object as it is, keeping its uid, timestamps, status and finalizers. Only the
+// resource version is replaced. It is used to migrate objects from another storage.
+func (s *Strategy) Import(ctx context.Context, object types.Object) (types.Object, error) {
+	obj, err := s.toUnstructured(object)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.write(ctx, func(tx *gosql.Tx) error {
+		current, err := s.store.get(ctx, tx, s.resource, obj.GetNamespace(), obj.GetName())
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return s.store.record(ctx, tx, s.resource, watch.Added, obj, nil)
+		}
+
+		return s.store.record(ctx, tx, s.resource, watch.Modified, obj, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.fromUnstructured(obj)
+}
+
+// matcher returns whether objects are in the namespace and match the predicate. The predicate is
+// matched against the labels, name and namespace of objects unless it has its own attributes.
+func (s *Strategy) matcher(namespace string, predicate storage.SelectionPredicate) func(obj *unstructured.Unstructured) (bool, error) {
+	return func(obj *unstructured.Unstructured) (bool, error) {
+		if namespace != "" && obj.GetNamespace() != namespace {
+			return false, nil
+		}
+
+		if predicate.GetAttrs != nil {
+			typed, err := s.fromUnstructured(obj)
+			if err != nil {
+				return false, err
+			}
+
+			return predicate.Matches(typed)
+		}
+
+		if predicate.Label != nil && !predicate.Label.Matches(labels.Set(obj.GetLabels())) {
+			return false, nil
+		}
+
+		if predicate.Field != nil && !predicate.Field.Matches(fields.Set{
+			"metadata.name":      obj.GetName(),
+			"metadata.namespace": obj.GetNamespace(),
+		}) {
+			return false, nil
+		}
+
+		return true, nil
+	}
+}
+
+func encodeContinue(key objectKey) (string, error) {
+	data, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeContinue(token string) (objectKey, error) {
+	var key objectKey
+	if token == "" {
+		return key, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err == nil {
+		err = json.Unmarshal(data, &key)
+	}
+	if err != nil {
+		return key, apierrors.NewBadRequest("invalid continue token")
+	}
+
+	return key, nil
+}
+
+// List lists the objects matching the predicate. Pages of limited lists are read at the latest version
+// of the store, not at the version of the first page.
+func (s *Strategy) List(ctx context.Context, namespace string, opts storage.ListOptions) (types.ObjectList, error) {
+	after, err := decodeContinue(opts.Predicate.Continue)
+	if err != nil {
+		return nil, err
+	}
+
+	// objects written after reading the version are newer than the version of the list, which is fine
+	// for a list that is followed by a watch
+	version, err := s.store.currentVersion(ctx, s.store.db)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := s.matcher(namespace, opts.Predicate)
+	limit := int(opts.Predicate.Limit)
+	items := make([]runtime.Object, 0)
+	var next string
+
+	for {
+		objects, err := s.store.objects(ctx, s.resource, namespace, after, listBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, obj := range objects {
+			after = objectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+			ok, err := matches(obj)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			typed, err := s.fromUnstructured(obj)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, typed)
+
+			if limit > 0 && len(items) == limit {
+				if i < len(objects)-1 || len(objects) == listBatchSize {
+					if next, err = encodeContinue(after); err != nil {
+						return nil, err
+					}
+				}
+				break
+			}
+		}
+
+		if len(objects) < listBatchSize || (limit > 0 && len(items) == limit) {
+			break
+		}
+	}
+
+	list := s.NewList()
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	list.SetResourceVersion(fmt.Sprint(version))
+	list.SetContinue(next)
+
+	return list, nil
+}
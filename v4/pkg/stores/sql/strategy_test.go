@@ -0,0 +1,381 @@
+package sql
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testStrategies(t *testing.T) (*Store, *Strategy, *Strategy) {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v4alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := Open(SQLite, "file:"+filepath.Join(t.TempDir(), "hobbyfarm.db"), scheme)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+
+	if err := store.Migrate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	configMaps, err := NewStrategy(&v4alpha1.ConfigMap{}, store, "configmaps")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secrets, err := NewStrategy(&v4alpha1.Secret{}, store, "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return store, configMaps, secrets
+}
+
+func configMap(name string, labels map[string]string, data map[string]string) *v4alpha1.ConfigMap {
+	return &v4alpha1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Data:       data,
+	}
+}
+
+func listNames(t *testing.T, list runtime.Object) []string {
+	t.Helper()
+
+	cml, ok := list.(*v4alpha1.ConfigMapList)
+	if !ok {
+		t.Fatalf("expected *ConfigMapList, got %T", list)
+	}
+
+	out := make([]string, len(cml.Items))
+	for i, cm := range cml.Items {
+		out[i] = cm.Name
+	}
+
+	return out
+}
+
+func Test_CreateGetUpdate(t *testing.T) {
+	ctx := context.Background()
+	_, configMaps, _ := testStrategies(t)
+
+	created, err := configMaps.Create(ctx, configMap("settings", nil, map[string]string{"a": "1"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetUID() == "" || created.GetResourceVersion() == "" || created.GetCreationTimestamp().Time.IsZero() {
+		t.Errorf("expected uid, resource version and creation timestamp to be set, got %+v", created)
+	}
+
+	if _, err := configMaps.Create(ctx, configMap("settings", nil, nil)); !apierrors.IsAlreadyExists(err) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+
+	got, err := configMaps.Get(ctx, "", "settings")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm := got.(*v4alpha1.ConfigMap)
+	if cm.Data["a"] != "1" || cm.ResourceVersion != created.GetResourceVersion() {
+		t.Errorf("expected the created config map, got %+v", cm)
+	}
+
+	cm.Data["a"] = "2"
+	updated, err := configMaps.Update(ctx, cm.DeepCopy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.GetResourceVersion() == created.GetResourceVersion() {
+		t.Error("expected update to change the resource version")
+	}
+	if updated.GetGeneration() != 2 {
+		t.Errorf("expected generation 2, got %d", updated.GetGeneration())
+	}
+	if updated.GetUID() != created.GetUID() {
+		t.Error("expected update to keep the uid")
+	}
+
+	// cm still has the resource version it was read with
+	cm.Data["a"] = "3"
+	if _, err := configMaps.Update(ctx, cm); !apierrors.IsConflict(err) {
+		t.Errorf("expected conflict error, got %v", err)
+	}
+
+	unchanged, err := configMaps.Update(ctx, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unchanged.GetResourceVersion() != updated.GetResourceVersion() {
+		t.Error("expected update without changes to keep the resource version")
+	}
+
+	if _, err := configMaps.Get(ctx, "", "missing"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func Test_GenerateName(t *testing.T) {
+	ctx := context.Background()
+	_, configMaps, _ := testStrategies(t)
+
+	created, err := configMaps.Create(ctx, &v4alpha1.ConfigMap{ObjectMeta: metav1.ObjectMeta{GenerateName: "cm-"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(created.GetName()) <= len("cm-") {
+		t.Errorf("expected generated name, got %s", created.GetName())
+	}
+
+	if _, err := configMaps.Create(ctx, &v4alpha1.ConfigMap{}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected bad request error, got %v", err)
+	}
+}
+
+func Test_List(t *testing.T) {
+	ctx := context.Background()
+	_, configMaps, secrets := testStrategies(t)
+
+	for _, cm := range []*v4alpha1.ConfigMap{
+		configMap("d", map[string]string{"app": "web"}, nil),
+		configMap("a", map[string]string{"app": "web"}, nil),
+		configMap("c", map[string]string{"app": "db"}, nil),
+		configMap("b", map[string]string{"app": "web"}, nil),
+	} {
+		if _, err := configMaps.Create(ctx, cm); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := secrets.Create(ctx, &v4alpha1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "a"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := configMaps.List(ctx, "", storage.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := listNames(t, all); len(got) != 4 || got[0] != "a" || got[3] != "d" {
+		t.Errorf("expected a, b, c, d, got %v", got)
+	}
+
+	web := labels.SelectorFromSet(labels.Set{"app": "web"})
+	first, err := configMaps.List(ctx, "", storage.ListOptions{
+		Predicate: storage.SelectionPredicate{Label: web, Limit: 2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := listNames(t, first); len(got) != 2 || got[0] != "a" || got[1] != "b" {
+		t.Errorf("expected a, b, got %v", got)
+	}
+	if first.GetContinue() == "" {
+		t.Fatal("expected continue token")
+	}
+
+	second, err := configMaps.List(ctx, "", storage.ListOptions{
+		Predicate: storage.SelectionPredicate{Label: web, Limit: 2, Continue: first.GetContinue()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := listNames(t, second); len(got) != 1 || got[0] != "d" {
+		t.Errorf("expected d, got %v", got)
+	}
+	if second.GetContinue() != "" {
+		t.Errorf("expected no continue token on the last page, got %s", second.GetContinue())
+	}
+
+	if _, err := configMaps.List(ctx, "", storage.ListOptions{
+		Predicate: storage.SelectionPredicate{Continue: "!"},
+	}); !apierrors.IsBadRequest(err) {
+		t.Errorf("expected bad request error, got %v", err)
+	}
+}
+
+func nextEvent(t *testing.T, events <-chan watch.Event) watch.Event {
+	t.Helper()
+
+	select {
+	case e, ok := <-events:
+		if !ok {
+			t.Fatal("watch closed")
+		}
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+
+	return watch.Event{}
+}
+
+func Test_Watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, configMaps, _ := testStrategies(t)
+
+	if _, err := configMaps.Create(ctx, configMap("existing", map[string]string{"app": "web"}, nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	web := labels.SelectorFromSet(labels.Set{"app": "web"})
+	events, err := configMaps.Watch(ctx, "", storage.ListOptions{
+		Predicate: storage.SelectionPredicate{Label: web},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e := nextEvent(t, events); e.Type != watch.Added || e.Object.(*v4alpha1.ConfigMap).Name != "existing" {
+		t.Errorf("expected ADDED existing, got %s %v", e.Type, e.Object)
+	}
+
+	if _, err := configMaps.Create(ctx, configMap("other", map[string]string{"app": "db"}, nil)); err != nil {
+		t.Fatal(err)
+	}
+	created, err := configMaps.Create(ctx, configMap("new", map[string]string{"app": "web"}, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := nextEvent(t, events); e.Type != watch.Added || e.Object.(*v4alpha1.ConfigMap).Name != "new" {
+		t.Errorf("expected ADDED new, got %s %v", e.Type, e.Object)
+	}
+
+	cm := created.(*v4alpha1.ConfigMap)
+	cm.Labels["app"] = "db"
+	if _, err := configMaps.Update(ctx, cm); err != nil {
+		t.Fatal(err)
+	}
+	if e := nextEvent(t, events); e.Type != watch.Deleted || e.Object.(*v4alpha1.ConfigMap).Name != "new" {
+		t.Errorf("expected DELETED new when it stops matching, got %s %v", e.Type, e.Object)
+	}
+
+	existing, err := configMaps.Get(ctx, "", "existing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := configMaps.Delete(ctx, existing); err != nil {
+		t.Fatal(err)
+	}
+	e := nextEvent(t, events)
+	if e.Type != watch.Deleted || e.Object.(*v4alpha1.ConfigMap).Name != "existing" {
+		t.Errorf("expected DELETED existing, got %s %v", e.Type, e.Object)
+	}
+
+	// resuming from the resource version of the last event does not repeat it
+	resumed, err := configMaps.Watch(ctx, "", storage.ListOptions{
+		ResourceVersion: e.Object.(*v4alpha1.ConfigMap).ResourceVersion,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := configMaps.Create(ctx, configMap("last", nil, nil)); err != nil {
+		t.Fatal(err)
+	}
+	if e := nextEvent(t, resumed); e.Type != watch.Added || e.Object.(*v4alpha1.ConfigMap).Name != "last" {
+		t.Errorf("expected ADDED last, got %s %v", e.Type, e.Object)
+	}
+}
+
+func Test_WatchExpired(t *testing.T) {
+	ctx := context.Background()
+	store, configMaps, _ := testStrategies(t)
+	store.EventRetention = 0
+
+	first, err := configMaps.Create(ctx, configMap("a", nil, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"b", "c"} {
+		if _, err := configMaps.Create(ctx, configMap(name, nil, nil)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Compact(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = configMaps.Watch(ctx, "", storage.ListOptions{ResourceVersion: first.GetResourceVersion()})
+	if !apierrors.IsResourceExpired(err) {
+		t.Errorf("expected resource expired error, got %v", err)
+	}
+}
+
+func Test_DeleteFinalizersAndOwners(t *testing.T) {
+	ctx := context.Background()
+	_, configMaps, secrets := testStrategies(t)
+
+	owner, err := configMaps.Create(ctx, &v4alpha1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "owner", Finalizers: []string{"hobbyfarm.io/test"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := configMaps.Create(ctx, &v4alpha1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ownerReference := metav1.OwnerReference{
+		APIVersion: v4alpha1.SchemeGroupVersion.String(),
+		Kind:       "ConfigMap",
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+	}
+	if _, err := secrets.Create(ctx, &v4alpha1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name:            "dependent",
+		OwnerReferences: []metav1.OwnerReference{ownerReference},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := secrets.Create(ctx, &v4alpha1.Secret{ObjectMeta: metav1.ObjectMeta{
+		Name: "shared",
+		OwnerReferences: []metav1.OwnerReference{ownerReference, {
+			APIVersion: v4alpha1.SchemeGroupVersion.String(),
+			Kind:       "ConfigMap",
+			Name:       other.GetName(),
+			UID:        other.GetUID(),
+		}},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the finalizer keeps the owner
+	deleting, err := configMaps.Delete(ctx, owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleting.GetDeletionTimestamp() == nil {
+		t.Fatal("expected deletion timestamp to be set")
+	}
+	if _, err := secrets.Get(ctx, "", "dependent"); err != nil {
+		t.Errorf("expected dependent to exist while the owner is finalized, got %v", err)
+	}
+
+	deleting.SetFinalizers(nil)
+	if _, err := configMaps.Update(ctx, deleting); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := configMaps.Get(ctx, "", "owner"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected owner to be deleted once finalized, got %v", err)
+	}
+	if _, err := secrets.Get(ctx, "", "dependent"); !apierrors.IsNotFound(err) {
+		t.Errorf("expected dependent to be deleted with its owner, got %v", err)
+	}
+	if _, err := secrets.Get(ctx, "", "shared"); err != nil {
+		t.Errorf("expected dependent with another owner to be kept, got %v", err)
+	}
+}
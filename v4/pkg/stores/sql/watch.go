@@ -0,0 +1,213 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/storage"
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// watchBatchSize is the number of events read at once by a watch
+const watchBatchSize = 100
+
+// Watch sends the changes of the objects matching the predicate after the resource version of the options.
+// Without resource version, or with resource version "0", the watch starts with an ADDED event for every
+// matching object. Objects that stop or start matching the predicate are sent as DELETED or ADDED.
+func (s *Strategy) Watch(ctx context.Context, namespace string, opts storage.ListOptions) (<-chan watch.Event, error) {
+	matches := s.matcher(namespace, opts.Predicate)
+
+	var since int64
+	var initial []watch.Event
+	switch opts.ResourceVersion {
+	case "", "0":
+		var err error
+		if since, err = s.store.currentVersion(ctx, s.store.db); err != nil {
+			return nil, err
+		}
+		if initial, err = s.snapshot(ctx, namespace, matches); err != nil {
+			return nil, err
+		}
+	default:
+		var err error
+		if since, err = strconv.ParseInt(opts.ResourceVersion, 10, 64); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid resource version %q", opts.ResourceVersion))
+		}
+		compacted, err := s.store.compacted(ctx, s.store.db)
+		if err != nil {
+			return nil, err
+		}
+		if since < compacted {
+			return nil, s.expired(since)
+		}
+	}
+
+	result := make(chan watch.Event)
+	go func() {
+		defer close(result)
+
+		for _, e := range initial {
+			if !s.send(ctx, result, e) {
+				return
+			}
+		}
+
+		s.follow(ctx, result, since, matches)
+	}()
+
+	return result, nil
+}
+
+func (s *Strategy) expired(version int64) *apierrors.StatusError {
+	return apierrors.NewResourceExpired(fmt.Sprintf("resource version %d of %s is too old", version, s.resource))
+}
+
+// snapshot returns an ADDED event for every object matching the predicate.
+func (s *Strategy) snapshot(ctx context.Context, namespace string, matches func(*unstructured.Unstructured) (bool, error)) ([]watch.Event, error) {
+	events := make([]watch.Event, 0)
+	after := objectKey{}
+
+	for {
+		objects, err := s.store.objects(ctx, s.resource, namespace, after, listBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, obj := range objects {
+			after = objectKey{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+
+			ok, err := matches(obj)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				continue
+			}
+
+			typed, err := s.fromUnstructured(obj)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, watch.Event{Type: watch.Added, Object: typed})
+		}
+
+		if len(objects) < listBatchSize {
+			return events, nil
+		}
+	}
+}
+
+func (s *Strategy) send(ctx context.Context, result chan<- watch.Event, e watch.Event) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case result <- e:
+		return true
+	}
+}
+
+// follow sends the events after the given resource version until the context is done. Writes of this
+// process wake the watch right away, writes of other processes are picked up with the poll interval.
+func (s *Strategy) follow(ctx context.Context, result chan<- watch.Event, since int64, matches func(*unstructured.Unstructured) (bool, error)) {
+	ticker := time.NewTicker(s.store.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		// take the channel before reading, so writes during the read are not missed
+		changed := s.store.changes()
+
+		for {
+			events, err := s.store.events(ctx, s.resource, since, watchBatchSize)
+			if err != nil {
+				s.fail(ctx, result, apierrors.NewInternalError(err))
+				return
+			}
+
+			// compacted events between since and the read events would be missing from the watch
+			compacted, err := s.store.compacted(ctx, s.store.db)
+			if err != nil {
+				s.fail(ctx, result, apierrors.NewInternalError(err))
+				return
+			}
+			if since < compacted {
+				s.fail(ctx, result, s.expired(since))
+				return
+			}
+
+			for _, e := range events {
+				since = e.id
+
+				out, ok, err := s.translate(e, matches)
+				if err != nil {
+					s.fail(ctx, result, apierrors.NewInternalError(err))
+					return
+				}
+				if ok && !s.send(ctx, result, out) {
+					return
+				}
+			}
+
+			if len(events) < watchBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
+
+// translate turns a stored event into the event of the watch, based on whether the object matched the
+// predicate before and after the write.
+func (s *Strategy) translate(e event, matches func(*unstructured.Unstructured) (bool, error)) (watch.Event, bool, error) {
+	now, err := matches(e.object)
+	if err != nil {
+		return watch.Event{}, false, err
+	}
+	before := false
+	if e.previous != nil {
+		if before, err = matches(e.previous); err != nil {
+			return watch.Event{}, false, err
+		}
+	}
+
+	var eventType watch.EventType
+	obj := e.object
+	switch {
+	case e.eventType == watch.Deleted && now:
+		eventType = watch.Deleted
+	case e.eventType == watch.Deleted:
+		return watch.Event{}, false, nil
+	case now && before:
+		eventType = watch.Modified
+	case now:
+		eventType = watch.Added
+	case before:
+		// objects that stop matching are sent with their new state, as the kubernetes apiserver does
+		eventType = watch.Deleted
+	default:
+		return watch.Event{}, false, nil
+	}
+
+	typed, err := s.fromUnstructured(obj)
+	if err != nil {
+		return watch.Event{}, false, err
+	}
+
+	return watch.Event{Type: eventType, Object: typed}, true, nil
+}
+
+func (s *Strategy) fail(ctx context.Context, result chan<- watch.Event, err apierrors.APIStatus) {
+	slog.Debug("sql watch ended", "resource", s.resource, "error", err.Status().Message)
+
+	status := err.Status()
+	s.send(ctx, result, watch.Event{Type: watch.Error, Object: &status})
+}
@@ -0,0 +1,193 @@
+package testserver
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/hobbyfarm/gargantua/v4/pkg/authentication/user"
+	"github.com/hobbyfarm/gargantua/v4/pkg/certs"
+	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
+	hfserver "github.com/hobbyfarm/gargantua/v4/pkg/server"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/memory"
+	"k8s.io/client-go/rest"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// readyTimeout is the time the server has to become ready
+const readyTimeout = 30 * time.Second
+
+// Server is a hobbyfarm-api server running in the test process. It keeps all resources in memory
+// and is stopped when the test ends.
+type Server struct {
+	// Config connects to the server as a member of the superuser group
+	Config *rest.Config
+
+	// Store holds the resources of the server
+	Store *memory.Store
+
+	host   string
+	caCert *x509.Certificate
+	caKey  *rsa.PrivateKey
+}
+
+// Start starts a server for the test and waits until it is ready.
+func Start(t testing.TB) *Server {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	s, err := start(ctx, t.TempDir())
+	if err != nil {
+		t.Fatalf("error starting hobbyfarm-api test server: %v", err)
+	}
+
+	return s
+}
+
+func start(ctx context.Context, dir string) (*Server, error) {
+	caPem, caKeyPem, err := certs.GenerateHFCACertificate()
+	if err != nil {
+		return nil, err
+	}
+
+	caBundle := filepath.Join(dir, "hf-ca-cert.pem")
+	if err := os.WriteFile(caBundle, caPem, 0600); err != nil {
+		return nil, err
+	}
+
+	certBlock, _ := pem.Decode(caPem)
+	keyBlock, _ := pem.Decode(caKeyPem)
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	httpPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+	httpsPort, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	store := memory.NewStore(scheme.Scheme)
+	svr, err := hfserver.NewMemoryServer(ctx, &hfserver.MemoryServerConfig{
+		Store:           store,
+		CACertBundle:    caBundle,
+		HTTPListenPort:  httpPort,
+		HTTPSListenPort: httpsPort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svr.Run(ctx); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		Store:  store,
+		host:   "https://127.0.0.1:" + strconv.Itoa(httpsPort),
+		caCert: caCert,
+		caKey:  caKey,
+	}
+
+	if s.Config, err = s.ConfigFor("test:admin", user.SuperuserGroup); err != nil {
+		return nil, err
+	}
+
+	if err := s.waitReady(ctx); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ConfigFor returns a config that connects to the server as the given user, authenticated by a client
+// certificate. Users that are not in the superuser group only get the permissions of their role bindings.
+func (s *Server) ConfigFor(username string, groups ...string) (*rest.Config, error) {
+	cert, key, err := certs.SignAuthCertificate(username, groups, s.caCert, s.caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rest.Config{
+		Host: s.host,
+		TLSClientConfig: rest.TLSClientConfig{
+			// the serving certificate of the server is self-signed
+			Insecure: true,
+			CertData: cert,
+			KeyData:  key,
+		},
+	}, nil
+}
+
+// Client returns a client that connects to the server as a member of the superuser group.
+func (s *Server) Client(t testing.TB) client.WithWatch {
+	t.Helper()
+
+	c, err := client.NewWithWatch(s.Config, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		t.Fatalf("error building client for hobbyfarm-api test server: %v", err)
+	}
+
+	return c
+}
+
+func (s *Server) waitReady(ctx context.Context) error {
+	httpClient, err := rest.HTTPClientFor(s.Config)
+	if err != nil {
+		return err
+	}
+
+	deadline := time.Now().Add(readyTimeout)
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.host+"/readyz", nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := httpClient.Do(req)
+		if err == nil {
+			_ = resp.Body.Close()
+			if resp.StatusCode == http.StatusOK {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("server did not become ready within %s", readyTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
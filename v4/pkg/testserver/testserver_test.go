@@ -0,0 +1,43 @@
+package testserver
+
+import (
+	"context"
+	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
+	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"testing"
+)
+
+func Test_Server(t *testing.T) {
+	ctx := context.Background()
+	s := Start(t)
+	c := s.Client(t)
+
+	scope := &v4alpha1.Scope{ObjectMeta: metav1.ObjectMeta{Name: "test"}, DisplayName: "Test"}
+	if err := c.Create(ctx, scope); err != nil {
+		t.Fatal(err)
+	}
+
+	got := &v4alpha1.Scope{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "test"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.DisplayName != "Test" {
+		t.Errorf("expected display name Test, got %s", got.DisplayName)
+	}
+
+	// users without role bindings are not allowed to do anything
+	cfg, err := s.ConfigFor("nobody")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unprivileged, err := client.New(cfg, client.Options{Scheme: scheme.Scheme})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := unprivileged.Get(ctx, client.ObjectKey{Name: "test"}, &v4alpha1.Scope{}); !apierrors.IsForbidden(err) {
+		t.Errorf("expected forbidden error, got %v", err)
+	}
+}
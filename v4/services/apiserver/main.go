@@ -4,8 +4,12 @@ import (
 	"fmt"
 	"github.com/ebauman/crder"
 	"github.com/hobbyfarm/gargantua/v4/pkg/crd"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventarchive"
 	"github.com/hobbyfarm/gargantua/v4/pkg/scheme"
 	server2 "github.com/hobbyfarm/gargantua/v4/pkg/server"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/memory"
+	"github.com/hobbyfarm/gargantua/v4/pkg/stores/sql"
+	mink "github.com/hobbyfarm/mink/pkg/server"
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/tools/clientcmd"
 	"log"
@@ -20,6 +24,10 @@ var (
 	skipcrdinstall bool
 	namespace      string
 	caCert         string
+	storageBackend string
+	sqlDialect     string
+	sqlDSN         string
+	archiveOptions eventarchive.Options
 )
 
 // TODO - These flags have been converted to Viper using v4/config, check there and replace here as necessary
@@ -29,6 +37,12 @@ func init() {
 	rootCmd.Flags().BoolVar(&skipcrdinstall, "skip-crd-installation", false, "skip installation of CRDs into remote cluster")
 	rootCmd.Flags().StringVar(&namespace, "namespace", "hobbyfarm", "namespace in which to store objects in remote cluster")
 	rootCmd.Flags().StringVar(&caCert, "ca-certificate", "", "path to CA certificate")
+	rootCmd.Flags().StringVar(&storageBackend, "storage", "kubernetes", "storage backend, kubernetes, sql or memory")
+	rootCmd.Flags().StringVar(&sqlDialect, "sql-dialect", "postgres", "sql dialect when using sql storage, postgres or sqlite")
+	rootCmd.Flags().StringVar(&sqlDSN, "sql-dsn", "", "data source name of the database when using sql storage")
+	rootCmd.Flags().StringVar(&archiveOptions.Dir, "event-archive-dir", "", "directory of the event archive merged into the event history")
+	rootCmd.Flags().StringVar(&archiveOptions.SQLDialect, "event-archive-sql-dialect", "", "sql dialect of the event archive merged into the event history, postgres or sqlite")
+	rootCmd.Flags().StringVar(&archiveOptions.SQLDSN, "event-archive-sql-dsn", "", "data source name of the event archive database")
 }
 
 var rootCmd = &cobra.Command{
@@ -38,25 +52,60 @@ var rootCmd = &cobra.Command{
 }
 
 func app(cmd *cobra.Command, args []string) error {
+	th := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
+	slog.SetDefault(slog.New(th))
+
+	archive, err := eventarchive.Open(cmd.Context(), archiveOptions)
+	if err != nil {
+		return fmt.Errorf("could not open event archive: %v", err.Error())
+	}
+
+	var server *mink.Server
+	switch storageBackend {
+	case "kubernetes":
+		server, err = kubernetesServer(cmd, archive)
+	case "sql":
+		server, err = sqlServer(cmd, archive)
+	case "memory":
+		slog.Warn("using memory storage, resources are lost when the apiserver stops")
+		server, err = server2.NewMemoryServer(cmd.Context(), &server2.MemoryServerConfig{
+			Store:        memory.NewStore(scheme.Scheme),
+			CACertBundle: caCert,
+			EventArchive: archive,
+		})
+	default:
+		return fmt.Errorf("unsupported storage %s, supported are kubernetes, sql and memory", storageBackend)
+	}
+	if err != nil {
+		return fmt.Errorf("could not build server: %v", err.Error())
+	}
+
+	if err := server.Run(cmd.Context()); err != nil {
+		return err
+	}
+
+	<-cmd.Context().Done()
+
+	return cmd.Context().Err()
+}
+
+func kubernetesServer(cmd *cobra.Command, archive eventarchive.Archive) (*mink.Server, error) {
 	cfg, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
 	if err != nil {
-		return fmt.Errorf("could not connect to kubernetes cluster: %v", err.Error())
+		return nil, fmt.Errorf("could not connect to kubernetes cluster: %v", err.Error())
 	}
 
 	kClient, err := client.NewWithWatch(cfg, client.Options{
 		Scheme: scheme.Scheme,
 	})
 	if err != nil {
-		return fmt.Errorf("could not build client: %v", err.Error())
+		return nil, fmt.Errorf("could not build client: %v", err.Error())
 	}
 
-	th := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: slog.LevelDebug})
-	slog.SetDefault(slog.New(th))
-
 	if !skipcrdinstall {
 		crds := crd.GenerateCRDs()
 		if err := crder.InstallUpdateCRDs(cfg, crds...); err != nil {
-			return fmt.Errorf("error installing/updating crds: %v", err.Error())
+			return nil, fmt.Errorf("error installing/updating crds: %v", err.Error())
 		}
 	}
 
@@ -64,20 +113,34 @@ func app(cmd *cobra.Command, args []string) error {
 		Client:                kClient,
 		ForceStorageNamespace: namespace,
 		CACertBundle:          caCert,
+		EventArchive:          archive,
 	}
 
-	server, err := server2.NewKubernetesServer(cmd.Context(), &kcc)
+	return server2.NewKubernetesServer(cmd.Context(), &kcc)
+}
+
+func sqlServer(cmd *cobra.Command, archive eventarchive.Archive) (*mink.Server, error) {
+	dialect, err := sql.DialectFor(sqlDialect)
 	if err != nil {
-		return fmt.Errorf("could not build server: %v", err.Error())
+		return nil, err
 	}
 
-	if err := server.Run(cmd.Context()); err != nil {
-		return err
+	store, err := sql.Open(dialect, sqlDSN, scheme.Scheme)
+	if err != nil {
+		return nil, err
 	}
 
-	<-cmd.Context().Done()
+	if err := store.Migrate(cmd.Context()); err != nil {
+		return nil, err
+	}
 
-	return cmd.Context().Err()
+	scc := server2.SQLServerConfig{
+		Store:        store,
+		CACertBundle: caCert,
+		EventArchive: archive,
+	}
+
+	return server2.NewSQLServer(cmd.Context(), &scc)
 }
 
 func main() {
@@ -6,9 +6,19 @@ import (
 	"github.com/hobbyfarm/gargantua/v4/pkg/apis/hobbyfarm.io/v4alpha1"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/accesscode"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/authentication/providers/ldap"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/authentication/providers/oidc"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/event"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/machine"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/machineclaim"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/machineset"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/otac"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/scheduledevent"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/serviceaccount"
+	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/session"
 	"github.com/hobbyfarm/gargantua/v4/pkg/controllers/user"
+	"github.com/hobbyfarm/gargantua/v4/pkg/eventarchive"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers"
+	"github.com/hobbyfarm/gargantua/v4/pkg/providers/fake"
 	"github.com/rancher/lasso/pkg/controller"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -20,6 +30,7 @@ import (
 	"os"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	runtimeMetrics "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"time"
 )
 
 var (
@@ -31,6 +42,11 @@ var (
 	caCert      string
 	server      string
 	logLevel    int
+
+	fakeProviders []string
+
+	eventTTL       time.Duration
+	archiveOptions eventarchive.Options
 )
 
 var rootCmd = &cobra.Command{
@@ -48,6 +64,20 @@ func init() {
 	rootCmd.Flags().StringVar(&kubecontext, "kubecontext", "default", "kubecontext")
 	rootCmd.Flags().StringVar(&namespace, "namespace", "hobbyfarm", "namespace in which to operate")
 	rootCmd.Flags().IntVar(&logLevel, "log-level", 4, "log level, valid values are ( -4 , 8 )")
+	rootCmd.Flags().StringSliceVar(&fakeProviders, "fake-providers", nil,
+		"names of providers whose machines are provisioned by the in-process fake driver, for testing")
+	rootCmd.Flags().DurationVar(&eventTTL, "event-ttl", event.DefaultTTL,
+		"time events are kept after the object they reference is gone")
+	rootCmd.Flags().StringVar(&archiveOptions.Dir, "event-archive-dir", "",
+		"directory in which expired events are archived as json lines")
+	rootCmd.Flags().Int64Var(&archiveOptions.MaxFileSize, "event-archive-max-file-size", 64<<20,
+		"size in bytes at which the event archive starts a new file")
+	rootCmd.Flags().IntVar(&archiveOptions.MaxFiles, "event-archive-max-files", 10,
+		"number of files the event archive keeps, 0 to keep all files")
+	rootCmd.Flags().StringVar(&archiveOptions.SQLDialect, "event-archive-sql-dialect", "",
+		"sql dialect of the database in which expired events are archived, postgres or sqlite")
+	rootCmd.Flags().StringVar(&archiveOptions.SQLDSN, "event-archive-sql-dsn", "",
+		"data source name of the database in which expired events are archived")
 }
 
 func app(cmd *cobra.Command, args []string) error {
@@ -90,6 +120,10 @@ func app(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("error registering ldap handlers: %s", err.Error())
 	}
 
+	if err := oidc.New(mgr); err != nil {
+		return fmt.Errorf("error registering oidc handlers: %s", err.Error())
+	}
+
 	if err := accesscode.New(mgr); err != nil {
 		return fmt.Errorf("error registering accesscode handlers: %s", err.Error())
 	}
@@ -98,6 +132,45 @@ func app(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("error registering otac handlers: %s", err.Error())
 	}
 
+	if err := machineset.New(mgr); err != nil {
+		return fmt.Errorf("error registering machineset handlers: %s", err.Error())
+	}
+
+	drivers := providers.NewRegistry()
+	for _, p := range fakeProviders {
+		if err := drivers.Register(p, fake.New()); err != nil {
+			return fmt.Errorf("error registering fake provider driver: %s", err.Error())
+		}
+	}
+
+	if err := machine.New(mgr, drivers); err != nil {
+		return fmt.Errorf("error registering machine handlers: %s", err.Error())
+	}
+
+	if err := machineclaim.New(mgr); err != nil {
+		return fmt.Errorf("error registering machineclaim handlers: %s", err.Error())
+	}
+
+	if err := scheduledevent.New(mgr); err != nil {
+		return fmt.Errorf("error registering scheduledevent handlers: %s", err.Error())
+	}
+
+	if err := session.New(mgr); err != nil {
+		return fmt.Errorf("error registering session handlers: %s", err.Error())
+	}
+
+	archive, err := eventarchive.Open(cmd.Context(), archiveOptions)
+	if err != nil {
+		return fmt.Errorf("error opening event archive: %s", err.Error())
+	}
+	if archive == nil {
+		slog.Warn("no event archive configured, expired events are deleted without being archived")
+	}
+
+	if err := event.New(mgr, archive, eventTTL); err != nil {
+		return fmt.Errorf("error registering event handlers: %s", err.Error())
+	}
+
 	if err := user.RegisterHandlers(factory); err != nil {
 		return fmt.Errorf("error registering users handlers: %s", err.Error())
 	}